@@ -8,6 +8,9 @@ import (
 	"time"
 
 	"github.com/chaitu35/costeasy/backend/app/config"
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/crypto/bcrypt"
@@ -169,57 +172,25 @@ func seedRolePermissions(ctx context.Context, db *pgxpool.Pool) error {
 	log.Println("✓ Role permissions seeded")
 	return nil
 }
+
+// seedGLAccounts provisions the demo organization's chart of accounts from the
+// trading template. Re-running the seed only adds accounts missing from the template.
 func seedGLAccounts(ctx context.Context, db *pgxpool.Pool) error {
 	log.Println("Seeding GL accounts...")
 
-	accounts := []struct {
-		code               string
-		name               string
-		accType            string
-		parentCode         *string
-		costCenterRequired bool
-	}{
-		{"AST-1000", "Cash", "ASSET", nil, false},
-		{"AST-1100", "Accounts Receivable", "ASSET", nil, false},
-		{"AST-1200", "Inventory", "ASSET", nil, true},
-		{"LIA-2000", "Accounts Payable", "LIABILITY", nil, false},
-		{"LIA-2100", "Accrued Expenses", "LIABILITY", nil, false},
-		{"EQU-3000", "Capital", "EQUITY", nil, false},
-		{"EQU-3100", "Retained Earnings", "EQUITY", nil, false},
-		{"REV-4000", "Sales Revenue", "REVENUE", nil, false},
-		{"REV-4100", "Service Revenue", "REVENUE", nil, false},
-		{"EXP-5000", "Cost of Goods Sold", "EXPENSE", nil, true},
-		{"EXP-6000", "Salaries Expense", "EXPENSE", nil, false},
-		{"EXP-6100", "Rent Expense", "EXPENSE", nil, false},
-		{"EXP-6200", "Utilities Expense", "EXPENSE", nil, false},
-	}
-
-	query := `
-        INSERT INTO gl_accounts (
-            id, code, name, type, parent_code, cost_center_required,
-            is_active, created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-        ON CONFLICT (code) DO NOTHING
-    `
+	templates := glservice.NewCOATemplateService(
+		glrepository.NewCOATemplateRepository(db),
+		glrepository.NewGLAccountRepository(db),
+	)
 
-	for _, acc := range accounts {
-		_, err := db.Exec(ctx, query,
-			uuid.New(),
-			acc.code,
-			acc.name,
-			acc.accType,
-			acc.parentCode,
-			acc.costCenterRequired,
-			true,
-			time.Now(),
-			time.Now(),
-		)
-		if err != nil {
-			return err
-		}
+	orgID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	app, err := templates.ApplyTemplate(ctx, orgID, gldomain.COATemplateTrading, uuid.Nil)
+	if err != nil {
+		return err
 	}
 
-	log.Println("✓ GL accounts seeded")
+	log.Printf("✓ GL accounts seeded from %s v%d (%d accounts, %d payroll mappings added)",
+		app.TemplateCode, app.Version, app.AccountsCreated, app.MappingsCreated)
 	return nil
 }
 
@@ -375,10 +346,70 @@ func seedPermissions(ctx context.Context, db *pgxpool.Pool) error {
 		{"gl", "accounts", "create", "Create Accounts", "Create new accounts"},
 		{"gl", "accounts", "edit", "Edit Accounts", "Edit existing accounts"},
 		{"gl", "accounts", "delete", "Delete Accounts", "Delete accounts"},
+		{"gl", "accounts", "restructure", "Merge and Move Accounts", "Merge accounts and move them within the chart of accounts"},
 		{"gl", "journal_entries", "view", "View Journal Entries", "View journal entries"},
 		{"gl", "journal_entries", "create", "Create Journal Entries", "Create journal entries"},
 		{"gl", "journal_entries", "edit", "Edit Journal Entries", "Edit journal entries"},
 		{"gl", "journal_entries", "delete", "Delete Journal Entries", "Delete journal entries"},
+		{"gl", "journal_entries", "post", "Post Journal Entries", "Post, void and reverse journal entries"},
+		{"gl", "journal_entries", "approve", "Approve Journal Entries", "Approve or reject journal entries submitted for approval"},
+		{"gl", "fiscal_periods", "view", "View Fiscal Periods", "View fiscal years and accounting periods"},
+		{"gl", "fiscal_periods", "create", "Create Fiscal Years", "Create fiscal years"},
+		{"gl", "fiscal_periods", "close", "Close Periods", "Soft-close and close accounting periods"},
+		{"gl", "fiscal_periods", "reopen", "Reopen Periods", "Reopen closed accounting periods"},
+		{"gl", "exchange_rates", "view", "View Exchange Rates", "View exchange rates"},
+		{"gl", "exchange_rates", "create", "Set Exchange Rates", "Create and update exchange rates"},
+		{"gl", "exchange_rates", "delete", "Delete Exchange Rates", "Delete exchange rates"},
+		{"gl", "fx_revaluations", "view", "View FX Revaluations", "View and preview unrealized FX revaluations"},
+		{"gl", "fx_revaluations", "run", "Run FX Revaluation", "Post unrealized FX revaluation entries"},
+		{"gl", "fx_revaluations", "edit", "Edit FX Revaluation Settings", "Set unrealized exchange gain and loss accounts"},
+		{"gl", "coa_templates", "view", "View Chart of Accounts Templates", "View industry chart of accounts templates"},
+		{"gl", "coa_templates", "apply", "Apply Chart of Accounts Templates", "Provision or upgrade a chart of accounts from a template"},
+		{"gl", "dimensions", "view", "View Dimensions", "View analytic dimensions and their values"},
+		{"gl", "dimensions", "create", "Create Dimensions", "Define analytic dimensions and add values"},
+		{"gl", "dimensions", "edit", "Edit Dimensions", "Rename, deactivate and set required account types for dimensions"},
+		{"gl", "recurring_journals", "view", "View Recurring Journals", "View recurring journal templates and their schedules"},
+		{"gl", "recurring_journals", "create", "Create Recurring Journals", "Create recurring journal templates"},
+		{"gl", "recurring_journals", "edit", "Edit Recurring Journals", "Edit, pause, resume and skip occurrences of recurring journals"},
+		{"gl", "recurring_journals", "generate", "Generate Recurring Journals", "Generate due recurring journal entries on demand"},
+		{"gl", "approval_rules", "view", "View Approval Rules", "View journal entry approval rules"},
+		{"gl", "approval_rules", "manage", "Manage Approval Rules", "Create, edit and deactivate journal entry approval rules"},
+		{"gl", "attachments", "view", "View Attachments", "View and download supporting documents on journal entries and imports"},
+		{"gl", "attachments", "upload", "Upload Attachments", "Attach supporting documents to journal entries"},
+		{"gl", "attachments", "delete", "Delete Attachments", "Remove attachments from draft journal entries"},
+		{"gl", "journal_sequences", "view", "View Journal Sequences", "View journal numbering sequences"},
+		{"gl", "journal_sequences", "manage", "Manage Journal Sequences", "Configure journal numbering prefixes and reset policies"},
+		{"gl", "opening_balances", "view", "View Opening Balances", "View and preview go-live opening balances"},
+		{"gl", "opening_balances", "post", "Post Opening Balances", "Post go-live opening balances as an OPENING entry"},
+		{"gl", "opening_balances", "reverse", "Reverse Opening Balances", "Reverse posted opening balances; grant to administrators only"},
+		{"gl", "imports", "view", "View Imports", "View import batches, download error reports and templates"},
+		{"gl", "imports", "create", "Run Imports", "Import charts of accounts and journal entries, and cancel running imports"},
+		{"gl", "imports", "rollback", "Roll Back Imports", "Roll back an import batch, deactivating the accounts or voiding the entries it created"},
+		{"gl", "reports", "view", "View Financial Reports", "View the trial balance, income statement, balance sheet and account statements"},
+
+		// Banking permissions
+		{"banking", "bank_accounts", "view", "View Bank Accounts", "View bank accounts, imported statements and their lines"},
+		{"banking", "bank_accounts", "manage", "Manage Bank Accounts", "Create, edit and deactivate bank accounts"},
+		{"banking", "bank_statements", "import", "Import Bank Statements", "Import CSV, OFX, MT940 and CAMT.053 bank statements"},
+		{"banking", "bank_reconciliations", "view", "View Bank Reconciliations", "View unmatched ledger lines and reconciliation reports"},
+		{"banking", "bank_reconciliations", "reconcile", "Reconcile Bank Accounts", "Match statement lines and post entries from them"},
+		{"banking", "bank_reconciliations", "lock", "Lock Bank Reconciliations", "Lock a reconciled period against further matching"},
+		{"banking", "bank_reconciliations", "unlock", "Unlock Bank Reconciliations", "Unlock a locked reconciliation; grant to administrators only"},
+
+		// Receivables permissions
+		{"receivables", "customers", "view", "View Customers", "View patients, insurers and corporate customers"},
+		{"receivables", "customers", "manage", "Manage Customers", "Create, edit and deactivate customers"},
+		{"receivables", "ar_invoices", "view", "View Sales Invoices", "View sales invoices and their allocations"},
+		{"receivables", "ar_invoices", "create", "Create Sales Invoices", "Create and post sales invoices"},
+		{"receivables", "ar_invoices", "void", "Void Sales Invoices", "Void posted sales invoices, reversing their entries"},
+		{"receivables", "ar_credit_notes", "view", "View Credit Notes", "View customer credit notes and their allocations"},
+		{"receivables", "ar_credit_notes", "create", "Create Credit Notes", "Create and post customer credit notes"},
+		{"receivables", "ar_credit_notes", "void", "Void Credit Notes", "Void posted credit notes, reversing their entries"},
+		{"receivables", "ar_receipts", "view", "View Receipts", "View customer receipts and their allocations"},
+		{"receivables", "ar_receipts", "create", "Create Receipts", "Record and post customer receipts"},
+		{"receivables", "ar_receipts", "void", "Void Receipts", "Void posted receipts, such as bounced cheques"},
+		{"receivables", "ar_allocations", "manage", "Manage Allocations", "Allocate receipts and credit notes to invoices, and remove allocations"},
+		{"receivables", "ar_reports", "view", "View Receivables Reports", "View the aged receivables report"},
 
 		// Auth permissions
 		{"auth", "users", "view", "View Users", "View user list"},
@@ -393,6 +424,10 @@ func seedPermissions(ctx context.Context, db *pgxpool.Pool) error {
 		// Settings permissions
 		{"settings", "organization", "view", "View Organization", "View organization settings"},
 		{"settings", "organization", "edit", "Edit Organization", "Edit organization settings"},
+
+		// Audit permissions
+		{"audit", "audit_logs", "view", "View Audit Logs", "View the audit trail of changes"},
+		{"audit", "audit_logs", "verify", "Verify Audit Logs", "Verify the integrity of the audit trail hash chain"},
 	}
 
 	query := `
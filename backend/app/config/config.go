@@ -21,6 +21,7 @@ type Config struct {
 	JWTRefreshExpiry time.Duration
 	Environment      string
 	TrustedProxies   []string
+	AttachmentsDir   string
 }
 
 func LoadConfig() *Config {
@@ -40,5 +41,13 @@ func LoadConfig() *Config {
 		JWTRefreshExpiry: 7 * 24 * time.Hour,
 		Environment:      os.Getenv("ENVIRONMENT"),
 		TrustedProxies:   []string{os.Getenv("TRUSTED_PROXIES")},
+		AttachmentsDir:   getEnvOrDefault("ATTACHMENTS_DIR", "./data/attachments"),
 	}
 }
+
+func getEnvOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
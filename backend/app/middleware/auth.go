@@ -60,9 +60,11 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 
 		// ✅ Build user context
 		userCtx := &contextx.UserContext{
-			UserID: userID,
-			Email:  claims.Email,
-			Roles:  claims.Roles,
+			UserID:    userID,
+			Email:     claims.Email,
+			Roles:     claims.Roles,
+			IPAddress: c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
 		}
 
 		// ✅ Store in both Gin and Request Context
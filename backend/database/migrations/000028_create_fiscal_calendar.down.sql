@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS accounting_period_events;
+DROP TABLE IF EXISTS accounting_periods;
+DROP TABLE IF EXISTS fiscal_years;
@@ -0,0 +1,57 @@
+-- ===============================================
+-- 000028_create_fiscal_calendar.up.sql
+-- Fiscal years, monthly accounting periods and period close history
+-- ===============================================
+
+CREATE TABLE IF NOT EXISTS fiscal_years (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name             VARCHAR(50) NOT NULL,
+    start_date       DATE NOT NULL,
+    end_date         DATE NOT NULL,
+    status           VARCHAR(20) NOT NULL DEFAULT 'OPEN', -- OPEN, SOFT_CLOSED, CLOSED
+    created_by       UUID NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_fiscal_year_dates CHECK (end_date > start_date),
+    CONSTRAINT check_fiscal_year_status CHECK (status IN ('OPEN', 'SOFT_CLOSED', 'CLOSED')),
+    UNIQUE (organization_id, name),
+    UNIQUE (organization_id, start_date)
+);
+
+CREATE TABLE IF NOT EXISTS accounting_periods (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fiscal_year_id   UUID NOT NULL REFERENCES fiscal_years(id) ON DELETE CASCADE,
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    period_number    INT NOT NULL,
+    name             VARCHAR(50) NOT NULL,
+    start_date       DATE NOT NULL,
+    end_date         DATE NOT NULL,
+    status           VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+    closed_by        UUID,
+    closed_at        TIMESTAMP,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_period_dates CHECK (end_date >= start_date),
+    CONSTRAINT check_period_status CHECK (status IN ('OPEN', 'SOFT_CLOSED', 'CLOSED')),
+    UNIQUE (fiscal_year_id, period_number)
+);
+
+-- Who closed and reopened each period
+CREATE TABLE IF NOT EXISTS accounting_period_events (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    period_id        UUID NOT NULL REFERENCES accounting_periods(id) ON DELETE CASCADE,
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    action           VARCHAR(20) NOT NULL, -- SOFT_CLOSE, CLOSE, REOPEN
+    from_status      VARCHAR(20) NOT NULL,
+    to_status        VARCHAR(20) NOT NULL,
+    reason           TEXT,
+    performed_by     UUID NOT NULL,
+    performed_at     TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_fiscal_years_org ON fiscal_years(organization_id);
+CREATE INDEX IF NOT EXISTS idx_accounting_periods_org_dates ON accounting_periods(organization_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_accounting_period_events_period ON accounting_period_events(period_id);
+
+COMMENT ON TABLE accounting_periods IS 'Monthly accounting periods; postings are refused unless the period is OPEN.';
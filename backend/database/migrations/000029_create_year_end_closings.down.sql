@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS fiscal_year_opening_balances;
+DROP TABLE IF EXISTS fiscal_year_closings;
+
+DROP INDEX IF EXISTS idx_journal_entries_journal_type;
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+ALTER TABLE journal_entries DROP COLUMN IF EXISTS journal_type;
@@ -0,0 +1,51 @@
+-- ===============================================
+-- 000029_create_year_end_closings.up.sql
+-- Journal types, year-end closes and opening balances brought forward
+-- ===============================================
+
+-- GENERAL for day-to-day entries, CLOSING for year-end closing entries
+ALTER TABLE journal_entries
+    ADD COLUMN IF NOT EXISTS journal_type VARCHAR(20) NOT NULL DEFAULT 'GENERAL';
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'CLOSING'));
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_journal_type ON journal_entries(organization_id, journal_type);
+
+CREATE TABLE IF NOT EXISTS fiscal_year_closings (
+    id                            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id               UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    fiscal_year_id                UUID NOT NULL REFERENCES fiscal_years(id) ON DELETE CASCADE,
+    next_fiscal_year_id           UUID NOT NULL REFERENCES fiscal_years(id),
+    retained_earnings_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    closing_entry_id              UUID REFERENCES journal_entries(id),
+    reversal_entry_id             UUID REFERENCES journal_entries(id),
+    net_income                    DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    status                        VARCHAR(20) NOT NULL DEFAULT 'ACTIVE', -- ACTIVE, REVERSED
+    closed_by                     UUID NOT NULL,
+    closed_at                     TIMESTAMP NOT NULL DEFAULT NOW(),
+    reversed_by                   UUID,
+    reversed_at                   TIMESTAMP,
+    reversal_reason               TEXT,
+    CONSTRAINT check_year_end_close_status CHECK (status IN ('ACTIVE', 'REVERSED'))
+);
+
+-- At most one close in effect per fiscal year (makes closing idempotent)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_fiscal_year_closings_active
+    ON fiscal_year_closings(fiscal_year_id) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS fiscal_year_opening_balances (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    fiscal_year_id     UUID NOT NULL REFERENCES fiscal_years(id) ON DELETE CASCADE,
+    organization_id    UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    account_id         UUID NOT NULL REFERENCES gl_accounts(id),
+    year_end_close_id  UUID NOT NULL REFERENCES fiscal_year_closings(id) ON DELETE CASCADE,
+    debit              DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    credit             DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    UNIQUE (fiscal_year_id, account_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fiscal_year_opening_balances_close ON fiscal_year_opening_balances(year_end_close_id);
+
+COMMENT ON TABLE fiscal_year_opening_balances IS 'Balance sheet balances brought forward by a year-end close; removed when the close is reversed.';
@@ -0,0 +1,9 @@
+ALTER TABLE journal_lines DROP CONSTRAINT IF EXISTS check_journal_line_currency;
+
+ALTER TABLE journal_lines
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS foreign_credit,
+    DROP COLUMN IF EXISTS foreign_debit,
+    DROP COLUMN IF EXISTS currency;
+
+DROP TABLE IF EXISTS gl_exchange_rates;
@@ -0,0 +1,41 @@
+-- ===============================================
+-- 000030_create_exchange_rates.up.sql
+-- Per-organization exchange rates and foreign currency journal lines
+-- ===============================================
+
+CREATE TABLE IF NOT EXISTS gl_exchange_rates (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    from_currency    VARCHAR(3) NOT NULL,
+    to_currency      VARCHAR(3) NOT NULL,
+    rate             NUMERIC(18, 8) NOT NULL,  -- to_currency units per from_currency unit
+    effective_date   DATE NOT NULL,
+    source           VARCHAR(20) NOT NULL DEFAULT 'MANUAL', -- MANUAL, IMPORT
+    created_by       UUID NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_exchange_rate_positive CHECK (rate > 0),
+    CONSTRAINT check_exchange_rate_currencies CHECK (from_currency <> to_currency),
+    CONSTRAINT check_exchange_rate_source CHECK (source IN ('MANUAL', 'IMPORT')),
+    -- One rate per pair per day; setting it again replaces it
+    CONSTRAINT uq_exchange_rate_pair_date UNIQUE (organization_id, from_currency, to_currency, effective_date)
+);
+
+-- Rate lookup: latest effective_date on or before a transaction date
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_lookup
+    ON gl_exchange_rates(organization_id, from_currency, to_currency, effective_date DESC);
+
+-- Foreign currency lines keep the entered amount; debit/credit stay in the base currency.
+-- currency and exchange_rate are NULL for base currency lines.
+ALTER TABLE journal_lines
+    ADD COLUMN IF NOT EXISTS currency       VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS foreign_debit  DECIMAL(15, 3) NOT NULL DEFAULT 0.000,
+    ADD COLUMN IF NOT EXISTS foreign_credit DECIMAL(15, 3) NOT NULL DEFAULT 0.000,
+    ADD COLUMN IF NOT EXISTS exchange_rate  NUMERIC(18, 8);
+
+ALTER TABLE journal_lines
+    ADD CONSTRAINT check_journal_line_currency
+    CHECK ((currency IS NULL) = (exchange_rate IS NULL));
+
+COMMENT ON TABLE gl_exchange_rates IS 'Per-organization exchange rates, effective from a date';
+COMMENT ON COLUMN journal_lines.currency IS 'Transaction currency, NULL when entered in the base currency';
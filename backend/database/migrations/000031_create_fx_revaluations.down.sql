@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS gl_fx_revaluation_lines;
+DROP TABLE IF EXISTS gl_fx_revaluations;
+DROP TABLE IF EXISTS gl_fx_revaluation_settings;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'CLOSING'));
@@ -0,0 +1,53 @@
+-- ===============================================
+-- 000031_create_fx_revaluations.up.sql
+-- Unrealized FX revaluation runs and their gain/loss accounts
+-- ===============================================
+
+-- REVALUATION for unrealized exchange gain/loss entries and their reversals
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'CLOSING', 'REVALUATION'));
+
+CREATE TABLE IF NOT EXISTS gl_fx_revaluation_settings (
+    organization_id  UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    gain_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    loss_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    updated_by       UUID NOT NULL,
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS gl_fx_revaluations (
+    id                    UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id       UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    as_of_date            DATE NOT NULL,
+    reversal_date         DATE NOT NULL,
+    base_currency         VARCHAR(3) NOT NULL,
+    gain_account_id       UUID NOT NULL REFERENCES gl_accounts(id),
+    loss_account_id       UUID NOT NULL REFERENCES gl_accounts(id),
+    revaluation_entry_id  UUID REFERENCES journal_entries(id),
+    reversal_entry_id     UUID REFERENCES journal_entries(id),
+    total_gain            DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    total_loss            DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    created_by            UUID NOT NULL,
+    created_at            TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_fx_revaluations_org_date ON gl_fx_revaluations(organization_id, as_of_date DESC);
+
+CREATE TABLE IF NOT EXISTS gl_fx_revaluation_lines (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    revaluation_id    UUID NOT NULL REFERENCES gl_fx_revaluations(id) ON DELETE CASCADE,
+    account_id        UUID NOT NULL REFERENCES gl_accounts(id),
+    currency          VARCHAR(3) NOT NULL,
+    foreign_balance   DECIMAL(15, 3) NOT NULL,
+    closing_rate      NUMERIC(18, 8) NOT NULL,
+    book_balance      DECIMAL(15, 2) NOT NULL,
+    revalued_balance  DECIMAL(15, 2) NOT NULL,
+    adjustment        DECIMAL(15, 2) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_fx_revaluation_lines_revaluation ON gl_fx_revaluation_lines(revaluation_id);
+
+COMMENT ON TABLE gl_fx_revaluations IS 'Period-end revaluations of monetary foreign currency balances; each entry is reversed on reversal_date.';
@@ -0,0 +1,5 @@
+ALTER TABLE gl_accounts DROP CONSTRAINT IF EXISTS uq_gl_accounts_org_code;
+
+ALTER TABLE gl_accounts ADD CONSTRAINT gl_accounts_code_key UNIQUE (code);
+
+ALTER TABLE gl_accounts DROP COLUMN IF EXISTS organization_id;
@@ -0,0 +1,49 @@
+-- ===============================================
+-- 000032_scope_accounts_to_organizations.up.sql
+-- Per-organization chart of accounts with codes unique per organization
+-- ===============================================
+
+ALTER TABLE gl_accounts
+    ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+
+-- Accounts already posted to belong to the organization that posted them
+UPDATE gl_accounts a
+SET organization_id = used.organization_id
+FROM (
+    SELECT DISTINCT ON (jl.account_id) jl.account_id, je.organization_id
+    FROM journal_lines jl
+    INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+    ORDER BY jl.account_id, je.created_at
+) used
+WHERE a.id = used.account_id
+  AND a.organization_id IS NULL;
+
+-- Remaining accounts go to the first organization created
+UPDATE gl_accounts
+SET organization_id = (SELECT id FROM organizations ORDER BY created_at LIMIT 1)
+WHERE organization_id IS NULL;
+
+ALTER TABLE gl_accounts ALTER COLUMN organization_id SET NOT NULL;
+
+-- Replace the global unique code with one per organization
+DO $$
+DECLARE
+    con RECORD;
+BEGIN
+    FOR con IN
+        SELECT c.conname
+        FROM pg_constraint c
+        INNER JOIN pg_attribute att ON att.attrelid = c.conrelid AND att.attnum = c.conkey[1]
+        WHERE c.conrelid = 'gl_accounts'::regclass
+          AND c.contype = 'u'
+          AND array_length(c.conkey, 1) = 1
+          AND att.attname = 'code'
+    LOOP
+        EXECUTE format('ALTER TABLE gl_accounts DROP CONSTRAINT %I', con.conname);
+    END LOOP;
+END $$;
+
+ALTER TABLE gl_accounts
+    ADD CONSTRAINT uq_gl_accounts_org_code UNIQUE (organization_id, code);
+
+COMMENT ON COLUMN gl_accounts.organization_id IS 'Owning organization; each organization keeps its own chart of accounts.';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS gl_account_merges;
+
+ALTER TABLE gl_accounts DROP COLUMN IF EXISTS is_postable;
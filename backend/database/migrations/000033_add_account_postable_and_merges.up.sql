@@ -0,0 +1,31 @@
+-- ===============================================
+-- 000033_add_account_postable_and_merges.up.sql
+-- Control account flag and account merge audit trail
+-- ===============================================
+
+-- FALSE for control accounts that only summarize their children
+ALTER TABLE gl_accounts
+    ADD COLUMN IF NOT EXISTS is_postable BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Existing parents that were never posted to become control accounts
+UPDATE gl_accounts a
+SET is_postable = FALSE
+WHERE EXISTS (SELECT 1 FROM gl_accounts c WHERE c.parent_code = a.id)
+  AND NOT EXISTS (SELECT 1 FROM journal_lines jl WHERE jl.account_id = a.id);
+
+CREATE TABLE IF NOT EXISTS gl_account_merges (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id    UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    source_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    source_code        VARCHAR(50) NOT NULL,
+    target_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    target_code        VARCHAR(50) NOT NULL,
+    lines_moved        BIGINT NOT NULL DEFAULT 0,
+    reason             TEXT NOT NULL,
+    merged_by          UUID NOT NULL,
+    merged_at          TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_account_merges_org ON gl_account_merges(organization_id, merged_at DESC);
+
+COMMENT ON TABLE gl_account_merges IS 'Audit trail of accounts merged into another; journal lines were re-pointed from source to target.';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS gl_coa_template_applications;
@@ -0,0 +1,20 @@
+-- ===============================================
+-- 000034_create_coa_template_applications.up.sql
+-- Industry chart of accounts template versions applied per organization
+-- ===============================================
+
+CREATE TABLE IF NOT EXISTS gl_coa_template_applications (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id   UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    template_code     VARCHAR(50) NOT NULL,
+    version           INTEGER NOT NULL CHECK (version > 0),
+    accounts_created  INTEGER NOT NULL DEFAULT 0,
+    mappings_created  INTEGER NOT NULL DEFAULT 0,
+    applied_by        UUID, -- NULL when provisioned on organization creation
+    applied_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_coa_template_applications_org
+    ON gl_coa_template_applications(organization_id, applied_at DESC);
+
+COMMENT ON TABLE gl_coa_template_applications IS 'Each provisioning or upgrade of an organization''s chart of accounts from a built-in industry template.';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_journal_lines_dimensions;
+ALTER TABLE journal_lines DROP COLUMN IF EXISTS dimensions;
+DROP TABLE IF EXISTS gl_dimension_values;
+DROP TABLE IF EXISTS gl_dimensions;
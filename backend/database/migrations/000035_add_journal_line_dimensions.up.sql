@@ -0,0 +1,36 @@
+-- ===============================================
+-- 000035_add_journal_line_dimensions.up.sql
+-- Analytic dimensions (cost center, department, project) on journal lines
+-- ===============================================
+
+CREATE TABLE IF NOT EXISTS gl_dimensions (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    code             VARCHAR(50) NOT NULL,
+    name             VARCHAR(255) NOT NULL,
+    required_for     TEXT[] NOT NULL DEFAULT '{}', -- Account types whose lines must carry a value
+    is_active        BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_gl_dimensions_org_code UNIQUE (organization_id, code)
+);
+
+CREATE TABLE IF NOT EXISTS gl_dimension_values (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dimension_id  UUID NOT NULL REFERENCES gl_dimensions(id) ON DELETE CASCADE,
+    code          VARCHAR(50) NOT NULL,
+    name          VARCHAR(255) NOT NULL,
+    is_active     BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_gl_dimension_values_code UNIQUE (dimension_id, code)
+);
+
+ALTER TABLE journal_lines
+    ADD COLUMN IF NOT EXISTS dimensions JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_journal_lines_dimensions
+    ON journal_lines USING GIN (dimensions jsonb_path_ops);
+
+COMMENT ON TABLE gl_dimensions IS 'Analytic dimensions an organization tags journal lines with.';
+COMMENT ON COLUMN journal_lines.dimensions IS 'Dimension code to value code, e.g. {"COST_CENTER": "CC-100"}.';
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS gl_recurring_journal_runs;
+DROP TABLE IF EXISTS gl_recurring_journal_lines;
+DROP TABLE IF EXISTS gl_recurring_journals;
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'CLOSING', 'REVALUATION'));
@@ -0,0 +1,61 @@
+-- ===============================================
+-- 000036_create_recurring_journals.up.sql
+-- Recurring journal templates, their schedules and generated occurrences
+-- ===============================================
+
+-- RECURRING for entries generated from recurring journal templates
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'CLOSING', 'REVALUATION', 'RECURRING'));
+
+CREATE TABLE IF NOT EXISTS gl_recurring_journals (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name             VARCHAR(255) NOT NULL,
+    description      VARCHAR(500) NOT NULL,
+    reference        VARCHAR(100) NOT NULL DEFAULT '',
+    frequency        VARCHAR(20) NOT NULL CHECK (frequency IN ('MONTHLY', 'QUARTERLY', 'SEMI_ANNUAL', 'ANNUAL')),
+    day_of_month     SMALLINT NOT NULL CHECK (day_of_month BETWEEN 1 AND 31),
+    start_date       DATE NOT NULL,
+    end_date         DATE,
+    auto_post        BOOLEAN NOT NULL DEFAULT FALSE,
+    status           VARCHAR(20) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'PAUSED', 'COMPLETED')),
+    next_run_date    DATE,
+    last_run_date    DATE,
+    created_by       UUID NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_recurring_journal_dates CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_recurring_journals_org ON gl_recurring_journals(organization_id);
+CREATE INDEX IF NOT EXISTS idx_gl_recurring_journals_due
+    ON gl_recurring_journals(next_run_date) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS gl_recurring_journal_lines (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id  UUID NOT NULL REFERENCES gl_recurring_journals(id) ON DELETE CASCADE,
+    line_number  INTEGER NOT NULL,
+    account_id   UUID NOT NULL REFERENCES gl_accounts(id),
+    description  VARCHAR(255) NOT NULL,
+    debit        DECIMAL(15,2) NOT NULL DEFAULT 0 CHECK (debit >= 0),
+    credit       DECIMAL(15,2) NOT NULL DEFAULT 0 CHECK (credit >= 0),
+    dimensions   JSONB NOT NULL DEFAULT '{}'::jsonb,
+    CONSTRAINT uq_gl_recurring_journal_lines_number UNIQUE (template_id, line_number)
+);
+
+-- One row per processed occurrence; the unique key stops an occurrence being generated twice
+CREATE TABLE IF NOT EXISTS gl_recurring_journal_runs (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id       UUID NOT NULL REFERENCES gl_recurring_journals(id) ON DELETE CASCADE,
+    occurrence_date   DATE NOT NULL,
+    status            VARCHAR(20) NOT NULL CHECK (status IN ('GENERATED', 'SKIPPED')),
+    journal_entry_id  UUID REFERENCES journal_entries(id) ON DELETE SET NULL,
+    created_by        UUID NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_gl_recurring_journal_runs_occurrence UNIQUE (template_id, occurrence_date)
+);
+
+COMMENT ON TABLE gl_recurring_journals IS 'Journal entries generated on a schedule, e.g. rent, depreciation, insurance amortisation.';
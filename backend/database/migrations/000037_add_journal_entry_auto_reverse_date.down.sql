@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_journal_entries_auto_reverse;
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_auto_reverse_date;
+ALTER TABLE journal_entries DROP COLUMN IF EXISTS auto_reverse_date;
@@ -0,0 +1,17 @@
+-- ===============================================
+-- 000037_add_journal_entry_auto_reverse_date.up.sql
+-- Auto-reversing accruals: posted entries reversed automatically on a date
+-- ===============================================
+
+ALTER TABLE journal_entries
+    ADD COLUMN IF NOT EXISTS auto_reverse_date DATE;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_auto_reverse_date
+    CHECK (auto_reverse_date IS NULL OR auto_reverse_date > transaction_date);
+
+-- The scheduler looks up posted entries whose auto-reverse date has arrived
+CREATE INDEX IF NOT EXISTS idx_journal_entries_auto_reverse
+    ON journal_entries(auto_reverse_date) WHERE status = 'POSTED' AND auto_reverse_date IS NOT NULL;
+
+COMMENT ON COLUMN journal_entries.auto_reverse_date IS 'When set, the posted entry is reversed automatically with a reversal dated on this date.';
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS journal_entry_approvals;
+DROP TABLE IF EXISTS gl_approval_rules;
+
+UPDATE journal_entries SET status = 'DRAFT' WHERE status = 'PENDING_APPROVAL';
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_status;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_status
+    CHECK (status IN ('DRAFT', 'POSTED', 'VOID', 'REVERSED'));
@@ -0,0 +1,41 @@
+-- ===============================================
+-- 000038_create_journal_entry_approvals.up.sql
+-- Maker-checker: approval rules and the approval history of journal entries
+-- ===============================================
+
+-- PENDING_APPROVAL for balanced entries waiting on an approver
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_status;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_status
+    CHECK (status IN ('DRAFT', 'PENDING_APPROVAL', 'POSTED', 'VOID', 'REVERSED'));
+
+-- An entry needs approval when it matches any active rule
+CREATE TABLE IF NOT EXISTS gl_approval_rules (
+    id                          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id             UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name                        VARCHAR(255) NOT NULL,
+    min_amount                  DECIMAL(15,2) NOT NULL DEFAULT 0 CHECK (min_amount >= 0),
+    account_ids                 UUID[] NOT NULL DEFAULT '{}',
+    require_different_approver  BOOLEAN NOT NULL DEFAULT TRUE,
+    is_active                   BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by                  UUID NOT NULL,
+    created_at                  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_approval_rules_org ON gl_approval_rules(organization_id);
+
+-- Append-only history of submissions, approvals and rejections
+CREATE TABLE IF NOT EXISTS journal_entry_approvals (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    journal_entry_id  UUID NOT NULL REFERENCES journal_entries(id) ON DELETE CASCADE,
+    action            VARCHAR(20) NOT NULL CHECK (action IN ('SUBMITTED', 'APPROVED', 'REJECTED')),
+    user_id           UUID NOT NULL,
+    comment           TEXT NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entry_approvals_entry ON journal_entry_approvals(journal_entry_id, created_at);
+
+COMMENT ON COLUMN gl_approval_rules.account_ids IS 'Empty matches every entry; otherwise the entry must touch one of these accounts.';
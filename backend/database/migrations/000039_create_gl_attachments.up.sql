@@ -0,0 +1,25 @@
+-- ===============================================
+-- 000039_create_gl_attachments.up.sql
+-- Supporting documents for journal entries and imports
+-- ===============================================
+
+-- Metadata only; the content lives in the blob store under storage_key.
+-- entity_id is a journal entry ID or an import log ID depending on entity_type.
+CREATE TABLE IF NOT EXISTS gl_attachments (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    entity_type      VARCHAR(20) NOT NULL CHECK (entity_type IN ('JOURNAL_ENTRY', 'IMPORT')),
+    entity_id        UUID NOT NULL,
+    file_name        VARCHAR(255) NOT NULL,
+    content_type     VARCHAR(100) NOT NULL,
+    size_bytes       BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum         CHAR(64) NOT NULL,
+    storage_key      VARCHAR(500) NOT NULL UNIQUE,
+    uploaded_by      UUID NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_attachments_entity ON gl_attachments(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_gl_attachments_org ON gl_attachments(organization_id);
+
+COMMENT ON COLUMN gl_attachments.checksum IS 'Hex SHA-256 of the content at upload; downloads are verified against it.';
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS audit_logs_no_truncate ON audit_logs;
+DROP TRIGGER IF EXISTS audit_logs_no_update_delete ON audit_logs;
+DROP FUNCTION IF EXISTS prevent_audit_log_change();
+DROP TABLE IF EXISTS audit_logs;
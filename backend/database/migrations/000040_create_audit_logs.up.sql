@@ -0,0 +1,47 @@
+-- ===============================================
+-- 000040_create_audit_logs.up.sql
+-- Append-only, hash-chained audit trail
+-- ===============================================
+
+-- Each row's hash covers its own fields and the previous row's hash, so any
+-- edit, deletion or reordering breaks the chain from that point on. Snapshots
+-- are JSON rather than JSONB to keep the exact bytes that were hashed.
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id               UUID PRIMARY KEY,
+    sequence         BIGINT NOT NULL UNIQUE CHECK (sequence > 0),
+    organization_id  UUID,
+    entity_type      VARCHAR(50) NOT NULL,
+    entity_id        UUID NOT NULL,
+    action           VARCHAR(50) NOT NULL,
+    user_id          UUID,
+    ip_address       VARCHAR(45),
+    user_agent       TEXT,
+    before_data      JSON,
+    after_data       JSON,
+    created_at       TIMESTAMPTZ NOT NULL,
+    prev_hash        CHAR(64) NOT NULL,
+    hash             CHAR(64) NOT NULL UNIQUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_entity ON audit_logs(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_user ON audit_logs(user_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_org ON audit_logs(organization_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs(created_at);
+
+-- Rows can only be inserted
+CREATE OR REPLACE FUNCTION prevent_audit_log_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only: % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_no_update_delete
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_audit_log_change();
+
+CREATE TRIGGER audit_logs_no_truncate
+    BEFORE TRUNCATE ON audit_logs
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION prevent_audit_log_change();
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS gl_journal_sequence_counters;
+DROP TABLE IF EXISTS gl_journal_sequences;
+
+UPDATE journal_entries
+SET journal_type = 'GENERAL'
+WHERE journal_type IN ('PAYROLL', 'BANK', 'SALES', 'PURCHASE', 'REVERSAL');
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'CLOSING', 'REVALUATION', 'RECURRING'));
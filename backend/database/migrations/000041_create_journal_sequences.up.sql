@@ -0,0 +1,37 @@
+-- ===============================================
+-- 000041_create_journal_sequences.up.sql
+-- Per-organization, per-journal-type entry numbering
+-- ===============================================
+
+-- PAYROLL, BANK, SALES, PURCHASE and REVERSAL journals, each numbered from its own sequence
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'PAYROLL', 'BANK', 'SALES', 'PURCHASE', 'REVERSAL',
+                            'CLOSING', 'REVALUATION', 'RECURRING'));
+
+-- Numbering configuration; journal types without a row use the built-in defaults
+CREATE TABLE IF NOT EXISTS gl_journal_sequences (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    journal_type     VARCHAR(20) NOT NULL,
+    prefix           VARCHAR(10) NOT NULL,
+    reset_policy     VARCHAR(10) NOT NULL CHECK (reset_policy IN ('YEARLY', 'MONTHLY', 'NEVER')),
+    padding          INT NOT NULL DEFAULT 5 CHECK (padding BETWEEN 1 AND 10),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT unique_journal_sequence UNIQUE (organization_id, journal_type)
+);
+
+-- Last number allocated per period: '2026' (yearly), '2026-10' (monthly) or '' (never resets).
+-- Incremented inside the posting transaction; the row lock serializes concurrent
+-- postings and a rollback returns the number, so posted numbers have no gaps.
+CREATE TABLE IF NOT EXISTS gl_journal_sequence_counters (
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    journal_type     VARCHAR(20) NOT NULL,
+    period_key       VARCHAR(7) NOT NULL,
+    last_number      BIGINT NOT NULL CHECK (last_number > 0),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, journal_type, period_key)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_journal_entries_import_log;
+ALTER TABLE journal_entries DROP COLUMN IF EXISTS import_log_id;
@@ -0,0 +1,13 @@
+-- ===============================================
+-- 000042_add_journal_entry_import_log.up.sql
+-- Link journal entries created by a file import to the import run
+-- ===============================================
+
+ALTER TABLE journal_entries
+    ADD COLUMN IF NOT EXISTS import_log_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_import_log
+    ON journal_entries(organization_id, import_log_id)
+    WHERE import_log_id IS NOT NULL;
+
+COMMENT ON COLUMN journal_entries.import_log_id IS 'Import run that created the entry (ImportResult.import_log_id); NULL for entries keyed in by hand.';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS gl_import_errors;
+DROP TABLE IF EXISTS gl_import_batches;
@@ -0,0 +1,50 @@
+-- ===============================================
+-- 000043_create_gl_import_batches.up.sql
+-- Persistent log of chart of accounts and journal entry imports
+-- ===============================================
+
+-- One row per import run, including validate-only and failed runs
+CREATE TABLE IF NOT EXISTS gl_import_batches (
+    id               UUID PRIMARY KEY,                  -- ImportResult.import_log_id
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    import_type      VARCHAR(20) NOT NULL,              -- ACCOUNTS / JOURNAL_ENTRIES
+    file_name        TEXT NOT NULL,
+    file_size        BIGINT NOT NULL DEFAULT 0,
+    legacy_system    VARCHAR(50),
+    status           VARCHAR(20) NOT NULL,              -- success/partial/failed/validated/rolled_back
+    validate_only    BOOLEAN NOT NULL DEFAULT FALSE,
+    auto_post        BOOLEAN NOT NULL DEFAULT FALSE,
+    total_rows       INT NOT NULL DEFAULT 0,
+    success_count    INT NOT NULL DEFAULT 0,
+    error_count      INT NOT NULL DEFAULT 0,
+    warning_count    INT NOT NULL DEFAULT 0,
+    imported_ids     UUID[] NOT NULL DEFAULT '{}',      -- Accounts or journal entries the run created
+    failure_reason   TEXT,                              -- Why a run failed before processing rows
+    migration_notes  TEXT,
+    imported_by      UUID NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at     TIMESTAMPTZ,
+    rolled_back_by   UUID,
+    rolled_back_at   TIMESTAMPTZ,
+    CONSTRAINT check_gl_import_type CHECK (import_type IN ('ACCOUNTS', 'JOURNAL_ENTRIES')),
+    CONSTRAINT check_gl_import_status CHECK (status IN ('success', 'partial', 'failed', 'validated', 'rolled_back'))
+);
+
+-- Row-level errors, used to annotate the error report workbook
+CREATE TABLE IF NOT EXISTS gl_import_errors (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    batch_id     UUID NOT NULL REFERENCES gl_import_batches(id) ON DELETE CASCADE,
+    row_number   INT NOT NULL,
+    column_name  VARCHAR(100),
+    field        VARCHAR(100) NOT NULL,
+    value        TEXT,
+    error_code   VARCHAR(50) NOT NULL,
+    message      TEXT NOT NULL,
+    legacy_code  VARCHAR(100),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_import_batches_org ON gl_import_batches(organization_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_gl_import_errors_batch ON gl_import_errors(batch_id, row_number);
+
+COMMENT ON TABLE gl_import_batches IS 'Each GL import attempt, so multi-attempt legacy migrations can be traced and rolled back.';
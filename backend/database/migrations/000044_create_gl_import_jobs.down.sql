@@ -0,0 +1,6 @@
+UPDATE gl_import_batches SET status = 'partial' WHERE status = 'cancelled';
+ALTER TABLE gl_import_batches DROP CONSTRAINT IF EXISTS check_gl_import_status;
+ALTER TABLE gl_import_batches ADD CONSTRAINT check_gl_import_status
+    CHECK (status IN ('success', 'partial', 'failed', 'validated', 'rolled_back'));
+
+DROP TABLE IF EXISTS gl_import_jobs;
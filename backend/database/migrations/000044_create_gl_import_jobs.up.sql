@@ -0,0 +1,44 @@
+-- ===============================================
+-- 000044_create_gl_import_jobs.up.sql
+-- Background jobs that run chart of accounts and journal entry imports
+-- ===============================================
+
+-- One row per queued import. The upload is kept as an attachment of the
+-- job's import log, so a job survives a restart while it waits.
+CREATE TABLE IF NOT EXISTS gl_import_jobs (
+    id                UUID PRIMARY KEY,
+    organization_id   UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    import_type       VARCHAR(20) NOT NULL,              -- ACCOUNTS / JOURNAL_ENTRIES
+    status            VARCHAR(20) NOT NULL DEFAULT 'QUEUED',
+    file_name         TEXT NOT NULL,
+    file_size         BIGINT NOT NULL DEFAULT 0,
+    import_log_id     UUID NOT NULL,                     -- gl_import_batches.id once the run finishes
+    attachment_id     UUID,                              -- The uploaded file
+    options           JSONB NOT NULL DEFAULT '{}',
+    processed_rows    INT NOT NULL DEFAULT 0,
+    total_rows        INT NOT NULL DEFAULT 0,            -- Estimated from the sheet dimension for workbooks
+    progress          SMALLINT NOT NULL DEFAULT 0,       -- Percent
+    cancel_requested  BOOLEAN NOT NULL DEFAULT FALSE,
+    result            JSONB,                             -- ImportResult of a finished run
+    error_message     TEXT,
+    created_by        UUID NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Heartbeat while running
+    started_at        TIMESTAMPTZ,
+    completed_at      TIMESTAMPTZ,
+    CONSTRAINT check_gl_import_job_type CHECK (import_type IN ('ACCOUNTS', 'JOURNAL_ENTRIES')),
+    CONSTRAINT check_gl_import_job_status CHECK (status IN ('QUEUED', 'RUNNING', 'COMPLETED', 'FAILED', 'CANCELLED')),
+    CONSTRAINT check_gl_import_job_progress CHECK (progress BETWEEN 0 AND 100)
+);
+
+-- Workers claim the oldest queued job
+CREATE INDEX IF NOT EXISTS idx_gl_import_jobs_queue ON gl_import_jobs(status, created_at)
+    WHERE status IN ('QUEUED', 'RUNNING');
+CREATE INDEX IF NOT EXISTS idx_gl_import_jobs_org ON gl_import_jobs(organization_id, created_at DESC);
+
+-- A cancelled job records its run as cancelled
+ALTER TABLE gl_import_batches DROP CONSTRAINT IF EXISTS check_gl_import_status;
+ALTER TABLE gl_import_batches ADD CONSTRAINT check_gl_import_status
+    CHECK (status IN ('success', 'partial', 'failed', 'validated', 'rolled_back', 'cancelled'));
+
+COMMENT ON TABLE gl_import_jobs IS 'Imports run by background workers, with progress for polling and cancellation.';
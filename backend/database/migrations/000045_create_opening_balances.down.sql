@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS gl_opening_balance_lines;
+DROP TABLE IF EXISTS gl_opening_balances;
+
+UPDATE journal_entries
+SET journal_type = 'GENERAL'
+WHERE journal_type = 'OPENING';
+
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'PAYROLL', 'BANK', 'SALES', 'PURCHASE', 'REVERSAL',
+                            'CLOSING', 'REVALUATION', 'RECURRING'));
@@ -0,0 +1,57 @@
+-- ===============================================
+-- 000045_create_opening_balances.up.sql
+-- Opening balances entered for an organization's go-live date
+-- ===============================================
+
+-- OPENING for the single entry that brings balances into the ledger
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS check_journal_type;
+
+ALTER TABLE journal_entries
+    ADD CONSTRAINT check_journal_type
+    CHECK (journal_type IN ('GENERAL', 'PAYROLL', 'BANK', 'SALES', 'PURCHASE', 'REVERSAL',
+                            'CLOSING', 'REVALUATION', 'RECURRING', 'OPENING'));
+
+CREATE TABLE IF NOT EXISTS gl_opening_balances (
+    id                   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id      UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    go_live_date         DATE NOT NULL,
+    description          VARCHAR(500) NOT NULL,
+    status               VARCHAR(20) NOT NULL DEFAULT 'POSTED',
+    entry_id             UUID NOT NULL REFERENCES journal_entries(id),
+    suspense_account_id  UUID REFERENCES gl_accounts(id),
+    suspense_amount      DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    total_debit          DECIMAL(15, 2) NOT NULL,
+    total_credit         DECIMAL(15, 2) NOT NULL,
+    created_by           UUID NOT NULL,
+    created_at           TIMESTAMP NOT NULL DEFAULT NOW(),
+    reversal_entry_id    UUID REFERENCES journal_entries(id),
+    reversed_by          UUID,
+    reversed_at          TIMESTAMP,
+    reversal_reason      TEXT,
+
+    CONSTRAINT check_opening_balance_status CHECK (status IN ('POSTED', 'REVERSED'))
+);
+
+-- An organization has one set of opening balances in effect at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_gl_opening_balances_org_posted
+    ON gl_opening_balances(organization_id)
+    WHERE status = 'POSTED';
+
+CREATE INDEX IF NOT EXISTS idx_gl_opening_balances_org ON gl_opening_balances(organization_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS gl_opening_balance_lines (
+    id                  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    opening_balance_id  UUID NOT NULL REFERENCES gl_opening_balances(id) ON DELETE CASCADE,
+    line_number         INT NOT NULL,
+    account_id          UUID NOT NULL REFERENCES gl_accounts(id),
+    party_reference     VARCHAR(100),
+    description         VARCHAR(255) NOT NULL,
+    debit               DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    credit              DECIMAL(15, 2) NOT NULL DEFAULT 0.00
+);
+
+CREATE INDEX IF NOT EXISTS idx_gl_opening_balance_lines_batch ON gl_opening_balance_lines(opening_balance_id);
+
+COMMENT ON TABLE gl_opening_balances IS 'Balances brought into the ledger at go-live, posted as one OPENING entry. Only reversed through the opening balances endpoint.';
+COMMENT ON COLUMN gl_opening_balances.suspense_amount IS 'Debit-positive amount posted to suspense_account_id to balance the entry; 0 when debits equalled credits.';
+COMMENT ON COLUMN gl_opening_balance_lines.party_reference IS 'Customer or supplier the balance is owed by or to; copied to the journal line reference.';
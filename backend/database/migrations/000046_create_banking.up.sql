@@ -0,0 +1,116 @@
+-- ===============================================
+-- 000046_create_banking.up.sql
+-- Bank accounts, imported bank statements and bank reconciliation
+-- ===============================================
+
+CREATE TABLE IF NOT EXISTS bank_accounts (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    gl_account_id    UUID NOT NULL REFERENCES gl_accounts(id),
+    name             VARCHAR(255) NOT NULL,
+    bank_name        VARCHAR(255),
+    account_number   VARCHAR(50),
+    iban             VARCHAR(34),
+    swift_code       VARCHAR(11),
+    currency         VARCHAR(3) NOT NULL,
+    is_active        BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by       UUID NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    -- Each ledger account holds one bank account's transactions
+    CONSTRAINT unique_bank_account_gl_account UNIQUE (gl_account_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_accounts_org ON bank_accounts(organization_id, name);
+
+CREATE TABLE IF NOT EXISTS bank_statements (
+    id                   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id      UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    bank_account_id      UUID NOT NULL REFERENCES bank_accounts(id) ON DELETE CASCADE,
+    format               VARCHAR(10) NOT NULL,
+    file_name            VARCHAR(255) NOT NULL,
+    checksum             VARCHAR(64) NOT NULL,
+    statement_reference  VARCHAR(100),
+    period_start         DATE NOT NULL,
+    period_end           DATE NOT NULL,
+    opening_balance      DECIMAL(15, 3),
+    closing_balance      DECIMAL(15, 3),
+    line_count           INT NOT NULL DEFAULT 0,
+    duplicate_count      INT NOT NULL DEFAULT 0,
+    imported_by          UUID NOT NULL,
+    imported_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_bank_statement_format CHECK (format IN ('CSV', 'OFX', 'MT940', 'CAMT053')),
+    CONSTRAINT unique_bank_statement_file UNIQUE (bank_account_id, checksum)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_statements_account_period ON bank_statements(bank_account_id, period_end DESC);
+
+CREATE TABLE IF NOT EXISTS bank_statement_lines (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    statement_id      UUID NOT NULL REFERENCES bank_statements(id) ON DELETE CASCADE,
+    bank_account_id   UUID NOT NULL REFERENCES bank_accounts(id) ON DELETE CASCADE,
+    line_number       INT NOT NULL,
+    transaction_date  DATE NOT NULL,
+    value_date        DATE,
+    amount            DECIMAL(15, 3) NOT NULL,
+    description       TEXT NOT NULL DEFAULT '',
+    reference         VARCHAR(100),
+    counterparty      VARCHAR(255),
+    external_id       VARCHAR(100) NOT NULL,
+    status            VARCHAR(20) NOT NULL DEFAULT 'UNMATCHED',
+    journal_entry_id  UUID REFERENCES journal_entries(id),
+    journal_line_id   UUID REFERENCES journal_lines(id),
+    match_method      VARCHAR(20),
+    matched_by        UUID,
+    matched_at        TIMESTAMP,
+
+    CONSTRAINT check_bank_statement_line_status CHECK (status IN ('UNMATCHED', 'MATCHED')),
+    CONSTRAINT check_bank_statement_line_match CHECK (match_method IS NULL OR match_method IN ('AUTO', 'MANUAL', 'CREATED')),
+    -- Lines already imported from an overlapping statement are skipped
+    CONSTRAINT unique_bank_statement_line UNIQUE (bank_account_id, external_id)
+);
+
+-- A ledger line clears against one statement line at most
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bank_statement_lines_journal_line
+    ON bank_statement_lines(journal_line_id)
+    WHERE journal_line_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_bank_statement_lines_account_date ON bank_statement_lines(bank_account_id, transaction_date);
+CREATE INDEX IF NOT EXISTS idx_bank_statement_lines_statement ON bank_statement_lines(statement_id, line_number);
+
+-- Locked reconciliation reports. Matches dated on or before the latest locked
+-- period end cannot change.
+CREATE TABLE IF NOT EXISTS bank_reconciliations (
+    id                        UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id           UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    bank_account_id           UUID NOT NULL REFERENCES bank_accounts(id) ON DELETE CASCADE,
+    period_start              DATE NOT NULL,
+    period_end                DATE NOT NULL,
+    status                    VARCHAR(20) NOT NULL DEFAULT 'LOCKED',
+    statement_balance         DECIMAL(15, 3) NOT NULL,
+    ledger_balance            DECIMAL(15, 3) NOT NULL,
+    unrecorded_total          DECIMAL(15, 3) NOT NULL,
+    outstanding_total         DECIMAL(15, 3) NOT NULL,
+    matched_count             INT NOT NULL DEFAULT 0,
+    items                     JSONB NOT NULL DEFAULT '{}',
+    locked_by                 UUID NOT NULL,
+    locked_at                 TIMESTAMP NOT NULL DEFAULT NOW(),
+    unlocked_by               UUID,
+    unlocked_at               TIMESTAMP,
+    unlock_reason             TEXT,
+
+    CONSTRAINT check_bank_reconciliation_status CHECK (status IN ('LOCKED', 'UNLOCKED')),
+    CONSTRAINT check_bank_reconciliation_period CHECK (period_end >= period_start)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bank_reconciliations_locked_period
+    ON bank_reconciliations(bank_account_id, period_end)
+    WHERE status = 'LOCKED';
+
+CREATE INDEX IF NOT EXISTS idx_bank_reconciliations_account ON bank_reconciliations(bank_account_id, period_end DESC);
+
+COMMENT ON COLUMN bank_statement_lines.amount IS 'Signed in the bank account currency: positive is money in (a debit to the bank ledger account).';
+COMMENT ON COLUMN bank_statement_lines.external_id IS 'Bank transaction ID (OFX FITID, MT940/CAMT bank reference) or a hash of the line when the format has none.';
+COMMENT ON COLUMN bank_reconciliations.items IS 'Unmatched statement lines and outstanding ledger lines as they stood when the report was locked.';
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS ar_allocations;
+DROP TABLE IF EXISTS ar_receipts;
+DROP TABLE IF EXISTS ar_credit_note_lines;
+DROP TABLE IF EXISTS ar_credit_notes;
+DROP TABLE IF EXISTS ar_invoice_lines;
+DROP TABLE IF EXISTS ar_invoices;
+DROP TABLE IF EXISTS ar_document_sequences;
+DROP TABLE IF EXISTS customers;
@@ -0,0 +1,183 @@
+-- ===============================================
+-- 000047_create_receivables.up.sql
+-- Accounts receivable: customers, sales invoices, credit notes, receipts
+-- and their allocations
+-- ===============================================
+
+CREATE TABLE IF NOT EXISTS customers (
+    id                     UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id        UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    code                   VARCHAR(50) NOT NULL,
+    name                   VARCHAR(255) NOT NULL,
+    customer_type          VARCHAR(20) NOT NULL,
+    email                  VARCHAR(255),
+    phone                  VARCHAR(50),
+    address                TEXT,
+    tax_number             VARCHAR(50),
+    currency               VARCHAR(3) NOT NULL,
+    payment_terms_days     INT NOT NULL DEFAULT 0,
+    receivable_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    is_active              BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by             UUID NOT NULL,
+    created_at             TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at             TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_customer_type CHECK (customer_type IN ('PATIENT', 'INSURER', 'CORPORATE')),
+    CONSTRAINT check_customer_payment_terms CHECK (payment_terms_days >= 0),
+    CONSTRAINT unique_customer_code UNIQUE (organization_id, code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customers_org_name ON customers(organization_id, name);
+
+-- Last number issued per organization and document type
+CREATE TABLE IF NOT EXISTS ar_document_sequences (
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    document_type    VARCHAR(20) NOT NULL,
+    last_number      BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (organization_id, document_type)
+);
+
+CREATE TABLE IF NOT EXISTS ar_invoices (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id    UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    customer_id        UUID NOT NULL REFERENCES customers(id),
+    invoice_number     VARCHAR(50) NOT NULL,
+    invoice_date       DATE NOT NULL,
+    due_date           DATE NOT NULL,
+    currency           VARCHAR(3) NOT NULL,
+    reference          VARCHAR(100),
+    description        TEXT NOT NULL DEFAULT '',
+    status             VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    total_amount       DECIMAL(15, 3) NOT NULL,
+    amount_allocated   DECIMAL(15, 3) NOT NULL DEFAULT 0,
+    journal_entry_id   UUID REFERENCES journal_entries(id),
+    reversal_entry_id  UUID REFERENCES journal_entries(id),
+    created_by         UUID NOT NULL,
+    created_at         TIMESTAMP NOT NULL DEFAULT NOW(),
+    posted_at          TIMESTAMP,
+    voided_by          UUID,
+    voided_at          TIMESTAMP,
+    void_reason        TEXT,
+
+    CONSTRAINT check_ar_invoice_status CHECK (status IN ('DRAFT', 'POSTED', 'VOID')),
+    CONSTRAINT check_ar_invoice_dates CHECK (due_date >= invoice_date),
+    CONSTRAINT check_ar_invoice_allocated CHECK (amount_allocated >= 0 AND amount_allocated <= total_amount),
+    CONSTRAINT unique_ar_invoice_number UNIQUE (organization_id, invoice_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ar_invoices_customer ON ar_invoices(customer_id, invoice_date);
+CREATE INDEX IF NOT EXISTS idx_ar_invoices_org_date ON ar_invoices(organization_id, invoice_date DESC);
+
+CREATE TABLE IF NOT EXISTS ar_invoice_lines (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    invoice_id   UUID NOT NULL REFERENCES ar_invoices(id) ON DELETE CASCADE,
+    line_number  INT NOT NULL,
+    account_id   UUID NOT NULL REFERENCES gl_accounts(id),
+    description  TEXT NOT NULL,
+    quantity     DECIMAL(15, 4) NOT NULL,
+    unit_price   DECIMAL(15, 3) NOT NULL,
+    amount       DECIMAL(15, 3) NOT NULL,
+
+    CONSTRAINT unique_ar_invoice_line UNIQUE (invoice_id, line_number)
+);
+
+CREATE TABLE IF NOT EXISTS ar_credit_notes (
+    id                  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id     UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    customer_id         UUID NOT NULL REFERENCES customers(id),
+    invoice_id          UUID REFERENCES ar_invoices(id),
+    credit_note_number  VARCHAR(50) NOT NULL,
+    credit_note_date    DATE NOT NULL,
+    currency            VARCHAR(3) NOT NULL,
+    reference           VARCHAR(100),
+    description         TEXT NOT NULL DEFAULT '',
+    status              VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    total_amount        DECIMAL(15, 3) NOT NULL,
+    amount_allocated    DECIMAL(15, 3) NOT NULL DEFAULT 0,
+    journal_entry_id    UUID REFERENCES journal_entries(id),
+    reversal_entry_id   UUID REFERENCES journal_entries(id),
+    created_by          UUID NOT NULL,
+    created_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+    posted_at           TIMESTAMP,
+    voided_by           UUID,
+    voided_at           TIMESTAMP,
+    void_reason         TEXT,
+
+    CONSTRAINT check_ar_credit_note_status CHECK (status IN ('DRAFT', 'POSTED', 'VOID')),
+    CONSTRAINT check_ar_credit_note_allocated CHECK (amount_allocated >= 0 AND amount_allocated <= total_amount),
+    CONSTRAINT unique_ar_credit_note_number UNIQUE (organization_id, credit_note_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ar_credit_notes_customer ON ar_credit_notes(customer_id, credit_note_date);
+CREATE INDEX IF NOT EXISTS idx_ar_credit_notes_org_date ON ar_credit_notes(organization_id, credit_note_date DESC);
+
+CREATE TABLE IF NOT EXISTS ar_credit_note_lines (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    credit_note_id  UUID NOT NULL REFERENCES ar_credit_notes(id) ON DELETE CASCADE,
+    line_number     INT NOT NULL,
+    account_id      UUID NOT NULL REFERENCES gl_accounts(id),
+    description     TEXT NOT NULL,
+    quantity        DECIMAL(15, 4) NOT NULL,
+    unit_price      DECIMAL(15, 3) NOT NULL,
+    amount          DECIMAL(15, 3) NOT NULL,
+
+    CONSTRAINT unique_ar_credit_note_line UNIQUE (credit_note_id, line_number)
+);
+
+CREATE TABLE IF NOT EXISTS ar_receipts (
+    id                  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id     UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    customer_id         UUID NOT NULL REFERENCES customers(id),
+    receipt_number      VARCHAR(50) NOT NULL,
+    receipt_date        DATE NOT NULL,
+    currency            VARCHAR(3) NOT NULL,
+    total_amount        DECIMAL(15, 3) NOT NULL,
+    amount_allocated    DECIMAL(15, 3) NOT NULL DEFAULT 0,
+    deposit_account_id  UUID NOT NULL REFERENCES gl_accounts(id),
+    payment_method      VARCHAR(20) NOT NULL,
+    reference           VARCHAR(100),
+    description         TEXT NOT NULL DEFAULT '',
+    status              VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    journal_entry_id    UUID REFERENCES journal_entries(id),
+    reversal_entry_id   UUID REFERENCES journal_entries(id),
+    created_by          UUID NOT NULL,
+    created_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+    posted_at           TIMESTAMP,
+    voided_by           UUID,
+    voided_at           TIMESTAMP,
+    void_reason         TEXT,
+
+    CONSTRAINT check_ar_receipt_status CHECK (status IN ('DRAFT', 'POSTED', 'VOID')),
+    CONSTRAINT check_ar_receipt_method CHECK (payment_method IN ('CASH', 'CARD', 'BANK_TRANSFER', 'CHEQUE')),
+    CONSTRAINT check_ar_receipt_amount CHECK (total_amount > 0),
+    CONSTRAINT check_ar_receipt_allocated CHECK (amount_allocated >= 0 AND amount_allocated <= total_amount),
+    CONSTRAINT unique_ar_receipt_number UNIQUE (organization_id, receipt_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ar_receipts_customer ON ar_receipts(customer_id, receipt_date);
+CREATE INDEX IF NOT EXISTS idx_ar_receipts_org_date ON ar_receipts(organization_id, receipt_date DESC);
+
+-- Receipts and credit notes settled against invoices
+CREATE TABLE IF NOT EXISTS ar_allocations (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    customer_id      UUID NOT NULL REFERENCES customers(id),
+    source_type      VARCHAR(20) NOT NULL,
+    source_id        UUID NOT NULL,
+    invoice_id       UUID NOT NULL REFERENCES ar_invoices(id),
+    amount           DECIMAL(15, 3) NOT NULL,
+    allocation_date  DATE NOT NULL,
+    created_by       UUID NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_ar_allocation_source CHECK (source_type IN ('RECEIPT', 'CREDIT_NOTE')),
+    CONSTRAINT check_ar_allocation_amount CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ar_allocations_invoice ON ar_allocations(invoice_id, allocation_date);
+CREATE INDEX IF NOT EXISTS idx_ar_allocations_source ON ar_allocations(source_type, source_id);
+
+COMMENT ON COLUMN customers.receivable_account_id IS 'ASSET control account the customer''s invoices, credit notes and receipts post to.';
+COMMENT ON COLUMN ar_invoices.amount_allocated IS 'Receipts and credit notes allocated to the invoice; the balance due is total_amount less this.';
+COMMENT ON COLUMN ar_allocations.allocation_date IS 'The later of the invoice and settling document dates; aged receivables as of earlier dates ignore the allocation.';
@@ -0,0 +1,21 @@
+UPDATE ar_invoices SET status = 'DRAFT', journal_entry_id = NULL WHERE status = 'PENDING_APPROVAL';
+UPDATE ar_credit_notes SET status = 'DRAFT', journal_entry_id = NULL WHERE status = 'PENDING_APPROVAL';
+UPDATE ar_receipts SET status = 'DRAFT', journal_entry_id = NULL WHERE status = 'PENDING_APPROVAL';
+
+ALTER TABLE ar_invoices DROP CONSTRAINT IF EXISTS check_ar_invoice_status;
+
+ALTER TABLE ar_invoices
+    ADD CONSTRAINT check_ar_invoice_status
+    CHECK (status IN ('DRAFT', 'POSTED', 'VOID'));
+
+ALTER TABLE ar_credit_notes DROP CONSTRAINT IF EXISTS check_ar_credit_note_status;
+
+ALTER TABLE ar_credit_notes
+    ADD CONSTRAINT check_ar_credit_note_status
+    CHECK (status IN ('DRAFT', 'POSTED', 'VOID'));
+
+ALTER TABLE ar_receipts DROP CONSTRAINT IF EXISTS check_ar_receipt_status;
+
+ALTER TABLE ar_receipts
+    ADD CONSTRAINT check_ar_receipt_status
+    CHECK (status IN ('DRAFT', 'POSTED', 'VOID'));
@@ -0,0 +1,28 @@
+-- ===============================================
+-- 000048_receivables_pending_approval.up.sql
+-- Receivables documents whose journal entry awaits approval
+-- ===============================================
+
+-- PENDING_APPROVAL while the document's entry is submitted for approval;
+-- the document is posted once the entry is approved
+ALTER TABLE ar_invoices DROP CONSTRAINT IF EXISTS check_ar_invoice_status;
+
+ALTER TABLE ar_invoices
+    ADD CONSTRAINT check_ar_invoice_status
+    CHECK (status IN ('DRAFT', 'PENDING_APPROVAL', 'POSTED', 'VOID'));
+
+ALTER TABLE ar_credit_notes DROP CONSTRAINT IF EXISTS check_ar_credit_note_status;
+
+ALTER TABLE ar_credit_notes
+    ADD CONSTRAINT check_ar_credit_note_status
+    CHECK (status IN ('DRAFT', 'PENDING_APPROVAL', 'POSTED', 'VOID'));
+
+ALTER TABLE ar_receipts DROP CONSTRAINT IF EXISTS check_ar_receipt_status;
+
+ALTER TABLE ar_receipts
+    ADD CONSTRAINT check_ar_receipt_status
+    CHECK (status IN ('DRAFT', 'PENDING_APPROVAL', 'POSTED', 'VOID'));
+
+COMMENT ON COLUMN ar_invoices.reversal_entry_id IS 'Entry reversing a voided invoice; set on a POSTED invoice while its reversal awaits approval.';
+COMMENT ON COLUMN ar_credit_notes.reversal_entry_id IS 'Entry reversing a voided credit note; set on a POSTED credit note while its reversal awaits approval.';
+COMMENT ON COLUMN ar_receipts.reversal_entry_id IS 'Entry reversing a voided receipt; set on a POSTED receipt while its reversal awaits approval.';
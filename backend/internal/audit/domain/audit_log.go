@@ -0,0 +1,170 @@
+// backend/internal/audit/domain/audit_log.go
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+// GenesisHash is the previous hash of the first entry in the chain
+var GenesisHash = strings.Repeat("0", 64)
+
+// AuditLog is one append-only entry in the audit trail. Entries form a single
+// hash chain: each Hash covers the entry's content and the previous entry's
+// hash, so editing or deleting any entry breaks every hash after it.
+type AuditLog struct {
+	ID             uuid.UUID       `json:"id"`
+	Sequence       int64           `json:"sequence"` // Gap-free position in the chain, from 1
+	OrganizationID *uuid.UUID      `json:"organization_id,omitempty"`
+	EntityType     string          `json:"entity_type"`
+	EntityID       uuid.UUID       `json:"entity_id"`
+	Action         string          `json:"action"`
+	UserID         *uuid.UUID      `json:"user_id,omitempty"` // nil for system changes (schedulers, self-registration)
+	IPAddress      string          `json:"ip_address"`
+	UserAgent      string          `json:"user_agent"`
+	Before         json.RawMessage `json:"before,omitempty"`
+	After          json.RawMessage `json:"after,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	PrevHash       string          `json:"prev_hash"`
+	Hash           string          `json:"hash"`
+}
+
+// AuditLogFilter narrows an audit log query. Zero values are ignored.
+type AuditLogFilter struct {
+	OrganizationID *uuid.UUID
+	EntityType     string
+	EntityID       *uuid.UUID
+	UserID         *uuid.UUID
+	From           *time.Time
+	To             *time.Time
+	Limit          int
+	Offset         int
+}
+
+// ChainVerification is the result of walking the hash chain
+type ChainVerification struct {
+	Verified     bool   `json:"verified"`
+	CheckedCount int64  `json:"checked_count"`
+	LastSequence int64  `json:"last_sequence"`
+	BrokenAt     *int64 `json:"broken_at,omitempty"` // First sequence that fails verification
+	Reason       string `json:"reason,omitempty"`
+}
+
+// NewAuditLog builds an unchained entry for a change made by the given actor
+func NewAuditLog(change audit.Change, userID *uuid.UUID, ipAddress, userAgent string) (*AuditLog, error) {
+	if change.EntityType == "" || change.EntityID == uuid.Nil {
+		return nil, ErrAuditEntityRequired
+	}
+	if change.Action == "" {
+		return nil, ErrAuditActionRequired
+	}
+
+	before, err := snapshot(change.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := snapshot(change.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLog{
+		ID:             uuid.New(),
+		OrganizationID: change.OrganizationID,
+		EntityType:     change.EntityType,
+		EntityID:       change.EntityID,
+		Action:         change.Action,
+		UserID:         userID,
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+		Before:         before,
+		After:          after,
+		// Stored with microsecond precision; truncate so the hash survives a round trip
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+// Chain links the entry after the previous one (sequence 0 and GenesisHash for the first)
+func (l *AuditLog) Chain(prevSequence int64, prevHash string) {
+	if prevHash == "" {
+		prevHash = GenesisHash
+	}
+	l.Sequence = prevSequence + 1
+	l.PrevHash = prevHash
+	l.Hash = l.ComputeHash()
+}
+
+// ComputeHash returns the hex SHA-256 over the entry's content and PrevHash.
+// Each field is length-prefixed so values cannot run into each other.
+func (l *AuditLog) ComputeHash() string {
+	h := sha256.New()
+	for _, field := range []string{
+		strconv.FormatInt(l.Sequence, 10),
+		l.PrevHash,
+		l.ID.String(),
+		optionalUUID(l.OrganizationID),
+		l.EntityType,
+		l.EntityID.String(),
+		l.Action,
+		optionalUUID(l.UserID),
+		l.IPAddress,
+		l.UserAgent,
+		string(l.Before),
+		string(l.After),
+		l.CreatedAt.UTC().Format(time.RFC3339Nano),
+	} {
+		io.WriteString(h, strconv.Itoa(len(field)))
+		io.WriteString(h, ":")
+		io.WriteString(h, field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify checks the entry follows the previous one and its hash matches its content
+func (l *AuditLog) Verify(prevSequence int64, prevHash string) error {
+	if prevHash == "" {
+		prevHash = GenesisHash
+	}
+	if l.Sequence != prevSequence+1 {
+		return fmt.Errorf("sequence %d follows %d", l.Sequence, prevSequence)
+	}
+	if l.PrevHash != prevHash {
+		return fmt.Errorf("previous hash does not match entry %d", prevSequence)
+	}
+	if l.Hash != l.ComputeHash() {
+		return fmt.Errorf("content does not match its hash")
+	}
+	return nil
+}
+
+// snapshot encodes a before/after value, treating nil as no snapshot
+func snapshot(v interface{}) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAuditSnapshotInvalid, err)
+	}
+	if bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	return data, nil
+}
+
+func optionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
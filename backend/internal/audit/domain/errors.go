@@ -0,0 +1,11 @@
+// backend/internal/audit/domain/errors.go
+package domain
+
+import "errors"
+
+var (
+	ErrAuditEntityRequired  = errors.New("audit entity type and ID are required")
+	ErrAuditActionRequired  = errors.New("audit action is required")
+	ErrAuditSnapshotInvalid = errors.New("audit snapshot cannot be encoded as JSON")
+	ErrAuditFilterInvalid   = errors.New("invalid audit log filter")
+)
@@ -0,0 +1,138 @@
+// backend/internal/audit/handler/audit_handler.go
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/audit/domain"
+	"github.com/chaitu35/costeasy/backend/internal/audit/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/audit/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AuditHandler struct {
+	service service.AuditServiceInterface
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(service service.AuditServiceInterface) *AuditHandler {
+	return &AuditHandler{service: service}
+}
+
+// ListAuditLogs handles GET /audit-logs
+// Query: organization_id, entity_type, entity_id, user_id, from, to (YYYY-MM-DD, inclusive), limit, offset
+func (h *AuditHandler) ListAuditLogs(c *gin.Context) {
+	filter := domain.AuditLogFilter{
+		EntityType: c.Query("entity_type"),
+	}
+
+	for param, target := range map[string]**uuid.UUID{
+		"organization_id": &filter.OrganizationID,
+		"entity_id":       &filter.EntityID,
+		"user_id":         &filter.UserID,
+	} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid " + param,
+				Message: err.Error(),
+			})
+			return
+		}
+		*target = &id
+	}
+
+	if value := c.Query("from"); value != "" {
+		from, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid from date",
+				Message: "Use format YYYY-MM-DD",
+			})
+			return
+		}
+		filter.From = &from
+	}
+
+	if value := c.Query("to"); value != "" {
+		to, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid to date",
+				Message: "Use format YYYY-MM-DD",
+			})
+			return
+		}
+		end := to.AddDate(0, 0, 1)
+		filter.To = &end
+	}
+
+	filter.Limit, _ = strconv.Atoi(c.Query("limit"))
+	filter.Offset, _ = strconv.Atoi(c.Query("offset"))
+
+	logs, err := h.service.ListLogs(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list audit logs",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.AuditLogResponse, len(logs))
+	for i, log := range logs {
+		responses[i] = toAuditLogResponse(log)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// VerifyAuditChain handles GET /audit-logs/verify
+func (h *AuditHandler) VerifyAuditChain(c *gin.Context) {
+	result, err := h.service.VerifyChain(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to verify audit chain",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// toAuditLogResponse converts domain.AuditLog to AuditLogResponse
+func toAuditLogResponse(log *domain.AuditLog) dto.AuditLogResponse {
+	response := dto.AuditLogResponse{
+		ID:         log.ID.String(),
+		Sequence:   log.Sequence,
+		EntityType: log.EntityType,
+		EntityID:   log.EntityID.String(),
+		Action:     log.Action,
+		IPAddress:  log.IPAddress,
+		UserAgent:  log.UserAgent,
+		Before:     log.Before,
+		After:      log.After,
+		CreatedAt:  log.CreatedAt.Format("2006-01-02T15:04:05.000000Z07:00"),
+		PrevHash:   log.PrevHash,
+		Hash:       log.Hash,
+	}
+
+	if log.OrganizationID != nil {
+		id := log.OrganizationID.String()
+		response.OrganizationID = &id
+	}
+	if log.UserID != nil {
+		id := log.UserID.String()
+		response.UserID = &id
+	}
+
+	return response
+}
@@ -0,0 +1,28 @@
+// backend/internal/audit/handler/dto/audit_dto.go
+package dto
+
+import "encoding/json"
+
+// AuditLogResponse represents one entry of the audit trail
+type AuditLogResponse struct {
+	ID             string          `json:"id"`
+	Sequence       int64           `json:"sequence"`
+	OrganizationID *string         `json:"organization_id,omitempty"`
+	EntityType     string          `json:"entity_type"`
+	EntityID       string          `json:"entity_id"`
+	Action         string          `json:"action"`
+	UserID         *string         `json:"user_id,omitempty"`
+	IPAddress      string          `json:"ip_address"`
+	UserAgent      string          `json:"user_agent"`
+	Before         json.RawMessage `json:"before,omitempty"`
+	After          json.RawMessage `json:"after,omitempty"`
+	CreatedAt      string          `json:"created_at"`
+	PrevHash       string          `json:"prev_hash"`
+	Hash           string          `json:"hash"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message,omitempty"`
+}
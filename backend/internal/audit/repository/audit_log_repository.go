@@ -0,0 +1,190 @@
+// backend/internal/audit/repository/audit_log_repository.go
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chaitu35/costeasy/backend/internal/audit/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// auditChainLockKey serializes appends to the chain across connections
+const auditChainLockKey = 7_413_920_016
+
+type AuditLogRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(pool *pgxpool.Pool) *AuditLogRepository {
+	return &AuditLogRepository{pool: pool}
+}
+
+const auditLogColumns = `
+        id, sequence, organization_id, entity_type, entity_id, action, user_id,
+        ip_address, user_agent, before_data, after_data, created_at, prev_hash, hash
+`
+
+// Append chains the entry after the current last entry and saves it
+func (r *AuditLogRepository) Append(ctx context.Context, log *domain.AuditLog) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, auditChainLockKey); err != nil {
+		return fmt.Errorf("failed to lock audit chain: %w", err)
+	}
+
+	var prevSequence int64
+	var prevHash string
+	err = tx.QueryRow(ctx, `SELECT sequence, hash FROM audit_logs ORDER BY sequence DESC LIMIT 1`).Scan(&prevSequence, &prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to read audit chain head: %w", err)
+	}
+
+	log.Chain(prevSequence, prevHash)
+
+	query := `
+        INSERT INTO audit_logs (` + auditLogColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+    `
+
+	_, err = tx.Exec(ctx, query,
+		log.ID,
+		log.Sequence,
+		log.OrganizationID,
+		log.EntityType,
+		log.EntityID,
+		log.Action,
+		log.UserID,
+		log.IPAddress,
+		log.UserAgent,
+		rawJSON(log.Before),
+		rawJSON(log.After),
+		log.CreatedAt,
+		log.PrevHash,
+		log.Hash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append audit log: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit audit log: %w", err)
+	}
+
+	return nil
+}
+
+// List returns entries matching the filter, newest first
+func (r *AuditLogRepository) List(ctx context.Context, filter domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	var conditions []string
+	var args []interface{}
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.OrganizationID != nil {
+		add("organization_id = $%d", *filter.OrganizationID)
+	}
+	if filter.EntityType != "" {
+		add("entity_type = $%d", filter.EntityType)
+	}
+	if filter.EntityID != nil {
+		add("entity_id = $%d", *filter.EntityID)
+	}
+	if filter.UserID != nil {
+		add("user_id = $%d", *filter.UserID)
+	}
+	if filter.From != nil {
+		add("created_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("created_at < $%d", *filter.To)
+	}
+
+	query := "SELECT" + auditLogColumns + "FROM audit_logs"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY sequence DESC"
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	return r.queryLogs(ctx, query, args...)
+}
+
+// ListAfter returns up to limit entries with a sequence greater than afterSequence, in chain order
+func (r *AuditLogRepository) ListAfter(ctx context.Context, afterSequence int64, limit int) ([]*domain.AuditLog, error) {
+	query := "SELECT" + auditLogColumns + `
+        FROM audit_logs
+        WHERE sequence > $1
+        ORDER BY sequence
+        LIMIT $2
+    `
+
+	return r.queryLogs(ctx, query, afterSequence, limit)
+}
+
+// queryLogs runs a query selecting auditLogColumns
+func (r *AuditLogRepository) queryLogs(ctx context.Context, query string, args ...interface{}) ([]*domain.AuditLog, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit logs: %w", err)
+	}
+	defer rows.Close()
+
+	var logs []*domain.AuditLog
+	for rows.Next() {
+		log := &domain.AuditLog{}
+		var before, after []byte
+		err := rows.Scan(
+			&log.ID,
+			&log.Sequence,
+			&log.OrganizationID,
+			&log.EntityType,
+			&log.EntityID,
+			&log.Action,
+			&log.UserID,
+			&log.IPAddress,
+			&log.UserAgent,
+			&before,
+			&after,
+			&log.CreatedAt,
+			&log.PrevHash,
+			&log.Hash,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit log: %w", err)
+		}
+		log.Before = before
+		log.After = after
+		logs = append(logs, log)
+	}
+
+	return logs, rows.Err()
+}
+
+// rawJSON passes a snapshot through as JSON text, or NULL when absent. The
+// column type is JSON rather than JSONB so the text, and therefore the hash,
+// is preserved byte for byte.
+func rawJSON(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,22 @@
+// backend/internal/audit/repository/audit_log_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/audit/domain"
+)
+
+// AuditLogRepositoryInterface defines data access for the append-only audit trail.
+// There are deliberately no update or delete methods.
+type AuditLogRepositoryInterface interface {
+	// Append chains the entry after the current last entry and saves it.
+	// Appends are serialized so the chain has no forks or gaps.
+	Append(ctx context.Context, log *domain.AuditLog) error
+
+	// List returns entries matching the filter, newest first
+	List(ctx context.Context, filter domain.AuditLogFilter) ([]*domain.AuditLog, error)
+
+	// ListAfter returns up to limit entries with a sequence greater than afterSequence, in chain order
+	ListAfter(ctx context.Context, afterSequence int64, limit int) ([]*domain.AuditLog, error)
+}
@@ -0,0 +1,18 @@
+// backend/internal/audit/routes/audit_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/audit/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterAuditRoutes registers the read-only audit trail routes
+func RegisterAuditRoutes(r *gin.RouterGroup, h *handler.AuditHandler, authMiddleware *middleware.AuthMiddleware) {
+	logs := r.Group("/audit-logs")
+	logs.Use(authMiddleware.Authenticate())
+	{
+		logs.GET("", authMiddleware.RequirePermission("audit_logs", "view"), h.ListAuditLogs)             // Filter by entity, user and date
+		logs.GET("/verify", authMiddleware.RequirePermission("audit_logs", "verify"), h.VerifyAuditChain) // Detect tampering
+	}
+}
@@ -0,0 +1,93 @@
+// backend/internal/audit/service/audit_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/audit/domain"
+	"github.com/chaitu35/costeasy/backend/internal/audit/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/chaitu35/costeasy/backend/pkg/contextx"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAuditPageSize = 100
+	maxAuditPageSize     = 1000
+	verifyBatchSize      = 1000
+)
+
+type AuditService struct {
+	repo repository.AuditLogRepositoryInterface
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(repo repository.AuditLogRepositoryInterface) *AuditService {
+	return &AuditService{repo: repo}
+}
+
+// Record appends a change to the trail, attributed to the user and client IP
+// in the request context. Changes made outside a request are recorded with no user.
+func (s *AuditService) Record(ctx context.Context, change audit.Change) error {
+	var userID *uuid.UUID
+	var ipAddress, userAgent string
+	if uc, ok := contextx.Get(ctx); ok {
+		userID = &uc.UserID
+		ipAddress = uc.IPAddress
+		userAgent = uc.UserAgent
+	}
+
+	log, err := domain.NewAuditLog(change, userID, ipAddress, userAgent)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Append(ctx, log)
+}
+
+// ListLogs queries the trail by organization, entity, user and date range, newest first
+func (s *AuditService) ListLogs(ctx context.Context, filter domain.AuditLogFilter) ([]*domain.AuditLog, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrAuditFilterInvalid)
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditPageSize
+	}
+	if filter.Limit > maxAuditPageSize {
+		filter.Limit = maxAuditPageSize
+	}
+
+	return s.repo.List(ctx, filter)
+}
+
+// VerifyChain walks the whole hash chain in batches and reports the first entry that fails
+func (s *AuditService) VerifyChain(ctx context.Context) (*domain.ChainVerification, error) {
+	result := &domain.ChainVerification{Verified: true}
+	prevHash := domain.GenesisHash
+
+	for {
+		logs, err := s.repo.ListAfter(ctx, result.LastSequence, verifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, log := range logs {
+			if err := log.Verify(result.LastSequence, prevHash); err != nil {
+				sequence := log.Sequence
+				result.Verified = false
+				result.BrokenAt = &sequence
+				result.Reason = err.Error()
+				return result, nil
+			}
+			result.CheckedCount++
+			result.LastSequence = log.Sequence
+			prevHash = log.Hash
+		}
+
+		if len(logs) < verifyBatchSize {
+			return result, nil
+		}
+	}
+}
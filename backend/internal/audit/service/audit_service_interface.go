@@ -0,0 +1,21 @@
+// backend/internal/audit/service/audit_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/audit/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+)
+
+// AuditServiceInterface defines business logic for the audit trail. It is the
+// audit.Recorder other modules write changes through.
+type AuditServiceInterface interface {
+	audit.Recorder
+
+	// ListLogs queries the trail by organization, entity, user and date range, newest first
+	ListLogs(ctx context.Context, filter domain.AuditLogFilter) ([]*domain.AuditLog, error)
+
+	// VerifyChain walks the whole hash chain and reports the first entry that fails
+	VerifyChain(ctx context.Context) (*domain.ChainVerification, error)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/chaitu35/costeasy/backend/internal/auth/domain"
 	"github.com/chaitu35/costeasy/backend/internal/auth/handler/dto"
 	"github.com/chaitu35/costeasy/backend/internal/auth/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
 	"github.com/chaitu35/costeasy/backend/pkg/jwt"
 )
 
@@ -20,6 +21,7 @@ type AuthService struct {
 	permRepo    repository.PermissionRepositoryInterface
 	refreshRepo repository.RefreshTokenRepositoryInterface // ✅ add this
 	jwtUtil     *jwt.JWTUtil
+	recorder    audit.Recorder
 }
 
 func NewAuthService(
@@ -28,6 +30,7 @@ func NewAuthService(
 	permRepo repository.PermissionRepositoryInterface,
 	refreshRepo repository.RefreshTokenRepositoryInterface, // ✅ add this
 	jwtUtil *jwt.JWTUtil,
+	recorder audit.Recorder,
 ) AuthServiceInterface {
 	return &AuthService{
 		userRepo:    userRepo,
@@ -35,6 +38,7 @@ func NewAuthService(
 		permRepo:    permRepo,
 		refreshRepo: refreshRepo, // ✅ assign here
 		jwtUtil:     jwtUtil,
+		recorder:    recorder,
 	}
 }
 
@@ -70,6 +74,8 @@ func (s *AuthService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 		return nil, err
 	}
 
+	s.recordUserChange(ctx, audit.ActionCreate, nil, user)
+
 	tokenPair, err := s.jwtUtil.GenerateTokenPair(user.ID, user.Email, nil, nil)
 	if err != nil {
 		return nil, err
@@ -214,6 +220,8 @@ func (s *AuthService) CreateUser(ctx context.Context, req *dto.CreateUserRequest
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
+
+	s.recordUserChange(ctx, audit.ActionCreate, nil, user)
 	return dto.ToUserResponse(user), nil
 }
 
@@ -223,6 +231,7 @@ func (s *AuthService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 		return nil, err
 	}
 
+	before := *user
 	if req.FirstName != "" {
 		user.FirstName = &req.FirstName
 	}
@@ -237,6 +246,8 @@ func (s *AuthService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return nil, err
 	}
+
+	s.recordUserChange(ctx, audit.ActionUpdate, &before, user)
 	return dto.ToUserResponse(user), nil
 }
 
@@ -255,10 +266,17 @@ func (s *AuthService) ChangePassword(ctx context.Context, userID uuid.UUID, req
 		return err
 	}
 
+	before := *user
 	user.PasswordHash = string(newHash)
 	user.PasswordChangedAt = time.Now()
 
-	return s.userRepo.Update(ctx, user)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// The hash itself is never serialized; the snapshots only show the change time
+	s.recordUserChange(ctx, "CHANGE_PASSWORD", &before, user)
+	return nil
 }
 
 func (s *AuthService) ListUsers(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*dto.UserResponse, error) {
@@ -275,7 +293,17 @@ func (s *AuthService) ListUsers(ctx context.Context, orgID uuid.UUID, limit, off
 }
 
 func (s *AuthService) DeleteUser(ctx context.Context, userID uuid.UUID) error {
-	return s.userRepo.Delete(ctx, userID)
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+
+	s.recordUserChange(ctx, audit.ActionDelete, user, nil)
+	return nil
 }
 
 //
@@ -285,15 +313,27 @@ func (s *AuthService) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 //
 
 func (s *AuthService) AssignRole(ctx context.Context, userID, roleID uuid.UUID) error {
-	return s.roleRepo.AssignToUser(ctx, userID, roleID)
+	if err := s.roleRepo.AssignToUser(ctx, userID, roleID); err != nil {
+		return err
+	}
+	s.recordRoleAssignment(ctx, "ASSIGN_ROLE", userID, nil, roleID)
+	return nil
 }
 
 func (s *AuthService) ChangeRole(ctx context.Context, userID, roleID uuid.UUID) error {
-	return s.roleRepo.ChangeUserRole(ctx, userID, roleID)
+	if err := s.roleRepo.ChangeUserRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+	s.recordRoleAssignment(ctx, "CHANGE_ROLE", userID, nil, roleID)
+	return nil
 }
 
 func (s *AuthService) RemoveRole(ctx context.Context, userID, roleID uuid.UUID) error {
-	return s.roleRepo.RemoveUserRole(ctx, userID, roleID)
+	if err := s.roleRepo.RemoveUserRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+	s.recordRoleAssignment(ctx, "REMOVE_ROLE", userID, &roleID, uuid.Nil)
+	return nil
 }
 
 func (s *AuthService) CreateRole(ctx context.Context, req *dto.CreateRoleRequest) (*dto.RoleResponse, error) {
@@ -317,6 +357,8 @@ func (s *AuthService) CreateRole(ctx context.Context, req *dto.CreateRoleRequest
 		return nil, err
 	}
 
+	s.recordRoleChange(ctx, audit.ActionCreate, nil, role)
+
 	return dto.ToRoleResponse(role, nil), nil
 }
 
@@ -326,6 +368,7 @@ func (s *AuthService) UpdateRole(ctx context.Context, roleID uuid.UUID, req *dto
 		return nil, err
 	}
 
+	before := *role
 	role.Name = req.Name
 	role.DisplayName = req.DisplayName
 	role.Description = &req.Description
@@ -335,6 +378,8 @@ func (s *AuthService) UpdateRole(ctx context.Context, roleID uuid.UUID, req *dto
 		return nil, err
 	}
 
+	s.recordRoleChange(ctx, audit.ActionUpdate, &before, role)
+
 	return dto.ToRoleResponse(role, nil), nil
 }
 
@@ -360,7 +405,17 @@ func (s *AuthService) ListRoles(ctx context.Context, orgID *uuid.UUID) ([]*dto.R
 }
 
 func (s *AuthService) DeleteRole(ctx context.Context, roleID uuid.UUID) error {
-	return s.roleRepo.Delete(ctx, roleID)
+	role, err := s.roleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.roleRepo.Delete(ctx, roleID); err != nil {
+		return err
+	}
+
+	s.recordRoleChange(ctx, audit.ActionDelete, role, nil)
+	return nil
 }
 
 //
@@ -375,6 +430,7 @@ func (s *AuthService) AssignPermissionsToRole(ctx context.Context, roleID uuid.U
 			return err
 		}
 	}
+	s.recordPermissionChange(ctx, "ASSIGN_PERMISSIONS", roleID, permissionIDs)
 	return nil
 }
 
@@ -384,6 +440,7 @@ func (s *AuthService) RemovePermissionsFromRole(ctx context.Context, roleID uuid
 			return err
 		}
 	}
+	s.recordPermissionChange(ctx, "REMOVE_PERMISSIONS", roleID, permissionIDs)
 	return nil
 }
 
@@ -428,3 +485,83 @@ func (s *AuthService) CheckPermission(ctx context.Context, userID uuid.UUID, mod
 	}
 	return false, nil
 }
+
+//
+// ──────────────────────────────────────────────
+//  AUDIT TRAIL
+// ──────────────────────────────────────────────
+//
+
+// roleAssignment is the audit snapshot of a user's role membership
+type roleAssignment struct {
+	RoleID uuid.UUID `json:"role_id"`
+}
+
+// permissionChange is the audit snapshot of permissions granted to or revoked from a role
+type permissionChange struct {
+	PermissionIDs []uuid.UUID `json:"permission_ids"`
+}
+
+func (s *AuthService) recordUserChange(ctx context.Context, action string, before, after *domain.User) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	audit.Log(ctx, s.recorder, audit.Change{
+		OrganizationID: orgRef(subject.OrganizationID),
+		EntityType:     audit.EntityUser,
+		EntityID:       subject.ID,
+		Action:         action,
+		Before:         before,
+		After:          after,
+	})
+}
+
+func (s *AuthService) recordRoleChange(ctx context.Context, action string, before, after *domain.Role) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	audit.Log(ctx, s.recorder, audit.Change{
+		OrganizationID: orgRef(subject.OrganizationID),
+		EntityType:     audit.EntityRole,
+		EntityID:       subject.ID,
+		Action:         action,
+		Before:         before,
+		After:          after,
+	})
+}
+
+// recordRoleAssignment records a role granted to (newRoleID) or removed from
+// (oldRoleID) a user
+func (s *AuthService) recordRoleAssignment(ctx context.Context, action string, userID uuid.UUID, oldRoleID *uuid.UUID, newRoleID uuid.UUID) {
+	change := audit.Change{
+		EntityType: audit.EntityUser,
+		EntityID:   userID,
+		Action:     action,
+	}
+	if oldRoleID != nil {
+		change.Before = roleAssignment{RoleID: *oldRoleID}
+	}
+	if newRoleID != uuid.Nil {
+		change.After = roleAssignment{RoleID: newRoleID}
+	}
+	audit.Log(ctx, s.recorder, change)
+}
+
+func (s *AuthService) recordPermissionChange(ctx context.Context, action string, roleID uuid.UUID, permissionIDs []uuid.UUID) {
+	audit.Log(ctx, s.recorder, audit.Change{
+		EntityType: audit.EntityRole,
+		EntityID:   roleID,
+		Action:     action,
+		After:      permissionChange{PermissionIDs: permissionIDs},
+	})
+}
+
+// orgRef returns nil for system-wide users and roles
+func orgRef(orgID uuid.UUID) *uuid.UUID {
+	if orgID == uuid.Nil {
+		return nil
+	}
+	return &orgID
+}
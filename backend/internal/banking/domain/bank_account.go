@@ -0,0 +1,104 @@
+// backend/internal/banking/domain/bank_account.go
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+var (
+	ibanPattern  = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{10,30}$`)
+	swiftPattern = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	nonAlnum     = regexp.MustCompile(`[^A-Z0-9]+`)
+)
+
+// BankAccount is an organization's account at a bank. Its transactions are
+// posted to a single ASSET ledger account, which statements are reconciled against.
+type BankAccount struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	GLAccountID    uuid.UUID `json:"gl_account_id"`
+	Name           string    `json:"name"`
+	BankName       string    `json:"bank_name,omitempty"`
+	AccountNumber  string    `json:"account_number,omitempty"`
+	IBAN           string    `json:"iban,omitempty"`
+	SWIFTCode      string    `json:"swift_code,omitempty"`
+	Currency       string    `json:"currency"`
+	IsActive       bool      `json:"is_active"`
+	CreatedBy      uuid.UUID `json:"created_by"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Normalize tidies the account's identifiers so they compare cleanly with
+// those quoted on statements
+func (a *BankAccount) Normalize() {
+	a.Name = strings.TrimSpace(a.Name)
+	a.BankName = strings.TrimSpace(a.BankName)
+	a.AccountNumber = strings.TrimSpace(a.AccountNumber)
+	a.IBAN = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(a.IBAN), " ", ""))
+	a.SWIFTCode = strings.ToUpper(strings.TrimSpace(a.SWIFTCode))
+	a.Currency = strings.ToUpper(strings.TrimSpace(a.Currency))
+}
+
+// Validate performs domain validation on BankAccount
+func (a *BankAccount) Validate() error {
+	if a.OrganizationID == uuid.Nil {
+		return NewBankingError("organization ID is required", ErrBankAccountInvalid)
+	}
+	if a.GLAccountID == uuid.Nil {
+		return NewBankingError("ledger account is required", ErrBankAccountGLInvalid)
+	}
+	if a.Name == "" {
+		return NewBankingError("name is required", ErrBankAccountInvalid)
+	}
+	if len(a.Name) > 255 || len(a.BankName) > 255 {
+		return NewBankingError("name cannot exceed 255 characters", ErrBankAccountInvalid)
+	}
+	if len(a.AccountNumber) > 50 {
+		return NewBankingError("account number cannot exceed 50 characters", ErrBankAccountInvalid)
+	}
+	if a.IBAN != "" && !ibanPattern.MatchString(a.IBAN) {
+		return NewBankingErrorf(ErrBankAccountInvalid, "invalid IBAN: %s", a.IBAN)
+	}
+	if a.SWIFTCode != "" && !swiftPattern.MatchString(a.SWIFTCode) {
+		return NewBankingErrorf(ErrBankAccountInvalid, "invalid SWIFT code: %s", a.SWIFTCode)
+	}
+	if _, err := money.LookupCurrency(a.Currency); err != nil {
+		return NewBankingErrorf(ErrBankAccountInvalid, "invalid currency: %s", a.Currency)
+	}
+	return nil
+}
+
+// BelongsTo checks if the bank account belongs to the organization
+func (a *BankAccount) BelongsTo(orgID uuid.UUID) bool {
+	return a.OrganizationID == orgID
+}
+
+// MatchesIdentifier checks if an account identifier quoted on a statement is
+// this account's number or IBAN. MT940 identifiers may carry a bank code
+// before the number, so a trailing match is enough. Accounts with no number
+// or IBAN on record accept any statement.
+func (a *BankAccount) MatchesIdentifier(identifier string) bool {
+	id := nonAlnum.ReplaceAllString(strings.ToUpper(identifier), "")
+	if id == "" {
+		return true
+	}
+
+	known := false
+	for _, own := range []string{a.IBAN, a.AccountNumber} {
+		own = nonAlnum.ReplaceAllString(strings.ToUpper(own), "")
+		if own == "" {
+			continue
+		}
+		known = true
+		if id == own || strings.HasSuffix(id, own) || strings.HasSuffix(own, id) {
+			return true
+		}
+	}
+	return !known
+}
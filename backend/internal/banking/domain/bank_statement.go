@@ -0,0 +1,280 @@
+// backend/internal/banking/domain/bank_statement.go
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// StatementFormat is the file format a statement was imported from
+type StatementFormat string
+
+const (
+	StatementFormatCSV     StatementFormat = "CSV"
+	StatementFormatOFX     StatementFormat = "OFX"
+	StatementFormatMT940   StatementFormat = "MT940"   // SWIFT MT940 customer statement
+	StatementFormatCAMT053 StatementFormat = "CAMT053" // ISO 20022 camt.053 bank-to-customer statement
+)
+
+// StatementLineStatus is whether a statement line has been cleared against the ledger
+type StatementLineStatus string
+
+const (
+	StatementLineUnmatched StatementLineStatus = "UNMATCHED"
+	StatementLineMatched   StatementLineStatus = "MATCHED"
+)
+
+// MatchMethod records how a statement line was matched
+type MatchMethod string
+
+const (
+	MatchAuto    MatchMethod = "AUTO"    // By the matching engine
+	MatchManual  MatchMethod = "MANUAL"  // Picked by a user
+	MatchCreated MatchMethod = "CREATED" // An entry was created from the line
+)
+
+// MaxStatementSize is the largest statement file accepted for import (10MB)
+const MaxStatementSize = 10 << 20
+
+// maxExternalIDLength is the longest bank transaction ID kept as is; longer
+// ones are hashed
+const maxExternalIDLength = 100
+
+// BankStatement is a statement imported from a bank file
+type BankStatement struct {
+	ID                 uuid.UUID       `json:"id"`
+	OrganizationID     uuid.UUID       `json:"organization_id"`
+	BankAccountID      uuid.UUID       `json:"bank_account_id"`
+	Format             StatementFormat `json:"format"`
+	FileName           string          `json:"file_name"`
+	Checksum           string          `json:"checksum"` // SHA-256 of the file, so a file is only imported once
+	StatementReference string          `json:"statement_reference,omitempty"`
+	AccountIdentifier  string          `json:"account_identifier,omitempty"` // As quoted in the file; not saved
+	Currency           string          `json:"currency,omitempty"`           // As quoted in the file; not saved
+	PeriodStart        time.Time       `json:"period_start"`
+	PeriodEnd          time.Time       `json:"period_end"`
+	OpeningBalance     *money.Amount   `json:"opening_balance,omitempty"` // nil when the file doesn't state it
+	ClosingBalance     *money.Amount   `json:"closing_balance,omitempty"`
+	LineCount          int             `json:"line_count"`      // Lines saved
+	DuplicateCount     int             `json:"duplicate_count"` // Lines skipped as already imported
+	ImportedBy         uuid.UUID       `json:"imported_by"`
+	ImportedAt         time.Time       `json:"imported_at"`
+	Lines              []StatementLine `json:"lines,omitempty"`
+}
+
+// StatementLine is one transaction on a bank statement
+type StatementLine struct {
+	ID              uuid.UUID           `json:"id"`
+	StatementID     uuid.UUID           `json:"statement_id"`
+	BankAccountID   uuid.UUID           `json:"bank_account_id"`
+	LineNumber      int                 `json:"line_number"`
+	TransactionDate time.Time           `json:"transaction_date"`
+	ValueDate       *time.Time          `json:"value_date,omitempty"`
+	Amount          money.Amount        `json:"amount"` // Positive is money in
+	Description     string              `json:"description"`
+	Reference       string              `json:"reference,omitempty"`
+	Counterparty    string              `json:"counterparty,omitempty"`
+	ExternalID      string              `json:"external_id"`
+	Status          StatementLineStatus `json:"status"`
+	JournalEntryID  *uuid.UUID          `json:"journal_entry_id,omitempty"`
+	JournalLineID   *uuid.UUID          `json:"journal_line_id,omitempty"`
+	MatchMethod     MatchMethod         `json:"match_method,omitempty"`
+	MatchedBy       *uuid.UUID          `json:"matched_by,omitempty"`
+	MatchedAt       *time.Time          `json:"matched_at,omitempty"`
+}
+
+// Prepare readies a parsed statement for saving against a bank account: it
+// numbers the lines, gives lines without a bank transaction ID one derived
+// from their content, and works out the period the lines cover
+func (s *BankStatement) Prepare(account *BankAccount, fileName string, content []byte, importedBy uuid.UUID) error {
+	if len(s.Lines) == 0 {
+		return NewBankingError("statement has no transactions", ErrStatementEmpty)
+	}
+	if !account.MatchesIdentifier(s.AccountIdentifier) {
+		return NewBankingErrorf(ErrStatementAccountMismatch,
+			"statement is for account %s, not %s", s.AccountIdentifier, account.Name)
+	}
+	if s.Currency != "" && !strings.EqualFold(s.Currency, account.Currency) {
+		return NewBankingErrorf(ErrStatementAccountMismatch,
+			"statement is in %s but the bank account is in %s", strings.ToUpper(s.Currency), account.Currency)
+	}
+
+	sum := sha256.Sum256(content)
+	s.ID = uuid.New()
+	s.OrganizationID = account.OrganizationID
+	s.BankAccountID = account.ID
+	s.FileName = fileName
+	s.Checksum = hex.EncodeToString(sum[:])
+	s.ImportedBy = importedBy
+	s.ImportedAt = time.Now()
+	if len(s.StatementReference) > 100 {
+		s.StatementReference = s.StatementReference[:100]
+	}
+
+	// Identical lines within a file are told apart by their occurrence
+	occurrences := make(map[string]int)
+	for i := range s.Lines {
+		line := &s.Lines[i]
+		if line.TransactionDate.IsZero() {
+			return NewBankingErrorf(ErrStatementInvalid, "line %d has no date", i+1)
+		}
+
+		line.ID = uuid.New()
+		line.StatementID = s.ID
+		line.BankAccountID = account.ID
+		line.LineNumber = i + 1
+		line.Status = StatementLineUnmatched
+		line.Description = strings.TrimSpace(line.Description)
+		line.Reference = truncate(strings.TrimSpace(line.Reference), 100)
+		line.Counterparty = truncate(strings.TrimSpace(line.Counterparty), 255)
+
+		if line.ExternalID == "" || len(line.ExternalID) > maxExternalIDLength {
+			key := line.ExternalID
+			if key == "" {
+				key = fmt.Sprintf("%s|%s|%s|%s", line.TransactionDate.Format("2006-01-02"), line.Amount, line.Reference, line.Description)
+			}
+			occurrences[key]++
+			h := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrences[key])))
+			line.ExternalID = "H:" + hex.EncodeToString(h[:16])
+		}
+
+		if s.PeriodStart.IsZero() || line.TransactionDate.Before(s.PeriodStart) {
+			s.PeriodStart = line.TransactionDate
+		}
+		if line.TransactionDate.After(s.PeriodEnd) {
+			s.PeriodEnd = line.TransactionDate
+		}
+	}
+
+	return nil
+}
+
+// IsMatched checks if the line has been cleared against a ledger line
+func (l *StatementLine) IsMatched() bool {
+	return l.Status == StatementLineMatched
+}
+
+// Match clears the line against a ledger line
+func (l *StatementLine) Match(ledger *LedgerLine, method MatchMethod, matchedBy uuid.UUID) error {
+	if l.IsMatched() {
+		return NewBankingError("statement line is already matched", ErrMatchInvalid)
+	}
+	if ledger.Amount != l.Amount {
+		return NewBankingErrorf(ErrMatchInvalid,
+			"ledger line amount %s does not equal statement amount %s", ledger.Amount, l.Amount)
+	}
+
+	now := time.Now()
+	l.Status = StatementLineMatched
+	l.JournalEntryID = &ledger.JournalEntryID
+	l.JournalLineID = &ledger.JournalLineID
+	l.MatchMethod = method
+	l.MatchedBy = &matchedBy
+	l.MatchedAt = &now
+	return nil
+}
+
+// Unmatch returns the line to the unmatched list
+func (l *StatementLine) Unmatch() error {
+	if !l.IsMatched() {
+		return NewBankingError("statement line is not matched", ErrMatchInvalid)
+	}
+
+	l.Status = StatementLineUnmatched
+	l.JournalEntryID = nil
+	l.JournalLineID = nil
+	l.MatchMethod = ""
+	l.MatchedBy = nil
+	l.MatchedAt = nil
+	return nil
+}
+
+// BuildEntry builds the posted BANK entry recording a statement line missing
+// from the ledger, such as a bank charge or interest: the bank account's
+// ledger account against an offset account, dated on the statement date.
+// A rate is given when the bank account is held in a foreign currency; the
+// lines then carry the statement amount in that currency.
+func (l *StatementLine) BuildEntry(account *BankAccount, offsetAccountID uuid.UUID, description string, rate *money.Rate, base money.Currency, createdBy uuid.UUID) (*gldomain.JournalEntry, error) {
+	if l.IsMatched() {
+		return nil, NewBankingError("statement line is already matched", ErrMatchInvalid)
+	}
+	if offsetAccountID == account.GLAccountID {
+		return nil, NewBankingError("offset account cannot be the bank's ledger account", ErrMatchInvalid)
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		description = l.Description
+	}
+	if description == "" {
+		description = "Bank statement transaction"
+	}
+	description = truncate(description, 255)
+
+	// Money in is a debit to the bank
+	bankDebit, bankCredit := l.Amount, money.Amount(0)
+	if l.Amount.IsNegative() {
+		bankDebit, bankCredit = 0, l.Amount.Abs()
+	}
+
+	now := time.Now()
+	entry := &gldomain.JournalEntry{
+		ID:              uuid.New(),
+		OrganizationID:  account.OrganizationID,
+		EntryNumber:     gldomain.NewDraftEntryNumber(),
+		JournalType:     gldomain.JournalTypeBank,
+		TransactionDate: l.TransactionDate,
+		Reference:       l.Reference,
+		Description:     description,
+		Status:          gldomain.EntryStatusDraft,
+		CreatedBy:       createdBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Lines: []gldomain.JournalLine{
+			{ID: uuid.New(), AccountID: account.GLAccountID, Reference: l.Reference, Description: description, Debit: bankDebit, Credit: bankCredit, LineNumber: 1},
+			{ID: uuid.New(), AccountID: offsetAccountID, Reference: l.Reference, Description: description, Debit: bankCredit, Credit: bankDebit, LineNumber: 2},
+		},
+	}
+
+	if rate != nil {
+		for i := range entry.Lines {
+			line := &entry.Lines[i]
+			line.Currency = account.Currency
+			line.ForeignDebit, line.ForeignCredit = line.Debit, line.Credit
+			line.ApplyExchangeRate(*rate, base)
+		}
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	entry.CalculateTotals()
+	if err := entry.Post(createdBy); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// truncate cuts s to at most n bytes without splitting a character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// utf8RuneStart checks if b begins a UTF-8 encoded character
+func utf8RuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,59 @@
+// backend/internal/banking/domain/errors.go
+package domain
+
+import "fmt"
+
+// BankingError represents a banking domain error
+type BankingError struct {
+	Message string
+	Code    string
+}
+
+// Error implements the error interface
+func (e *BankingError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+}
+
+// NewBankingError creates a new banking error
+func NewBankingError(message, code string) *BankingError {
+	return &BankingError{
+		Message: message,
+		Code:    code,
+	}
+}
+
+// NewBankingErrorf creates a new banking error with formatted message
+func NewBankingErrorf(code, format string, args ...interface{}) *BankingError {
+	return &BankingError{
+		Message: fmt.Sprintf(format, args...),
+		Code:    code,
+	}
+}
+
+// Banking error codes
+const (
+	// Bank account errors
+	ErrBankAccountInvalid   = "BANK_ACCOUNT_INVALID"
+	ErrBankAccountNotFound  = "BANK_ACCOUNT_NOT_FOUND"
+	ErrBankAccountGLInvalid = "BANK_ACCOUNT_GL_ACCOUNT_INVALID"
+	ErrBankAccountInactive  = "BANK_ACCOUNT_INACTIVE"
+
+	// Statement import errors
+	ErrStatementFormat          = "BANK_STATEMENT_FORMAT_UNSUPPORTED"
+	ErrStatementInvalid         = "BANK_STATEMENT_INVALID"
+	ErrStatementEmpty           = "BANK_STATEMENT_EMPTY"
+	ErrStatementAccountMismatch = "BANK_STATEMENT_ACCOUNT_MISMATCH"
+	ErrStatementDuplicate       = "BANK_STATEMENT_DUPLICATE"
+	ErrStatementNotFound        = "BANK_STATEMENT_NOT_FOUND"
+	ErrStatementLineNotFound    = "BANK_STATEMENT_LINE_NOT_FOUND"
+
+	// Reconciliation errors
+	ErrMatchInvalid             = "BANK_MATCH_INVALID"
+	ErrLedgerLineNotFound       = "BANK_LEDGER_LINE_NOT_FOUND"
+	ErrReconciliationLocked     = "BANK_RECONCILIATION_LOCKED"
+	ErrReconciliationUnbalanced = "BANK_RECONCILIATION_UNBALANCED"
+	ErrReconciliationInvalid    = "BANK_RECONCILIATION_INVALID"
+	ErrReconciliationNotFound   = "BANK_RECONCILIATION_NOT_FOUND"
+	ErrStatementBalanceRequired = "BANK_STATEMENT_BALANCE_REQUIRED"
+	ErrReconciliationNotLatest  = "BANK_RECONCILIATION_NOT_LATEST"
+)
@@ -0,0 +1,167 @@
+// backend/internal/banking/domain/matching.go
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// DefaultMatchWindowDays is how far apart, in days, a statement line and a
+// ledger line may be dated and still be matched automatically
+const DefaultMatchWindowDays = 3
+
+// minReferenceLength is the shortest reference worth comparing; shorter ones
+// match too much by chance
+const minReferenceLength = 3
+
+// LedgerLine is a posted journal line on a bank account's ledger account
+type LedgerLine struct {
+	JournalLineID   uuid.UUID    `json:"journal_line_id"`
+	JournalEntryID  uuid.UUID    `json:"journal_entry_id"`
+	EntryNumber     string       `json:"entry_number"`
+	TransactionDate time.Time    `json:"transaction_date"`
+	Amount          money.Amount `json:"amount"` // Debit less credit, in the bank account currency
+	Reference       string       `json:"reference,omitempty"`
+	EntryReference  string       `json:"entry_reference,omitempty"`
+	Description     string       `json:"description"`
+}
+
+// MatchProposal pairs a statement line with the ledger line it clears
+type MatchProposal struct {
+	StatementLine *StatementLine
+	LedgerLine    *LedgerLine
+}
+
+// AutoMatch pairs unmatched statement lines with ledger lines of exactly the
+// same amount dated within windowDays of them. When more than one ledger
+// line qualifies, one whose reference matches the statement line's wins,
+// then the closest in date; lines that still can't be told apart are left
+// for the user. Each ledger line is used once.
+func AutoMatch(lines []*StatementLine, ledger []*LedgerLine, windowDays int) []MatchProposal {
+	if windowDays < 0 {
+		windowDays = DefaultMatchWindowDays
+	}
+	window := time.Duration(windowDays) * 24 * time.Hour
+
+	byAmount := make(map[money.Amount][]*LedgerLine)
+	for _, l := range ledger {
+		byAmount[l.Amount] = append(byAmount[l.Amount], l)
+	}
+
+	// Earlier lines claim ledger lines first, so results don't depend on input order
+	ordered := make([]*StatementLine, 0, len(lines))
+	for _, line := range lines {
+		if !line.IsMatched() {
+			ordered = append(ordered, line)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].TransactionDate.Before(ordered[j].TransactionDate)
+	})
+
+	used := make(map[uuid.UUID]bool)
+	var proposals []MatchProposal
+	for _, line := range ordered {
+		var candidates []*LedgerLine
+		for _, l := range byAmount[line.Amount] {
+			if used[l.JournalLineID] || dateDistance(line.TransactionDate, l.TransactionDate) > window {
+				continue
+			}
+			candidates = append(candidates, l)
+		}
+
+		best := pickCandidate(line, candidates)
+		if best == nil {
+			continue
+		}
+		used[best.JournalLineID] = true
+		proposals = append(proposals, MatchProposal{StatementLine: line, LedgerLine: best})
+	}
+
+	return proposals
+}
+
+// pickCandidate chooses between ledger lines of the right amount and date,
+// returning nil when there are none or no clear winner
+func pickCandidate(line *StatementLine, candidates []*LedgerLine) *LedgerLine {
+	if len(candidates) <= 1 {
+		if len(candidates) == 1 {
+			return candidates[0]
+		}
+		return nil
+	}
+
+	var referenced []*LedgerLine
+	for _, c := range candidates {
+		if referencesMatch(line, c) {
+			referenced = append(referenced, c)
+		}
+	}
+	if len(referenced) == 1 {
+		return referenced[0]
+	}
+	if len(referenced) > 1 {
+		candidates = referenced
+	}
+
+	// Closest in date, if only one is
+	var best *LedgerLine
+	tie := false
+	for _, c := range candidates {
+		if best == nil {
+			best = c
+			continue
+		}
+		d, bestD := dateDistance(line.TransactionDate, c.TransactionDate), dateDistance(line.TransactionDate, best.TransactionDate)
+		switch {
+		case d < bestD:
+			best, tie = c, false
+		case d == bestD:
+			tie = true
+		}
+	}
+	if tie {
+		return nil
+	}
+	return best
+}
+
+// referencesMatch checks if any reference on the statement line appears in
+// the ledger line's references, ignoring case and punctuation
+func referencesMatch(line *StatementLine, ledger *LedgerLine) bool {
+	theirs := []string{normalizeReference(line.Reference), normalizeReference(line.Description)}
+	ours := []string{normalizeReference(ledger.Reference), normalizeReference(ledger.EntryReference), normalizeReference(ledger.EntryNumber)}
+
+	for _, o := range ours {
+		if len(o) < minReferenceLength {
+			continue
+		}
+		for _, t := range theirs {
+			if len(t) < minReferenceLength {
+				continue
+			}
+			if strings.Contains(t, o) || strings.Contains(o, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeReference keeps only the letters and digits of a reference
+func normalizeReference(s string) string {
+	return nonAlnum.ReplaceAllString(strings.ToUpper(s), "")
+}
+
+// dateDistance is how far apart two dates are
+func dateDistance(a, b time.Time) time.Duration {
+	d := a.Sub(b)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
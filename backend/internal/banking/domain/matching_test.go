@@ -0,0 +1,225 @@
+// backend/internal/banking/domain/matching_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// bankLine is a statement line named for the test
+type bankLine struct {
+	name      string
+	day       int // Day of January 2025
+	amount    string
+	reference string
+	matched   bool
+}
+
+// bookLine is a ledger line named for the test
+type bookLine struct {
+	name      string
+	day       int
+	amount    string
+	reference string
+	number    string
+}
+
+func jan(day int) time.Time {
+	return time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAutoMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		window int
+		lines  []bankLine
+		ledger []bookLine
+		want   map[string]string // Statement line to the ledger line it matched
+	}{
+		{
+			name:   "same amount within the window",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00"}},
+			ledger: []bookLine{{name: "l1", day: 8, amount: "-250.00"}},
+			want:   map[string]string{"s1": "l1"},
+		},
+		{
+			name:   "outside the window",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00"}},
+			ledger: []bookLine{{name: "l1", day: 6, amount: "-250.00"}},
+			want:   map[string]string{},
+		},
+		{
+			name:   "amounts must be equal",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00"}},
+			ledger: []bookLine{{name: "l1", day: 10, amount: "250.00"}, {name: "l2", day: 10, amount: "-250.01"}},
+			want:   map[string]string{},
+		},
+		{
+			name:   "negative window uses the default",
+			window: -1,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "100.00"}},
+			ledger: []bookLine{{name: "l1", day: 13, amount: "100.00"}},
+			want:   map[string]string{"s1": "l1"},
+		},
+		{
+			name:   "matching reference beats a closer date",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00", reference: "inv-1001"}},
+			ledger: []bookLine{
+				{name: "l1", day: 10, amount: "-250.00", reference: "INV 1002"},
+				{name: "l2", day: 12, amount: "-250.00", reference: "INV/1001"},
+			},
+			want: map[string]string{"s1": "l2"},
+		},
+		{
+			name:   "entry number counts as a reference",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00", reference: "Paid JE-2025-00042"}},
+			ledger: []bookLine{
+				{name: "l1", day: 10, amount: "-250.00", number: "JE-2025-00041"},
+				{name: "l2", day: 10, amount: "-250.00", number: "JE-2025-00042"},
+			},
+			want: map[string]string{"s1": "l2"},
+		},
+		{
+			name:   "closest date without references",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00"}},
+			ledger: []bookLine{{name: "l1", day: 8, amount: "-250.00"}, {name: "l2", day: 9, amount: "-250.00"}},
+			want:   map[string]string{"s1": "l2"},
+		},
+		{
+			name:   "equally close candidates are left for the user",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00"}},
+			ledger: []bookLine{{name: "l1", day: 9, amount: "-250.00"}, {name: "l2", day: 11, amount: "-250.00"}},
+			want:   map[string]string{},
+		},
+		{
+			name:   "short references are ignored",
+			window: 3,
+			lines:  []bankLine{{name: "s1", day: 10, amount: "-250.00", reference: "12"}},
+			ledger: []bookLine{
+				{name: "l1", day: 9, amount: "-250.00", reference: "12"},
+				{name: "l2", day: 11, amount: "-250.00"},
+			},
+			want: map[string]string{},
+		},
+		{
+			name:   "each ledger line is used once, earliest statement line first",
+			window: 3,
+			lines: []bankLine{
+				{name: "s2", day: 11, amount: "75.00"},
+				{name: "s1", day: 10, amount: "75.00"},
+			},
+			ledger: []bookLine{{name: "l1", day: 11, amount: "75.00"}},
+			want:   map[string]string{"s1": "l1"},
+		},
+		{
+			name:   "matched statement lines are skipped",
+			window: 3,
+			lines: []bankLine{
+				{name: "s1", day: 10, amount: "75.00", matched: true},
+				{name: "s2", day: 12, amount: "75.00"},
+			},
+			ledger: []bookLine{{name: "l1", day: 10, amount: "75.00"}},
+			want:   map[string]string{"s2": "l1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := make([]*StatementLine, len(tt.lines))
+			lineNames := make(map[*StatementLine]string, len(tt.lines))
+			for i, l := range tt.lines {
+				lines[i] = &StatementLine{
+					ID:              uuid.New(),
+					TransactionDate: jan(l.day),
+					Amount:          money.MustParse(l.amount),
+					Reference:       l.reference,
+					Status:          StatementLineUnmatched,
+				}
+				if l.matched {
+					lines[i].Status = StatementLineMatched
+				}
+				lineNames[lines[i]] = l.name
+			}
+
+			ledger := make([]*LedgerLine, len(tt.ledger))
+			ledgerNames := make(map[*LedgerLine]string, len(tt.ledger))
+			for i, l := range tt.ledger {
+				ledger[i] = &LedgerLine{
+					JournalLineID:   uuid.New(),
+					JournalEntryID:  uuid.New(),
+					EntryNumber:     l.number,
+					TransactionDate: jan(l.day),
+					Amount:          money.MustParse(l.amount),
+					Reference:       l.reference,
+				}
+				ledgerNames[ledger[i]] = l.name
+			}
+
+			got := make(map[string]string)
+			for _, p := range AutoMatch(lines, ledger, tt.window) {
+				got[lineNames[p.StatementLine]] = ledgerNames[p.LedgerLine]
+			}
+
+			if len(got) != len(tt.want) {
+				t.Errorf("AutoMatch() = %v, want %v", got, tt.want)
+			}
+			for line, want := range tt.want {
+				if got[line] != want {
+					t.Errorf("%s matched %q, want %q", line, got[line], want)
+				}
+			}
+		})
+	}
+}
+
+func TestStatementLineMatch(t *testing.T) {
+	ledger := &LedgerLine{JournalLineID: uuid.New(), JournalEntryID: uuid.New(), Amount: money.MustParse("-40.00")}
+
+	tests := []struct {
+		name    string
+		amount  string
+		status  StatementLineStatus
+		wantErr bool
+	}{
+		{name: "equal amount", amount: "-40.00", status: StatementLineUnmatched},
+		{name: "different amount", amount: "40.00", status: StatementLineUnmatched, wantErr: true},
+		{name: "already matched", amount: "-40.00", status: StatementLineMatched, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := &StatementLine{Amount: money.MustParse(tt.amount), Status: tt.status}
+
+			err := line.Match(ledger, MatchManual, uuid.New())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Match() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match() error = %v", err)
+			}
+			if !line.IsMatched() || line.JournalLineID == nil || *line.JournalLineID != ledger.JournalLineID {
+				t.Errorf("line not matched to the ledger line: %+v", line)
+			}
+
+			if err := line.Unmatch(); err != nil {
+				t.Fatalf("Unmatch() error = %v", err)
+			}
+			if line.IsMatched() || line.JournalLineID != nil || line.MatchMethod != "" {
+				t.Errorf("line still matched after Unmatch(): %+v", line)
+			}
+		})
+	}
+}
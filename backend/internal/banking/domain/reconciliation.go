@@ -0,0 +1,160 @@
+// backend/internal/banking/domain/reconciliation.go
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// ReconciliationStatus is whether a reconciliation report still holds its period
+type ReconciliationStatus string
+
+const (
+	ReconciliationLocked   ReconciliationStatus = "LOCKED"
+	ReconciliationUnlocked ReconciliationStatus = "UNLOCKED"
+)
+
+// ReconciliationItems are the lines that explain the difference between the
+// statement and the ledger at the period end
+type ReconciliationItems struct {
+	UnrecordedLines  []StatementLine `json:"unrecorded_lines"`  // On the statement, not yet in the ledger
+	OutstandingLines []LedgerLine    `json:"outstanding_lines"` // In the ledger, not yet through the bank
+}
+
+// BankReconciliation is a bank account's reconciliation report for a period.
+// A locked report freezes matching for every line dated on or before its
+// period end.
+type BankReconciliation struct {
+	ID               uuid.UUID            `json:"id"`
+	OrganizationID   uuid.UUID            `json:"organization_id"`
+	BankAccountID    uuid.UUID            `json:"bank_account_id"`
+	PeriodStart      time.Time            `json:"period_start"`
+	PeriodEnd        time.Time            `json:"period_end"`
+	Status           ReconciliationStatus `json:"status"`
+	StatementBalance money.Amount         `json:"statement_balance"` // Closing balance per bank
+	LedgerBalance    money.Amount         `json:"ledger_balance"`    // Closing balance per books
+	UnrecordedTotal  money.Amount         `json:"unrecorded_total"`
+	OutstandingTotal money.Amount         `json:"outstanding_total"`
+	MatchedCount     int                  `json:"matched_count"` // Statement lines in the period cleared against the ledger
+	Items            ReconciliationItems  `json:"items"`
+	LockedBy         *uuid.UUID           `json:"locked_by,omitempty"`
+	LockedAt         *time.Time           `json:"locked_at,omitempty"`
+	UnlockedBy       *uuid.UUID           `json:"unlocked_by,omitempty"`
+	UnlockedAt       *time.Time           `json:"unlocked_at,omitempty"`
+	UnlockReason     string               `json:"unlock_reason,omitempty"`
+}
+
+// NewBankReconciliation builds a report as of periodEnd from the statement
+// and ledger balances and the lines left unmatched on each side
+func NewBankReconciliation(
+	account *BankAccount,
+	periodStart, periodEnd time.Time,
+	statementBalance, ledgerBalance money.Amount,
+	unrecorded []StatementLine,
+	outstanding []LedgerLine,
+	matchedCount int,
+) (*BankReconciliation, error) {
+	if periodEnd.Before(periodStart) {
+		return nil, NewBankingError("period end cannot be before period start", ErrReconciliationInvalid)
+	}
+
+	r := &BankReconciliation{
+		ID:               uuid.New(),
+		OrganizationID:   account.OrganizationID,
+		BankAccountID:    account.ID,
+		PeriodStart:      periodStart,
+		PeriodEnd:        periodEnd,
+		StatementBalance: statementBalance,
+		LedgerBalance:    ledgerBalance,
+		MatchedCount:     matchedCount,
+		Items: ReconciliationItems{
+			UnrecordedLines:  unrecorded,
+			OutstandingLines: outstanding,
+		},
+	}
+	if r.Items.UnrecordedLines == nil {
+		r.Items.UnrecordedLines = []StatementLine{}
+	}
+	if r.Items.OutstandingLines == nil {
+		r.Items.OutstandingLines = []LedgerLine{}
+	}
+
+	for _, line := range unrecorded {
+		r.UnrecordedTotal += line.Amount
+	}
+	for _, line := range outstanding {
+		r.OutstandingTotal += line.Amount
+	}
+
+	return r, nil
+}
+
+// AdjustedStatementBalance is the bank's balance once items still in transit
+// have cleared
+func (r *BankReconciliation) AdjustedStatementBalance() money.Amount {
+	return r.StatementBalance + r.OutstandingTotal
+}
+
+// AdjustedLedgerBalance is the book balance once the bank's own transactions
+// have been recorded
+func (r *BankReconciliation) AdjustedLedgerBalance() money.Amount {
+	return r.LedgerBalance + r.UnrecordedTotal
+}
+
+// Difference is what the report can't explain; zero when reconciled
+func (r *BankReconciliation) Difference() money.Amount {
+	return r.AdjustedStatementBalance() - r.AdjustedLedgerBalance()
+}
+
+// IsReconciled checks if the adjusted balances agree
+func (r *BankReconciliation) IsReconciled() bool {
+	return r.Difference() == 0
+}
+
+// IsLocked checks if the report holds its period
+func (r *BankReconciliation) IsLocked() bool {
+	return r.Status == ReconciliationLocked
+}
+
+// Lock freezes the period. Only a reconciled report can be locked.
+func (r *BankReconciliation) Lock(lockedBy uuid.UUID) error {
+	if r.Status != "" {
+		return NewBankingError("reconciliation has already been locked", ErrReconciliationInvalid)
+	}
+	if !r.IsReconciled() {
+		return NewBankingErrorf(ErrReconciliationUnbalanced,
+			"adjusted statement and ledger balances differ by %s", r.Difference().Abs())
+	}
+
+	now := time.Now()
+	r.Status = ReconciliationLocked
+	r.LockedBy = &lockedBy
+	r.LockedAt = &now
+	return nil
+}
+
+// Unlock releases the period so its matches can change again
+func (r *BankReconciliation) Unlock(unlockedBy uuid.UUID, reason string) error {
+	if !r.IsLocked() {
+		return NewBankingError("reconciliation is not locked", ErrReconciliationInvalid)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return NewBankingError("a reason is required to unlock a reconciliation", ErrReconciliationInvalid)
+	}
+
+	now := time.Now()
+	r.Status = ReconciliationUnlocked
+	r.UnlockedBy = &unlockedBy
+	r.UnlockedAt = &now
+	r.UnlockReason = reason
+	return nil
+}
+
+// Covers checks if a date falls within the periods the report holds
+func (r *BankReconciliation) Covers(date time.Time) bool {
+	return r.IsLocked() && !date.After(r.PeriodEnd)
+}
@@ -0,0 +1,223 @@
+// backend/internal/banking/handler/bank_account_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/internal/banking/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/banking/service"
+	"github.com/chaitu35/costeasy/backend/pkg/contextx"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type BankAccountHandler struct {
+	service service.BankAccountServiceInterface
+}
+
+// NewBankAccountHandler creates a new bank account handler
+func NewBankAccountHandler(service service.BankAccountServiceInterface) *BankAccountHandler {
+	return &BankAccountHandler{service: service}
+}
+
+// CreateBankAccount handles POST /bank-accounts?organization_id=
+func (h *BankAccountHandler) CreateBankAccount(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	account, _, ok := bindBankAccount(c, orgID)
+	if !ok {
+		return
+	}
+	account.CreatedBy = getUserIDFromContext(c)
+
+	account, err := h.service.CreateBankAccount(c.Request.Context(), account)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create bank account",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toBankAccountResponse(account))
+}
+
+// UpdateBankAccount handles PUT /bank-accounts/:id?organization_id=
+func (h *BankAccountHandler) UpdateBankAccount(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	existing, err := h.service.GetBankAccount(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Bank account not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account, isActive, ok := bindBankAccount(c, orgID)
+	if !ok {
+		return
+	}
+	account.ID = id
+	account.IsActive = existing.IsActive
+	if isActive != nil {
+		account.IsActive = *isActive
+	}
+
+	account, err = h.service.UpdateBankAccount(c.Request.Context(), account)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update bank account",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toBankAccountResponse(account))
+}
+
+// GetBankAccount handles GET /bank-accounts/:id?organization_id=
+func (h *BankAccountHandler) GetBankAccount(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	account, err := h.service.GetBankAccount(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Bank account not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toBankAccountResponse(account))
+}
+
+// ListBankAccounts handles GET /bank-accounts?organization_id=&include_inactive=
+func (h *BankAccountHandler) ListBankAccounts(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	accounts, err := h.service.ListBankAccounts(c.Request.Context(), orgID, c.Query("include_inactive") == "true")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list bank accounts",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.BankAccountResponse, len(accounts))
+	for i, account := range accounts {
+		responses[i] = toBankAccountResponse(account)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// bindBankAccount reads a create or update request into a bank account,
+// writing the error response and returning false when it is invalid
+func bindBankAccount(c *gin.Context, orgID uuid.UUID) (*domain.BankAccount, *bool, bool) {
+	var req dto.BankAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	glAccountID, err := uuid.Parse(req.GLAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid ledger account ID",
+			Message: err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	account := &domain.BankAccount{
+		OrganizationID: orgID,
+		GLAccountID:    glAccountID,
+		Name:           req.Name,
+		BankName:       req.BankName,
+		AccountNumber:  req.AccountNumber,
+		IBAN:           req.IBAN,
+		SWIFTCode:      req.SWIFTCode,
+		Currency:       req.Currency,
+	}
+	return account, req.IsActive, true
+}
+
+// toBankAccountResponse converts domain.BankAccount to BankAccountResponse
+func toBankAccountResponse(a *domain.BankAccount) dto.BankAccountResponse {
+	return dto.BankAccountResponse{
+		ID:             a.ID.String(),
+		OrganizationID: a.OrganizationID.String(),
+		GLAccountID:    a.GLAccountID.String(),
+		Name:           a.Name,
+		BankName:       a.BankName,
+		AccountNumber:  a.AccountNumber,
+		IBAN:           a.IBAN,
+		SWIFTCode:      a.SWIFTCode,
+		Currency:       a.Currency,
+		IsActive:       a.IsActive,
+		CreatedAt:      a.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      a.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// parseOrganizationID reads the organization_id query parameter, writing the
+// error response and returning false when it is invalid
+func parseOrganizationID(c *gin.Context) (uuid.UUID, bool) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, false
+	}
+	return orgID, true
+}
+
+// parseOrgAndID reads the organization and the :id path parameter, writing
+// the error response and returning false when they are invalid
+func parseOrgAndID(c *gin.Context, what string) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid " + what + " ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return orgID, id, true
+}
+
+// getUserIDFromContext returns the authenticated user's ID. Banking routes
+// all require authentication.
+func getUserIDFromContext(c *gin.Context) uuid.UUID {
+	if uc, ok := contextx.Get(c.Request.Context()); ok {
+		return uc.UserID
+	}
+	return uuid.Nil
+}
@@ -0,0 +1,242 @@
+// backend/internal/banking/handler/bank_statement_handler.go
+package handler
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/internal/banking/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/banking/repository"
+	"github.com/chaitu35/costeasy/backend/internal/banking/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type BankStatementHandler struct {
+	service service.BankStatementServiceInterface
+}
+
+// NewBankStatementHandler creates a new bank statement handler
+func NewBankStatementHandler(service service.BankStatementServiceInterface) *BankStatementHandler {
+	return &BankStatementHandler{service: service}
+}
+
+// ImportStatement handles POST /bank-accounts/:id/statements?organization_id=&format=
+// (multipart "file"). The format (CSV, OFX, MT940, CAMT053) is worked out
+// from the file when not given.
+func (h *BankStatementHandler) ImportStatement(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, domain.MaxStatementSize+1<<20)
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "File is required",
+			Message: err.Error(),
+		})
+		return
+	}
+	if header.Size > domain.MaxStatementSize {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "File too large",
+			Message: "Statements cannot exceed 10MB",
+		})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to read file",
+			Message: err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to read file",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	format := domain.StatementFormat(c.Query("format"))
+	statement, err := h.service.ImportStatement(c.Request.Context(), orgID, bankAccountID, header.Filename, content, format, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to import statement",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toBankStatementResponse(statement))
+}
+
+// ListStatements handles GET /bank-accounts/:id/statements?organization_id=
+func (h *BankStatementHandler) ListStatements(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	statements, err := h.service.ListStatements(c.Request.Context(), orgID, bankAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list statements",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.BankStatementResponse, len(statements))
+	for i, statement := range statements {
+		responses[i] = toBankStatementResponse(statement)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetStatement handles GET /bank-statements/:id?organization_id=
+func (h *BankStatementHandler) GetStatement(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "statement")
+	if !ok {
+		return
+	}
+
+	statement, err := h.service.GetStatement(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Statement not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toBankStatementResponse(statement))
+}
+
+// ListStatementLines handles GET /bank-accounts/:id/statement-lines?organization_id=&status=&from=&to=
+func (h *BankStatementHandler) ListStatementLines(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	filter := repository.StatementLineFilter{
+		BankAccountID: bankAccountID,
+		Status:        domain.StatementLineStatus(c.Query("status")),
+	}
+	if filter.FromDate, ok = parseDateQuery(c, "from"); !ok {
+		return
+	}
+	if filter.ToDate, ok = parseDateQuery(c, "to"); !ok {
+		return
+	}
+
+	lines, err := h.service.ListStatementLines(c.Request.Context(), orgID, filter)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list statement lines",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.StatementLineResponse, len(lines))
+	for i, line := range lines {
+		responses[i] = toStatementLineResponse(line)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// toBankStatementResponse converts domain.BankStatement to BankStatementResponse
+func toBankStatementResponse(s *domain.BankStatement) dto.BankStatementResponse {
+	response := dto.BankStatementResponse{
+		ID:                 s.ID.String(),
+		BankAccountID:      s.BankAccountID.String(),
+		Format:             string(s.Format),
+		FileName:           s.FileName,
+		StatementReference: s.StatementReference,
+		PeriodStart:        s.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:          s.PeriodEnd.Format("2006-01-02"),
+		OpeningBalance:     s.OpeningBalance,
+		ClosingBalance:     s.ClosingBalance,
+		LineCount:          s.LineCount,
+		DuplicateCount:     s.DuplicateCount,
+		ImportedBy:         s.ImportedBy.String(),
+		ImportedAt:         s.ImportedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	for i := range s.Lines {
+		response.Lines = append(response.Lines, toStatementLineResponse(&s.Lines[i]))
+	}
+
+	return response
+}
+
+// toStatementLineResponse converts domain.StatementLine to StatementLineResponse
+func toStatementLineResponse(l *domain.StatementLine) dto.StatementLineResponse {
+	response := dto.StatementLineResponse{
+		ID:              l.ID.String(),
+		StatementID:     l.StatementID.String(),
+		LineNumber:      l.LineNumber,
+		TransactionDate: l.TransactionDate.Format("2006-01-02"),
+		Amount:          l.Amount,
+		Description:     l.Description,
+		Reference:       l.Reference,
+		Counterparty:    l.Counterparty,
+		Status:          string(l.Status),
+		JournalEntryID:  uuidString(l.JournalEntryID),
+		JournalLineID:   uuidString(l.JournalLineID),
+		MatchMethod:     string(l.MatchMethod),
+	}
+
+	if l.ValueDate != nil {
+		date := l.ValueDate.Format("2006-01-02")
+		response.ValueDate = &date
+	}
+	if l.MatchedAt != nil {
+		at := l.MatchedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.MatchedAt = &at
+	}
+
+	return response
+}
+
+// parseDateQuery reads an optional YYYY-MM-DD query parameter, writing the
+// error response and returning false when it is invalid
+func parseDateQuery(c *gin.Context, param string) (*time.Time, bool) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, true
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid " + param + " date",
+			Message: "Use format YYYY-MM-DD",
+		})
+		return nil, false
+	}
+	return &date, true
+}
+
+// uuidString formats an optional ID
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
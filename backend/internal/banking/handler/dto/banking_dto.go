@@ -0,0 +1,153 @@
+// backend/internal/banking/handler/dto/banking_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// BankAccountRequest represents the request body for creating or updating a bank account
+type BankAccountRequest struct {
+	GLAccountID   string `json:"gl_account_id" binding:"required"` // A postable ASSET account
+	Name          string `json:"name" binding:"required"`
+	BankName      string `json:"bank_name"`
+	AccountNumber string `json:"account_number"`
+	IBAN          string `json:"iban"`
+	SWIFTCode     string `json:"swift_code"`
+	Currency      string `json:"currency" binding:"required"`
+	IsActive      *bool  `json:"is_active"` // Updates only; defaults to unchanged
+}
+
+// BankAccountResponse represents a bank account
+type BankAccountResponse struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organization_id"`
+	GLAccountID    string `json:"gl_account_id"`
+	Name           string `json:"name"`
+	BankName       string `json:"bank_name,omitempty"`
+	AccountNumber  string `json:"account_number,omitempty"`
+	IBAN           string `json:"iban,omitempty"`
+	SWIFTCode      string `json:"swift_code,omitempty"`
+	Currency       string `json:"currency"`
+	IsActive       bool   `json:"is_active"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+// BankStatementResponse represents an imported statement
+type BankStatementResponse struct {
+	ID                 string                  `json:"id"`
+	BankAccountID      string                  `json:"bank_account_id"`
+	Format             string                  `json:"format"`
+	FileName           string                  `json:"file_name"`
+	StatementReference string                  `json:"statement_reference,omitempty"`
+	PeriodStart        string                  `json:"period_start"`
+	PeriodEnd          string                  `json:"period_end"`
+	OpeningBalance     *money.Amount           `json:"opening_balance,omitempty"`
+	ClosingBalance     *money.Amount           `json:"closing_balance,omitempty"`
+	LineCount          int                     `json:"line_count"`
+	DuplicateCount     int                     `json:"duplicate_count"` // Lines skipped as already imported
+	ImportedBy         string                  `json:"imported_by"`
+	ImportedAt         string                  `json:"imported_at"`
+	Lines              []StatementLineResponse `json:"lines,omitempty"`
+}
+
+// StatementLineResponse represents one transaction on a bank statement
+type StatementLineResponse struct {
+	ID              string       `json:"id"`
+	StatementID     string       `json:"statement_id"`
+	LineNumber      int          `json:"line_number"`
+	TransactionDate string       `json:"transaction_date"`
+	ValueDate       *string      `json:"value_date,omitempty"`
+	Amount          money.Amount `json:"amount"` // Positive is money in
+	Description     string       `json:"description"`
+	Reference       string       `json:"reference,omitempty"`
+	Counterparty    string       `json:"counterparty,omitempty"`
+	Status          string       `json:"status"`
+	JournalEntryID  *string      `json:"journal_entry_id,omitempty"`
+	JournalLineID   *string      `json:"journal_line_id,omitempty"`
+	MatchMethod     string       `json:"match_method,omitempty"`
+	MatchedAt       *string      `json:"matched_at,omitempty"`
+}
+
+// LedgerLineResponse represents a posted journal line on a bank account's ledger account
+type LedgerLineResponse struct {
+	JournalLineID   string       `json:"journal_line_id"`
+	JournalEntryID  string       `json:"journal_entry_id"`
+	EntryNumber     string       `json:"entry_number"`
+	TransactionDate string       `json:"transaction_date"`
+	Amount          money.Amount `json:"amount"` // Debit less credit, in the bank account currency
+	Reference       string       `json:"reference,omitempty"`
+	EntryReference  string       `json:"entry_reference,omitempty"`
+	Description     string       `json:"description"`
+}
+
+// AutoMatchRequest represents the request body for matching statement lines automatically
+type AutoMatchRequest struct {
+	WindowDays *int `json:"window_days"` // Days either side of the statement date; defaults to 3
+}
+
+// AutoMatchResponse represents the lines matched automatically
+type AutoMatchResponse struct {
+	MatchedCount int                     `json:"matched_count"`
+	Lines        []StatementLineResponse `json:"lines"`
+}
+
+// MatchLineRequest represents the request body for matching a statement line by hand
+type MatchLineRequest struct {
+	JournalLineID string `json:"journal_line_id" binding:"required"`
+}
+
+// CreateEntryRequest represents the request body for posting an entry from a statement line
+type CreateEntryRequest struct {
+	OffsetAccountID string `json:"offset_account_id" binding:"required"` // e.g. bank charges expense
+	Description     string `json:"description"`                          // Defaults to the statement description
+}
+
+// CreateEntryResponse represents a statement line with the entry posted for it
+type CreateEntryResponse struct {
+	Line        StatementLineResponse `json:"line"`
+	EntryID     string                `json:"entry_id"`
+	EntryNumber string                `json:"entry_number"`
+}
+
+// ReconciliationRequest represents the period of a reconciliation report. Without a
+// statement balance, the balance is taken from imported statements.
+type ReconciliationRequest struct {
+	PeriodStart      string        `json:"period_start" binding:"required"` // YYYY-MM-DD
+	PeriodEnd        string        `json:"period_end" binding:"required"`   // YYYY-MM-DD
+	StatementBalance *money.Amount `json:"statement_balance"`
+}
+
+// UnlockReconciliationRequest represents the request body for unlocking a reconciliation
+type UnlockReconciliationRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// ReconciliationResponse represents a bank reconciliation report
+type ReconciliationResponse struct {
+	ID                       *string                 `json:"id,omitempty"` // Set once locked
+	BankAccountID            string                  `json:"bank_account_id"`
+	PeriodStart              string                  `json:"period_start"`
+	PeriodEnd                string                  `json:"period_end"`
+	Status                   string                  `json:"status,omitempty"`
+	StatementBalance         money.Amount            `json:"statement_balance"`
+	OutstandingTotal         money.Amount            `json:"outstanding_total"`
+	AdjustedStatementBalance money.Amount            `json:"adjusted_statement_balance"`
+	LedgerBalance            money.Amount            `json:"ledger_balance"`
+	UnrecordedTotal          money.Amount            `json:"unrecorded_total"`
+	AdjustedLedgerBalance    money.Amount            `json:"adjusted_ledger_balance"`
+	Difference               money.Amount            `json:"difference"`
+	IsReconciled             bool                    `json:"is_reconciled"`
+	MatchedCount             int                     `json:"matched_count"`
+	UnrecordedLines          []StatementLineResponse `json:"unrecorded_lines"`
+	OutstandingLines         []LedgerLineResponse    `json:"outstanding_lines"`
+	LockedBy                 *string                 `json:"locked_by,omitempty"`
+	LockedAt                 *string                 `json:"locked_at,omitempty"`
+	UnlockedBy               *string                 `json:"unlocked_by,omitempty"`
+	UnlockedAt               *string                 `json:"unlocked_at,omitempty"`
+	UnlockReason             string                  `json:"unlock_reason,omitempty"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,427 @@
+// backend/internal/banking/handler/reconciliation_handler.go
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/internal/banking/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/banking/service"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReconciliationHandler struct {
+	service service.ReconciliationServiceInterface
+}
+
+// NewReconciliationHandler creates a new reconciliation handler
+func NewReconciliationHandler(service service.ReconciliationServiceInterface) *ReconciliationHandler {
+	return &ReconciliationHandler{service: service}
+}
+
+// AutoMatch handles POST /bank-accounts/:id/auto-match?organization_id=
+func (h *ReconciliationHandler) AutoMatch(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	var req dto.AutoMatchRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid request body",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+	windowDays := domain.DefaultMatchWindowDays
+	if req.WindowDays != nil {
+		windowDays = *req.WindowDays
+	}
+
+	lines, err := h.service.AutoMatch(c.Request.Context(), orgID, bankAccountID, windowDays, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to match statement lines",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := dto.AutoMatchResponse{
+		MatchedCount: len(lines),
+		Lines:        make([]dto.StatementLineResponse, len(lines)),
+	}
+	for i, line := range lines {
+		response.Lines[i] = toStatementLineResponse(line)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListLedgerLines handles GET /bank-accounts/:id/ledger-lines?organization_id=&from=&to=
+// Lists posted ledger lines not yet matched, for matching by hand
+func (h *ReconciliationHandler) ListLedgerLines(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	from, ok := parseDateQuery(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseDateQuery(c, "to")
+	if !ok {
+		return
+	}
+
+	lines, err := h.service.ListUnmatchedLedgerLines(c.Request.Context(), orgID, bankAccountID, from, to)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list ledger lines",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.LedgerLineResponse, len(lines))
+	for i, line := range lines {
+		responses[i] = toLedgerLineResponse(line)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// MatchLine handles POST /bank-statement-lines/:id/match?organization_id=
+func (h *ReconciliationHandler) MatchLine(c *gin.Context) {
+	orgID, lineID, ok := parseOrgAndID(c, "statement line")
+	if !ok {
+		return
+	}
+
+	var req dto.MatchLineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	journalLineID, err := uuid.Parse(req.JournalLineID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid journal line ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	line, err := h.service.MatchLine(c.Request.Context(), orgID, lineID, journalLineID, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to match statement line",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toStatementLineResponse(line))
+}
+
+// UnmatchLine handles POST /bank-statement-lines/:id/unmatch?organization_id=
+func (h *ReconciliationHandler) UnmatchLine(c *gin.Context) {
+	orgID, lineID, ok := parseOrgAndID(c, "statement line")
+	if !ok {
+		return
+	}
+
+	line, err := h.service.UnmatchLine(c.Request.Context(), orgID, lineID, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to unmatch statement line",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toStatementLineResponse(line))
+}
+
+// CreateEntry handles POST /bank-statement-lines/:id/entry?organization_id=
+// Posts an entry for a line missing from the ledger, such as a bank charge
+func (h *ReconciliationHandler) CreateEntry(c *gin.Context) {
+	orgID, lineID, ok := parseOrgAndID(c, "statement line")
+	if !ok {
+		return
+	}
+
+	var req dto.CreateEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	offsetAccountID, err := uuid.Parse(req.OffsetAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid offset account ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	line, entry, err := h.service.CreateEntryFromLine(c.Request.Context(), orgID, lineID, offsetAccountID, req.Description, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create entry",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateEntryResponse{
+		Line:        toStatementLineResponse(line),
+		EntryID:     entry.ID.String(),
+		EntryNumber: entry.EntryNumber,
+	})
+}
+
+// GetReport handles GET /bank-accounts/:id/reconciliation?organization_id=&period_start=&period_end=&statement_balance=
+// Builds the report without saving it
+func (h *ReconciliationHandler) GetReport(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	req := dto.ReconciliationRequest{
+		PeriodStart: c.Query("period_start"),
+		PeriodEnd:   c.Query("period_end"),
+	}
+	if value := c.Query("statement_balance"); value != "" {
+		balance, err := money.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid statement balance",
+				Message: err.Error(),
+			})
+			return
+		}
+		req.StatementBalance = &balance
+	}
+
+	start, end, ok := parsePeriod(c, req)
+	if !ok {
+		return
+	}
+
+	rec, err := h.service.GetReport(c.Request.Context(), orgID, bankAccountID, start, end, req.StatementBalance)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to build reconciliation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationResponse(rec))
+}
+
+// LockReconciliation handles POST /bank-accounts/:id/reconciliations?organization_id=
+// Saves a reconciled report and freezes matching up to its period end
+func (h *ReconciliationHandler) LockReconciliation(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	var req dto.ReconciliationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	start, end, ok := parsePeriod(c, req)
+	if !ok {
+		return
+	}
+
+	rec, err := h.service.LockReconciliation(c.Request.Context(), orgID, bankAccountID, start, end, req.StatementBalance, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to lock reconciliation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toReconciliationResponse(rec))
+}
+
+// ListReconciliations handles GET /bank-accounts/:id/reconciliations?organization_id=
+func (h *ReconciliationHandler) ListReconciliations(c *gin.Context) {
+	orgID, bankAccountID, ok := parseOrgAndID(c, "bank account")
+	if !ok {
+		return
+	}
+
+	recs, err := h.service.ListReconciliations(c.Request.Context(), orgID, bankAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list reconciliations",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.ReconciliationResponse, len(recs))
+	for i, rec := range recs {
+		responses[i] = toReconciliationResponse(rec)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetReconciliation handles GET /bank-reconciliations/:id?organization_id=
+func (h *ReconciliationHandler) GetReconciliation(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "reconciliation")
+	if !ok {
+		return
+	}
+
+	rec, err := h.service.GetReconciliation(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Reconciliation not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationResponse(rec))
+}
+
+// UnlockReconciliation handles POST /bank-reconciliations/:id/unlock?organization_id=
+func (h *ReconciliationHandler) UnlockReconciliation(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "reconciliation")
+	if !ok {
+		return
+	}
+
+	var req dto.UnlockReconciliationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rec, err := h.service.UnlockReconciliation(c.Request.Context(), orgID, id, getUserIDFromContext(c), req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to unlock reconciliation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationResponse(rec))
+}
+
+// parsePeriod reads a report's period, writing the error response and
+// returning false when it is invalid
+func parsePeriod(c *gin.Context, req dto.ReconciliationRequest) (time.Time, time.Time, bool) {
+	start, err := time.Parse("2006-01-02", req.PeriodStart)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid period_start format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return time.Time{}, time.Time{}, false
+	}
+
+	end, err := time.Parse("2006-01-02", req.PeriodEnd)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid period_end format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return time.Time{}, time.Time{}, false
+	}
+
+	return start, end, true
+}
+
+// toLedgerLineResponse converts domain.LedgerLine to LedgerLineResponse
+func toLedgerLineResponse(l *domain.LedgerLine) dto.LedgerLineResponse {
+	return dto.LedgerLineResponse{
+		JournalLineID:   l.JournalLineID.String(),
+		JournalEntryID:  l.JournalEntryID.String(),
+		EntryNumber:     l.EntryNumber,
+		TransactionDate: l.TransactionDate.Format("2006-01-02"),
+		Amount:          l.Amount,
+		Reference:       l.Reference,
+		EntryReference:  l.EntryReference,
+		Description:     l.Description,
+	}
+}
+
+// toReconciliationResponse converts domain.BankReconciliation to ReconciliationResponse
+func toReconciliationResponse(r *domain.BankReconciliation) dto.ReconciliationResponse {
+	response := dto.ReconciliationResponse{
+		BankAccountID:            r.BankAccountID.String(),
+		PeriodStart:              r.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:                r.PeriodEnd.Format("2006-01-02"),
+		Status:                   string(r.Status),
+		StatementBalance:         r.StatementBalance,
+		OutstandingTotal:         r.OutstandingTotal,
+		AdjustedStatementBalance: r.AdjustedStatementBalance(),
+		LedgerBalance:            r.LedgerBalance,
+		UnrecordedTotal:          r.UnrecordedTotal,
+		AdjustedLedgerBalance:    r.AdjustedLedgerBalance(),
+		Difference:               r.Difference(),
+		IsReconciled:             r.IsReconciled(),
+		MatchedCount:             r.MatchedCount,
+		UnrecordedLines:          make([]dto.StatementLineResponse, len(r.Items.UnrecordedLines)),
+		OutstandingLines:         make([]dto.LedgerLineResponse, len(r.Items.OutstandingLines)),
+		LockedBy:                 uuidString(r.LockedBy),
+		UnlockedBy:               uuidString(r.UnlockedBy),
+		UnlockReason:             r.UnlockReason,
+	}
+
+	// Reports are only saved, and so only have an ID, once locked
+	if r.Status != "" {
+		id := r.ID.String()
+		response.ID = &id
+	}
+	for i := range r.Items.UnrecordedLines {
+		response.UnrecordedLines[i] = toStatementLineResponse(&r.Items.UnrecordedLines[i])
+	}
+	for i := range r.Items.OutstandingLines {
+		response.OutstandingLines[i] = toLedgerLineResponse(&r.Items.OutstandingLines[i])
+	}
+	if r.LockedAt != nil {
+		at := r.LockedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.LockedAt = &at
+	}
+	if r.UnlockedAt != nil {
+		at := r.UnlockedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.UnlockedAt = &at
+	}
+
+	return response
+}
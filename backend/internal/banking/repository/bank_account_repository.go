@@ -0,0 +1,170 @@
+// backend/internal/banking/repository/bank_account_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BankAccountRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBankAccountRepository creates a new bank account repository
+func NewBankAccountRepository(pool *pgxpool.Pool) *BankAccountRepository {
+	return &BankAccountRepository{pool: pool}
+}
+
+const bankAccountColumns = `
+        id, organization_id, gl_account_id, name, COALESCE(bank_name, ''),
+        COALESCE(account_number, ''), COALESCE(iban, ''), COALESCE(swift_code, ''),
+        currency, is_active, created_by, created_at, updated_at
+`
+
+// Create saves a new bank account
+func (r *BankAccountRepository) Create(ctx context.Context, a *domain.BankAccount) error {
+	query := `
+        INSERT INTO bank_accounts (
+            id, organization_id, gl_account_id, name, bank_name, account_number,
+            iban, swift_code, currency, is_active, created_by, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), $9, $10, $11, $12, $13)
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		a.ID,
+		a.OrganizationID,
+		a.GLAccountID,
+		a.Name,
+		a.BankName,
+		a.AccountNumber,
+		a.IBAN,
+		a.SWIFTCode,
+		a.Currency,
+		a.IsActive,
+		a.CreatedBy,
+		a.CreatedAt,
+		a.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create bank account: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves changes to a bank account
+func (r *BankAccountRepository) Update(ctx context.Context, a *domain.BankAccount) error {
+	query := `
+        UPDATE bank_accounts
+        SET gl_account_id = $2, name = $3, bank_name = NULLIF($4, ''),
+            account_number = NULLIF($5, ''), iban = NULLIF($6, ''),
+            swift_code = NULLIF($7, ''), currency = $8, is_active = $9, updated_at = $10
+        WHERE id = $1
+    `
+
+	result, err := r.pool.Exec(ctx, query,
+		a.ID,
+		a.GLAccountID,
+		a.Name,
+		a.BankName,
+		a.AccountNumber,
+		a.IBAN,
+		a.SWIFTCode,
+		a.Currency,
+		a.IsActive,
+		a.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update bank account: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.NewBankingError("bank account not found", domain.ErrBankAccountNotFound)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a bank account
+func (r *BankAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BankAccount, error) {
+	query := "SELECT" + bankAccountColumns + "FROM bank_accounts WHERE id = $1"
+
+	a, err := scanBankAccount(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewBankingError("bank account not found", domain.ErrBankAccountNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank account: %w", err)
+	}
+
+	return a, nil
+}
+
+// GetByGLAccount retrieves the bank account posted to a ledger account, or nil if none
+func (r *BankAccountRepository) GetByGLAccount(ctx context.Context, glAccountID uuid.UUID) (*domain.BankAccount, error) {
+	query := "SELECT" + bankAccountColumns + "FROM bank_accounts WHERE gl_account_id = $1"
+
+	a, err := scanBankAccount(r.pool.QueryRow(ctx, query, glAccountID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bank account: %w", err)
+	}
+
+	return a, nil
+}
+
+// List lists an organization's bank accounts by name
+func (r *BankAccountRepository) List(ctx context.Context, orgID uuid.UUID, includeInactive bool) ([]*domain.BankAccount, error) {
+	query := "SELECT" + bankAccountColumns + `
+        FROM bank_accounts
+        WHERE organization_id = $1 AND ($2 OR is_active)
+        ORDER BY name
+    `
+
+	rows, err := r.pool.Query(ctx, query, orgID, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bank accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []*domain.BankAccount
+	for rows.Next() {
+		a, err := scanBankAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bank account: %w", err)
+		}
+		accounts = append(accounts, a)
+	}
+
+	return accounts, rows.Err()
+}
+
+// scanBankAccount reads a row selected with bankAccountColumns
+func scanBankAccount(row pgx.Row) (*domain.BankAccount, error) {
+	var a domain.BankAccount
+	err := row.Scan(
+		&a.ID,
+		&a.OrganizationID,
+		&a.GLAccountID,
+		&a.Name,
+		&a.BankName,
+		&a.AccountNumber,
+		&a.IBAN,
+		&a.SWIFTCode,
+		&a.Currency,
+		&a.IsActive,
+		&a.CreatedBy,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
@@ -0,0 +1,27 @@
+// backend/internal/banking/repository/bank_account_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/google/uuid"
+)
+
+// BankAccountRepositoryInterface defines data access for bank accounts
+type BankAccountRepositoryInterface interface {
+	// Create saves a new bank account
+	Create(ctx context.Context, account *domain.BankAccount) error
+
+	// Update saves changes to a bank account
+	Update(ctx context.Context, account *domain.BankAccount) error
+
+	// GetByID retrieves a bank account
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.BankAccount, error)
+
+	// GetByGLAccount retrieves the bank account posted to a ledger account (nil if none)
+	GetByGLAccount(ctx context.Context, glAccountID uuid.UUID) (*domain.BankAccount, error)
+
+	// List lists an organization's bank accounts by name
+	List(ctx context.Context, orgID uuid.UUID, includeInactive bool) ([]*domain.BankAccount, error)
+}
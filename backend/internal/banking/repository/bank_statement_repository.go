@@ -0,0 +1,437 @@
+// backend/internal/banking/repository/bank_statement_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BankStatementRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBankStatementRepository creates a new bank statement repository
+func NewBankStatementRepository(pool *pgxpool.Pool) *BankStatementRepository {
+	return &BankStatementRepository{pool: pool}
+}
+
+const bankStatementColumns = `
+        id, organization_id, bank_account_id, format, file_name, checksum,
+        COALESCE(statement_reference, ''), period_start, period_end,
+        opening_balance, closing_balance, line_count, duplicate_count,
+        imported_by, imported_at
+`
+
+const statementLineColumns = `
+        id, statement_id, bank_account_id, line_number, transaction_date, value_date,
+        amount, description, COALESCE(reference, ''), COALESCE(counterparty, ''),
+        external_id, status, journal_entry_id, journal_line_id,
+        COALESCE(match_method, ''), matched_by, matched_at
+`
+
+// Create saves a statement with its lines in one transaction. Lines already
+// imported for the bank account, from an overlapping statement, are skipped
+// and counted as duplicates; the statement keeps only the lines saved. New
+// lines dated within a locked reconciliation (by lockedThrough) would change
+// it, so the import is refused.
+func (r *BankStatementRepository) Create(ctx context.Context, s *domain.BankStatement, lockedThrough *time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+        SELECT EXISTS (SELECT 1 FROM bank_statements WHERE bank_account_id = $1 AND checksum = $2)
+    `, s.BankAccountID, s.Checksum).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check statement: %w", err)
+	}
+	if exists {
+		return domain.NewBankingErrorf(domain.ErrStatementDuplicate, "%s has already been imported", s.FileName)
+	}
+
+	statementQuery := `
+        INSERT INTO bank_statements (
+            id, organization_id, bank_account_id, format, file_name, checksum,
+            statement_reference, period_start, period_end, opening_balance,
+            closing_balance, imported_by, imported_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10, $11, $12, $13)
+    `
+
+	_, err = tx.Exec(ctx, statementQuery,
+		s.ID,
+		s.OrganizationID,
+		s.BankAccountID,
+		s.Format,
+		s.FileName,
+		s.Checksum,
+		s.StatementReference,
+		s.PeriodStart,
+		s.PeriodEnd,
+		s.OpeningBalance,
+		s.ClosingBalance,
+		s.ImportedBy,
+		s.ImportedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert bank statement: %w", err)
+	}
+
+	lineQuery := `
+        INSERT INTO bank_statement_lines (
+            id, statement_id, bank_account_id, line_number, transaction_date,
+            value_date, amount, description, reference, counterparty, external_id, status
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NULLIF($10, ''), $11, $12)
+        ON CONFLICT (bank_account_id, external_id) DO NOTHING
+    `
+
+	saved := make([]domain.StatementLine, 0, len(s.Lines))
+	for _, line := range s.Lines {
+		result, err := tx.Exec(ctx, lineQuery,
+			line.ID,
+			s.ID,
+			s.BankAccountID,
+			line.LineNumber,
+			line.TransactionDate,
+			line.ValueDate,
+			line.Amount,
+			line.Description,
+			line.Reference,
+			line.Counterparty,
+			line.ExternalID,
+			line.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert statement line %d: %w", line.LineNumber, err)
+		}
+		if result.RowsAffected() == 0 {
+			s.DuplicateCount++
+			continue
+		}
+		if lockedThrough != nil && !line.TransactionDate.After(*lockedThrough) {
+			return domain.NewBankingErrorf(domain.ErrReconciliationLocked,
+				"line %d is dated %s, within a locked reconciliation ending %s",
+				line.LineNumber, line.TransactionDate.Format("2006-01-02"), lockedThrough.Format("2006-01-02"))
+		}
+		saved = append(saved, line)
+	}
+	s.Lines = saved
+	s.LineCount = len(saved)
+
+	_, err = tx.Exec(ctx, `
+        UPDATE bank_statements SET line_count = $2, duplicate_count = $3 WHERE id = $1
+    `, s.ID, s.LineCount, s.DuplicateCount)
+	if err != nil {
+		return fmt.Errorf("failed to update bank statement: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a statement with its lines
+func (r *BankStatementRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BankStatement, error) {
+	statements, err := r.queryStatements(ctx, "SELECT"+bankStatementColumns+"FROM bank_statements WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, domain.NewBankingError("bank statement not found", domain.ErrStatementNotFound)
+	}
+
+	s := statements[0]
+	lines, err := r.queryLines(ctx, "SELECT"+statementLineColumns+`
+        FROM bank_statement_lines
+        WHERE statement_id = $1
+        ORDER BY line_number
+    `, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		s.Lines = append(s.Lines, *line)
+	}
+
+	return s, nil
+}
+
+// List lists a bank account's statements without lines, most recent first
+func (r *BankStatementRepository) List(ctx context.Context, bankAccountID uuid.UUID) ([]*domain.BankStatement, error) {
+	query := "SELECT" + bankStatementColumns + `
+        FROM bank_statements
+        WHERE bank_account_id = $1
+        ORDER BY period_end DESC, imported_at DESC
+    `
+
+	return r.queryStatements(ctx, query, bankAccountID)
+}
+
+// GetLine retrieves a statement line
+func (r *BankStatementRepository) GetLine(ctx context.Context, id uuid.UUID) (*domain.StatementLine, error) {
+	lines, err := r.queryLines(ctx, "SELECT"+statementLineColumns+"FROM bank_statement_lines WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, domain.NewBankingError("statement line not found", domain.ErrStatementLineNotFound)
+	}
+	return lines[0], nil
+}
+
+// ListLines lists a bank account's statement lines matching a filter, by date
+func (r *BankStatementRepository) ListLines(ctx context.Context, filter StatementLineFilter) ([]*domain.StatementLine, error) {
+	conditions := []string{"bank_account_id = $1"}
+	args := []interface{}{filter.BankAccountID}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.FromDate != nil {
+		args = append(args, *filter.FromDate)
+		conditions = append(conditions, fmt.Sprintf("transaction_date >= $%d", len(args)))
+	}
+	if filter.ToDate != nil {
+		args = append(args, *filter.ToDate)
+		conditions = append(conditions, fmt.Sprintf("transaction_date <= $%d", len(args)))
+	}
+
+	query := "SELECT" + statementLineColumns + `
+        FROM bank_statement_lines
+        WHERE ` + strings.Join(conditions, " AND ") + `
+        ORDER BY transaction_date, statement_id, line_number
+    `
+
+	return r.queryLines(ctx, query, args...)
+}
+
+// ListUnrecorded lists a bank account's statement lines dated by a date that
+// were not yet in the ledger at the end of it: those unmatched, and those
+// matched to entries dated later
+func (r *BankStatementRepository) ListUnrecorded(ctx context.Context, bankAccountID uuid.UUID, asOfDate time.Time) ([]*domain.StatementLine, error) {
+	query := "SELECT" + statementLineColumns + `
+        FROM bank_statement_lines
+        WHERE bank_account_id = $1
+          AND transaction_date <= $2
+          AND (
+              status = 'UNMATCHED'
+              OR EXISTS (
+                  SELECT 1 FROM journal_entries je
+                  WHERE je.id = bank_statement_lines.journal_entry_id AND je.transaction_date > $2
+              )
+          )
+        ORDER BY transaction_date, statement_id, line_number
+    `
+
+	return r.queryLines(ctx, query, bankAccountID, asOfDate)
+}
+
+// SaveMatches saves the match state of statement lines in one transaction
+func (r *BankStatementRepository) SaveMatches(ctx context.Context, lines []*domain.StatementLine) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, line := range lines {
+		if err := updateLineMatch(ctx, tx, line); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// MatchWithEntry saves a posted entry and the statement line it was created
+// from, matched to it, in one transaction
+func (r *BankStatementRepository) MatchWithEntry(ctx context.Context, line *domain.StatementLine, entry *gldomain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := glrepository.InsertJournalEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if err := updateLineMatch(ctx, tx, line); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetBalanceAsOf works out the bank balance at the end of a date: the
+// closing balance of the latest statement ending on or before it, plus the
+// lines imported since. Returns nil when no statement states a closing balance.
+func (r *BankStatementRepository) GetBalanceAsOf(ctx context.Context, bankAccountID uuid.UUID, date time.Time) (*money.Amount, error) {
+	var closing money.Amount
+	var periodEnd time.Time
+	err := r.pool.QueryRow(ctx, `
+        SELECT closing_balance, period_end
+        FROM bank_statements
+        WHERE bank_account_id = $1 AND closing_balance IS NOT NULL AND period_end <= $2
+        ORDER BY period_end DESC, imported_at DESC
+        LIMIT 1
+    `, bankAccountID, date).Scan(&closing, &periodEnd)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statement closing balance: %w", err)
+	}
+
+	var movement money.Amount
+	err = r.pool.QueryRow(ctx, `
+        SELECT COALESCE(SUM(amount), 0)
+        FROM bank_statement_lines
+        WHERE bank_account_id = $1 AND transaction_date > $2 AND transaction_date <= $3
+    `, bankAccountID, periodEnd, date).Scan(&movement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum statement lines: %w", err)
+	}
+
+	balance := closing + movement
+	return &balance, nil
+}
+
+// CountMatched counts a bank account's matched statement lines dated within a period
+func (r *BankStatementRepository) CountMatched(ctx context.Context, bankAccountID uuid.UUID, from, to time.Time) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `
+        SELECT COUNT(*)
+        FROM bank_statement_lines
+        WHERE bank_account_id = $1 AND status = 'MATCHED'
+          AND transaction_date >= $2 AND transaction_date <= $3
+    `, bankAccountID, from, to).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count matched statement lines: %w", err)
+	}
+	return count, nil
+}
+
+// updateLineMatch saves a statement line's match state within a transaction
+func updateLineMatch(ctx context.Context, tx pgx.Tx, line *domain.StatementLine) error {
+	query := `
+        UPDATE bank_statement_lines
+        SET status = $2, journal_entry_id = $3, journal_line_id = $4,
+            match_method = NULLIF($5, ''), matched_by = $6, matched_at = $7
+        WHERE id = $1
+    `
+
+	_, err := tx.Exec(ctx, query,
+		line.ID,
+		line.Status,
+		line.JournalEntryID,
+		line.JournalLineID,
+		line.MatchMethod,
+		line.MatchedBy,
+		line.MatchedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update statement line %d: %w", line.LineNumber, err)
+	}
+
+	return nil
+}
+
+// queryStatements runs a query selecting bankStatementColumns
+func (r *BankStatementRepository) queryStatements(ctx context.Context, query string, args ...interface{}) ([]*domain.BankStatement, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bank statements: %w", err)
+	}
+	defer rows.Close()
+
+	var statements []*domain.BankStatement
+	for rows.Next() {
+		s := &domain.BankStatement{}
+		err := rows.Scan(
+			&s.ID,
+			&s.OrganizationID,
+			&s.BankAccountID,
+			&s.Format,
+			&s.FileName,
+			&s.Checksum,
+			&s.StatementReference,
+			&s.PeriodStart,
+			&s.PeriodEnd,
+			&s.OpeningBalance,
+			&s.ClosingBalance,
+			&s.LineCount,
+			&s.DuplicateCount,
+			&s.ImportedBy,
+			&s.ImportedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bank statement: %w", err)
+		}
+		statements = append(statements, s)
+	}
+
+	return statements, rows.Err()
+}
+
+// queryLines runs a query selecting statementLineColumns
+func (r *BankStatementRepository) queryLines(ctx context.Context, query string, args ...interface{}) ([]*domain.StatementLine, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query statement lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []*domain.StatementLine
+	for rows.Next() {
+		line := &domain.StatementLine{}
+		err := rows.Scan(
+			&line.ID,
+			&line.StatementID,
+			&line.BankAccountID,
+			&line.LineNumber,
+			&line.TransactionDate,
+			&line.ValueDate,
+			&line.Amount,
+			&line.Description,
+			&line.Reference,
+			&line.Counterparty,
+			&line.ExternalID,
+			&line.Status,
+			&line.JournalEntryID,
+			&line.JournalLineID,
+			&line.MatchMethod,
+			&line.MatchedBy,
+			&line.MatchedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan statement line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
@@ -0,0 +1,58 @@
+// backend/internal/banking/repository/bank_statement_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// BankStatementRepositoryInterface defines data access for imported bank statements
+type BankStatementRepositoryInterface interface {
+	// Create saves a statement with its lines in one transaction, skipping
+	// lines already imported for the bank account. New lines dated by
+	// lockedThrough (when given) are refused.
+	Create(ctx context.Context, statement *domain.BankStatement, lockedThrough *time.Time) error
+
+	// GetByID retrieves a statement with its lines
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.BankStatement, error)
+
+	// List lists a bank account's statements without lines, most recent first
+	List(ctx context.Context, bankAccountID uuid.UUID) ([]*domain.BankStatement, error)
+
+	// GetLine retrieves a statement line
+	GetLine(ctx context.Context, id uuid.UUID) (*domain.StatementLine, error)
+
+	// ListLines lists a bank account's statement lines matching a filter, by date
+	ListLines(ctx context.Context, filter StatementLineFilter) ([]*domain.StatementLine, error)
+
+	// ListUnrecorded lists a bank account's statement lines dated by a date
+	// that were not yet in the ledger at the end of it, by date
+	ListUnrecorded(ctx context.Context, bankAccountID uuid.UUID, asOfDate time.Time) ([]*domain.StatementLine, error)
+
+	// SaveMatches saves the match state of statement lines in one transaction
+	SaveMatches(ctx context.Context, lines []*domain.StatementLine) error
+
+	// MatchWithEntry saves a posted entry and the statement line it was created
+	// from, matched to it, in one transaction
+	MatchWithEntry(ctx context.Context, line *domain.StatementLine, entry *gldomain.JournalEntry) error
+
+	// GetBalanceAsOf works out the bank balance at the end of a date from the
+	// latest closing balance on or before it (nil if no statement states one)
+	GetBalanceAsOf(ctx context.Context, bankAccountID uuid.UUID, date time.Time) (*money.Amount, error)
+
+	// CountMatched counts a bank account's matched statement lines dated within a period
+	CountMatched(ctx context.Context, bankAccountID uuid.UUID, from, to time.Time) (int, error)
+}
+
+// StatementLineFilter selects statement lines; empty fields match everything
+type StatementLineFilter struct {
+	BankAccountID uuid.UUID
+	Status        domain.StatementLineStatus
+	FromDate      *time.Time
+	ToDate        *time.Time
+}
@@ -0,0 +1,165 @@
+// backend/internal/banking/repository/ledger_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LedgerRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLedgerRepository creates a new ledger repository
+func NewLedgerRepository(pool *pgxpool.Pool) *LedgerRepository {
+	return &LedgerRepository{pool: pool}
+}
+
+// ledgerAmount is a line's amount in the bank account's currency: the base
+// amount unless a foreign currency ($3) is given
+const ledgerAmount = `
+        CASE WHEN $3 = '' THEN jl.debit - jl.credit ELSE jl.foreign_debit - jl.foreign_credit END
+`
+
+// unmatchedLedgerQuery selects posted lines on the ledger account ($2) dated
+// by the cutoff ($4) that no statement line dated by then has cleared. An
+// entry and its reversal both dated by the cutoff cancel out and are left
+// out unless one of them is already matched, which leaves the other to be
+// matched too.
+const unmatchedLedgerQuery = `
+        SELECT jl.id, je.id, je.entry_number, je.transaction_date,` + ledgerAmount + `AS amount,
+               COALESCE(jl.reference, ''), COALESCE(je.reference, ''), jl.description
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        WHERE je.organization_id = $1
+          AND jl.account_id = $2
+          AND ($3 = '' OR jl.currency = $3)
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND je.transaction_date <= $4
+          AND NOT EXISTS (
+              SELECT 1 FROM bank_statement_lines bsl
+              WHERE bsl.journal_line_id = jl.id AND bsl.transaction_date <= $4
+          )
+          AND NOT EXISTS (
+              SELECT 1 FROM journal_entries pair
+              WHERE (pair.id = je.reversal_of OR pair.reversal_of = je.id)
+                AND pair.status IN ('POSTED', 'REVERSED')
+                AND pair.transaction_date <= $4
+                AND NOT EXISTS (
+                    SELECT 1 FROM bank_statement_lines bsl
+                    WHERE bsl.journal_entry_id IN (pair.id, je.id)
+                )
+          )
+`
+
+// ListUnmatched lists posted ledger lines not matched to a statement line, by
+// date. With a to date, it lists the lines outstanding at the end of it:
+// lines matched to statement lines dated later were still in transit then.
+// Lines with no amount in the account's currency are left out.
+func (r *LedgerRepository) ListUnmatched(ctx context.Context, filter LedgerFilter) ([]*domain.LedgerLine, error) {
+	toDate := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if filter.ToDate != nil {
+		toDate = *filter.ToDate
+	}
+	args := []interface{}{filter.OrganizationID, filter.GLAccountID, filter.Currency, toDate}
+
+	query := unmatchedLedgerQuery
+	if filter.FromDate != nil {
+		args = append(args, *filter.FromDate)
+		query += " AND je.transaction_date >= $5"
+	}
+	query += " AND (" + ledgerAmount + ") <> 0 ORDER BY je.transaction_date, je.entry_number, jl.line_number"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []*domain.LedgerLine
+	for rows.Next() {
+		line, err := scanLedgerLine(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ledger line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
+
+// GetUnmatchedLine retrieves a posted ledger line on the bank account's
+// ledger account that no statement line has cleared yet
+func (r *LedgerRepository) GetUnmatchedLine(ctx context.Context, filter LedgerFilter, journalLineID uuid.UUID) (*domain.LedgerLine, error) {
+	query := `
+        SELECT jl.id, je.id, je.entry_number, je.transaction_date,` + ledgerAmount + `AS amount,
+               COALESCE(jl.reference, ''), COALESCE(je.reference, ''), jl.description
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        WHERE je.organization_id = $1
+          AND jl.account_id = $2
+          AND ($3 = '' OR jl.currency = $3)
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND jl.id = $4
+          AND NOT EXISTS (SELECT 1 FROM bank_statement_lines bsl WHERE bsl.journal_line_id = jl.id)
+    `
+
+	line, err := scanLedgerLine(r.pool.QueryRow(ctx, query, filter.OrganizationID, filter.GLAccountID, filter.Currency, journalLineID))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewBankingError("ledger line not found on the bank account, not posted or already matched", domain.ErrLedgerLineNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger line: %w", err)
+	}
+
+	return line, nil
+}
+
+// GetBalance sums the ledger account's posted lines up to the end of a date,
+// in the bank account's currency
+func (r *LedgerRepository) GetBalance(ctx context.Context, filter LedgerFilter, asOfDate time.Time) (money.Amount, error) {
+	query := `
+        SELECT COALESCE(SUM(` + ledgerAmount + `), 0)
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        WHERE je.organization_id = $1
+          AND jl.account_id = $2
+          AND ($3 = '' OR jl.currency = $3)
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND je.transaction_date <= $4
+    `
+
+	var balance money.Amount
+	err := r.pool.QueryRow(ctx, query, filter.OrganizationID, filter.GLAccountID, filter.Currency, asOfDate).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ledger balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// scanLedgerLine reads a row selected like unmatchedLedgerQuery
+func scanLedgerLine(row pgx.Row) (*domain.LedgerLine, error) {
+	line := &domain.LedgerLine{}
+	err := row.Scan(
+		&line.JournalLineID,
+		&line.JournalEntryID,
+		&line.EntryNumber,
+		&line.TransactionDate,
+		&line.Amount,
+		&line.Reference,
+		&line.EntryReference,
+		&line.Description,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return line, nil
+}
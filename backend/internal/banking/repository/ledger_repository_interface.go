@@ -0,0 +1,36 @@
+// backend/internal/banking/repository/ledger_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// LedgerRepositoryInterface reads the posted journal lines of bank accounts'
+// ledger accounts for reconciliation
+type LedgerRepositoryInterface interface {
+	// ListUnmatched lists posted ledger lines not matched to a statement line
+	// dated by the filter's to date, by date
+	ListUnmatched(ctx context.Context, filter LedgerFilter) ([]*domain.LedgerLine, error)
+
+	// GetUnmatchedLine retrieves a posted ledger line not yet matched to a statement line
+	GetUnmatchedLine(ctx context.Context, filter LedgerFilter, journalLineID uuid.UUID) (*domain.LedgerLine, error)
+
+	// GetBalance sums the ledger account's posted lines up to the end of a date
+	GetBalance(ctx context.Context, filter LedgerFilter, asOfDate time.Time) (money.Amount, error)
+}
+
+// LedgerFilter selects a bank account's ledger lines. Currency is set for
+// bank accounts held in a foreign currency, whose lines are read in that
+// currency rather than the base currency.
+type LedgerFilter struct {
+	OrganizationID uuid.UUID
+	GLAccountID    uuid.UUID
+	Currency       string
+	FromDate       *time.Time
+	ToDate         *time.Time
+}
@@ -0,0 +1,171 @@
+// backend/internal/banking/repository/reconciliation_repository.go
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReconciliationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReconciliationRepository creates a new reconciliation repository
+func NewReconciliationRepository(pool *pgxpool.Pool) *ReconciliationRepository {
+	return &ReconciliationRepository{pool: pool}
+}
+
+const reconciliationColumns = `
+        id, organization_id, bank_account_id, period_start, period_end, status,
+        statement_balance, ledger_balance, unrecorded_total, outstanding_total,
+        matched_count, items, locked_by, locked_at, unlocked_by, unlocked_at,
+        COALESCE(unlock_reason, '')
+`
+
+// Create saves a locked reconciliation report
+func (r *ReconciliationRepository) Create(ctx context.Context, rec *domain.BankReconciliation) error {
+	items, err := json.Marshal(rec.Items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reconciliation items: %w", err)
+	}
+
+	query := `
+        INSERT INTO bank_reconciliations (
+            id, organization_id, bank_account_id, period_start, period_end, status,
+            statement_balance, ledger_balance, unrecorded_total, outstanding_total,
+            matched_count, items, locked_by, locked_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+    `
+
+	_, err = r.pool.Exec(ctx, query,
+		rec.ID,
+		rec.OrganizationID,
+		rec.BankAccountID,
+		rec.PeriodStart,
+		rec.PeriodEnd,
+		rec.Status,
+		rec.StatementBalance,
+		rec.LedgerBalance,
+		rec.UnrecordedTotal,
+		rec.OutstandingTotal,
+		rec.MatchedCount,
+		items,
+		rec.LockedBy,
+		rec.LockedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create bank reconciliation: %w", err)
+	}
+
+	return nil
+}
+
+// Unlock saves an unlocked reconciliation report
+func (r *ReconciliationRepository) Unlock(ctx context.Context, rec *domain.BankReconciliation) error {
+	query := `
+        UPDATE bank_reconciliations
+        SET status = $2, unlocked_by = $3, unlocked_at = $4, unlock_reason = $5
+        WHERE id = $1 AND status = 'LOCKED'
+    `
+
+	result, err := r.pool.Exec(ctx, query, rec.ID, rec.Status, rec.UnlockedBy, rec.UnlockedAt, rec.UnlockReason)
+	if err != nil {
+		return fmt.Errorf("failed to unlock bank reconciliation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.NewBankingError("reconciliation not found or not locked", domain.ErrReconciliationNotFound)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a reconciliation report
+func (r *ReconciliationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BankReconciliation, error) {
+	recs, err := r.queryReconciliations(ctx, "SELECT"+reconciliationColumns+"FROM bank_reconciliations WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		return nil, domain.NewBankingError("reconciliation not found", domain.ErrReconciliationNotFound)
+	}
+	return recs[0], nil
+}
+
+// GetLatestLocked retrieves a bank account's locked report with the latest
+// period end, or nil if none
+func (r *ReconciliationRepository) GetLatestLocked(ctx context.Context, bankAccountID uuid.UUID) (*domain.BankReconciliation, error) {
+	query := "SELECT" + reconciliationColumns + `
+        FROM bank_reconciliations
+        WHERE bank_account_id = $1 AND status = 'LOCKED'
+        ORDER BY period_end DESC
+        LIMIT 1
+    `
+
+	recs, err := r.queryReconciliations(ctx, query, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return recs[0], nil
+}
+
+// List lists a bank account's reconciliation reports, latest period first
+func (r *ReconciliationRepository) List(ctx context.Context, bankAccountID uuid.UUID) ([]*domain.BankReconciliation, error) {
+	query := "SELECT" + reconciliationColumns + `
+        FROM bank_reconciliations
+        WHERE bank_account_id = $1
+        ORDER BY period_end DESC, locked_at DESC
+    `
+
+	return r.queryReconciliations(ctx, query, bankAccountID)
+}
+
+// queryReconciliations runs a query selecting reconciliationColumns
+func (r *ReconciliationRepository) queryReconciliations(ctx context.Context, query string, args ...interface{}) ([]*domain.BankReconciliation, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bank reconciliations: %w", err)
+	}
+	defer rows.Close()
+
+	var recs []*domain.BankReconciliation
+	for rows.Next() {
+		rec := &domain.BankReconciliation{}
+		var items []byte
+		err := rows.Scan(
+			&rec.ID,
+			&rec.OrganizationID,
+			&rec.BankAccountID,
+			&rec.PeriodStart,
+			&rec.PeriodEnd,
+			&rec.Status,
+			&rec.StatementBalance,
+			&rec.LedgerBalance,
+			&rec.UnrecordedTotal,
+			&rec.OutstandingTotal,
+			&rec.MatchedCount,
+			&items,
+			&rec.LockedBy,
+			&rec.LockedAt,
+			&rec.UnlockedBy,
+			&rec.UnlockedAt,
+			&rec.UnlockReason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bank reconciliation: %w", err)
+		}
+		if err := json.Unmarshal(items, &rec.Items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal reconciliation items: %w", err)
+		}
+		recs = append(recs, rec)
+	}
+
+	return recs, rows.Err()
+}
@@ -0,0 +1,27 @@
+// backend/internal/banking/repository/reconciliation_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/google/uuid"
+)
+
+// ReconciliationRepositoryInterface defines data access for locked bank reconciliation reports
+type ReconciliationRepositoryInterface interface {
+	// Create saves a locked reconciliation report
+	Create(ctx context.Context, reconciliation *domain.BankReconciliation) error
+
+	// Unlock saves an unlocked reconciliation report
+	Unlock(ctx context.Context, reconciliation *domain.BankReconciliation) error
+
+	// GetByID retrieves a reconciliation report
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.BankReconciliation, error)
+
+	// GetLatestLocked retrieves a bank account's locked report with the latest period end (nil if none)
+	GetLatestLocked(ctx context.Context, bankAccountID uuid.UUID) (*domain.BankReconciliation, error)
+
+	// List lists a bank account's reconciliation reports, latest period first
+	List(ctx context.Context, bankAccountID uuid.UUID) ([]*domain.BankReconciliation, error)
+}
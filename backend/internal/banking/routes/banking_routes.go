@@ -0,0 +1,59 @@
+// backend/internal/banking/routes/banking_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/banking/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterBankingRoutes registers bank account, statement import and
+// reconciliation routes. Unlocking a reconciliation has its own permission,
+// held by administrators only.
+func RegisterBankingRoutes(
+	r *gin.RouterGroup,
+	accountHandler *handler.BankAccountHandler,
+	statementHandler *handler.BankStatementHandler,
+	reconHandler *handler.ReconciliationHandler,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	accounts := r.Group("/bank-accounts")
+	accounts.Use(authMiddleware.Authenticate())
+	{
+		accounts.POST("", authMiddleware.RequirePermission("bank_accounts", "manage"), accountHandler.CreateBankAccount)    // Link a bank account to an ASSET ledger account
+		accounts.GET("", authMiddleware.RequirePermission("bank_accounts", "view"), accountHandler.ListBankAccounts)        // List bank accounts
+		accounts.GET("/:id", authMiddleware.RequirePermission("bank_accounts", "view"), accountHandler.GetBankAccount)      // Bank account details
+		accounts.PUT("/:id", authMiddleware.RequirePermission("bank_accounts", "manage"), accountHandler.UpdateBankAccount) // Update or deactivate
+
+		accounts.POST("/:id/statements", authMiddleware.RequirePermission("bank_statements", "import"), statementHandler.ImportStatement)    // Import CSV, OFX, MT940 or CAMT.053
+		accounts.GET("/:id/statements", authMiddleware.RequirePermission("bank_accounts", "view"), statementHandler.ListStatements)          // Imported statements
+		accounts.GET("/:id/statement-lines", authMiddleware.RequirePermission("bank_accounts", "view"), statementHandler.ListStatementLines) // Filter by status and date
+
+		accounts.POST("/:id/auto-match", authMiddleware.RequirePermission("bank_reconciliations", "reconcile"), reconHandler.AutoMatch)          // Match by amount, date window and reference
+		accounts.GET("/:id/ledger-lines", authMiddleware.RequirePermission("bank_reconciliations", "view"), reconHandler.ListLedgerLines)        // Unmatched ledger lines
+		accounts.GET("/:id/reconciliation", authMiddleware.RequirePermission("bank_reconciliations", "view"), reconHandler.GetReport)            // Report for a period, not saved
+		accounts.POST("/:id/reconciliations", authMiddleware.RequirePermission("bank_reconciliations", "lock"), reconHandler.LockReconciliation) // Lock a reconciled period
+		accounts.GET("/:id/reconciliations", authMiddleware.RequirePermission("bank_reconciliations", "view"), reconHandler.ListReconciliations) // Locked and unlocked reports
+	}
+
+	statements := r.Group("/bank-statements")
+	statements.Use(authMiddleware.Authenticate())
+	{
+		statements.GET("/:id", authMiddleware.RequirePermission("bank_accounts", "view"), statementHandler.GetStatement) // Statement with its lines
+	}
+
+	lines := r.Group("/bank-statement-lines")
+	lines.Use(authMiddleware.Authenticate())
+	{
+		lines.POST("/:id/match", authMiddleware.RequirePermission("bank_reconciliations", "reconcile"), reconHandler.MatchLine)     // Match to a ledger line by hand
+		lines.POST("/:id/unmatch", authMiddleware.RequirePermission("bank_reconciliations", "reconcile"), reconHandler.UnmatchLine) // Undo a match
+		lines.POST("/:id/entry", authMiddleware.RequirePermission("bank_reconciliations", "reconcile"), reconHandler.CreateEntry)   // Post a bank charge or similar and match it
+	}
+
+	reconciliations := r.Group("/bank-reconciliations")
+	reconciliations.Use(authMiddleware.Authenticate())
+	{
+		reconciliations.GET("/:id", authMiddleware.RequirePermission("bank_reconciliations", "view"), reconHandler.GetReconciliation)              // Saved report
+		reconciliations.POST("/:id/unlock", authMiddleware.RequirePermission("bank_reconciliations", "unlock"), reconHandler.UnlockReconciliation) // Reopen the latest locked period
+	}
+}
@@ -0,0 +1,147 @@
+// backend/internal/banking/service/bank_account_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/internal/banking/repository"
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type BankAccountService struct {
+	repo        repository.BankAccountRepositoryInterface
+	accountRepo glrepository.GLAccountRepositoryInterface
+	recorder    audit.Recorder
+}
+
+// NewBankAccountService creates a new bank account service
+func NewBankAccountService(
+	repo repository.BankAccountRepositoryInterface,
+	accountRepo glrepository.GLAccountRepositoryInterface,
+	recorder audit.Recorder,
+) *BankAccountService {
+	return &BankAccountService{
+		repo:        repo,
+		accountRepo: accountRepo,
+		recorder:    recorder,
+	}
+}
+
+// CreateBankAccount sets up a bank account. Its transactions are posted to a
+// postable ASSET ledger account no other bank account uses.
+func (s *BankAccountService) CreateBankAccount(ctx context.Context, account *domain.BankAccount) (*domain.BankAccount, error) {
+	account.Normalize()
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkGLAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	account.ID = uuid.New()
+	account.IsActive = true
+	account.CreatedAt = now
+	account.UpdatedAt = now
+
+	if err := s.repo.Create(ctx, account); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, account.OrganizationID, audit.EntityBankAccount, account.ID, audit.ActionCreate, nil, account)
+
+	return account, nil
+}
+
+// UpdateBankAccount saves changes to one of an organization's bank accounts.
+// The currency is fixed once statements are in it, so it can't change.
+func (s *BankAccountService) UpdateBankAccount(ctx context.Context, account *domain.BankAccount) (*domain.BankAccount, error) {
+	existing, err := s.GetBankAccount(ctx, account.OrganizationID, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account.Normalize()
+	if account.Currency != existing.Currency {
+		return nil, domain.NewBankingError("a bank account's currency cannot be changed", domain.ErrBankAccountInvalid)
+	}
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	if account.GLAccountID != existing.GLAccountID {
+		if err := s.checkGLAccount(ctx, account); err != nil {
+			return nil, err
+		}
+	}
+
+	account.CreatedBy = existing.CreatedBy
+	account.CreatedAt = existing.CreatedAt
+	account.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, account.OrganizationID, audit.EntityBankAccount, account.ID, audit.ActionUpdate, existing, account)
+
+	return account, nil
+}
+
+// GetBankAccount retrieves one of an organization's bank accounts
+func (s *BankAccountService) GetBankAccount(ctx context.Context, orgID, id uuid.UUID) (*domain.BankAccount, error) {
+	return findBankAccount(ctx, s.repo, orgID, id)
+}
+
+// ListBankAccounts lists an organization's bank accounts by name
+func (s *BankAccountService) ListBankAccounts(ctx context.Context, orgID uuid.UUID, includeInactive bool) ([]*domain.BankAccount, error) {
+	return s.repo.List(ctx, orgID, includeInactive)
+}
+
+// checkGLAccount checks a bank account's ledger account is one of the
+// organization's active, postable ASSET accounts and not another bank's
+func (s *BankAccountService) checkGLAccount(ctx context.Context, account *domain.BankAccount) error {
+	glAccount, err := s.accountRepo.GetGLAccountByID(ctx, account.GLAccountID, true)
+	if err != nil {
+		return domain.NewBankingErrorf(domain.ErrBankAccountGLInvalid, "ledger account %s not found", account.GLAccountID)
+	}
+	if !glAccount.BelongsTo(account.OrganizationID) {
+		return domain.NewBankingErrorf(domain.ErrBankAccountGLInvalid, "ledger account %s belongs to another organization", glAccount.Code)
+	}
+	if glAccount.Type != gldomain.AccountTypeAsset {
+		return domain.NewBankingErrorf(domain.ErrBankAccountGLInvalid, "ledger account %s is not an asset account", glAccount.Code)
+	}
+	if !glAccount.IsActive {
+		return domain.NewBankingErrorf(domain.ErrBankAccountGLInvalid, "ledger account %s is inactive", glAccount.Code)
+	}
+	if !glAccount.IsPostable {
+		return domain.NewBankingErrorf(domain.ErrBankAccountGLInvalid, "ledger account %s is a control account", glAccount.Code)
+	}
+
+	linked, err := s.repo.GetByGLAccount(ctx, account.GLAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to check ledger account: %w", err)
+	}
+	if linked != nil && linked.ID != account.ID {
+		return domain.NewBankingErrorf(domain.ErrBankAccountGLInvalid, "ledger account %s is already used by bank account %s", glAccount.Code, linked.Name)
+	}
+
+	return nil
+}
+
+// findBankAccount retrieves one of an organization's bank accounts
+func findBankAccount(ctx context.Context, repo repository.BankAccountRepositoryInterface, orgID, id uuid.UUID) (*domain.BankAccount, error) {
+	account, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !account.BelongsTo(orgID) {
+		return nil, domain.NewBankingError("bank account not found", domain.ErrBankAccountNotFound)
+	}
+	return account, nil
+}
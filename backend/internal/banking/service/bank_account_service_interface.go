@@ -0,0 +1,24 @@
+// backend/internal/banking/service/bank_account_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/google/uuid"
+)
+
+// BankAccountServiceInterface defines business logic for bank accounts
+type BankAccountServiceInterface interface {
+	// CreateBankAccount sets up a bank account posted to an ASSET ledger account
+	CreateBankAccount(ctx context.Context, account *domain.BankAccount) (*domain.BankAccount, error)
+
+	// UpdateBankAccount saves changes to one of an organization's bank accounts
+	UpdateBankAccount(ctx context.Context, account *domain.BankAccount) (*domain.BankAccount, error)
+
+	// GetBankAccount retrieves one of an organization's bank accounts
+	GetBankAccount(ctx context.Context, orgID, id uuid.UUID) (*domain.BankAccount, error)
+
+	// ListBankAccounts lists an organization's bank accounts by name
+	ListBankAccounts(ctx context.Context, orgID uuid.UUID, includeInactive bool) ([]*domain.BankAccount, error)
+}
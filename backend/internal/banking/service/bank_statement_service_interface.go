@@ -0,0 +1,26 @@
+// backend/internal/banking/service/bank_statement_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/internal/banking/repository"
+	"github.com/google/uuid"
+)
+
+// BankStatementServiceInterface defines business logic for importing bank statements
+type BankStatementServiceInterface interface {
+	// ImportStatement reads a CSV, OFX, MT940 or CAMT.053 file into a bank account's statements.
+	// An empty format is worked out from the file.
+	ImportStatement(ctx context.Context, orgID, bankAccountID uuid.UUID, fileName string, content []byte, format domain.StatementFormat, importedBy uuid.UUID) (*domain.BankStatement, error)
+
+	// GetStatement retrieves one of an organization's statements with its lines
+	GetStatement(ctx context.Context, orgID, id uuid.UUID) (*domain.BankStatement, error)
+
+	// ListStatements lists a bank account's statements, most recent first
+	ListStatements(ctx context.Context, orgID, bankAccountID uuid.UUID) ([]*domain.BankStatement, error)
+
+	// ListStatementLines lists a bank account's statement lines matching a filter, by date
+	ListStatementLines(ctx context.Context, orgID uuid.UUID, filter repository.StatementLineFilter) ([]*domain.StatementLine, error)
+}
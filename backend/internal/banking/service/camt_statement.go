@@ -0,0 +1,203 @@
+// backend/internal/banking/service/camt_statement.go
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+)
+
+// camtDocument is an ISO 20022 camt.053 bank-to-customer statement. Tags are
+// matched without their namespace, so any version of the message reads.
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	ID       string        `xml:"Id"`
+	IBAN     string        `xml:"Acct>Id>IBAN"`
+	Other    string        `xml:"Acct>Id>Othr>Id"`
+	Currency string        `xml:"Acct>Ccy"`
+	Balances []camtBalance `xml:"Bal"`
+	Entries  []camtEntry   `xml:"Ntry"`
+}
+
+type camtBalance struct {
+	Type      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount    camtAmount `xml:"Amt"`
+	Indicator string     `xml:"CdtDbtInd"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// camtStatus is a plain code up to camt.053.001.04 and a Cd element after
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtEntry struct {
+	Reference       string          `xml:"NtryRef"`
+	Amount          camtAmount      `xml:"Amt"`
+	Indicator       string          `xml:"CdtDbtInd"`
+	Reversal        bool            `xml:"RvslInd"`
+	Status          camtStatus      `xml:"Sts"`
+	BookingDate     camtDate        `xml:"BookgDt"`
+	ValueDate       camtDate        `xml:"ValDt"`
+	ServicerRef     string          `xml:"AcctSvcrRef"`
+	AdditionalInfo  string          `xml:"AddtlNtryInf"`
+	TransactionInfo []camtTxDetails `xml:"NtryDtls>TxDtls"`
+}
+
+type camtTxDetails struct {
+	EndToEndID    string   `xml:"Refs>EndToEndId"`
+	ServicerRef   string   `xml:"Refs>AcctSvcrRef"`
+	DebtorName    string   `xml:"RltdPties>Dbtr>Nm"`
+	DebtorParty   string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+	CreditorName  string   `xml:"RltdPties>Cdtr>Nm"`
+	CreditorParty string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+	Remittance    []string `xml:"RmtInf>Ustrd"`
+}
+
+// parseCAMTStatement reads a camt.053 file. Only booked entries are taken;
+// pending and information-only ones are yet to settle.
+func parseCAMTStatement(content []byte) (*domain.BankStatement, error) {
+	var doc camtDocument
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil // Treat Latin-1 declarations as UTF-8; banks send ASCII in practice
+	}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "failed to read CAMT.053: %v", err)
+	}
+
+	parts := make([]*domain.BankStatement, 0, len(doc.Statements))
+	for _, stmt := range doc.Statements {
+		statement := &domain.BankStatement{
+			StatementReference: stmt.ID,
+			AccountIdentifier:  firstNonEmpty(stmt.IBAN, stmt.Other),
+			Currency:           stmt.Currency,
+		}
+
+		for _, bal := range stmt.Balances {
+			amount, err := camtSignedAmount(bal.Amount, bal.Indicator, false)
+			if err != nil {
+				return nil, err
+			}
+			switch bal.Type {
+			case "OPBD", "PRCD":
+				if statement.OpeningBalance == nil {
+					statement.OpeningBalance = amountPtr(amount)
+				}
+			case "CLBD":
+				statement.ClosingBalance = amountPtr(amount)
+			}
+			if statement.Currency == "" {
+				statement.Currency = bal.Amount.Currency
+			}
+		}
+
+		for i, entry := range stmt.Entries {
+			status := strings.TrimSpace(firstNonEmpty(entry.Status.Code, entry.Status.Value))
+			if status != "" && status != "BOOK" {
+				continue
+			}
+
+			line, err := camtLine(entry)
+			if err != nil {
+				return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "entry %d: %v", i+1, err)
+			}
+			statement.Lines = append(statement.Lines, *line)
+		}
+
+		parts = append(parts, statement)
+	}
+
+	return combineStatements(parts)
+}
+
+// camtLine converts a booked entry to a statement line
+func camtLine(entry camtEntry) (*domain.StatementLine, error) {
+	date, err := parseCAMTDate(entry.BookingDate)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := camtSignedAmount(entry.Amount, entry.Indicator, entry.Reversal)
+	if err != nil {
+		return nil, err
+	}
+
+	line := &domain.StatementLine{
+		TransactionDate: date,
+		Amount:          amount,
+		Reference:       entry.Reference,
+	}
+	if valueDate, err := parseCAMTDate(entry.ValueDate); err == nil {
+		line.ValueDate = &valueDate
+	}
+
+	description := []string{entry.AdditionalInfo}
+	servicerRef := entry.ServicerRef
+	for _, tx := range entry.TransactionInfo {
+		if tx.EndToEndID != "" && tx.EndToEndID != "NOTPROVIDED" && line.Reference == "" {
+			line.Reference = tx.EndToEndID
+		}
+		if servicerRef == "" {
+			servicerRef = tx.ServicerRef
+		}
+		// The other party is the payer of money in and the payee of money out
+		if line.Counterparty == "" {
+			if amount.IsPositive() {
+				line.Counterparty = firstNonEmpty(tx.DebtorName, tx.DebtorParty)
+			} else {
+				line.Counterparty = firstNonEmpty(tx.CreditorName, tx.CreditorParty)
+			}
+		}
+		description = append(description, tx.Remittance...)
+	}
+	line.Description = strings.Join(strings.Fields(strings.Join(description, " ")), " ")
+
+	if servicerRef != "" {
+		line.ExternalID = fmt.Sprintf("%s/%s/%s", date.Format("20060102"), amount, servicerRef)
+	}
+
+	return line, nil
+}
+
+// camtSignedAmount signs an amount by its credit/debit indicator; a reversal
+// undoes the original so goes the other way
+func camtSignedAmount(a camtAmount, indicator string, reversal bool) (money.Amount, error) {
+	amount, err := money.Parse(strings.TrimSpace(a.Value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount: %s", a.Value)
+	}
+	if (indicator == "DBIT") != reversal {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// parseCAMTDate reads a date or date-time element
+func parseCAMTDate(d camtDate) (time.Time, error) {
+	value := strings.TrimSpace(firstNonEmpty(d.Date, d.DateTime))
+	if len(value) >= 10 {
+		if date, err := time.Parse("2006-01-02", value[:10]); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date: %q", value)
+}
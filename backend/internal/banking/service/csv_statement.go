@@ -0,0 +1,220 @@
+// backend/internal/banking/service/csv_statement.go
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+)
+
+// csvStatementColumns maps the headings banks use to the fields of a line
+var csvStatementColumns = map[string]string{
+	"date":                "date",
+	"transaction date":    "date",
+	"txn date":            "date",
+	"tran date":           "date",
+	"posting date":        "date",
+	"post date":           "date",
+	"booking date":        "date",
+	"value date":          "value_date",
+	"description":         "description",
+	"details":             "description",
+	"narrative":           "description",
+	"narration":           "description",
+	"particulars":         "description",
+	"transaction details": "description",
+	"memo":                "description",
+	"remarks":             "description",
+	"reference":           "reference",
+	"ref":                 "reference",
+	"reference no":        "reference",
+	"ref no":              "reference",
+	"cheque no":           "reference",
+	"chq no":              "reference",
+	"check number":        "reference",
+	"amount":              "amount",
+	"transaction amount":  "amount",
+	"debit":               "debit",
+	"debit amount":        "debit",
+	"withdrawal":          "debit",
+	"withdrawals":         "debit",
+	"money out":           "debit",
+	"paid out":            "debit",
+	"dr":                  "debit",
+	"credit":              "credit",
+	"credit amount":       "credit",
+	"deposit":             "credit",
+	"deposits":            "credit",
+	"money in":            "credit",
+	"paid in":             "credit",
+	"cr":                  "credit",
+	"balance":             "balance",
+	"running balance":     "balance",
+	"payee":               "counterparty",
+	"counterparty":        "counterparty",
+	"beneficiary":         "counterparty",
+	"name":                "counterparty",
+	"transaction id":      "external_id",
+	"fitid":               "external_id",
+	"bank reference":      "external_id",
+}
+
+// parseCSVStatement reads a bank's CSV export. The first row naming a date
+// and either an amount or debit and credit columns is the header; rows
+// before it (account details banks often print first) are skipped. Debit
+// columns are money out of the account.
+func parseCSVStatement(content []byte) (*domain.BankStatement, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = csvDelimiter(content)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "failed to read CSV: %v", err)
+	}
+
+	headerRow := -1
+	var index map[string]int
+	for i, record := range records {
+		index = csvStatementIndex(record)
+		_, hasAmount := index["amount"]
+		_, hasDebit := index["debit"]
+		_, hasCredit := index["credit"]
+		if _, ok := index["date"]; ok && (hasAmount || (hasDebit && hasCredit)) {
+			headerRow = i
+			break
+		}
+	}
+	if headerRow < 0 {
+		return nil, domain.NewBankingError("CSV needs a header with a date column and an amount or debit and credit columns", domain.ErrStatementInvalid)
+	}
+
+	statement := &domain.BankStatement{}
+	var balances []money.Amount
+	for i, record := range records[headerRow+1:] {
+		rowNumber := headerRow + i + 2
+		dateValue := csvField(record, index, "date")
+		if dateValue == "" {
+			continue // Blank and summary rows
+		}
+
+		date, err := parseStatementDate(dateValue)
+		if err != nil {
+			return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "row %d: %v", rowNumber, err)
+		}
+
+		amount, err := csvAmount(record, index)
+		if err != nil {
+			return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "row %d: %v", rowNumber, err)
+		}
+
+		line := domain.StatementLine{
+			TransactionDate: date,
+			Amount:          amount,
+			Description:     csvField(record, index, "description"),
+			Reference:       csvField(record, index, "reference"),
+			Counterparty:    csvField(record, index, "counterparty"),
+			ExternalID:      csvField(record, index, "external_id"),
+		}
+		if value := csvField(record, index, "value_date"); value != "" {
+			if valueDate, err := parseStatementDate(value); err == nil {
+				line.ValueDate = &valueDate
+			}
+		}
+		statement.Lines = append(statement.Lines, line)
+
+		if _, ok := index["balance"]; ok {
+			balance, err := parseStatementAmount(csvField(record, index, "balance"))
+			if err != nil {
+				balances = nil
+				delete(index, "balance")
+				continue
+			}
+			balances = append(balances, balance)
+		}
+	}
+
+	// A running balance gives the statement's balances, read in date order
+	// whichever way round the bank lists the rows
+	if n := len(balances); n > 0 && n == len(statement.Lines) {
+		first, last := 0, n-1
+		if statement.Lines[n-1].TransactionDate.Before(statement.Lines[0].TransactionDate) {
+			first, last = n-1, 0
+		}
+		statement.OpeningBalance = amountPtr(balances[first] - statement.Lines[first].Amount)
+		statement.ClosingBalance = amountPtr(balances[last])
+	}
+
+	return statement, nil
+}
+
+// csvDelimiter picks the separator a CSV uses from its first lines
+func csvDelimiter(content []byte) rune {
+	head := content[:min(len(content), 2048)]
+	best, bestCount := ',', 0
+	for _, d := range []rune{',', ';', '\t', '|'} {
+		if count := bytes.Count(head, []byte(string(d))); count > bestCount {
+			best, bestCount = d, count
+		}
+	}
+	return best
+}
+
+// csvStatementIndex maps a row's recognized headings to their column
+func csvStatementIndex(record []string) map[string]int {
+	index := make(map[string]int)
+	for i, heading := range record {
+		heading = strings.ToLower(strings.Trim(strings.TrimSpace(heading), ".:"))
+		heading = strings.Join(strings.Fields(strings.NewReplacer("_", " ", ".", " ", "(", " ", ")", " ").Replace(heading)), " ")
+		if field, ok := csvStatementColumns[heading]; ok {
+			if _, seen := index[field]; !seen {
+				index[field] = i
+			}
+		}
+	}
+	return index
+}
+
+// csvField returns a row's trimmed value for a field, or "" if absent
+func csvField(record []string, index map[string]int, field string) string {
+	i, ok := index[field]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// csvAmount reads a row's signed amount from its amount column, or from its
+// debit and credit columns
+func csvAmount(record []string, index map[string]int) (money.Amount, error) {
+	if _, ok := index["amount"]; ok {
+		value := csvField(record, index, "amount")
+		if value == "" {
+			return 0, fmt.Errorf("amount is required")
+		}
+		return parseStatementAmount(value)
+	}
+
+	var amount money.Amount
+	if value := csvField(record, index, "credit"); value != "" {
+		credit, err := parseStatementAmount(value)
+		if err != nil {
+			return 0, err
+		}
+		amount += credit.Abs()
+	}
+	if value := csvField(record, index, "debit"); value != "" {
+		debit, err := parseStatementAmount(value)
+		if err != nil {
+			return 0, err
+		}
+		amount -= debit.Abs()
+	}
+	return amount, nil
+}
@@ -0,0 +1,199 @@
+// backend/internal/banking/service/mt940_statement.go
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+)
+
+var (
+	// mt940Tag matches the start of a field, e.g. ":61:"
+	mt940Tag = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+
+	// mt940Transaction splits the first line of a :61: statement line: value
+	// date, entry date, mark, funds code, amount, transaction type, the
+	// account owner's reference and the bank's reference
+	mt940Transaction = regexp.MustCompile(`^([0-9]{6})([0-9]{4})?(RC|RD|C|D)([A-Z])?([0-9]+,[0-9]*)([NFS][A-Z0-9]{3})(.*?)(?://(.*))?$`)
+
+	// mt940Balance splits a balance field: mark, date, currency and amount
+	mt940Balance = regexp.MustCompile(`^(C|D)([0-9]{6})([A-Z]{3})([0-9]+,[0-9]*)`)
+
+	// mt940Subfield matches the ?NN subfield codes some banks structure :86: with
+	mt940Subfield = regexp.MustCompile(`\?[0-9]{2}`)
+)
+
+// looksLikeMT940 checks if content has the fields every MT940 statement starts with
+func looksLikeMT940(content []byte) bool {
+	return bytes.Contains(content, []byte(":20:")) && bytes.Contains(content, []byte(":25:")) &&
+		(bytes.Contains(content, []byte(":60F:")) || bytes.Contains(content, []byte(":60M:")))
+}
+
+// mt940Field is a field with its continuation lines
+type mt940Field struct {
+	tag   string
+	lines []string
+}
+
+// parseMT940Statement reads a SWIFT MT940 customer statement file. Statements
+// split over several messages (:60M:/:62M: interim balances) and files with
+// several statements are combined.
+func parseMT940Statement(content []byte) (*domain.BankStatement, error) {
+	var fields []*mt940Field
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	for _, raw := range strings.Split(text, "\n") {
+		raw = strings.TrimRight(raw, " \r")
+		if match := mt940Tag.FindStringSubmatch(raw); match != nil {
+			fields = append(fields, &mt940Field{tag: match[1], lines: []string{match[2]}})
+			continue
+		}
+		// Message trailers and block markers end a field
+		if raw == "" || raw == "-" || strings.HasPrefix(raw, "-}") || strings.HasPrefix(raw, "{") {
+			continue
+		}
+		if len(fields) > 0 {
+			last := fields[len(fields)-1]
+			last.lines = append(last.lines, raw)
+		}
+	}
+
+	var parts []*domain.BankStatement
+	var statement *domain.BankStatement
+	var line *domain.StatementLine
+	for _, field := range fields {
+		switch field.tag {
+		case "20":
+			statement = &domain.BankStatement{StatementReference: strings.TrimSpace(field.lines[0])}
+			parts = append(parts, statement)
+			line = nil
+		case "25":
+			if statement != nil {
+				statement.AccountIdentifier = strings.TrimSpace(field.lines[0])
+			}
+		case "60F", "60M":
+			if statement == nil {
+				continue
+			}
+			balance, currency, err := parseMT940Balance(field.lines[0])
+			if err != nil {
+				return nil, err
+			}
+			statement.OpeningBalance = amountPtr(balance)
+			statement.Currency = currency
+		case "62F", "62M":
+			if statement == nil {
+				continue
+			}
+			balance, _, err := parseMT940Balance(field.lines[0])
+			if err != nil {
+				return nil, err
+			}
+			statement.ClosingBalance = amountPtr(balance)
+			line = nil
+		case "61":
+			if statement == nil {
+				return nil, domain.NewBankingError("MT940 statement line before the :20: field", domain.ErrStatementInvalid)
+			}
+			parsed, err := parseMT940Transaction(field.lines)
+			if err != nil {
+				return nil, err
+			}
+			statement.Lines = append(statement.Lines, *parsed)
+			line = &statement.Lines[len(statement.Lines)-1]
+		case "86":
+			// Information to the account owner about the preceding line
+			if line != nil {
+				info := strings.Join(strings.Fields(mt940Subfield.ReplaceAllString(strings.Join(field.lines, " "), " ")), " ")
+				line.Description = strings.TrimSpace(info + " " + line.Description)
+			}
+		}
+	}
+
+	return combineStatements(parts)
+}
+
+// parseMT940Transaction reads a :61: statement line
+func parseMT940Transaction(lines []string) (*domain.StatementLine, error) {
+	match := mt940Transaction.FindStringSubmatch(strings.TrimSpace(lines[0]))
+	if match == nil {
+		return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid MT940 statement line: %s", lines[0])
+	}
+
+	valueDate, err := time.Parse("060102", match[1])
+	if err != nil {
+		return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid MT940 value date: %s", match[1])
+	}
+	entryDate := valueDate
+	if match[2] != "" {
+		// The entry date has no year; it can fall either side of a year end
+		entryDate, err = time.Parse("20060102", fmt.Sprintf("%04d%s", valueDate.Year(), match[2]))
+		if err != nil {
+			return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid MT940 entry date: %s", match[2])
+		}
+		if entryDate.Sub(valueDate) > 180*24*time.Hour {
+			entryDate = entryDate.AddDate(-1, 0, 0)
+		} else if valueDate.Sub(entryDate) > 180*24*time.Hour {
+			entryDate = entryDate.AddDate(1, 0, 0)
+		}
+	}
+
+	amount, err := parseMT940Amount(match[5])
+	if err != nil {
+		return nil, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid MT940 amount: %s", match[5])
+	}
+	// RC and RD reverse an earlier credit or debit
+	if match[3] == "D" || match[3] == "RC" {
+		amount = -amount
+	}
+
+	reference := strings.TrimSpace(match[7])
+	if strings.EqualFold(reference, "NONREF") {
+		reference = ""
+	}
+
+	line := &domain.StatementLine{
+		TransactionDate: entryDate,
+		ValueDate:       &valueDate,
+		Amount:          amount,
+		Reference:       reference,
+		Description:     strings.TrimSpace(strings.Join(lines[1:], " ")),
+	}
+	if bankReference := strings.TrimSpace(match[8]); bankReference != "" {
+		line.ExternalID = fmt.Sprintf("%s/%s/%s", entryDate.Format("20060102"), amount, bankReference)
+	}
+
+	return line, nil
+}
+
+// parseMT940Balance reads a balance field, returning the signed balance and its currency
+func parseMT940Balance(value string) (money.Amount, string, error) {
+	match := mt940Balance.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, "", domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid MT940 balance: %s", value)
+	}
+
+	amount, err := parseMT940Amount(match[4])
+	if err != nil {
+		return 0, "", domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid MT940 balance: %s", value)
+	}
+	if match[1] == "D" {
+		amount = -amount
+	}
+
+	return amount, match[3], nil
+}
+
+// parseMT940Amount reads an MT940 amount, which always has a decimal comma
+// and may end in it ("100,")
+func parseMT940Amount(value string) (money.Amount, error) {
+	whole, frac, _ := strings.Cut(value, ",")
+	if frac == "" {
+		frac = "0"
+	}
+	return money.Parse(whole + "." + frac)
+}
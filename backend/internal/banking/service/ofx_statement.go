@@ -0,0 +1,150 @@
+// backend/internal/banking/service/ofx_statement.go
+package service
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+)
+
+// ofxTag matches an OFX tag with the text after it. OFX 1.x is SGML and
+// leaves value tags unclosed, so closing tags are optional throughout.
+var ofxTag = regexp.MustCompile(`<(/?)([A-Za-z0-9.]+)>([^<]*)`)
+
+// parseOFXStatement reads an OFX or QFX download, SGML (1.x) or XML (2.x).
+// Each bank or credit card statement response in the file becomes a part of
+// the combined statement.
+func parseOFXStatement(content []byte) (*domain.BankStatement, error) {
+	start := bytes.Index(bytes.ToUpper(content), []byte("<OFX>"))
+	if start < 0 {
+		return nil, domain.NewBankingError("not an OFX file", domain.ErrStatementInvalid)
+	}
+
+	var parts []*domain.BankStatement
+	var statement *domain.BankStatement
+	var line *domain.StatementLine
+	var checkNumber, name, memo string
+	inLedgerBalance := false
+
+	for _, match := range ofxTag.FindAllSubmatch(content[start:], -1) {
+		closing := len(match[1]) > 0
+		tag := strings.ToUpper(string(match[2]))
+		value := strings.TrimSpace(html.UnescapeString(string(match[3])))
+
+		switch tag {
+		case "STMTRS", "CCSTMTRS":
+			if closing {
+				if statement != nil {
+					parts = append(parts, statement)
+				}
+				statement = nil
+			} else {
+				statement = &domain.BankStatement{}
+			}
+			continue
+		case "STMTTRN":
+			if closing && line != nil && statement != nil {
+				line.Reference = checkNumber
+				line.Counterparty = name
+				line.Description = firstNonEmpty(memo, name)
+				if name != "" && memo != "" && !strings.Contains(memo, name) {
+					line.Description = name + " " + memo
+				}
+				statement.Lines = append(statement.Lines, *line)
+				line = nil
+			} else if !closing {
+				line = &domain.StatementLine{}
+				checkNumber, name, memo = "", "", ""
+			}
+			continue
+		case "LEDGERBAL":
+			inLedgerBalance = !closing
+			continue
+		}
+		if closing || statement == nil {
+			continue
+		}
+
+		switch {
+		case line != nil:
+			switch tag {
+			case "DTPOSTED":
+				date, err := parseOFXDate(value)
+				if err != nil {
+					return nil, err
+				}
+				line.TransactionDate = date
+			case "DTAVAIL":
+				if date, err := parseOFXDate(value); err == nil {
+					line.ValueDate = &date
+				}
+			case "TRNAMT":
+				amount, err := parseStatementAmount(value)
+				if err != nil {
+					return nil, err
+				}
+				line.Amount = amount
+			case "FITID":
+				line.ExternalID = value
+			case "CHECKNUM":
+				checkNumber = value
+			case "REFNUM":
+				if checkNumber == "" {
+					checkNumber = value
+				}
+			case "NAME":
+				name = value
+			case "MEMO":
+				memo = value
+			}
+		case inLedgerBalance && tag == "BALAMT":
+			amount, err := parseStatementAmount(value)
+			if err != nil {
+				return nil, err
+			}
+			statement.ClosingBalance = amountPtr(amount)
+		case tag == "ACCTID":
+			statement.AccountIdentifier = value
+		case tag == "CURDEF":
+			statement.Currency = value
+		}
+	}
+
+	for _, part := range parts {
+		// OFX gives only the ledger balance; the opening balance follows from it
+		if part.ClosingBalance != nil {
+			opening := *part.ClosingBalance
+			for _, l := range part.Lines {
+				opening -= l.Amount
+			}
+			part.OpeningBalance = amountPtr(opening)
+		}
+	}
+
+	return combineStatements(parts)
+}
+
+// parseOFXDate reads the date part of an OFX timestamp
+// (YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]])
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) >= 8 {
+		if date, err := time.Parse("20060102", value[:8]); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid OFX date: %s", value)
+}
+
+// firstNonEmpty returns the first value that is not blank
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
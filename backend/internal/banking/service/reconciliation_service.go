@@ -0,0 +1,505 @@
+// backend/internal/banking/service/reconciliation_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/internal/banking/repository"
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+type ReconciliationService struct {
+	repo          repository.ReconciliationRepositoryInterface
+	statementRepo repository.BankStatementRepositoryInterface
+	accountRepo   repository.BankAccountRepositoryInterface
+	ledgerRepo    repository.LedgerRepositoryInterface
+	glAccountRepo glrepository.GLAccountRepositoryInterface
+	periodRepo    glrepository.FiscalPeriodRepositoryInterface
+	rateRepo      glrepository.ExchangeRateRepositoryInterface
+	rateService   glservice.ExchangeRateServiceInterface
+	recorder      audit.Recorder
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(
+	repo repository.ReconciliationRepositoryInterface,
+	statementRepo repository.BankStatementRepositoryInterface,
+	accountRepo repository.BankAccountRepositoryInterface,
+	ledgerRepo repository.LedgerRepositoryInterface,
+	glAccountRepo glrepository.GLAccountRepositoryInterface,
+	periodRepo glrepository.FiscalPeriodRepositoryInterface,
+	rateRepo glrepository.ExchangeRateRepositoryInterface,
+	rateService glservice.ExchangeRateServiceInterface,
+	recorder audit.Recorder,
+) *ReconciliationService {
+	return &ReconciliationService{
+		repo:          repo,
+		statementRepo: statementRepo,
+		accountRepo:   accountRepo,
+		ledgerRepo:    ledgerRepo,
+		glAccountRepo: glAccountRepo,
+		periodRepo:    periodRepo,
+		rateRepo:      rateRepo,
+		rateService:   rateService,
+		recorder:      recorder,
+	}
+}
+
+// AutoMatch matches a bank account's unmatched statement lines to posted
+// ledger lines of the same amount dated within the window, using references
+// to choose between candidates. Lines within a locked reconciliation are
+// left alone, as are lines with no clear match.
+func (s *ReconciliationService) AutoMatch(ctx context.Context, orgID, bankAccountID uuid.UUID, windowDays int, matchedBy uuid.UUID) ([]*domain.StatementLine, error) {
+	account, err := findBankAccount(ctx, s.accountRepo, orgID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.ledgerFilter(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	lineFilter := repository.StatementLineFilter{
+		BankAccountID: account.ID,
+		Status:        domain.StatementLineUnmatched,
+	}
+
+	lock, err := s.repo.GetLatestLocked(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	if lock != nil {
+		after := lock.PeriodEnd.AddDate(0, 0, 1)
+		filter.FromDate = &after
+		lineFilter.FromDate = &after
+	}
+
+	lines, err := s.statementRepo.ListLines(ctx, lineFilter)
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := s.ledgerRepo.ListUnmatched(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := domain.AutoMatch(lines, ledger, windowDays)
+	matched := make([]*domain.StatementLine, 0, len(proposals))
+	for _, p := range proposals {
+		if err := p.StatementLine.Match(p.LedgerLine, domain.MatchAuto, matchedBy); err != nil {
+			return nil, err
+		}
+		matched = append(matched, p.StatementLine)
+	}
+	if len(matched) == 0 {
+		return matched, nil
+	}
+
+	if err := s.statementRepo.SaveMatches(ctx, matched); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityBankAccount, account.ID, "AUTO_MATCH", nil, matched)
+
+	return matched, nil
+}
+
+// MatchLine matches a statement line to a posted ledger line of the same
+// amount on the bank account's ledger account
+func (s *ReconciliationService) MatchLine(ctx context.Context, orgID, statementLineID, journalLineID, matchedBy uuid.UUID) (*domain.StatementLine, error) {
+	line, account, err := s.statementLine(ctx, orgID, statementLineID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.ledgerFilter(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := s.ledgerRepo.GetUnmatchedLine(ctx, filter, journalLineID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureUnlocked(ctx, account, line.TransactionDate, ledger.TransactionDate); err != nil {
+		return nil, err
+	}
+
+	before := *line
+	if err := line.Match(ledger, domain.MatchManual, matchedBy); err != nil {
+		return nil, err
+	}
+
+	if err := s.statementRepo.SaveMatches(ctx, []*domain.StatementLine{line}); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityBankStatement, line.StatementID, "MATCH", &before, line)
+
+	return line, nil
+}
+
+// UnmatchLine returns a matched statement line to the unmatched list. An
+// entry created from the line stays posted; reverse it if it was wrong.
+func (s *ReconciliationService) UnmatchLine(ctx context.Context, orgID, statementLineID, unmatchedBy uuid.UUID) (*domain.StatementLine, error) {
+	line, account, err := s.statementLine(ctx, orgID, statementLineID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureUnlocked(ctx, account, line.TransactionDate); err != nil {
+		return nil, err
+	}
+
+	before := *line
+	if err := line.Unmatch(); err != nil {
+		return nil, err
+	}
+
+	if err := s.statementRepo.SaveMatches(ctx, []*domain.StatementLine{line}); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityBankStatement, line.StatementID, "UNMATCH", &before, line)
+
+	return line, nil
+}
+
+// CreateEntryFromLine posts a BANK entry for a statement line missing from
+// the ledger, such as a bank charge or interest, against the offset account,
+// and matches the line to it in the same transaction
+func (s *ReconciliationService) CreateEntryFromLine(
+	ctx context.Context,
+	orgID, statementLineID, offsetAccountID uuid.UUID,
+	description string,
+	createdBy uuid.UUID,
+) (*domain.StatementLine, *gldomain.JournalEntry, error) {
+	line, account, err := s.statementLine(ctx, orgID, statementLineID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.ensureUnlocked(ctx, account, line.TransactionDate); err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkOffsetAccount(ctx, orgID, offsetAccountID); err != nil {
+		return nil, nil, err
+	}
+	if err := ensurePeriodOpen(ctx, s.periodRepo, orgID, line.TransactionDate); err != nil {
+		return nil, nil, err
+	}
+
+	base, err := s.rateRepo.GetBaseCurrency(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rate *money.Rate
+	if account.Currency != base {
+		exchangeRate, err := s.rateService.GetRate(ctx, orgID, account.Currency, base, line.TransactionDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		rate = &exchangeRate.Rate
+	}
+
+	entry, err := line.BuildEntry(account, offsetAccountID, description, rate, money.CurrencyOrDefault(base), createdBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	before := *line
+	bankLine := entry.Lines[0]
+	ledger := &domain.LedgerLine{
+		JournalLineID:   bankLine.ID,
+		JournalEntryID:  entry.ID,
+		EntryNumber:     entry.EntryNumber,
+		TransactionDate: entry.TransactionDate,
+		Amount:          line.Amount,
+		Reference:       bankLine.Reference,
+		Description:     bankLine.Description,
+	}
+	if err := line.Match(ledger, domain.MatchCreated, createdBy); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.statementRepo.MatchWithEntry(ctx, line, entry); err != nil {
+		return nil, nil, fmt.Errorf("failed to save bank entry: %w", err)
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityJournalEntry, entry.ID, audit.ActionCreate, nil, entry)
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityBankStatement, line.StatementID, "MATCH", &before, line)
+
+	return line, entry, nil
+}
+
+// ListUnmatchedLedgerLines lists a bank account's posted ledger lines not
+// matched to a statement line, for matching by hand
+func (s *ReconciliationService) ListUnmatchedLedgerLines(ctx context.Context, orgID, bankAccountID uuid.UUID, from, to *time.Time) ([]*domain.LedgerLine, error) {
+	account, err := findBankAccount(ctx, s.accountRepo, orgID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.ledgerFilter(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	filter.FromDate = from
+	filter.ToDate = to
+
+	return s.ledgerRepo.ListUnmatched(ctx, filter)
+}
+
+// GetReport builds a bank account's reconciliation report as of a period
+// end: the statement and ledger balances, the statement lines not yet in
+// the ledger and the ledger lines not yet through the bank
+func (s *ReconciliationService) GetReport(
+	ctx context.Context,
+	orgID, bankAccountID uuid.UUID,
+	periodStart, periodEnd time.Time,
+	statementBalance *money.Amount,
+) (*domain.BankReconciliation, error) {
+	account, err := findBankAccount(ctx, s.accountRepo, orgID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildReport(ctx, account, periodStart, periodEnd, statementBalance)
+}
+
+// LockReconciliation saves a reconciled report and freezes matching for
+// every line dated up to its period end. Periods are locked in order.
+func (s *ReconciliationService) LockReconciliation(
+	ctx context.Context,
+	orgID, bankAccountID uuid.UUID,
+	periodStart, periodEnd time.Time,
+	statementBalance *money.Amount,
+	lockedBy uuid.UUID,
+) (*domain.BankReconciliation, error) {
+	account, err := findBankAccount(ctx, s.accountRepo, orgID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.repo.GetLatestLocked(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil && !periodEnd.After(latest.PeriodEnd) {
+		return nil, domain.NewBankingErrorf(domain.ErrReconciliationLocked,
+			"reconciliation is already locked through %s", latest.PeriodEnd.Format("2006-01-02"))
+	}
+
+	rec, err := s.buildReport(ctx, account, periodStart, periodEnd, statementBalance)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.Lock(lockedBy); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, rec); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityBankReconciliation, rec.ID, "LOCK", nil, rec)
+
+	return rec, nil
+}
+
+// UnlockReconciliation releases a bank account's latest locked report so
+// its period's matches can change. Earlier reports are unlocked in turn.
+func (s *ReconciliationService) UnlockReconciliation(ctx context.Context, orgID, id, unlockedBy uuid.UUID, reason string) (*domain.BankReconciliation, error) {
+	rec, err := s.GetReconciliation(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.repo.GetLatestLocked(ctx, rec.BankAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil && latest.ID != rec.ID && rec.IsLocked() {
+		return nil, domain.NewBankingErrorf(domain.ErrReconciliationNotLatest,
+			"unlock the reconciliation through %s first", latest.PeriodEnd.Format("2006-01-02"))
+	}
+
+	before := *rec
+	if err := rec.Unlock(unlockedBy, reason); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Unlock(ctx, rec); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityBankReconciliation, rec.ID, "UNLOCK", &before, rec)
+
+	return rec, nil
+}
+
+// GetReconciliation retrieves one of an organization's saved reports
+func (s *ReconciliationService) GetReconciliation(ctx context.Context, orgID, id uuid.UUID) (*domain.BankReconciliation, error) {
+	rec, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rec.OrganizationID != orgID {
+		return nil, domain.NewBankingError("reconciliation not found", domain.ErrReconciliationNotFound)
+	}
+	return rec, nil
+}
+
+// ListReconciliations lists a bank account's saved reports, latest period first
+func (s *ReconciliationService) ListReconciliations(ctx context.Context, orgID, bankAccountID uuid.UUID) ([]*domain.BankReconciliation, error) {
+	if _, err := findBankAccount(ctx, s.accountRepo, orgID, bankAccountID); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, bankAccountID)
+}
+
+// buildReport gathers the balances and unmatched lines for a report
+func (s *ReconciliationService) buildReport(
+	ctx context.Context,
+	account *domain.BankAccount,
+	periodStart, periodEnd time.Time,
+	statementBalance *money.Amount,
+) (*domain.BankReconciliation, error) {
+	if statementBalance == nil {
+		balance, err := s.statementRepo.GetBalanceAsOf(ctx, account.ID, periodEnd)
+		if err != nil {
+			return nil, err
+		}
+		if balance == nil {
+			return nil, domain.NewBankingErrorf(domain.ErrStatementBalanceRequired,
+				"no imported statement gives a closing balance by %s; enter the statement balance", periodEnd.Format("2006-01-02"))
+		}
+		statementBalance = balance
+	}
+
+	filter, err := s.ledgerFilter(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	filter.ToDate = &periodEnd
+
+	ledgerBalance, err := s.ledgerRepo.GetBalance(ctx, filter, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	outstanding, err := s.ledgerRepo.ListUnmatched(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	unrecorded, err := s.statementRepo.ListUnrecorded(ctx, account.ID, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	matchedCount, err := s.statementRepo.CountMatched(ctx, account.ID, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	unrecordedLines := make([]domain.StatementLine, len(unrecorded))
+	for i, line := range unrecorded {
+		unrecordedLines[i] = *line
+	}
+	outstandingLines := make([]domain.LedgerLine, len(outstanding))
+	for i, line := range outstanding {
+		outstandingLines[i] = *line
+	}
+
+	return domain.NewBankReconciliation(account, periodStart, periodEnd, *statementBalance, ledgerBalance, unrecordedLines, outstandingLines, matchedCount)
+}
+
+// statementLine retrieves one of an organization's statement lines with its bank account
+func (s *ReconciliationService) statementLine(ctx context.Context, orgID, id uuid.UUID) (*domain.StatementLine, *domain.BankAccount, error) {
+	line, err := s.statementRepo.GetLine(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := s.accountRepo.GetByID(ctx, line.BankAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !account.BelongsTo(orgID) {
+		return nil, nil, domain.NewBankingError("statement line not found", domain.ErrStatementLineNotFound)
+	}
+	return line, account, nil
+}
+
+// ledgerFilter selects a bank account's ledger lines, in its own currency
+// when that is not the organization's base currency
+func (s *ReconciliationService) ledgerFilter(ctx context.Context, account *domain.BankAccount) (repository.LedgerFilter, error) {
+	filter := repository.LedgerFilter{
+		OrganizationID: account.OrganizationID,
+		GLAccountID:    account.GLAccountID,
+	}
+
+	base, err := s.rateRepo.GetBaseCurrency(ctx, account.OrganizationID)
+	if err != nil {
+		return filter, err
+	}
+	if account.Currency != base {
+		filter.Currency = account.Currency
+	}
+
+	return filter, nil
+}
+
+// ensureUnlocked checks none of the dates fall within a locked reconciliation
+func (s *ReconciliationService) ensureUnlocked(ctx context.Context, account *domain.BankAccount, dates ...time.Time) error {
+	lock, err := s.repo.GetLatestLocked(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return nil
+	}
+
+	for _, date := range dates {
+		if lock.Covers(date) {
+			return domain.NewBankingErrorf(domain.ErrReconciliationLocked,
+				"%s is within the reconciliation locked through %s", date.Format("2006-01-02"), lock.PeriodEnd.Format("2006-01-02"))
+		}
+	}
+	return nil
+}
+
+// checkOffsetAccount checks an entry's offset account is one of the
+// organization's active, postable accounts
+func (s *ReconciliationService) checkOffsetAccount(ctx context.Context, orgID, id uuid.UUID) error {
+	account, err := s.glAccountRepo.GetGLAccountByID(ctx, id, true)
+	if err != nil {
+		return domain.NewBankingErrorf(domain.ErrMatchInvalid, "offset account %s not found", id)
+	}
+	if !account.BelongsTo(orgID) {
+		return domain.NewBankingErrorf(domain.ErrMatchInvalid, "offset account %s belongs to another organization", account.Code)
+	}
+	if !account.IsActive {
+		return domain.NewBankingErrorf(domain.ErrMatchInvalid, "offset account %s is inactive", account.Code)
+	}
+	if !account.IsPostable {
+		return domain.NewBankingErrorf(domain.ErrMatchInvalid, "offset account %s is a control account", account.Code)
+	}
+	return nil
+}
+
+// ensurePeriodOpen checks entries can be posted on a date; dates outside
+// any defined accounting period are allowed
+func ensurePeriodOpen(ctx context.Context, repo glrepository.FiscalPeriodRepositoryInterface, orgID uuid.UUID, date time.Time) error {
+	period, err := repo.GetPeriodByDate(ctx, orgID, date)
+	if err != nil {
+		return fmt.Errorf("failed to check accounting period: %w", err)
+	}
+	if period == nil {
+		return nil
+	}
+
+	return period.EnsurePostingAllowed()
+}
@@ -0,0 +1,48 @@
+// backend/internal/banking/service/reconciliation_service_interface.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// ReconciliationServiceInterface defines business logic for reconciling bank statements with the ledger
+type ReconciliationServiceInterface interface {
+	// AutoMatch matches a bank account's unmatched statement lines to posted ledger lines
+	// by amount, date window and reference. A negative window uses the default.
+	AutoMatch(ctx context.Context, orgID, bankAccountID uuid.UUID, windowDays int, matchedBy uuid.UUID) ([]*domain.StatementLine, error)
+
+	// MatchLine matches a statement line to a ledger line of the same amount
+	MatchLine(ctx context.Context, orgID, statementLineID, journalLineID, matchedBy uuid.UUID) (*domain.StatementLine, error)
+
+	// UnmatchLine returns a matched statement line to the unmatched list
+	UnmatchLine(ctx context.Context, orgID, statementLineID, unmatchedBy uuid.UUID) (*domain.StatementLine, error)
+
+	// CreateEntryFromLine posts an entry for a statement line missing from the ledger,
+	// such as a bank charge, and matches the line to it
+	CreateEntryFromLine(ctx context.Context, orgID, statementLineID, offsetAccountID uuid.UUID, description string, createdBy uuid.UUID) (*domain.StatementLine, *gldomain.JournalEntry, error)
+
+	// ListUnmatchedLedgerLines lists a bank account's posted ledger lines not matched to a statement line
+	ListUnmatchedLedgerLines(ctx context.Context, orgID, bankAccountID uuid.UUID, from, to *time.Time) ([]*domain.LedgerLine, error)
+
+	// GetReport builds a bank account's reconciliation report for a period.
+	// Without a statement balance, the balance is taken from imported statements.
+	GetReport(ctx context.Context, orgID, bankAccountID uuid.UUID, periodStart, periodEnd time.Time, statementBalance *money.Amount) (*domain.BankReconciliation, error)
+
+	// LockReconciliation saves a reconciled report and freezes matching up to its period end
+	LockReconciliation(ctx context.Context, orgID, bankAccountID uuid.UUID, periodStart, periodEnd time.Time, statementBalance *money.Amount, lockedBy uuid.UUID) (*domain.BankReconciliation, error)
+
+	// UnlockReconciliation releases a bank account's latest locked report
+	UnlockReconciliation(ctx context.Context, orgID, id, unlockedBy uuid.UUID, reason string) (*domain.BankReconciliation, error)
+
+	// GetReconciliation retrieves one of an organization's saved reports
+	GetReconciliation(ctx context.Context, orgID, id uuid.UUID) (*domain.BankReconciliation, error)
+
+	// ListReconciliations lists a bank account's saved reports, latest period first
+	ListReconciliations(ctx context.Context, orgID, bankAccountID uuid.UUID) ([]*domain.BankReconciliation, error)
+}
@@ -0,0 +1,186 @@
+// backend/internal/banking/service/statement_import.go
+package service
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+)
+
+// statementDateLayouts are the dates accepted in CSV statements. Slashed
+// dates are read day first, as banks in the region print them.
+var statementDateLayouts = []string{
+	"2006-01-02",
+	"02/01/2006",
+	"2/1/2006",
+	"02-01-2006",
+	"2-1-2006",
+	"02.01.2006",
+	"2006/01/02",
+	"02 Jan 2006",
+	"2 Jan 2006",
+	"02-Jan-2006",
+	"02-Jan-06",
+	"02 January 2006",
+	"Jan 2, 2006",
+	"02/01/06",
+	"20060102",
+	"2006-01-02T15:04:05",
+	"02/01/2006 15:04",
+	"02/01/2006 15:04:05",
+}
+
+// DetectStatementFormat works out a statement file's format from its name,
+// falling back to its content
+func DetectStatementFormat(fileName string, content []byte) (domain.StatementFormat, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return domain.StatementFormatCSV, nil
+	case ".txt":
+		// MT940 files are often saved as text
+		if looksLikeMT940(content) {
+			return domain.StatementFormatMT940, nil
+		}
+		return domain.StatementFormatCSV, nil
+	case ".ofx", ".qfx":
+		return domain.StatementFormatOFX, nil
+	case ".sta", ".mt940", ".940":
+		return domain.StatementFormatMT940, nil
+	case ".xml", ".camt", ".053":
+		return domain.StatementFormatCAMT053, nil
+	}
+
+	head := bytes.ToUpper(content[:min(len(content), 1024)])
+	switch {
+	case bytes.Contains(head, []byte("OFXHEADER")) || bytes.Contains(head, []byte("<OFX>")):
+		return domain.StatementFormatOFX, nil
+	case bytes.Contains(head, []byte("CAMT.053")) || bytes.Contains(head, []byte("BKTOCSTMRSTMT")):
+		return domain.StatementFormatCAMT053, nil
+	case looksLikeMT940(content):
+		return domain.StatementFormatMT940, nil
+	}
+
+	return "", domain.NewBankingErrorf(domain.ErrStatementFormat,
+		"cannot tell the format of %s; use CSV, OFX, MT940 or CAMT.053", fileName)
+}
+
+// ParseStatement reads a statement file. Files holding several statements
+// (MT940 and CAMT.053 can) are combined into one covering them all.
+func ParseStatement(format domain.StatementFormat, content []byte) (*domain.BankStatement, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	var statement *domain.BankStatement
+	var err error
+	switch format {
+	case domain.StatementFormatCSV:
+		statement, err = parseCSVStatement(content)
+	case domain.StatementFormatOFX:
+		statement, err = parseOFXStatement(content)
+	case domain.StatementFormatMT940:
+		statement, err = parseMT940Statement(content)
+	case domain.StatementFormatCAMT053:
+		statement, err = parseCAMTStatement(content)
+	default:
+		return nil, domain.NewBankingErrorf(domain.ErrStatementFormat, "unsupported statement format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	statement.Format = format
+	return statement, nil
+}
+
+// combineStatements merges consecutive statements from one file: the first
+// one's opening balance, the last one's closing balance and all their lines
+func combineStatements(parts []*domain.BankStatement) (*domain.BankStatement, error) {
+	if len(parts) == 0 {
+		return nil, domain.NewBankingError("no statement found in file", domain.ErrStatementEmpty)
+	}
+
+	combined := parts[0]
+	for _, part := range parts[1:] {
+		if part.AccountIdentifier != "" && combined.AccountIdentifier != "" &&
+			!strings.EqualFold(part.AccountIdentifier, combined.AccountIdentifier) {
+			return nil, domain.NewBankingError("file holds statements for more than one account", domain.ErrStatementAccountMismatch)
+		}
+		if combined.AccountIdentifier == "" {
+			combined.AccountIdentifier = part.AccountIdentifier
+		}
+		if combined.Currency == "" {
+			combined.Currency = part.Currency
+		}
+		if part.ClosingBalance != nil {
+			combined.ClosingBalance = part.ClosingBalance
+		}
+		combined.Lines = append(combined.Lines, part.Lines...)
+	}
+
+	return combined, nil
+}
+
+// parseStatementAmount reads an amount written with either a decimal point or
+// a decimal comma, with or without thousands separators, a sign, brackets or
+// a currency code
+func parseStatementAmount(value string) (money.Amount, error) {
+	s := strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r == '.', r == ',', r == '-', r == '+':
+			return r
+		}
+		return -1
+	}, s)
+	if strings.HasSuffix(s, "-") {
+		negative = !negative
+		s = strings.TrimSuffix(s, "-")
+	}
+
+	lastDot, lastComma := strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+	switch {
+	case lastComma > lastDot && len(s)-lastComma-1 != 3:
+		// 1.234,56 or 12,5: the comma is the decimal mark
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	case lastComma > lastDot && lastDot >= 0:
+		// 1.234,567
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	default:
+		s = strings.ReplaceAll(s, ",", "")
+	}
+
+	amount, err := money.Parse(s)
+	if err != nil {
+		return 0, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid amount: %s", value)
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// parseStatementDate reads a date in any of statementDateLayouts
+func parseStatementDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range statementDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, domain.NewBankingErrorf(domain.ErrStatementInvalid, "invalid date: %s", value)
+}
+
+// amountPtr returns a pointer to a copy of an amount
+func amountPtr(a money.Amount) *money.Amount {
+	return &a
+}
@@ -0,0 +1,292 @@
+// backend/internal/banking/service/statement_import_test.go
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/banking/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+)
+
+func TestParseStatementAmount(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "1234.56", want: "1234.56"},
+		{input: "1,234.56", want: "1234.56"},
+		{input: "1.234,56", want: "1234.56"},
+		{input: "12,5", want: "12.5"},
+		{input: "1,234", want: "1234"},
+		{input: "1.234,567", want: "1234.567"},
+		{input: "-250.00", want: "-250.00"},
+		{input: "(250.00)", want: "-250.00"},
+		{input: "250.00-", want: "-250.00"},
+		{input: "AED 1,000.00", want: "1000.00"},
+		{input: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseStatementAmount(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseStatementAmount(%q) = %s, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseStatementAmount(%q) error = %v", tt.input, err)
+			}
+			if want := money.MustParse(tt.want); got != want {
+				t.Errorf("parseStatementAmount(%q) = %s, want %s", tt.input, got, want)
+			}
+		})
+	}
+}
+
+func TestParseStatementDate(t *testing.T) {
+	want := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []string{
+		"2025-01-02",
+		"02/01/2025",
+		"2/1/2025",
+		"02-01-2025",
+		"02.01.2025",
+		"2025/01/02",
+		"2 Jan 2025",
+		"02-Jan-25",
+		"Jan 2, 2025",
+		"20250102",
+		"2025-01-02T15:04:05",
+		"02/01/2025 15:04",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := parseStatementDate(input)
+			if err != nil {
+				t.Fatalf("parseStatementDate(%q) error = %v", input, err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("parseStatementDate(%q) = %s, want %s", input, got.Format("2006-01-02"), want.Format("2006-01-02"))
+			}
+		})
+	}
+
+	if _, err := parseStatementDate("13/13/2025"); err == nil {
+		t.Error("parseStatementDate(13/13/2025) succeeded, want an error")
+	}
+}
+
+func TestDetectStatementFormat(t *testing.T) {
+	tests := []struct {
+		fileName string
+		content  string
+		want     domain.StatementFormat
+	}{
+		{fileName: "jan.csv", content: "Date,Amount\n", want: domain.StatementFormatCSV},
+		{fileName: "jan.txt", content: "Date,Amount\n", want: domain.StatementFormatCSV},
+		{fileName: "jan.txt", content: ":20:S1\n:25:123\n:60F:C250101AED0,\n", want: domain.StatementFormatMT940},
+		{fileName: "jan.qfx", content: "", want: domain.StatementFormatOFX},
+		{fileName: "jan.sta", content: "", want: domain.StatementFormatMT940},
+		{fileName: "jan.xml", content: "", want: domain.StatementFormatCAMT053},
+		{fileName: "download", content: "OFXHEADER:100\n<OFX>", want: domain.StatementFormatOFX},
+		{fileName: "download", content: `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">`, want: domain.StatementFormatCAMT053},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName+" "+string(tt.want), func(t *testing.T) {
+			got, err := DetectStatementFormat(tt.fileName, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("DetectStatementFormat() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectStatementFormat() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := DetectStatementFormat("download", []byte("hello")); err == nil {
+		t.Error("DetectStatementFormat() of unknown content succeeded, want an error")
+	}
+}
+
+// Each sample statement holds the same two transactions: a 250.00 payment on
+// 2 January 2025 and a 500.00 receipt the next day, taking the balance from
+// 1000.00 to 1250.00
+const (
+	csvSample = "Account Statement\n" +
+		"Account No,AE070331234567890123456\n" +
+		"Date,Description,Reference,Debit,Credit,Balance\n" +
+		"02/01/2025,Payment to supplier,INV-1001,250.00,,750.00\n" +
+		"03/01/2025,Customer receipt,,,500.00,\"1,250.00\"\n"
+
+	mt940Sample = ":20:STMT1\n" +
+		":25:AE070331234567890123456\n" +
+		":28C:1/1\n" +
+		":60F:C250101AED1000,00\n" +
+		":61:2501020102D250,00NTRFINV-1001//BANKREF1\n" +
+		":86:Payment to supplier\n" +
+		":61:250103C500,NTRFNONREF\n" +
+		":86:Customer receipt\n" +
+		":62F:C250103AED1250,00\n" +
+		"-\n"
+
+	ofxSample = "OFXHEADER:100\nDATA:OFXSGML\n\n" +
+		"<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>AED\n" +
+		"<BANKACCTFROM><ACCTID>AE070331234567890123456</BANKACCTFROM>\n" +
+		"<BANKTRANLIST>\n" +
+		"<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250102120000[-5:EST]<TRNAMT>-250.00<FITID>T1<CHECKNUM>INV-1001<MEMO>Payment to supplier</STMTTRN>\n" +
+		"<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20250103<TRNAMT>500.00<FITID>T2<MEMO>Customer receipt</STMTTRN>\n" +
+		"</BANKTRANLIST>\n" +
+		"<LEDGERBAL><BALAMT>1250.00<DTASOF>20250103</LEDGERBAL>\n" +
+		"</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>\n"
+
+	camtSample = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"><BkToCstmrStmt><Stmt>
+  <Id>STMT1</Id>
+  <Acct><Id><IBAN>AE070331234567890123456</IBAN></Id><Ccy>AED</Ccy></Acct>
+  <Bal><Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp><Amt Ccy="AED">1000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd></Bal>
+  <Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="AED">1250.00</Amt><CdtDbtInd>CRDT</CdtDbtInd></Bal>
+  <Ntry>
+    <NtryRef>INV-1001</NtryRef><Amt Ccy="AED">250.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>BOOK</Sts>
+    <BookgDt><Dt>2025-01-02</Dt></BookgDt><AcctSvcrRef>BANKREF1</AcctSvcrRef>
+    <AddtlNtryInf>Payment to supplier</AddtlNtryInf>
+  </Ntry>
+  <Ntry>
+    <Amt Ccy="AED">500.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+    <BookgDt><DtTm>2025-01-03T10:00:00</DtTm></BookgDt>
+    <AddtlNtryInf>Customer receipt</AddtlNtryInf>
+  </Ntry>
+  <Ntry>
+    <Amt Ccy="AED">99.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts>
+    <BookgDt><Dt>2025-01-03</Dt></BookgDt>
+  </Ntry>
+</Stmt></BkToCstmrStmt></Document>`
+)
+
+func TestParseStatement(t *testing.T) {
+	type wantLine struct {
+		date        time.Time
+		amount      string
+		description string
+		reference   string
+	}
+	wantLines := []wantLine{
+		{date: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), amount: "-250.00", description: "Payment to supplier", reference: "INV-1001"},
+		{date: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), amount: "500.00", description: "Customer receipt"},
+	}
+
+	tests := []struct {
+		format      domain.StatementFormat
+		content     string
+		wantAccount string
+	}{
+		{format: domain.StatementFormatCSV, content: "\xef\xbb\xbf" + csvSample},
+		{format: domain.StatementFormatMT940, content: mt940Sample, wantAccount: "AE070331234567890123456"},
+		{format: domain.StatementFormatOFX, content: ofxSample, wantAccount: "AE070331234567890123456"},
+		{format: domain.StatementFormatCAMT053, content: camtSample, wantAccount: "AE070331234567890123456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			statement, err := ParseStatement(tt.format, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("ParseStatement() error = %v", err)
+			}
+
+			if statement.Format != tt.format {
+				t.Errorf("Format = %s, want %s", statement.Format, tt.format)
+			}
+			if statement.AccountIdentifier != tt.wantAccount {
+				t.Errorf("AccountIdentifier = %q, want %q", statement.AccountIdentifier, tt.wantAccount)
+			}
+			if statement.OpeningBalance == nil || *statement.OpeningBalance != money.MustParse("1000.00") {
+				t.Errorf("OpeningBalance = %v, want 1000.00", statement.OpeningBalance)
+			}
+			if statement.ClosingBalance == nil || *statement.ClosingBalance != money.MustParse("1250.00") {
+				t.Errorf("ClosingBalance = %v, want 1250.00", statement.ClosingBalance)
+			}
+
+			if len(statement.Lines) != len(wantLines) {
+				t.Fatalf("got %d lines, want %d", len(statement.Lines), len(wantLines))
+			}
+			for i, want := range wantLines {
+				got := statement.Lines[i]
+				if !got.TransactionDate.Equal(want.date) {
+					t.Errorf("line %d date = %s, want %s", i+1, got.TransactionDate.Format("2006-01-02"), want.date.Format("2006-01-02"))
+				}
+				if got.Amount != money.MustParse(want.amount) {
+					t.Errorf("line %d amount = %s, want %s", i+1, got.Amount, want.amount)
+				}
+				if got.Description != want.description {
+					t.Errorf("line %d description = %q, want %q", i+1, got.Description, want.description)
+				}
+				if got.Reference != want.reference {
+					t.Errorf("line %d reference = %q, want %q", i+1, got.Reference, want.reference)
+				}
+			}
+		})
+	}
+}
+
+func TestParseMT940Transaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		wantDate   time.Time
+		wantValue  time.Time
+		wantAmount string
+	}{
+		{
+			name:       "debit with entry date",
+			line:       "2501020103D250,00NTRFINV-1001",
+			wantDate:   time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+			wantValue:  time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantAmount: "-250.00",
+		},
+		{
+			name:       "entry date in the next year",
+			line:       "2412310102C100,NTRFNONREF",
+			wantDate:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantValue:  time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			wantAmount: "100.00",
+		},
+		{
+			name:       "reversal of a debit is money in",
+			line:       "250105RD75,50NTRFNONREF",
+			wantDate:   time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantValue:  time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantAmount: "75.50",
+		},
+		{
+			name:       "reversal of a credit is money out",
+			line:       "250105RC75,50NTRFNONREF",
+			wantDate:   time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantValue:  time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantAmount: "-75.50",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, err := parseMT940Transaction([]string{tt.line})
+			if err != nil {
+				t.Fatalf("parseMT940Transaction() error = %v", err)
+			}
+			if !line.TransactionDate.Equal(tt.wantDate) {
+				t.Errorf("date = %s, want %s", line.TransactionDate.Format("2006-01-02"), tt.wantDate.Format("2006-01-02"))
+			}
+			if line.ValueDate == nil || !line.ValueDate.Equal(tt.wantValue) {
+				t.Errorf("value date = %v, want %s", line.ValueDate, tt.wantValue.Format("2006-01-02"))
+			}
+			if line.Amount != money.MustParse(tt.wantAmount) {
+				t.Errorf("amount = %s, want %s", line.Amount, tt.wantAmount)
+			}
+		})
+	}
+}
@@ -7,12 +7,19 @@ import (
 )
 
 type GLAccount struct {
-	ID         uuid.UUID   `json:"id"`
-	Code       string      `json:"code"`
-	Name       string      `json:"name"`
-	Type       AccountType `json:"type"`
-	ParentCode *uuid.UUID  `json:"parent_id,omitempty"`
-	CreateAt   time.Time   `json:"create_at"`
-	UpdateAt   time.Time   `json:"update_at"`
-	IsActive   bool        `json:"is_active"`
+	ID             uuid.UUID   `json:"id"`
+	OrganizationID uuid.UUID   `json:"organization_id"` // Each organization keeps its own chart of accounts
+	Code           string      `json:"code"`
+	Name           string      `json:"name"`
+	Type           AccountType `json:"type"`
+	ParentCode     *uuid.UUID  `json:"parent_id,omitempty"`
+	CreateAt       time.Time   `json:"create_at"`
+	UpdateAt       time.Time   `json:"update_at"`
+	IsActive       bool        `json:"is_active"`
+	IsPostable     bool        `json:"is_postable"` // False for control accounts that only summarize their children
+}
+
+// BelongsTo checks if the account is part of an organization's chart of accounts
+func (a *GLAccount) BelongsTo(orgID uuid.UUID) bool {
+	return a.OrganizationID == orgID
 }
@@ -0,0 +1,67 @@
+// backend/internal/gl-core/domain/account_statement.go
+package domain
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// CounterAccount is an account on the other side of a statement line's entry
+type CounterAccount struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+}
+
+// AccountStatementLine is one posted line of an account statement
+type AccountStatementLine struct {
+	JournalEntryID  uuid.UUID        `json:"journal_entry_id"` // For drill-down to the entry
+	LineID          uuid.UUID        `json:"line_id"`
+	EntryNumber     string           `json:"entry_number"`
+	JournalType     JournalType      `json:"journal_type"`
+	TransactionDate time.Time        `json:"transaction_date"`
+	Reference       string           `json:"reference"`   // Line reference, or the entry's when the line has none
+	Description     string           `json:"description"` // Line description, or the entry's when the line has none
+	CounterAccounts []CounterAccount `json:"counter_accounts"`
+	Debit           money.Amount     `json:"debit"`
+	Credit          money.Amount     `json:"credit"`
+	Balance         money.Amount     `json:"balance"` // Running balance after this line (positive = debit)
+}
+
+// AccountStatement lists an account's posted lines for a period between its
+// opening and closing balances
+type AccountStatement struct {
+	OrganizationID uuid.UUID              `json:"organization_id"`
+	AccountID      uuid.UUID              `json:"account_id"`
+	AccountCode    string                 `json:"account_code"`
+	AccountName    string                 `json:"account_name"`
+	AccountType    AccountType            `json:"account_type"`
+	FromDate       time.Time              `json:"from_date"`
+	ToDate         time.Time              `json:"to_date"`
+	OpeningBalance money.Amount           `json:"opening_balance"` // Positive = debit
+	TotalDebit     money.Amount           `json:"total_debit"`
+	TotalCredit    money.Amount           `json:"total_credit"`
+	ClosingBalance money.Amount           `json:"closing_balance"` // Positive = debit
+	Lines          []AccountStatementLine `json:"lines"`
+	Dimensions     LineDimensions         `json:"dimensions,omitempty"` // Dimension filter the statement was run with
+	GeneratedAt    time.Time              `json:"generated_at"`
+}
+
+// CalculateBalances runs the balance down the lines from the opening balance
+// and derives the period totals and closing balance
+func (s *AccountStatement) CalculateBalances() {
+	s.TotalDebit, s.TotalCredit = 0, 0
+	balance := s.OpeningBalance
+
+	for i := range s.Lines {
+		line := &s.Lines[i]
+		balance += line.Debit - line.Credit
+		line.Balance = balance
+		s.TotalDebit += line.Debit
+		s.TotalCredit += line.Credit
+	}
+
+	s.ClosingBalance = balance
+}
@@ -0,0 +1,133 @@
+// backend/internal/gl-core/domain/account_tree.go
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// AccountTreeNode is an account in the chart of accounts hierarchy with its
+// own balance and the balance rolled up from its descendants
+type AccountTreeNode struct {
+	Account          GLAccount          `json:"account"`
+	Balance          money.Amount       `json:"balance"`           // Own posted balance (positive = debit)
+	AggregateBalance money.Amount       `json:"aggregate_balance"` // Own balance plus all descendants
+	Children         []*AccountTreeNode `json:"children"`
+}
+
+// BuildAccountTree arranges accounts by ParentCode into a tree ordered by code.
+// Accounts whose parent is missing (or part of a parent cycle) become roots.
+func BuildAccountTree(accounts []GLAccount, balances map[uuid.UUID]money.Amount) []*AccountTreeNode {
+	nodes := make(map[uuid.UUID]*AccountTreeNode, len(accounts))
+	for _, account := range accounts {
+		nodes[account.ID] = &AccountTreeNode{
+			Account:  account,
+			Balance:  balances[account.ID],
+			Children: []*AccountTreeNode{},
+		}
+	}
+
+	var roots []*AccountTreeNode
+	for _, account := range accounts {
+		node := nodes[account.ID]
+		if account.ParentCode != nil && *account.ParentCode != account.ID {
+			if parent, ok := nodes[*account.ParentCode]; ok && !isAncestor(nodes, account.ID, parent) {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	sortAccountNodes(roots)
+	for _, root := range roots {
+		root.aggregate()
+	}
+
+	return roots
+}
+
+// isAncestor checks if the account is an ancestor of node, walking parent links
+func isAncestor(nodes map[uuid.UUID]*AccountTreeNode, accountID uuid.UUID, node *AccountTreeNode) bool {
+	seen := make(map[uuid.UUID]bool)
+	for node != nil && !seen[node.Account.ID] {
+		if node.Account.ID == accountID {
+			return true
+		}
+		seen[node.Account.ID] = true
+		if node.Account.ParentCode == nil {
+			return false
+		}
+		node = nodes[*node.Account.ParentCode]
+	}
+	return false
+}
+
+// aggregate sums the subtree balances and orders children by code
+func (n *AccountTreeNode) aggregate() money.Amount {
+	sortAccountNodes(n.Children)
+	n.AggregateBalance = n.Balance
+	for _, child := range n.Children {
+		n.AggregateBalance += child.aggregate()
+	}
+	return n.AggregateBalance
+}
+
+func sortAccountNodes(nodes []*AccountTreeNode) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Account.Code < nodes[j].Account.Code })
+}
+
+// AccountMerge records one account being merged into another. Historical
+// journal lines are re-pointed to the target and the source is deactivated.
+type AccountMerge struct {
+	ID              uuid.UUID `json:"id"`
+	OrganizationID  uuid.UUID `json:"organization_id"`
+	SourceAccountID uuid.UUID `json:"source_account_id"`
+	SourceCode      string    `json:"source_code"`
+	TargetAccountID uuid.UUID `json:"target_account_id"`
+	TargetCode      string    `json:"target_code"`
+	LinesMoved      int64     `json:"lines_moved"`
+	Reason          string    `json:"reason"`
+	MergedBy        uuid.UUID `json:"merged_by"`
+	MergedAt        time.Time `json:"merged_at"`
+}
+
+// NewAccountMerge validates that source can be merged into target
+func NewAccountMerge(source, target GLAccount, reason string, mergedBy uuid.UUID) (*AccountMerge, error) {
+	if source.ID == target.ID {
+		return nil, NewGLError("an account cannot be merged into itself", ErrAccountMergeInvalid)
+	}
+	if !target.BelongsTo(source.OrganizationID) {
+		return nil, NewGLErrorf(ErrAccountOrgMismatch, "account %s belongs to another organization", target.Code)
+	}
+	if source.Type != target.Type {
+		return nil, NewGLErrorf(ErrAccountMergeInvalid,
+			"cannot merge %s account %s into %s account %s", source.Type, source.Code, target.Type, target.Code)
+	}
+	if !target.IsActive {
+		return nil, NewGLErrorf(ErrAccountMergeInvalid, "target account %s is inactive", target.Code)
+	}
+	if !target.IsPostable {
+		return nil, NewGLErrorf(ErrAccountNotPostable, "target account %s is a control account", target.Code)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, NewGLError("a reason is required to merge accounts", ErrAccountMergeInvalid)
+	}
+
+	return &AccountMerge{
+		ID:              uuid.New(),
+		OrganizationID:  source.OrganizationID,
+		SourceAccountID: source.ID,
+		SourceCode:      source.Code,
+		TargetAccountID: target.ID,
+		TargetCode:      target.Code,
+		Reason:          reason,
+		MergedBy:        mergedBy,
+		MergedAt:        time.Now(),
+	}, nil
+}
@@ -0,0 +1,163 @@
+// backend/internal/gl-core/domain/approval.go
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// ApprovalAction is a step in a journal entry's maker-checker workflow
+type ApprovalAction string
+
+const (
+	ApprovalActionSubmitted ApprovalAction = "SUBMITTED" // Maker sent the entry for approval
+	ApprovalActionApproved  ApprovalAction = "APPROVED"  // Checker approved and posted the entry
+	ApprovalActionRejected  ApprovalAction = "REJECTED"  // Checker returned the entry to DRAFT
+)
+
+// ApprovalRule decides which journal entries need a checker's approval before
+// posting. A rule matches an entry when every condition it sets holds: the
+// entry total is at least MinAmount, and a line posts to one of AccountIDs.
+// A rule with neither condition matches every entry.
+type ApprovalRule struct {
+	ID                       uuid.UUID    `json:"id"`
+	OrganizationID           uuid.UUID    `json:"organization_id"`
+	Name                     string       `json:"name"`
+	MinAmount                money.Amount `json:"min_amount"`                 // 0 for any amount
+	AccountIDs               []uuid.UUID  `json:"account_ids"`                // Empty for any account
+	RequireDifferentApprover bool         `json:"require_different_approver"` // Approver must not be the entry's creator
+	IsActive                 bool         `json:"is_active"`
+	CreatedBy                uuid.UUID    `json:"created_by"`
+	CreatedAt                time.Time    `json:"created_at"`
+	UpdatedAt                time.Time    `json:"updated_at"`
+}
+
+// JournalEntryApproval records one submit, approve or reject action with its comment
+type JournalEntryApproval struct {
+	ID             uuid.UUID      `json:"id"`
+	JournalEntryID uuid.UUID      `json:"journal_entry_id"`
+	Action         ApprovalAction `json:"action"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Comment        string         `json:"comment"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// Validate performs domain validation on ApprovalRule
+func (r *ApprovalRule) Validate() error {
+	if r.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrApprovalRuleInvalid)
+	}
+
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" || len(r.Name) > 255 {
+		return NewGLError("rule name is required and cannot exceed 255 characters", ErrApprovalRuleInvalid)
+	}
+
+	if r.MinAmount < 0 {
+		return NewGLError("minimum amount cannot be negative", ErrApprovalRuleInvalid)
+	}
+
+	return nil
+}
+
+// Matches checks if the rule applies to an entry
+func (r *ApprovalRule) Matches(entry *JournalEntry) bool {
+	if !r.IsActive {
+		return false
+	}
+
+	if r.MinAmount > 0 {
+		entry.CalculateTotals()
+		if entry.TotalDebit < r.MinAmount {
+			return false
+		}
+	}
+
+	if len(r.AccountIDs) > 0 {
+		return r.touchesAccount(entry)
+	}
+
+	return true
+}
+
+// touchesAccount checks if any line of the entry posts to one of the rule's accounts
+func (r *ApprovalRule) touchesAccount(entry *JournalEntry) bool {
+	for _, line := range entry.Lines {
+		for _, id := range r.AccountIDs {
+			if line.AccountID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchingApprovalRules returns the rules that require an entry to be approved
+func MatchingApprovalRules(entry *JournalEntry, rules []*ApprovalRule) []*ApprovalRule {
+	var matched []*ApprovalRule
+	for _, rule := range rules {
+		if rule.Matches(entry) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// NewJournalEntryApproval records an approval workflow action
+func NewJournalEntryApproval(entryID uuid.UUID, action ApprovalAction, userID uuid.UUID, comment string) *JournalEntryApproval {
+	return &JournalEntryApproval{
+		ID:             uuid.New(),
+		JournalEntryID: entryID,
+		Action:         action,
+		UserID:         userID,
+		Comment:        strings.TrimSpace(comment),
+		CreatedAt:      time.Now(),
+	}
+}
+
+// Submit sends a draft entry for approval
+func (je *JournalEntry) Submit() error {
+	if !je.Status.CanTransitionTo(EntryStatusPendingApproval) || !je.IsBalanced() {
+		return NewGLErrorf(ErrJournalCannotSubmit, "entry cannot be submitted for approval (status: %s)", je.Status)
+	}
+
+	je.Status = EntryStatusPendingApproval
+	je.UpdatedAt = time.Now()
+	return nil
+}
+
+// Approve posts a pending entry on behalf of the approver. Rules requiring a
+// different approver reject approval by the entry's creator.
+func (je *JournalEntry) Approve(approvedBy uuid.UUID, rules []*ApprovalRule) error {
+	if je.Status != EntryStatusPendingApproval {
+		return NewGLErrorf(ErrJournalCannotApprove, "only entries pending approval can be approved (status: %s)", je.Status)
+	}
+
+	for _, rule := range rules {
+		if rule.RequireDifferentApprover && approvedBy == je.CreatedBy {
+			return NewGLErrorf(ErrApprovalSegregationOfDuties,
+				"entry must be approved by someone other than its creator (rule: %s)", rule.Name)
+		}
+	}
+
+	now := time.Now()
+	je.Status = EntryStatusPosted
+	je.PostingDate = &now
+	je.PostedBy = &approvedBy
+	je.UpdatedAt = now
+	return nil
+}
+
+// Reject returns a pending entry to DRAFT so its maker can correct it
+func (je *JournalEntry) Reject() error {
+	if je.Status != EntryStatusPendingApproval {
+		return NewGLErrorf(ErrJournalCannotApprove, "only entries pending approval can be rejected (status: %s)", je.Status)
+	}
+
+	je.Status = EntryStatusDraft
+	je.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,139 @@
+// backend/internal/gl-core/domain/attachment.go
+package domain
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAttachmentSize is the largest supporting document accepted (20 MB)
+const MaxAttachmentSize int64 = 20 << 20
+
+// AttachmentEntityType is the kind of record a document supports
+type AttachmentEntityType string
+
+const (
+	AttachmentEntityJournalEntry AttachmentEntityType = "JOURNAL_ENTRY"
+	AttachmentEntityImport       AttachmentEntityType = "IMPORT" // Keyed by ImportResult.ImportLogID
+)
+
+// IsValid checks if the entity type is supported
+func (t AttachmentEntityType) IsValid() bool {
+	return t == AttachmentEntityJournalEntry || t == AttachmentEntityImport
+}
+
+// allowedAttachmentTypes maps accepted MIME types to the file extensions they may carry
+var allowedAttachmentTypes = map[string][]string{
+	"application/pdf": {".pdf"},
+	"image/png":       {".png"},
+	"image/jpeg":      {".jpg", ".jpeg"},
+	"text/csv":        {".csv"},
+	"text/plain":      {".txt", ".csv", ".iif"},
+	"text/xml":        {".xml"},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": {".xlsx"},
+	"application/vnd.ms-excel": {".xls"},
+}
+
+// Attachment is a supporting document (invoice PDF, receipt, original import
+// workbook) stored in the blob store. The checksum is a hex SHA-256 of the
+// content taken at upload so later tampering with the stored file is detectable.
+type Attachment struct {
+	ID             uuid.UUID            `json:"id"`
+	OrganizationID uuid.UUID            `json:"organization_id"`
+	EntityType     AttachmentEntityType `json:"entity_type"`
+	EntityID       uuid.UUID            `json:"entity_id"`
+	FileName       string               `json:"file_name"`
+	ContentType    string               `json:"content_type"`
+	SizeBytes      int64                `json:"size_bytes"`
+	Checksum       string               `json:"checksum"`
+	StorageKey     string               `json:"storage_key"`
+	UploadedBy     uuid.UUID            `json:"uploaded_by"`
+	CreatedAt      time.Time            `json:"created_at"`
+}
+
+// NewAttachment creates a validated attachment. The storage key is derived
+// from the organization, entity and attachment ID, never from the file name.
+func NewAttachment(orgID uuid.UUID, entityType AttachmentEntityType, entityID uuid.UUID, fileName, contentType string, size int64, checksum string, uploadedBy uuid.UUID) (*Attachment, error) {
+	a := &Attachment{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		EntityType:     entityType,
+		EntityID:       entityID,
+		FileName:       strings.TrimSpace(filepath.Base(fileName)),
+		ContentType:    contentType,
+		SizeBytes:      size,
+		Checksum:       checksum,
+		UploadedBy:     uploadedBy,
+		CreatedAt:      time.Now(),
+	}
+	a.StorageKey = path.Join(orgID.String(), strings.ToLower(string(entityType)), entityID.String(), a.ID.String())
+
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Validate performs domain validation on Attachment
+func (a *Attachment) Validate() error {
+	if a.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrAttachmentInvalid)
+	}
+
+	if !a.EntityType.IsValid() || a.EntityID == uuid.Nil {
+		return NewGLErrorf(ErrAttachmentInvalid, "invalid attachment target: %s", a.EntityType)
+	}
+
+	if a.FileName == "" || a.FileName == "." || len(a.FileName) > 255 {
+		return NewGLError("file name is required and cannot exceed 255 characters", ErrAttachmentInvalid)
+	}
+
+	if a.SizeBytes <= 0 {
+		return NewGLError("file is empty", ErrAttachmentInvalid)
+	}
+
+	if a.SizeBytes > MaxAttachmentSize {
+		return NewGLErrorf(ErrAttachmentTooLarge, "file exceeds the %d MB limit", MaxAttachmentSize>>20)
+	}
+
+	if err := ValidateAttachmentType(a.FileName, a.ContentType); err != nil {
+		return err
+	}
+
+	if len(a.Checksum) != 64 {
+		return NewGLError("checksum must be a hex SHA-256 digest", ErrAttachmentInvalid)
+	}
+
+	return nil
+}
+
+// ValidateAttachmentType checks that the MIME type is allowed and agrees with the file extension
+func ValidateAttachmentType(fileName, contentType string) error {
+	extensions, ok := allowedAttachmentTypes[contentType]
+	if !ok {
+		return NewGLErrorf(ErrAttachmentTypeNotAllowed, "file type %s is not allowed", contentType)
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for _, allowed := range extensions {
+		if ext == allowed {
+			return nil
+		}
+	}
+
+	return NewGLError(fmt.Sprintf("file extension %q does not match its content (%s)", ext, contentType), ErrAttachmentTypeNotAllowed)
+}
+
+// VerifyChecksum checks stored content against the checksum taken at upload
+func (a *Attachment) VerifyChecksum(checksum string) error {
+	if !strings.EqualFold(a.Checksum, checksum) {
+		return NewGLErrorf(ErrAttachmentChecksumInvalid, "attachment %s does not match its upload checksum", a.ID)
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+// backend/internal/gl-core/domain/coa_template.go
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaxRole marks the accounts a template sets aside for indirect tax
+type TaxRole string
+
+const (
+	TaxRoleOutput TaxRole = "VAT_OUTPUT" // Tax charged on sales, owed to the authority
+	TaxRoleInput  TaxRole = "VAT_INPUT"  // Tax paid on purchases, recoverable
+)
+
+// COATemplateAccount is one account in a chart of accounts template.
+// Parents must be listed before their children.
+type COATemplateAccount struct {
+	Code       string      `json:"code"`
+	Name       string      `json:"name"`
+	Type       AccountType `json:"type"`
+	ParentCode string      `json:"parent_code,omitempty"`
+	IsPostable bool        `json:"is_postable"`
+	TaxRole    TaxRole     `json:"tax_role,omitempty"`
+}
+
+// COATemplatePayrollMapping is a default payroll component to GL account mapping
+type COATemplatePayrollMapping struct {
+	ComponentType string `json:"component_type"` // BASIC, ALLOWANCE, DEDUCTION, GRATUITY
+	ComponentName string `json:"component_name"`
+	DebitCode     string `json:"debit_code"`
+	CreditCode    string `json:"credit_code"`
+	Description   string `json:"description"`
+}
+
+// COATemplate is a versioned starter chart of accounts for an industry.
+// Bumping Version lets organizations that applied an earlier version pick up
+// accounts and mappings added since; nothing already provisioned is changed.
+type COATemplate struct {
+	Code            string                      `json:"code"`
+	Version         int                         `json:"version"`
+	Name            string                      `json:"name"`
+	Description     string                      `json:"description"`
+	Industries      []string                    `json:"industries"` // Organization types this is the default for
+	Accounts        []COATemplateAccount        `json:"accounts"`
+	PayrollMappings []COATemplatePayrollMapping `json:"payroll_mappings"`
+}
+
+// COATemplateApplication records the template version an organization's chart
+// of accounts was last provisioned from
+type COATemplateApplication struct {
+	ID              uuid.UUID `json:"id"`
+	OrganizationID  uuid.UUID `json:"organization_id"`
+	TemplateCode    string    `json:"template_code"`
+	Version         int       `json:"version"`
+	AccountsCreated int       `json:"accounts_created"`
+	MappingsCreated int       `json:"mappings_created"`
+	AppliedBy       uuid.UUID `json:"applied_by"` // uuid.Nil when provisioned on organization creation
+	AppliedAt       time.Time `json:"applied_at"`
+}
+
+// PayrollGLMapping is a resolved payroll mapping ready to be saved for an organization
+type PayrollGLMapping struct {
+	ID              uuid.UUID `json:"id"`
+	OrganizationID  uuid.UUID `json:"organization_id"`
+	ComponentType   string    `json:"component_type"`
+	ComponentName   string    `json:"component_name"`
+	DebitAccountID  uuid.UUID `json:"debit_account_id"`
+	CreditAccountID uuid.UUID `json:"credit_account_id"`
+	Description     string    `json:"description"`
+}
+
+// TaxAccounts returns the template accounts that carry a tax role
+func (t *COATemplate) TaxAccounts() []COATemplateAccount {
+	var accounts []COATemplateAccount
+	for _, a := range t.Accounts {
+		if a.TaxRole != "" {
+			accounts = append(accounts, a)
+		}
+	}
+	return accounts
+}
+
+// BuildAccounts returns the template accounts an organization does not have yet.
+// existing maps the organization's account codes to IDs; it is updated with
+// the new accounts so children resolve their parents.
+func (t *COATemplate) BuildAccounts(orgID uuid.UUID, existing map[string]uuid.UUID) []GLAccount {
+	now := time.Now()
+	var accounts []GLAccount
+	for _, a := range t.Accounts {
+		if _, ok := existing[a.Code]; ok {
+			continue
+		}
+
+		account := GLAccount{
+			ID:             uuid.New(),
+			OrganizationID: orgID,
+			Code:           a.Code,
+			Name:           a.Name,
+			Type:           a.Type,
+			CreateAt:       now,
+			UpdateAt:       now,
+			IsActive:       true,
+			IsPostable:     a.IsPostable,
+		}
+		if parentID, ok := existing[a.ParentCode]; ok {
+			account.ParentCode = &parentID
+		}
+
+		existing[a.Code] = account.ID
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// BuildPayrollMappings resolves the template's payroll mappings against the
+// organization's accounts. Mappings whose accounts are missing are skipped.
+func (t *COATemplate) BuildPayrollMappings(orgID uuid.UUID, accounts map[string]uuid.UUID) []PayrollGLMapping {
+	var mappings []PayrollGLMapping
+	for _, m := range t.PayrollMappings {
+		debitID, okDebit := accounts[m.DebitCode]
+		creditID, okCredit := accounts[m.CreditCode]
+		if !okDebit || !okCredit {
+			continue
+		}
+		mappings = append(mappings, PayrollGLMapping{
+			ID:              uuid.New(),
+			OrganizationID:  orgID,
+			ComponentType:   m.ComponentType,
+			ComponentName:   m.ComponentName,
+			DebitAccountID:  debitID,
+			CreditAccountID: creditID,
+			Description:     m.Description,
+		})
+	}
+	return mappings
+}
+
+// NewCOATemplateApplication starts an application record for a template
+func NewCOATemplateApplication(orgID uuid.UUID, template *COATemplate, appliedBy uuid.UUID) *COATemplateApplication {
+	return &COATemplateApplication{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		TemplateCode:   template.Code,
+		Version:        template.Version,
+		AppliedBy:      appliedBy,
+		AppliedAt:      time.Now(),
+	}
+}
+
+// CanApply checks if a template may be applied over the organization's last
+// application: the same template at the same or a newer version
+func (a *COATemplateApplication) CanApply(template *COATemplate) error {
+	if a.TemplateCode != template.Code {
+		return NewGLErrorf(ErrCOATemplateConflict,
+			"chart of accounts was provisioned from template %s; cannot apply %s", a.TemplateCode, template.Code)
+	}
+	if template.Version < a.Version {
+		return NewGLErrorf(ErrCOATemplateConflict,
+			"template %s is already at version %d", a.TemplateCode, a.Version)
+	}
+	return nil
+}
+
+// FindCOATemplate looks up a built-in template by code
+func FindCOATemplate(code string) (*COATemplate, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for i := range coaTemplates {
+		if coaTemplates[i].Code == code {
+			return &coaTemplates[i], nil
+		}
+	}
+	return nil, NewGLErrorf(ErrCOATemplateNotFound, "chart of accounts template not found: %s", code)
+}
+
+// DefaultCOATemplate picks the template for an organization type, falling
+// back to the services template
+func DefaultCOATemplate(orgType string) *COATemplate {
+	orgType = strings.ToUpper(strings.TrimSpace(orgType))
+	for i := range coaTemplates {
+		for _, industry := range coaTemplates[i].Industries {
+			if industry == orgType {
+				return &coaTemplates[i]
+			}
+		}
+	}
+	template, _ := FindCOATemplate(COATemplateServices)
+	return template
+}
+
+// COATemplates returns the built-in templates
+func COATemplates() []COATemplate {
+	return coaTemplates
+}
+
+// Built-in template codes
+const (
+	COATemplateHealthcareClinic = "HEALTHCARE_CLINIC"
+	COATemplateTrading          = "TRADING"
+	COATemplateServices         = "SERVICES"
+)
+
+// Accounts shared by every template. Codes follow the TYPE-NNNN scheme used by the seed data.
+var (
+	commonAssetAccounts = []COATemplateAccount{
+		{Code: "AST-1000", Name: "Current Assets", Type: AccountTypeAsset},
+		{Code: "AST-1010", Name: "Cash on Hand", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+		{Code: "AST-1020", Name: "Bank Accounts", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+		{Code: "AST-1100", Name: "Accounts Receivable", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+		{Code: "AST-1300", Name: "VAT Recoverable", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true, TaxRole: TaxRoleInput},
+		{Code: "AST-1400", Name: "Prepaid Expenses", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+		{Code: "AST-1500", Name: "Property and Equipment", Type: AccountTypeAsset},
+		{Code: "AST-1510", Name: "Furniture and Fixtures", Type: AccountTypeAsset, ParentCode: "AST-1500", IsPostable: true},
+		{Code: "AST-1520", Name: "Computer Equipment", Type: AccountTypeAsset, ParentCode: "AST-1500", IsPostable: true},
+		{Code: "AST-1590", Name: "Accumulated Depreciation", Type: AccountTypeAsset, ParentCode: "AST-1500", IsPostable: true},
+	}
+
+	commonLiabilityAccounts = []COATemplateAccount{
+		{Code: "LIA-2000", Name: "Current Liabilities", Type: AccountTypeLiability},
+		{Code: "LIA-2010", Name: "Accounts Payable", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true},
+		{Code: "LIA-2100", Name: "Accrued Expenses", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true},
+		{Code: "LIA-2200", Name: "Salaries Payable", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true},
+		{Code: "LIA-2210", Name: "End of Service Benefits Payable", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true},
+		{Code: "LIA-2220", Name: "Payroll Deductions Payable", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true},
+		{Code: "LIA-2300", Name: "VAT Payable", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true, TaxRole: TaxRoleOutput},
+	}
+
+	commonEquityAccounts = []COATemplateAccount{
+		{Code: "EQU-3000", Name: "Capital", Type: AccountTypeEquity, IsPostable: true},
+		{Code: "EQU-3100", Name: "Retained Earnings", Type: AccountTypeEquity, IsPostable: true},
+	}
+
+	commonExpenseAccounts = []COATemplateAccount{
+		{Code: "EXP-6000", Name: "Staff Costs", Type: AccountTypeExpense},
+		{Code: "EXP-6010", Name: "Salaries and Wages", Type: AccountTypeExpense, ParentCode: "EXP-6000", IsPostable: true},
+		{Code: "EXP-6020", Name: "Allowances", Type: AccountTypeExpense, ParentCode: "EXP-6000", IsPostable: true},
+		{Code: "EXP-6030", Name: "End of Service Benefits", Type: AccountTypeExpense, ParentCode: "EXP-6000", IsPostable: true},
+		{Code: "EXP-6100", Name: "Rent Expense", Type: AccountTypeExpense, IsPostable: true},
+		{Code: "EXP-6200", Name: "Utilities Expense", Type: AccountTypeExpense, IsPostable: true},
+		{Code: "EXP-6300", Name: "Depreciation Expense", Type: AccountTypeExpense, IsPostable: true},
+		{Code: "EXP-6400", Name: "Bank Charges", Type: AccountTypeExpense, IsPostable: true},
+	}
+
+	commonPayrollMappings = []COATemplatePayrollMapping{
+		{ComponentType: "BASIC", ComponentName: "Basic Salary", DebitCode: "EXP-6010", CreditCode: "LIA-2200", Description: "Basic salary accrual"},
+		{ComponentType: "ALLOWANCE", ComponentName: "Housing Allowance", DebitCode: "EXP-6020", CreditCode: "LIA-2200", Description: "Housing allowance accrual"},
+		{ComponentType: "ALLOWANCE", ComponentName: "Transport Allowance", DebitCode: "EXP-6020", CreditCode: "LIA-2200", Description: "Transport allowance accrual"},
+		{ComponentType: "DEDUCTION", ComponentName: "Salary Deductions", DebitCode: "LIA-2200", CreditCode: "LIA-2220", Description: "Deductions withheld from net pay"},
+		{ComponentType: "GRATUITY", ComponentName: "End of Service Gratuity", DebitCode: "EXP-6030", CreditCode: "LIA-2210", Description: "End of service benefits provision"},
+	}
+)
+
+// templateAccounts joins account groups into one parents-first list
+func templateAccounts(groups ...[]COATemplateAccount) []COATemplateAccount {
+	var accounts []COATemplateAccount
+	for _, group := range groups {
+		accounts = append(accounts, group...)
+	}
+	return accounts
+}
+
+var coaTemplates = []COATemplate{
+	{
+		Code:        COATemplateHealthcareClinic,
+		Version:     1,
+		Name:        "Healthcare Clinic",
+		Description: "Clinics and medical centres billing patients and insurers",
+		Industries:  []string{"HEALTHCARE"},
+		Accounts: templateAccounts(
+			commonAssetAccounts,
+			[]COATemplateAccount{
+				{Code: "AST-1110", Name: "Insurance Receivables", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+				{Code: "AST-1200", Name: "Medical Supplies Inventory", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+				{Code: "AST-1530", Name: "Medical Equipment", Type: AccountTypeAsset, ParentCode: "AST-1500", IsPostable: true},
+			},
+			commonLiabilityAccounts,
+			commonEquityAccounts,
+			[]COATemplateAccount{
+				{Code: "REV-4000", Name: "Patient Revenue", Type: AccountTypeRevenue},
+				{Code: "REV-4010", Name: "Consultation Revenue", Type: AccountTypeRevenue, ParentCode: "REV-4000", IsPostable: true},
+				{Code: "REV-4020", Name: "Procedure Revenue", Type: AccountTypeRevenue, ParentCode: "REV-4000", IsPostable: true},
+				{Code: "REV-4030", Name: "Laboratory Revenue", Type: AccountTypeRevenue, ParentCode: "REV-4000", IsPostable: true},
+				{Code: "REV-4040", Name: "Pharmacy Revenue", Type: AccountTypeRevenue, ParentCode: "REV-4000", IsPostable: true},
+				{Code: "REV-4900", Name: "Insurance Claim Rejections", Type: AccountTypeRevenue, IsPostable: true},
+				{Code: "EXP-5000", Name: "Medical Supplies Consumed", Type: AccountTypeExpense, IsPostable: true},
+			},
+			commonExpenseAccounts,
+			[]COATemplateAccount{
+				{Code: "EXP-6500", Name: "Medical Malpractice Insurance", Type: AccountTypeExpense, IsPostable: true},
+				{Code: "EXP-6600", Name: "Licensing and Regulatory Fees", Type: AccountTypeExpense, IsPostable: true},
+			},
+		),
+		PayrollMappings: commonPayrollMappings,
+	},
+	{
+		Code:        COATemplateTrading,
+		Version:     1,
+		Name:        "Trading",
+		Description: "Retail, wholesale and distribution businesses holding inventory",
+		Industries:  []string{"RETAIL", "MANUFACTURING", "LOGISTICS"},
+		Accounts: templateAccounts(
+			commonAssetAccounts,
+			[]COATemplateAccount{
+				{Code: "AST-1200", Name: "Inventory", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+				{Code: "AST-1210", Name: "Goods in Transit", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+			},
+			commonLiabilityAccounts,
+			commonEquityAccounts,
+			[]COATemplateAccount{
+				{Code: "REV-4000", Name: "Sales Revenue", Type: AccountTypeRevenue, IsPostable: true},
+				{Code: "REV-4100", Name: "Sales Returns and Discounts", Type: AccountTypeRevenue, IsPostable: true},
+				{Code: "EXP-5000", Name: "Cost of Goods Sold", Type: AccountTypeExpense, IsPostable: true},
+				{Code: "EXP-5100", Name: "Freight and Duties", Type: AccountTypeExpense, IsPostable: true},
+				{Code: "EXP-5200", Name: "Inventory Write-offs", Type: AccountTypeExpense, IsPostable: true},
+			},
+			commonExpenseAccounts,
+		),
+		PayrollMappings: commonPayrollMappings,
+	},
+	{
+		Code:        COATemplateServices,
+		Version:     1,
+		Name:        "Services",
+		Description: "Professional and other service businesses without inventory",
+		Industries:  []string{"SERVICE", "FINANCE", "EDUCATION", "HOSPITALITY", "REAL_ESTATE", "OTHER"},
+		Accounts: templateAccounts(
+			commonAssetAccounts,
+			[]COATemplateAccount{
+				{Code: "AST-1150", Name: "Unbilled Revenue", Type: AccountTypeAsset, ParentCode: "AST-1000", IsPostable: true},
+			},
+			commonLiabilityAccounts,
+			[]COATemplateAccount{
+				{Code: "LIA-2400", Name: "Customer Advances", Type: AccountTypeLiability, ParentCode: "LIA-2000", IsPostable: true},
+			},
+			commonEquityAccounts,
+			[]COATemplateAccount{
+				{Code: "REV-4000", Name: "Service Revenue", Type: AccountTypeRevenue, IsPostable: true},
+				{Code: "REV-4100", Name: "Other Income", Type: AccountTypeRevenue, IsPostable: true},
+				{Code: "EXP-5000", Name: "Cost of Services", Type: AccountTypeExpense, IsPostable: true},
+			},
+			commonExpenseAccounts,
+			[]COATemplateAccount{
+				{Code: "EXP-6500", Name: "Professional Fees", Type: AccountTypeExpense, IsPostable: true},
+			},
+		),
+		PayrollMappings: commonPayrollMappings,
+	},
+}
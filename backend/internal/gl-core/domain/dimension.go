@@ -0,0 +1,212 @@
+// backend/internal/gl-core/domain/dimension.go
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Standard analytic dimension codes. Organizations may define others.
+const (
+	DimensionCostCenter  = "COST_CENTER"
+	DimensionDepartment  = "DEPARTMENT"
+	DimensionProject     = "PROJECT"
+	DimensionServiceLine = "SERVICE_LINE" // Doctor or service line, for clinic P&L
+)
+
+var dimensionCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,49}$`)
+
+// LineDimensions tags a journal line with analytic values, keyed by dimension
+// code (e.g. COST_CENTER: "CC-100", DEPARTMENT: "RADIOLOGY")
+type LineDimensions map[string]string
+
+// Normalize upper-cases codes and drops empty values
+func (d LineDimensions) Normalize() LineDimensions {
+	if len(d) == 0 {
+		return nil
+	}
+	normalized := make(LineDimensions, len(d))
+	for code, value := range d {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if code == "" || value == "" {
+			continue
+		}
+		normalized[code] = value
+	}
+	if len(normalized) == 0 {
+		return nil
+	}
+	return normalized
+}
+
+// Matches checks if the line carries every value in the filter
+func (d LineDimensions) Matches(filter LineDimensions) bool {
+	for code, value := range filter {
+		if d[code] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Codes returns the dimension codes in sorted order
+func (d LineDimensions) Codes() []string {
+	codes := make([]string, 0, len(d))
+	for code := range d {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Dimension is an analytic dimension an organization tags journal lines with
+type Dimension struct {
+	ID             uuid.UUID        `json:"id"`
+	OrganizationID uuid.UUID        `json:"organization_id"`
+	Code           string           `json:"code"`
+	Name           string           `json:"name"`
+	RequiredFor    []AccountType    `json:"required_for"` // Lines on these account types must carry a value
+	IsActive       bool             `json:"is_active"`
+	Values         []DimensionValue `json:"values,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// DimensionValue is one selectable value of a dimension, e.g. a cost center
+type DimensionValue struct {
+	ID          uuid.UUID `json:"id"`
+	DimensionID uuid.UUID `json:"dimension_id"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewDimension creates a validated dimension
+func NewDimension(orgID uuid.UUID, code, name string, requiredFor []AccountType) (*Dimension, error) {
+	now := time.Now()
+	d := &Dimension{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Code:           strings.ToUpper(strings.TrimSpace(code)),
+		Name:           strings.TrimSpace(name),
+		RequiredFor:    requiredFor,
+		IsActive:       true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Validate performs domain validation on Dimension
+func (d *Dimension) Validate() error {
+	if d.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrDimensionInvalid)
+	}
+
+	if !dimensionCodePattern.MatchString(d.Code) {
+		return NewGLErrorf(ErrDimensionInvalid, "dimension code %q must be upper case letters, digits and underscores", d.Code)
+	}
+
+	if d.Name == "" {
+		return NewGLError("dimension name is required", ErrDimensionInvalid)
+	}
+
+	for _, t := range d.RequiredFor {
+		if _, err := PrefixForType(t); err != nil {
+			return NewGLErrorf(ErrDimensionInvalid, "invalid account type: %s", t)
+		}
+	}
+
+	return nil
+}
+
+// IsRequiredFor checks if lines on an account type must carry a value
+func (d *Dimension) IsRequiredFor(t AccountType) bool {
+	for _, required := range d.RequiredFor {
+		if required == t {
+			return true
+		}
+	}
+	return false
+}
+
+// FindValue looks up a value by code
+func (d *Dimension) FindValue(code string) *DimensionValue {
+	for i := range d.Values {
+		if d.Values[i].Code == code {
+			return &d.Values[i]
+		}
+	}
+	return nil
+}
+
+// NewDimensionValue creates a validated dimension value
+func NewDimensionValue(dimensionID uuid.UUID, code, name string) (*DimensionValue, error) {
+	now := time.Now()
+	v := &DimensionValue{
+		ID:          uuid.New(),
+		DimensionID: dimensionID,
+		Code:        strings.ToUpper(strings.TrimSpace(code)),
+		Name:        strings.TrimSpace(name),
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if v.Code == "" || len(v.Code) > 50 {
+		return nil, NewGLError("dimension value code is required and cannot exceed 50 characters", ErrDimensionValueInvalid)
+	}
+	if v.Name == "" {
+		return nil, NewGLError("dimension value name is required", ErrDimensionValueInvalid)
+	}
+
+	return v, nil
+}
+
+// ValidateDimensions checks the entry's line dimensions against the organization's
+// dimensions: every tag must name an active dimension and one of its active
+// values, and dimensions required for a line's account type must be present.
+func ValidateDimensions(entry *JournalEntry, accounts map[uuid.UUID]*GLAccount, dimensions []*Dimension, result *PostingValidationResult) {
+	byCode := make(map[string]*Dimension, len(dimensions))
+	for _, d := range dimensions {
+		byCode[d.Code] = d
+	}
+
+	for i, line := range entry.Lines {
+		for _, code := range line.Dimensions.Codes() {
+			value := line.Dimensions[code]
+			d, ok := byCode[code]
+			if !ok || !d.IsActive {
+				result.AddError(fmt.Sprintf("line %d: dimension %s is not defined or inactive", i+1, code))
+				continue
+			}
+			v := d.FindValue(value)
+			if v == nil || !v.IsActive {
+				result.AddError(fmt.Sprintf("line %d: %s value %s is not defined or inactive", i+1, d.Name, value))
+			}
+		}
+
+		account, ok := accounts[line.AccountID]
+		if !ok {
+			continue
+		}
+		for _, d := range dimensions {
+			if d.IsActive && d.IsRequiredFor(account.Type) && line.Dimensions[d.Code] == "" {
+				result.AddError(fmt.Sprintf("line %d: %s is required for %s account %s", i+1, d.Name, account.Type, account.Code))
+			}
+		}
+	}
+}
@@ -5,32 +5,21 @@ package domain
 type EntryStatus string
 
 const (
-	EntryStatusDraft    EntryStatus = "DRAFT"    // Editable, not posted
-	EntryStatusPosted   EntryStatus = "POSTED"   // Posted to ledger, immutable
-	EntryStatusVoid     EntryStatus = "VOID"     // Voided entry
-	EntryStatusReversed EntryStatus = "REVERSED" // Reversed entry
+	EntryStatusDraft           EntryStatus = "DRAFT"            // Editable, not posted
+	EntryStatusPendingApproval EntryStatus = "PENDING_APPROVAL" // Submitted, awaiting a checker's approval
+	EntryStatusPosted          EntryStatus = "POSTED"           // Posted to ledger, immutable
+	EntryStatusVoid            EntryStatus = "VOID"             // Voided entry
+	EntryStatusReversed        EntryStatus = "REVERSED"         // Reversed entry
 )
 
-// IsParentAccount checks if this account is a parent/control account
-// Parent accounts typically have shorter codes and should not be used for direct posting
-func (a *GLAccount) IsParentAccount() bool {
-	// Simple heuristic: parent accounts have shorter codes
-	// Adjust based on your account numbering scheme:
-	// - "1000" = parent (4 digits)
-	// - "1000-001" = child (8+ characters)
-	// - "1100" = parent (4 digits)
-	// - "1100.01" = child (7+ characters)
-
-	return len(a.Code) <= 4
-}
-
 // IsValid checks if the status is valid
 func (es EntryStatus) IsValid() bool {
 	validStatuses := map[EntryStatus]bool{
-		EntryStatusDraft:    true,
-		EntryStatusPosted:   true,
-		EntryStatusVoid:     true,
-		EntryStatusReversed: true,
+		EntryStatusDraft:           true,
+		EntryStatusPendingApproval: true,
+		EntryStatusPosted:          true,
+		EntryStatusVoid:            true,
+		EntryStatusReversed:        true,
 	}
 	return validStatuses[es]
 }
@@ -44,9 +33,14 @@ func (es EntryStatus) String() string {
 func (es EntryStatus) CanTransitionTo(newStatus EntryStatus) bool {
 	validTransitions := map[EntryStatus][]EntryStatus{
 		EntryStatusDraft: {
+			EntryStatusPendingApproval,
 			EntryStatusPosted,
 			EntryStatusVoid,
 		},
+		EntryStatusPendingApproval: {
+			EntryStatusPosted, // Approved
+			EntryStatusDraft,  // Rejected
+		},
 		EntryStatusPosted: {
 			EntryStatusVoid,
 			EntryStatusReversed,
@@ -47,6 +47,10 @@ const (
     ErrJournalCannotReverse           = "JOURNAL_CANNOT_REVERSE"
     ErrJournalCannotEdit              = "JOURNAL_CANNOT_EDIT"
     ErrJournalLineNotFound            = "JOURNAL_LINE_NOT_FOUND"
+    ErrJournalAutoReverseDateInvalid  = "JOURNAL_AUTO_REVERSE_DATE_INVALID"
+    ErrJournalCannotSubmit            = "JOURNAL_CANNOT_SUBMIT"
+    ErrJournalCannotApprove           = "JOURNAL_CANNOT_APPROVE"
+    ErrJournalApprovalRequired        = "JOURNAL_APPROVAL_REQUIRED"
 
     // Journal Line errors
     ErrJournalLineAccountRequired     = "JOURNAL_LINE_ACCOUNT_REQUIRED"
@@ -54,7 +58,87 @@ const (
     ErrJournalLineBothAmounts         = "JOURNAL_LINE_BOTH_AMOUNTS"
     ErrJournalLineNegativeDebit       = "JOURNAL_LINE_NEGATIVE_DEBIT"
     ErrJournalLineNegativeCredit      = "JOURNAL_LINE_NEGATIVE_CREDIT"
+    ErrJournalLineAmountPrecision     = "JOURNAL_LINE_AMOUNT_PRECISION"
+    ErrJournalLineCurrencyInvalid     = "JOURNAL_LINE_CURRENCY_INVALID"
+    ErrJournalLineForeignAmount       = "JOURNAL_LINE_FOREIGN_AMOUNT_INVALID"
+    ErrJournalLineExchangeRateInvalid = "JOURNAL_LINE_EXCHANGE_RATE_INVALID"
     ErrJournalLineDescriptionRequired = "JOURNAL_LINE_DESCRIPTION_REQUIRED"
     ErrJournalLineDescriptionTooLong  = "JOURNAL_LINE_DESCRIPTION_TOO_LONG"
     ErrJournalLineReferenceTooLong    = "JOURNAL_LINE_REFERENCE_TOO_LONG"
+
+    // Account errors
+    ErrAccountOrgRequired  = "ACCOUNT_ORG_REQUIRED"
+    ErrAccountOrgMismatch  = "ACCOUNT_ORG_MISMATCH"
+    ErrAccountNotPostable  = "ACCOUNT_NOT_POSTABLE"
+    ErrAccountMoveInvalid  = "ACCOUNT_MOVE_INVALID"
+    ErrAccountMergeInvalid = "ACCOUNT_MERGE_INVALID"
+
+    // Chart of accounts template errors
+    ErrCOATemplateNotFound = "COA_TEMPLATE_NOT_FOUND"
+    ErrCOATemplateConflict = "COA_TEMPLATE_CONFLICT"
+
+    // Analytic dimension errors
+    ErrDimensionInvalid      = "DIMENSION_INVALID"
+    ErrDimensionNotFound     = "DIMENSION_NOT_FOUND"
+    ErrDimensionValueInvalid = "DIMENSION_VALUE_INVALID"
+
+    // Approval workflow errors
+    ErrApprovalRuleInvalid         = "APPROVAL_RULE_INVALID"
+    ErrApprovalRuleNotFound        = "APPROVAL_RULE_NOT_FOUND"
+    ErrApprovalSegregationOfDuties = "APPROVAL_SEGREGATION_OF_DUTIES"
+
+    // Attachment errors
+    ErrAttachmentInvalid         = "ATTACHMENT_INVALID"
+    ErrAttachmentNotFound        = "ATTACHMENT_NOT_FOUND"
+    ErrAttachmentTooLarge        = "ATTACHMENT_TOO_LARGE"
+    ErrAttachmentTypeNotAllowed  = "ATTACHMENT_TYPE_NOT_ALLOWED"
+    ErrAttachmentChecksumInvalid = "ATTACHMENT_CHECKSUM_INVALID"
+    ErrAttachmentCannotDelete    = "ATTACHMENT_CANNOT_DELETE"
+
+    // Journal numbering errors
+    ErrJournalTypeInvalid     = "JOURNAL_TYPE_INVALID"
+    ErrJournalSequenceInvalid = "JOURNAL_SEQUENCE_INVALID"
+    ErrJournalAlreadyNumbered = "JOURNAL_ALREADY_NUMBERED"
+
+    // Import errors
+    ErrImportNotFound       = "IMPORT_NOT_FOUND"
+    ErrImportCannotRollback = "IMPORT_CANNOT_ROLLBACK"
+    ErrImportJobNotFound    = "IMPORT_JOB_NOT_FOUND"
+    ErrImportJobFinished    = "IMPORT_JOB_FINISHED"
+
+    // Recurring journal errors
+    ErrRecurringTemplateInvalid      = "RECURRING_TEMPLATE_INVALID"
+    ErrRecurringTemplateNotFound     = "RECURRING_TEMPLATE_NOT_FOUND"
+    ErrRecurringTemplateInvalidState = "RECURRING_TEMPLATE_INVALID_STATE"
+    ErrRecurringScheduleInvalid      = "RECURRING_SCHEDULE_INVALID"
+    ErrRecurringOccurrenceInvalid    = "RECURRING_OCCURRENCE_INVALID"
+
+    // Fiscal calendar errors
+    ErrFiscalYearOrgRequired   = "FISCAL_YEAR_ORG_REQUIRED"
+    ErrFiscalYearInvalidDates  = "FISCAL_YEAR_INVALID_DATES"
+    ErrFiscalYearOverlap       = "FISCAL_YEAR_OVERLAP"
+    ErrPeriodClosed            = "PERIOD_CLOSED"
+    ErrPeriodInvalidTransition = "PERIOD_INVALID_TRANSITION"
+    ErrPeriodReasonRequired    = "PERIOD_REASON_REQUIRED"
+
+    // Exchange rate errors
+    ErrExchangeRateInvalid  = "EXCHANGE_RATE_INVALID"
+    ErrExchangeRateNotFound = "EXCHANGE_RATE_NOT_FOUND"
+
+    // FX revaluation errors
+    ErrFXRevaluationNotConfigured  = "FX_REVALUATION_NOT_CONFIGURED"
+    ErrFXRevaluationAccountInvalid = "FX_REVALUATION_ACCOUNT_INVALID"
+    ErrFXRevaluationAlreadyRun     = "FX_REVALUATION_ALREADY_RUN"
+
+    // Year-end close errors
+    ErrYearEndRetainedEarningsInvalid = "YEAR_END_RETAINED_EARNINGS_INVALID"
+    ErrYearEndNotClosed               = "YEAR_END_NOT_CLOSED"
+
+    // Opening balance errors
+    ErrOpeningBalanceInvalid         = "OPENING_BALANCE_INVALID"
+    ErrOpeningBalanceUnbalanced      = "OPENING_BALANCE_UNBALANCED"
+    ErrOpeningBalanceAlreadyPosted   = "OPENING_BALANCE_ALREADY_POSTED"
+    ErrOpeningBalanceNotFound        = "OPENING_BALANCE_NOT_FOUND"
+    ErrOpeningBalanceAlreadyReversed = "OPENING_BALANCE_ALREADY_REVERSED"
+    ErrOpeningEntryProtected         = "OPENING_ENTRY_PROTECTED"
 )
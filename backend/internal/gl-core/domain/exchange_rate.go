@@ -0,0 +1,94 @@
+// backend/internal/gl-core/domain/exchange_rate.go
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// ExchangeRateSource describes where a rate came from
+type ExchangeRateSource string
+
+const (
+	ExchangeRateSourceManual ExchangeRateSource = "MANUAL" // Entered by a user
+	ExchangeRateSourceImport ExchangeRateSource = "IMPORT" // Loaded from a rate feed or file
+)
+
+// ExchangeRate is an organization's rate for converting one currency into
+// another, effective from a date until the next rate for the same pair
+type ExchangeRate struct {
+	ID             uuid.UUID          `json:"id"`
+	OrganizationID uuid.UUID          `json:"organization_id"`
+	FromCurrency   string             `json:"from_currency"` // Transaction currency (e.g. USD)
+	ToCurrency     string             `json:"to_currency"`   // Usually the organization's base currency
+	Rate           money.Rate         `json:"rate"`          // ToCurrency units per FromCurrency unit
+	EffectiveDate  time.Time          `json:"effective_date"`
+	Source         ExchangeRateSource `json:"source"`
+	CreatedBy      uuid.UUID          `json:"created_by"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+// NewExchangeRate creates a validated manual exchange rate
+func NewExchangeRate(orgID uuid.UUID, from, to string, rate money.Rate, effectiveDate time.Time, createdBy uuid.UUID) (*ExchangeRate, error) {
+	now := time.Now()
+	er := &ExchangeRate{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		FromCurrency:   strings.ToUpper(strings.TrimSpace(from)),
+		ToCurrency:     strings.ToUpper(strings.TrimSpace(to)),
+		Rate:           rate,
+		EffectiveDate:  time.Date(effectiveDate.Year(), effectiveDate.Month(), effectiveDate.Day(), 0, 0, 0, 0, time.UTC),
+		Source:         ExchangeRateSourceManual,
+		CreatedBy:      createdBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := er.Validate(); err != nil {
+		return nil, err
+	}
+
+	return er, nil
+}
+
+// Validate performs domain validation on ExchangeRate
+func (er *ExchangeRate) Validate() error {
+	if er.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrJournalOrgRequired)
+	}
+
+	if _, err := money.LookupCurrency(er.FromCurrency); err != nil {
+		return NewGLErrorf(ErrExchangeRateInvalid, "invalid currency code: %s", er.FromCurrency)
+	}
+
+	if _, err := money.LookupCurrency(er.ToCurrency); err != nil {
+		return NewGLErrorf(ErrExchangeRateInvalid, "invalid currency code: %s", er.ToCurrency)
+	}
+
+	if er.FromCurrency == er.ToCurrency {
+		return NewGLError("exchange rate currencies must differ", ErrExchangeRateInvalid)
+	}
+
+	if !er.Rate.IsPositive() {
+		return NewGLError("exchange rate must be positive", ErrExchangeRateInvalid)
+	}
+
+	if er.EffectiveDate.IsZero() {
+		return NewGLError("effective date is required", ErrExchangeRateInvalid)
+	}
+
+	return nil
+}
+
+// Inverse returns the rate for the opposite direction, used when only the
+// reverse pair has been entered
+func (er *ExchangeRate) Inverse() *ExchangeRate {
+	inverse := *er
+	inverse.FromCurrency, inverse.ToCurrency = er.ToCurrency, er.FromCurrency
+	inverse.Rate = er.Rate.Inverse()
+	return &inverse
+}
@@ -0,0 +1,94 @@
+// backend/internal/gl-core/domain/financial_statement.go
+package domain
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// StatementType represents the kind of financial statement
+type StatementType string
+
+const (
+	StatementTypeIncome       StatementType = "INCOME_STATEMENT"
+	StatementTypeBalanceSheet StatementType = "BALANCE_SHEET"
+)
+
+// ComparisonType represents which comparative column a statement includes
+type ComparisonType string
+
+const (
+	ComparisonNone        ComparisonType = "NONE"
+	ComparisonPriorPeriod ComparisonType = "PRIOR_PERIOD"
+	ComparisonPriorYear   ComparisonType = "PRIOR_YEAR"
+)
+
+// IsValid checks if the comparison type is valid
+func (ct ComparisonType) IsValid() bool {
+	switch ct {
+	case ComparisonNone, ComparisonPriorPeriod, ComparisonPriorYear:
+		return true
+	}
+	return false
+}
+
+// StatementColumn describes one amount column of a statement
+type StatementColumn struct {
+	Label    string     `json:"label"`
+	FromDate *time.Time `json:"from_date,omitempty"` // nil for balance sheet columns
+	ToDate   time.Time  `json:"to_date"`
+}
+
+// StatementLine represents an account or computed line in a statement section.
+// Amounts are aligned with the statement's Columns and use the natural sign of
+// the section (revenue, liabilities and equity are shown as positive credits).
+type StatementLine struct {
+	AccountID  *uuid.UUID     `json:"account_id,omitempty"` // nil for computed lines
+	Code       string         `json:"code,omitempty"`
+	Name       string         `json:"name"`
+	Level      int            `json:"level"`
+	IsSubtotal bool           `json:"is_subtotal"` // Amounts include child accounts
+	Amounts    []money.Amount `json:"amounts"`
+}
+
+// StatementSection groups lines of one account type
+type StatementSection struct {
+	Type   AccountType     `json:"type"`
+	Title  string          `json:"title"`
+	Lines  []StatementLine `json:"lines"`
+	Totals []money.Amount  `json:"totals"`
+}
+
+// FinancialStatement represents an income statement or balance sheet
+type FinancialStatement struct {
+	Type           StatementType      `json:"type"`
+	OrganizationID uuid.UUID          `json:"organization_id"`
+	Columns        []StatementColumn  `json:"columns"`
+	Sections       []StatementSection `json:"sections"`
+	Summary        []StatementLine    `json:"summary"`              // Net income, total liabilities and equity, etc.
+	Dimensions     LineDimensions     `json:"dimensions,omitempty"` // Dimension filter the statement was run with
+	GeneratedAt    time.Time          `json:"generated_at"`
+}
+
+// NaturalBalance converts a debit-positive net amount into the account type's
+// natural sign (debit-normal for assets and expenses, credit-normal otherwise)
+func NaturalBalance(accountType AccountType, debit, credit money.Amount) money.Amount {
+	switch accountType {
+	case AccountTypeAsset, AccountTypeExpense:
+		return debit - credit
+	default:
+		return credit - debit
+	}
+}
+
+// Section returns the section of the given account type, or nil
+func (fs *FinancialStatement) Section(accountType AccountType) *StatementSection {
+	for i := range fs.Sections {
+		if fs.Sections[i].Type == accountType {
+			return &fs.Sections[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// backend/internal/gl-core/domain/fiscal_period.go
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PeriodStatus represents the status of an accounting period
+type PeriodStatus string
+
+const (
+	PeriodStatusOpen       PeriodStatus = "OPEN"        // Posting allowed
+	PeriodStatusSoftClosed PeriodStatus = "SOFT_CLOSED" // Locked for review, can be reopened or closed
+	PeriodStatusClosed     PeriodStatus = "CLOSED"      // Books closed
+)
+
+// PeriodAction represents a status change performed on a period
+type PeriodAction string
+
+const (
+	PeriodActionSoftClose PeriodAction = "SOFT_CLOSE"
+	PeriodActionClose     PeriodAction = "CLOSE"
+	PeriodActionReopen    PeriodAction = "REOPEN"
+)
+
+// IsValid checks if the status is valid
+func (ps PeriodStatus) IsValid() bool {
+	switch ps {
+	case PeriodStatusOpen, PeriodStatusSoftClosed, PeriodStatusClosed:
+		return true
+	}
+	return false
+}
+
+// AllowsPosting checks if entries dated in a period with this status can be posted or changed
+func (ps PeriodStatus) AllowsPosting() bool {
+	return ps == PeriodStatusOpen
+}
+
+// CanTransitionTo checks if status can transition to another status
+func (ps PeriodStatus) CanTransitionTo(newStatus PeriodStatus) bool {
+	validTransitions := map[PeriodStatus][]PeriodStatus{
+		PeriodStatusOpen:       {PeriodStatusSoftClosed, PeriodStatusClosed},
+		PeriodStatusSoftClosed: {PeriodStatusOpen, PeriodStatusClosed},
+		PeriodStatusClosed:     {PeriodStatusOpen},
+	}
+
+	for _, allowed := range validTransitions[ps] {
+		if allowed == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// FiscalYear represents an organization's fiscal year
+type FiscalYear struct {
+	ID             uuid.UUID          `json:"id"`
+	OrganizationID uuid.UUID          `json:"organization_id"`
+	Name           string             `json:"name"` // e.g. FY2025
+	StartDate      time.Time          `json:"start_date"`
+	EndDate        time.Time          `json:"end_date"`
+	Status         PeriodStatus       `json:"status"`
+	Periods        []AccountingPeriod `json:"periods"`
+	CreatedBy      uuid.UUID          `json:"created_by"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+// AccountingPeriod represents a monthly period within a fiscal year
+type AccountingPeriod struct {
+	ID             uuid.UUID    `json:"id"`
+	FiscalYearID   uuid.UUID    `json:"fiscal_year_id"`
+	OrganizationID uuid.UUID    `json:"organization_id"`
+	PeriodNumber   int          `json:"period_number"` // 1-12
+	Name           string       `json:"name"`          // e.g. 2025-01
+	StartDate      time.Time    `json:"start_date"`
+	EndDate        time.Time    `json:"end_date"`
+	Status         PeriodStatus `json:"status"`
+	ClosedBy       *uuid.UUID   `json:"closed_by,omitempty"`
+	ClosedAt       *time.Time   `json:"closed_at,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// PeriodEvent records who changed a period's status and when
+type PeriodEvent struct {
+	ID             uuid.UUID    `json:"id"`
+	PeriodID       uuid.UUID    `json:"period_id"`
+	OrganizationID uuid.UUID    `json:"organization_id"`
+	Action         PeriodAction `json:"action"`
+	FromStatus     PeriodStatus `json:"from_status"`
+	ToStatus       PeriodStatus `json:"to_status"`
+	Reason         string       `json:"reason"`
+	PerformedBy    uuid.UUID    `json:"performed_by"`
+	PerformedAt    time.Time    `json:"performed_at"`
+}
+
+// NewFiscalYear creates a twelve-month fiscal year with monthly OPEN periods
+func NewFiscalYear(orgID uuid.UUID, name string, startDate time.Time, createdBy uuid.UUID) (*FiscalYear, error) {
+	if orgID == uuid.Nil {
+		return nil, NewGLError("organization ID is required", ErrFiscalYearOrgRequired)
+	}
+
+	if startDate.IsZero() {
+		return nil, NewGLError("start date is required", ErrFiscalYearInvalidDates)
+	}
+
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
+	if start.Day() != 1 {
+		return nil, NewGLError("fiscal year must start on the first day of a month", ErrFiscalYearInvalidDates)
+	}
+
+	if name == "" {
+		name = fmt.Sprintf("FY%d", start.AddDate(1, 0, -1).Year())
+	}
+
+	now := time.Now()
+	fy := &FiscalYear{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Name:           name,
+		StartDate:      start,
+		EndDate:        start.AddDate(1, 0, -1),
+		Status:         PeriodStatusOpen,
+		Periods:        make([]AccountingPeriod, 12),
+		CreatedBy:      createdBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	for i := 0; i < 12; i++ {
+		periodStart := start.AddDate(0, i, 0)
+		fy.Periods[i] = AccountingPeriod{
+			ID:             uuid.New(),
+			FiscalYearID:   fy.ID,
+			OrganizationID: orgID,
+			PeriodNumber:   i + 1,
+			Name:           periodStart.Format("2006-01"),
+			StartDate:      periodStart,
+			EndDate:        periodStart.AddDate(0, 1, -1),
+			Status:         PeriodStatusOpen,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+	}
+
+	return fy, nil
+}
+
+// Contains checks if a date falls within the period
+func (p *AccountingPeriod) Contains(date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return !day.Before(p.StartDate) && !day.After(p.EndDate)
+}
+
+// ChangeStatus moves the period to a new status and returns the event to record
+func (p *AccountingPeriod) ChangeStatus(action PeriodAction, performedBy uuid.UUID, reason string) (*PeriodEvent, error) {
+	var newStatus PeriodStatus
+	switch action {
+	case PeriodActionSoftClose:
+		newStatus = PeriodStatusSoftClosed
+	case PeriodActionClose:
+		newStatus = PeriodStatusClosed
+	case PeriodActionReopen:
+		newStatus = PeriodStatusOpen
+		if reason == "" {
+			return nil, NewGLError("a reason is required to reopen a period", ErrPeriodReasonRequired)
+		}
+	default:
+		return nil, NewGLErrorf(ErrPeriodInvalidTransition, "unknown period action: %s", action)
+	}
+
+	if !p.Status.CanTransitionTo(newStatus) {
+		return nil, NewGLErrorf(ErrPeriodInvalidTransition, "period %s cannot change from %s to %s", p.Name, p.Status, newStatus)
+	}
+
+	now := time.Now()
+	event := &PeriodEvent{
+		ID:             uuid.New(),
+		PeriodID:       p.ID,
+		OrganizationID: p.OrganizationID,
+		Action:         action,
+		FromStatus:     p.Status,
+		ToStatus:       newStatus,
+		Reason:         reason,
+		PerformedBy:    performedBy,
+		PerformedAt:    now,
+	}
+
+	p.Status = newStatus
+	p.UpdatedAt = now
+	if newStatus == PeriodStatusOpen {
+		p.ClosedBy = nil
+		p.ClosedAt = nil
+	} else {
+		p.ClosedBy = &performedBy
+		p.ClosedAt = &now
+	}
+
+	return event, nil
+}
+
+// EnsurePostingAllowed returns an error if the period does not accept postings
+func (p *AccountingPeriod) EnsurePostingAllowed() error {
+	if !p.Status.AllowsPosting() {
+		return NewGLErrorf(ErrPeriodClosed, "accounting period %s is %s", p.Name, p.Status)
+	}
+	return nil
+}
+
+// Close closes every period of the fiscal year and the year itself,
+// returning the period events to record
+func (fy *FiscalYear) Close(closedBy uuid.UUID, reason string) ([]PeriodEvent, error) {
+	var events []PeriodEvent
+	for i := range fy.Periods {
+		if fy.Periods[i].Status == PeriodStatusClosed {
+			continue
+		}
+		event, err := fy.Periods[i].ChangeStatus(PeriodActionClose, closedBy, reason)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	fy.Status = PeriodStatusClosed
+	fy.UpdatedAt = time.Now()
+
+	return events, nil
+}
+
+// Reopen reopens a closed fiscal year and its final period so year-end
+// adjustments can be posted; earlier periods stay closed until reopened one by one
+func (fy *FiscalYear) Reopen(reopenedBy uuid.UUID, reason string) ([]PeriodEvent, error) {
+	if fy.Status != PeriodStatusClosed {
+		return nil, NewGLErrorf(ErrPeriodInvalidTransition, "fiscal year %s is not closed", fy.Name)
+	}
+	if reason == "" {
+		return nil, NewGLError("a reason is required to reopen a fiscal year", ErrPeriodReasonRequired)
+	}
+
+	var events []PeriodEvent
+	if n := len(fy.Periods); n > 0 && fy.Periods[n-1].Status != PeriodStatusOpen {
+		event, err := fy.Periods[n-1].ChangeStatus(PeriodActionReopen, reopenedBy, reason)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	fy.Status = PeriodStatusOpen
+	fy.UpdatedAt = time.Now()
+
+	return events, nil
+}
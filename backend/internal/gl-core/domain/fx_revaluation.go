@@ -0,0 +1,227 @@
+// backend/internal/gl-core/domain/fx_revaluation.go
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// FXRevaluationSettings holds the accounts an organization posts unrealized
+// exchange gains and losses to
+type FXRevaluationSettings struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	GainAccountID  uuid.UUID `json:"gain_account_id"`
+	LossAccountID  uuid.UUID `json:"loss_account_id"`
+	UpdatedBy      uuid.UUID `json:"updated_by"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// FXBalance is an account's balance of foreign currency lines in one currency,
+// with its base currency value at the historical rates the lines were posted at
+type FXBalance struct {
+	AccountID      uuid.UUID    `json:"account_id"`
+	Code           string       `json:"code"`
+	Name           string       `json:"name"`
+	Type           AccountType  `json:"type"`
+	Currency       string       `json:"currency"`
+	ForeignBalance money.Amount `json:"foreign_balance"` // Debit-positive, in Currency
+	BookBalance    money.Amount `json:"book_balance"`    // Debit-positive, in the base currency
+}
+
+// FXRevaluation is a period-end revaluation of monetary foreign currency balances
+// at closing rates. The adjustment entry is reversed on the following day so
+// each run starts again from historical rates.
+type FXRevaluation struct {
+	ID                 uuid.UUID           `json:"id"`
+	OrganizationID     uuid.UUID           `json:"organization_id"`
+	AsOfDate           time.Time           `json:"as_of_date"`
+	ReversalDate       time.Time           `json:"reversal_date"`
+	BaseCurrency       string              `json:"base_currency"`
+	GainAccountID      uuid.UUID           `json:"gain_account_id"`
+	LossAccountID      uuid.UUID           `json:"loss_account_id"`
+	RevaluationEntryID *uuid.UUID          `json:"revaluation_entry_id,omitempty"` // nil when nothing needed adjusting
+	ReversalEntryID    *uuid.UUID          `json:"reversal_entry_id,omitempty"`
+	TotalGain          money.Amount        `json:"total_gain"`
+	TotalLoss          money.Amount        `json:"total_loss"`
+	Lines              []FXRevaluationLine `json:"lines"`
+	CreatedBy          uuid.UUID           `json:"created_by"`
+	CreatedAt          time.Time           `json:"created_at"`
+}
+
+// FXRevaluationLine is one account's foreign currency balance before and after revaluation
+type FXRevaluationLine struct {
+	ID              uuid.UUID    `json:"id"`
+	AccountID       uuid.UUID    `json:"account_id"`
+	AccountCode     string       `json:"account_code"`
+	AccountName     string       `json:"account_name"`
+	AccountType     AccountType  `json:"account_type"`
+	Currency        string       `json:"currency"`
+	ForeignBalance  money.Amount `json:"foreign_balance"`
+	ClosingRate     money.Rate   `json:"closing_rate"`
+	BookBalance     money.Amount `json:"book_balance"`     // Before: at historical rates
+	RevaluedBalance money.Amount `json:"revalued_balance"` // After: at the closing rate
+	Adjustment      money.Amount `json:"adjustment"`       // Positive is a gain
+}
+
+// Validate performs domain validation on FXRevaluationSettings
+func (s *FXRevaluationSettings) Validate() error {
+	if s.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrJournalOrgRequired)
+	}
+	if s.GainAccountID == uuid.Nil || s.LossAccountID == uuid.Nil {
+		return NewGLError("gain and loss accounts are required", ErrFXRevaluationAccountInvalid)
+	}
+	return nil
+}
+
+// IsMonetary checks if balances of this account type are revalued at closing rates
+func (t AccountType) IsMonetary() bool {
+	return t == AccountTypeAsset || t == AccountTypeLiability
+}
+
+// NewFXRevaluation starts a revaluation as of a date using the configured accounts
+func NewFXRevaluation(settings *FXRevaluationSettings, asOfDate time.Time, base money.Currency, createdBy uuid.UUID) *FXRevaluation {
+	asOf := time.Date(asOfDate.Year(), asOfDate.Month(), asOfDate.Day(), 0, 0, 0, 0, time.UTC)
+	return &FXRevaluation{
+		ID:             uuid.New(),
+		OrganizationID: settings.OrganizationID,
+		AsOfDate:       asOf,
+		ReversalDate:   asOf.AddDate(0, 0, 1),
+		BaseCurrency:   base.Code,
+		GainAccountID:  settings.GainAccountID,
+		LossAccountID:  settings.LossAccountID,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// AddBalance revalues a foreign currency balance at the closing rate. Balances
+// on non-monetary accounts are skipped.
+func (r *FXRevaluation) AddBalance(balance FXBalance, closingRate money.Rate, base money.Currency) {
+	if !balance.Type.IsMonetary() {
+		return
+	}
+
+	places := base.MinorUnits
+	if places > LedgerDecimalPlaces {
+		places = LedgerDecimalPlaces
+	}
+
+	revalued := balance.ForeignBalance.Convert(closingRate).RoundTo(places)
+	line := FXRevaluationLine{
+		ID:              uuid.New(),
+		AccountID:       balance.AccountID,
+		AccountCode:     balance.Code,
+		AccountName:     balance.Name,
+		AccountType:     balance.Type,
+		Currency:        balance.Currency,
+		ForeignBalance:  balance.ForeignBalance,
+		ClosingRate:     closingRate,
+		BookBalance:     balance.BookBalance,
+		RevaluedBalance: revalued,
+		Adjustment:      revalued - balance.BookBalance,
+	}
+
+	if line.Adjustment > 0 {
+		r.TotalGain += line.Adjustment
+	} else {
+		r.TotalLoss -= line.Adjustment
+	}
+	r.Lines = append(r.Lines, line)
+}
+
+// NetGain returns total gains less total losses
+func (r *FXRevaluation) NetGain() money.Amount {
+	return r.TotalGain - r.TotalLoss
+}
+
+// BuildEntries builds the posted revaluation entry dated AsOfDate and its posted
+// reversal dated ReversalDate. Returns nil entries when no balance needs adjusting.
+// Gains and losses are posted gross to the configured accounts.
+func (r *FXRevaluation) BuildEntries(entryNumber, reversalNumber string) (*JournalEntry, *JournalEntry, error) {
+	if r.TotalGain == 0 && r.TotalLoss == 0 {
+		return nil, nil, nil
+	}
+
+	now := time.Now()
+	label := r.AsOfDate.Format("2006-01-02")
+	entry := &JournalEntry{
+		ID:              uuid.New(),
+		OrganizationID:  r.OrganizationID,
+		EntryNumber:     entryNumber,
+		JournalType:     JournalTypeRevaluation,
+		TransactionDate: r.AsOfDate,
+		Reference:       "FXREV-" + label,
+		Description:     "Unrealized exchange gain/loss as of " + label,
+		Status:          EntryStatusDraft,
+		CreatedBy:       r.CreatedBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	for _, line := range r.Lines {
+		if line.Adjustment == 0 {
+			continue
+		}
+		adjustment := JournalLine{
+			ID:          uuid.New(),
+			AccountID:   line.AccountID,
+			Description: fmt.Sprintf("Revalue %s %s at %s", line.Currency, line.ForeignBalance, line.ClosingRate),
+			LineNumber:  len(entry.Lines) + 1,
+		}
+		if line.Adjustment > 0 {
+			adjustment.Debit = line.Adjustment
+		} else {
+			adjustment.Credit = -line.Adjustment
+		}
+		entry.Lines = append(entry.Lines, adjustment)
+	}
+
+	if r.TotalGain != 0 {
+		entry.Lines = append(entry.Lines, JournalLine{
+			ID:          uuid.New(),
+			AccountID:   r.GainAccountID,
+			Description: "Unrealized exchange gain",
+			Credit:      r.TotalGain,
+			LineNumber:  len(entry.Lines) + 1,
+		})
+	}
+	if r.TotalLoss != 0 {
+		entry.Lines = append(entry.Lines, JournalLine{
+			ID:          uuid.New(),
+			AccountID:   r.LossAccountID,
+			Description: "Unrealized exchange loss",
+			Debit:       r.TotalLoss,
+			LineNumber:  len(entry.Lines) + 1,
+		})
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, nil, err
+	}
+	entry.CalculateTotals()
+	if err := entry.Post(r.CreatedBy); err != nil {
+		return nil, nil, err
+	}
+
+	reversal, err := entry.CreateReversal(r.CreatedBy, reversalNumber, r.ReversalDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Both entries are numbered when saved, so don't quote the entry's number
+	reversal.Description = "Reversal of unrealized exchange gain/loss as of " + label
+	if err := reversal.Post(r.CreatedBy); err != nil {
+		return nil, nil, err
+	}
+
+	entry.Status = EntryStatusReversed
+	entry.ReversedBy = &r.CreatedBy
+
+	r.RevaluationEntryID = &entry.ID
+	r.ReversalEntryID = &reversal.ID
+
+	return entry, reversal, nil
+}
@@ -0,0 +1,85 @@
+// backend/internal/gl-core/domain/import_batch.go
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportType identifies what an import run loads
+type ImportType string
+
+const (
+	ImportTypeAccounts       ImportType = "ACCOUNTS"
+	ImportTypeJournalEntries ImportType = "JOURNAL_ENTRIES"
+)
+
+// Import run statuses, as reported in ImportResult.Status
+const (
+	ImportStatusSuccess    = "success"
+	ImportStatusPartial    = "partial"
+	ImportStatusFailed     = "failed"
+	ImportStatusValidated  = "validated"
+	ImportStatusRolledBack = "rolled_back"
+	ImportStatusCancelled  = "cancelled" // Stopped by its background job; rows saved before that are kept
+)
+
+// ImportBatch is the stored record of one import run
+type ImportBatch struct {
+	ID             uuid.UUID          `json:"id"` // The run's import log ID
+	OrganizationID uuid.UUID          `json:"organization_id"`
+	ImportType     ImportType         `json:"import_type"`
+	FileName       string             `json:"file_name"`
+	FileSize       int64              `json:"file_size"`
+	LegacySystem   string             `json:"legacy_system,omitempty"`
+	Status         string             `json:"status"`
+	ValidateOnly   bool               `json:"validate_only"`
+	AutoPost       bool               `json:"auto_post"`
+	TotalRows      int                `json:"total_rows"`
+	SuccessCount   int                `json:"success_count"`
+	ErrorCount     int                `json:"error_count"`
+	WarningCount   int                `json:"warning_count"`
+	ImportedIDs    []uuid.UUID        `json:"imported_ids"`             // Accounts or journal entries the run created
+	FailureReason  string             `json:"failure_reason,omitempty"` // Why the run stopped before processing rows
+	MigrationNotes string             `json:"migration_notes,omitempty"`
+	ImportedBy     uuid.UUID          `json:"imported_by"`
+	CreatedAt      time.Time          `json:"created_at"`
+	CompletedAt    *time.Time         `json:"completed_at,omitempty"`
+	RolledBackBy   *uuid.UUID         `json:"rolled_back_by,omitempty"`
+	RolledBackAt   *time.Time         `json:"rolled_back_at,omitempty"`
+	Errors         []ImportBatchError `json:"errors,omitempty"` // Only loaded for a single batch
+}
+
+// ImportBatchError is a stored row-level import error
+type ImportBatchError struct {
+	Row        int    `json:"row"`
+	Column     string `json:"column,omitempty"`
+	Field      string `json:"field"`
+	Value      string `json:"value"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	LegacyCode string `json:"legacy_code,omitempty"`
+}
+
+// CanRollback checks if the run saved anything that has not been rolled back yet
+func (b *ImportBatch) CanRollback() bool {
+	if b.Status != ImportStatusSuccess && b.Status != ImportStatusPartial && b.Status != ImportStatusCancelled {
+		return false
+	}
+	return len(b.ImportedIDs) > 0
+}
+
+// RolledBack marks the batch as rolled back by a user
+func (b *ImportBatch) RolledBack(userID uuid.UUID) error {
+	if !b.CanRollback() {
+		return NewGLErrorf(ErrImportCannotRollback, "import in status %s has nothing to roll back", b.Status)
+	}
+
+	now := time.Now()
+	b.Status = ImportStatusRolledBack
+	b.RolledBackBy = &userID
+	b.RolledBackAt = &now
+
+	return nil
+}
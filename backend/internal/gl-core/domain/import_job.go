@@ -0,0 +1,109 @@
+// backend/internal/gl-core/domain/import_job.go
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportJobStatus is the state of a background import
+type ImportJobStatus string
+
+const (
+	ImportJobQueued    ImportJobStatus = "QUEUED"
+	ImportJobRunning   ImportJobStatus = "RUNNING"
+	ImportJobCompleted ImportJobStatus = "COMPLETED" // The run finished; its result may still report row errors
+	ImportJobFailed    ImportJobStatus = "FAILED"
+	ImportJobCancelled ImportJobStatus = "CANCELLED"
+)
+
+// ImportJob is an import queued to run in the background
+type ImportJob struct {
+	ID              uuid.UUID       `json:"id"`
+	OrganizationID  uuid.UUID       `json:"organization_id"`
+	ImportType      ImportType      `json:"import_type"`
+	Status          ImportJobStatus `json:"status"`
+	FileName        string          `json:"file_name"`
+	FileSize        int64           `json:"file_size"`
+	ImportLogID     uuid.UUID       `json:"import_log_id"` // The run's import batch, once it has finished
+	AttachmentID    *uuid.UUID      `json:"attachment_id,omitempty"`
+	Options         json.RawMessage `json:"options"`
+	ProcessedRows   int             `json:"processed_rows"`
+	TotalRows       int             `json:"total_rows"` // Estimated for workbooks until the run finishes
+	Progress        int             `json:"progress"`   // Percent
+	CancelRequested bool            `json:"cancel_requested"`
+	Result          json.RawMessage `json:"result,omitempty"` // ImportResult of a finished run
+	ErrorMessage    string          `json:"error_message,omitempty"`
+	CreatedBy       uuid.UUID       `json:"created_by"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	StartedAt       *time.Time      `json:"started_at,omitempty"`
+	CompletedAt     *time.Time      `json:"completed_at,omitempty"`
+}
+
+// NewImportJob creates a queued job with a fresh import log ID
+func NewImportJob(orgID uuid.UUID, importType ImportType, fileName string, fileSize int64, options json.RawMessage, createdBy uuid.UUID) *ImportJob {
+	now := time.Now()
+	return &ImportJob{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		ImportType:     importType,
+		Status:         ImportJobQueued,
+		FileName:       fileName,
+		FileSize:       fileSize,
+		ImportLogID:    uuid.New(),
+		Options:        options,
+		CreatedBy:      createdBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// IsFinished checks if the job has stopped for good
+func (j *ImportJob) IsFinished() bool {
+	return j.Status == ImportJobCompleted || j.Status == ImportJobFailed || j.Status == ImportJobCancelled
+}
+
+// RequestCancel asks the job to stop. A queued job is cancelled at once; a
+// running one stops at its next progress update.
+func (j *ImportJob) RequestCancel() error {
+	if j.IsFinished() {
+		return NewGLErrorf(ErrImportJobFinished, "import job is already %s", j.Status)
+	}
+
+	j.CancelRequested = true
+	if j.Status == ImportJobQueued {
+		now := time.Now()
+		j.Status = ImportJobCancelled
+		j.CompletedAt = &now
+	}
+
+	return nil
+}
+
+// Finish records the outcome of the run
+func (j *ImportJob) Finish(status ImportJobStatus, result json.RawMessage, errorMessage string) {
+	now := time.Now()
+	j.Status = status
+	j.Result = result
+	j.ErrorMessage = errorMessage
+	j.CompletedAt = &now
+	if status == ImportJobCompleted {
+		j.Progress = 100
+	}
+}
+
+// ProgressPercent works out how far through its rows a run is. It stays
+// below 100 until the job has finished.
+func ProgressPercent(processed, total int) int {
+	if total <= 0 || processed <= 0 {
+		return 0
+	}
+	percent := processed * 100 / total
+	if percent > 99 {
+		percent = 99
+	}
+	return percent
+}
@@ -2,9 +2,9 @@
 package domain
 
 import (
-	"fmt"
 	"time"
 
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -12,19 +12,22 @@ import (
 type JournalEntry struct {
 	ID              uuid.UUID     `json:"id"`
 	OrganizationID  uuid.UUID     `json:"organization_id"`
-	EntryNumber     string        `json:"entry_number"`     // Auto-generated: JE-20251031-0001
+	EntryNumber     string        `json:"entry_number"`     // From the journal type's sequence on posting, e.g. JE-2025-00001
+	JournalType     JournalType   `json:"journal_type"`     // GENERAL, PAYROLL, BANK, SALES, PURCHASE, REVERSAL, ...
 	TransactionDate time.Time     `json:"transaction_date"` // When transaction occurred
 	PostingDate     *time.Time    `json:"posting_date"`     // When entry was posted (nil if not posted)
 	Reference       string        `json:"reference"`        // External reference (invoice, receipt, etc.)
 	Description     string        `json:"description"`      // Entry description
 	Status          EntryStatus   `json:"status"`
-	Lines           []JournalLine `json:"lines"`        // Entry lines (debits/credits)
-	TotalDebit      float64       `json:"total_debit"`  // Calculated total debits
-	TotalCredit     float64       `json:"total_credit"` // Calculated total credits
-	CreatedBy       uuid.UUID     `json:"created_by"`   // User who created
-	PostedBy        *uuid.UUID    `json:"posted_by"`    // User who posted (nil if not posted)
-	ReversedBy      *uuid.UUID    `json:"reversed_by"`  // User who reversed (nil if not reversed)
-	ReversalOf      *uuid.UUID    `json:"reversal_of"`  // Original entry ID if this is a reversal
+	Lines           []JournalLine `json:"lines"`             // Entry lines (debits/credits)
+	TotalDebit      money.Amount  `json:"total_debit"`       // Calculated total debits
+	TotalCredit     money.Amount  `json:"total_credit"`      // Calculated total credits
+	CreatedBy       uuid.UUID     `json:"created_by"`        // User who created
+	PostedBy        *uuid.UUID    `json:"posted_by"`         // User who posted (nil if not posted)
+	ReversedBy      *uuid.UUID    `json:"reversed_by"`       // User who reversed (nil if not reversed)
+	ReversalOf      *uuid.UUID    `json:"reversal_of"`       // Original entry ID if this is a reversal
+	AutoReverseDate *time.Time    `json:"auto_reverse_date"` // Accruals: reversal is posted automatically on this date
+	ImportLogID     *uuid.UUID    `json:"import_log_id"`     // Import run that created the entry (nil if keyed in)
 	CreatedAt       time.Time     `json:"created_at"`
 	UpdatedAt       time.Time     `json:"updated_at"`
 }
@@ -55,6 +58,11 @@ func (je *JournalEntry) Validate() error {
 		return NewGLError("description cannot exceed 500 characters", ErrJournalDescriptionTooLong)
 	}
 
+	// Auto-reversal must fall after the entry it reverses
+	if je.AutoReverseDate != nil && !je.AutoReverseDate.After(je.TransactionDate) {
+		return NewGLError("auto-reverse date must be after the transaction date", ErrJournalAutoReverseDateInvalid)
+	}
+
 	// Lines validation
 	if len(je.Lines) == 0 {
 		return NewGLError("journal entry must have at least one line", ErrJournalNoLines)
@@ -90,15 +98,10 @@ func (je *JournalEntry) CalculateTotals() {
 	}
 }
 
-// IsBalanced checks if debits equal credits (within precision tolerance)
+// IsBalanced checks if debits exactly equal credits
 func (je *JournalEntry) IsBalanced() bool {
 	je.CalculateTotals()
-
-	// Use small tolerance for floating-point comparison (0.01 for 2 decimal places)
-	tolerance := 0.01
-	difference := je.TotalDebit - je.TotalCredit
-
-	return difference >= -tolerance && difference <= tolerance
+	return je.TotalDebit == je.TotalCredit
 }
 
 // CanPost checks if entry can be posted
@@ -148,8 +151,8 @@ func (je *JournalEntry) Void() error {
 	return nil
 }
 
-// CreateReversal creates a reversal entry
-func (je *JournalEntry) CreateReversal(reversedBy uuid.UUID, newEntryNumber string) (*JournalEntry, error) {
+// CreateReversal creates a draft reversal entry dated reversalDate
+func (je *JournalEntry) CreateReversal(reversedBy uuid.UUID, newEntryNumber string, reversalDate time.Time) (*JournalEntry, error) {
 	if !je.CanReverse() {
 		return nil, NewGLError("entry cannot be reversed", ErrJournalCannotReverse)
 	}
@@ -158,7 +161,8 @@ func (je *JournalEntry) CreateReversal(reversedBy uuid.UUID, newEntryNumber stri
 		ID:              uuid.New(),
 		OrganizationID:  je.OrganizationID,
 		EntryNumber:     newEntryNumber,
-		TransactionDate: time.Now(),
+		JournalType:     je.JournalType.ReversalType(),
+		TransactionDate: reversalDate,
 		Reference:       "REV-" + je.Reference,
 		Description:     "Reversal of " + je.EntryNumber + ": " + je.Description,
 		Status:          EntryStatusDraft,
@@ -169,16 +173,9 @@ func (je *JournalEntry) CreateReversal(reversedBy uuid.UUID, newEntryNumber stri
 		UpdatedAt:       time.Now(),
 	}
 
-	// Reverse all lines (swap debits and credits)
-	for i, line := range je.Lines {
-		reversal.Lines[i] = JournalLine{
-			ID:          uuid.New(),
-			AccountID:   line.AccountID,
-			Description: "Reversal: " + line.Description,
-			Debit:       line.Credit, // Swap
-			Credit:      line.Debit,  // Swap
-			LineNumber:  line.LineNumber,
-		}
+	// Reverse all lines (swap debits and credits, including foreign amounts)
+	for i := range je.Lines {
+		reversal.Lines[i] = je.Lines[i].Reverse()
 	}
 
 	reversal.CalculateTotals()
@@ -186,6 +183,11 @@ func (je *JournalEntry) CreateReversal(reversedBy uuid.UUID, newEntryNumber stri
 	return reversal, nil
 }
 
+// IsAutoReversalDue checks if a posted entry's auto-reverse date has arrived
+func (je *JournalEntry) IsAutoReversalDue(asOf time.Time) bool {
+	return je.Status == EntryStatusPosted && je.AutoReverseDate != nil && !je.AutoReverseDate.After(asOf)
+}
+
 // IsReversal checks if this entry is a reversal
 func (je *JournalEntry) IsReversal() bool {
 	return je.ReversalOf != nil
@@ -235,7 +237,11 @@ func (je *JournalEntry) GetLineCount() int {
 	return len(je.Lines)
 }
 
-// GenerateEntryNumber generates a unique entry number (format: JE-YYYYMMDD-####)
-func GenerateEntryNumber(date time.Time, sequence int) string {
-	return fmt.Sprintf("JE-%s-%04d", date.Format("20060102"), sequence)
+// NeedsEntryNumber checks if the entry has been posted but still carries its
+// draft placeholder, so a number must be allocated from its journal sequence
+func (je *JournalEntry) NeedsEntryNumber() bool {
+	if !IsDraftEntryNumber(je.EntryNumber) {
+		return false
+	}
+	return je.Status != EntryStatusDraft && je.Status != EntryStatusPendingApproval
 }
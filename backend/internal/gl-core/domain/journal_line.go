@@ -2,18 +2,32 @@
 package domain
 
 import (
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
+// LedgerDecimalPlaces is the precision of amounts stored on journal lines (DECIMAL(15,2))
+const LedgerDecimalPlaces = 2
+
 // JournalLine represents a single line in a journal entry
 type JournalLine struct {
-	ID          uuid.UUID `json:"id"`
-	AccountID   uuid.UUID `json:"account_id"`
-	Reference   string    `json:"reference"`
-	Description string    `json:"description"`
-	Debit       float64   `json:"debit"`
-	Credit      float64   `json:"credit"`
-	LineNumber  int       `json:"line_number"`
+	ID          uuid.UUID    `json:"id"`
+	AccountID   uuid.UUID    `json:"account_id"`
+	Reference   string       `json:"reference"`
+	Description string       `json:"description"`
+	Debit       money.Amount `json:"debit"`  // Base currency
+	Credit      money.Amount `json:"credit"` // Base currency
+	LineNumber  int          `json:"line_number"`
+
+	// Foreign currency lines keep the amount as entered; Debit/Credit hold the
+	// base currency equivalent. Currency is empty for base currency lines.
+	Currency      string       `json:"currency,omitempty"`
+	ForeignDebit  money.Amount `json:"foreign_debit"`
+	ForeignCredit money.Amount `json:"foreign_credit"`
+	ExchangeRate  money.Rate   `json:"exchange_rate"` // Base currency units per unit of Currency
+
+	// Analytic tags (cost center, department, project, ...), validated on posting
+	Dimensions LineDimensions `json:"dimensions,omitempty"`
 }
 
 // Validate performs domain validation on JournalLine
@@ -38,6 +52,14 @@ func (jl *JournalLine) Validate() error {
 		return NewGLError("credit amount cannot be negative", ErrJournalLineNegativeCredit)
 	}
 
+	if jl.Debit.RoundTo(LedgerDecimalPlaces) != jl.Debit || jl.Credit.RoundTo(LedgerDecimalPlaces) != jl.Credit {
+		return NewGLErrorf(ErrJournalLineAmountPrecision, "amounts cannot have more than %d decimal places", LedgerDecimalPlaces)
+	}
+
+	if err := jl.validateCurrency(); err != nil {
+		return err
+	}
+
 	if jl.Description == "" {
 		return NewGLError("line description is required", ErrJournalLineDescriptionRequired)
 	}
@@ -53,6 +75,102 @@ func (jl *JournalLine) Validate() error {
 	return nil
 }
 
+// validateCurrency checks the foreign currency amount and rate against the base amounts
+func (jl *JournalLine) validateCurrency() error {
+	if jl.Currency == "" {
+		if jl.ForeignDebit != 0 || jl.ForeignCredit != 0 || jl.ExchangeRate != 0 {
+			return NewGLError("foreign amounts require a currency", ErrJournalLineForeignAmount)
+		}
+		return nil
+	}
+
+	currency, err := money.LookupCurrency(jl.Currency)
+	if err != nil {
+		return NewGLErrorf(ErrJournalLineCurrencyInvalid, "invalid currency code: %s", jl.Currency)
+	}
+
+	if jl.ForeignDebit < 0 || jl.ForeignCredit < 0 {
+		return NewGLError("foreign amounts cannot be negative", ErrJournalLineForeignAmount)
+	}
+
+	// The foreign amount must sit on the same side as the base amount
+	if (jl.ForeignDebit != 0) != (jl.Debit != 0) || (jl.ForeignCredit != 0) != (jl.Credit != 0) {
+		return NewGLError("foreign amount must be on the same side as the base amount", ErrJournalLineForeignAmount)
+	}
+
+	if !jl.ForeignDebit.IsRounded(currency) || !jl.ForeignCredit.IsRounded(currency) {
+		return NewGLErrorf(ErrJournalLineForeignAmount, "%s amounts cannot have more than %d decimal places", currency.Code, currency.MinorUnits)
+	}
+
+	if !jl.ExchangeRate.IsPositive() {
+		return NewGLError("exchange rate must be positive", ErrJournalLineExchangeRateInvalid)
+	}
+
+	return nil
+}
+
+// IsForeignCurrency checks if the line was entered in a currency other than the base currency
+func (jl *JournalLine) IsForeignCurrency() bool {
+	return jl.Currency != ""
+}
+
+// ApplyExchangeRate derives the base currency debit and credit from the foreign amounts
+func (jl *JournalLine) ApplyExchangeRate(rate money.Rate, base money.Currency) {
+	places := base.MinorUnits
+	if places > LedgerDecimalPlaces {
+		places = LedgerDecimalPlaces
+	}
+
+	jl.ExchangeRate = rate
+	jl.Debit = jl.ForeignDebit.Convert(rate).RoundTo(places)
+	jl.Credit = jl.ForeignCredit.Convert(rate).RoundTo(places)
+}
+
+// conversionKey groups foreign currency lines converted at the same rate
+type conversionKey struct {
+	currency string
+	rate     money.Rate
+}
+
+// BalanceConversionRounding absorbs the difference left by rounding each
+// foreign currency line to the base currency separately. Lines in the same
+// currency at the same rate whose foreign amounts balance must balance in the
+// base currency too, so any difference between them is rounding; it is put
+// on the largest of those lines. Lines converted at different rates are left
+// alone, since a difference between them is a real exchange difference.
+func (je *JournalEntry) BalanceConversionRounding() {
+	groups := make(map[conversionKey][]int)
+	for i, line := range je.Lines {
+		if line.IsForeignCurrency() {
+			key := conversionKey{currency: line.Currency, rate: line.ExchangeRate}
+			groups[key] = append(groups[key], i)
+		}
+	}
+
+	for _, indexes := range groups {
+		var foreign, base money.Amount
+		largest := -1
+		for _, i := range indexes {
+			line := &je.Lines[i]
+			foreign += line.ForeignDebit - line.ForeignCredit
+			base += line.Debit - line.Credit
+			if largest < 0 || line.GetAmount() > je.Lines[largest].GetAmount() {
+				largest = i
+			}
+		}
+		if foreign != 0 || base == 0 {
+			continue
+		}
+
+		line := &je.Lines[largest]
+		if line.IsDebit() {
+			line.Debit -= base
+		} else {
+			line.Credit += base
+		}
+	}
+}
+
 // IsDebit checks if this is a debit line
 func (jl *JournalLine) IsDebit() bool {
 	return jl.Debit > 0
@@ -64,7 +182,7 @@ func (jl *JournalLine) IsCredit() bool {
 }
 
 // GetAmount returns the non-zero amount
-func (jl *JournalLine) GetAmount() float64 {
+func (jl *JournalLine) GetAmount() money.Amount {
 	if jl.IsDebit() {
 		return jl.Debit
 	}
@@ -89,5 +207,12 @@ func (jl *JournalLine) Reverse() JournalLine {
 		Debit:       jl.Credit,
 		Credit:      jl.Debit,
 		LineNumber:  jl.LineNumber,
+
+		Currency:      jl.Currency,
+		ForeignDebit:  jl.ForeignCredit,
+		ForeignCredit: jl.ForeignDebit,
+		ExchangeRate:  jl.ExchangeRate,
+
+		Dimensions: jl.Dimensions,
 	}
 }
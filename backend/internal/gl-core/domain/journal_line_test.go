@@ -0,0 +1,150 @@
+// backend/internal/gl-core/domain/journal_line_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+func mustAmount(t *testing.T, s string) money.Amount {
+	t.Helper()
+	a, err := money.Parse(s)
+	if err != nil {
+		t.Fatalf("parse amount %q: %v", s, err)
+	}
+	return a
+}
+
+func mustRate(t *testing.T, s string) money.Rate {
+	t.Helper()
+	r, err := money.ParseRate(s)
+	if err != nil {
+		t.Fatalf("parse rate %q: %v", s, err)
+	}
+	return r
+}
+
+// foreignEntry builds a draft entry of USD lines; positive amounts are debits
+func foreignEntry(t *testing.T, rate string, amounts ...string) *JournalEntry {
+	t.Helper()
+	entry := &JournalEntry{
+		OrganizationID:  uuid.New(),
+		EntryNumber:     NewDraftEntryNumber(),
+		TransactionDate: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		Description:     "Foreign currency entry",
+		Status:          EntryStatusDraft,
+	}
+	base := money.CurrencyOrDefault("AED")
+	for i, s := range amounts {
+		amount := mustAmount(t, s)
+		line := JournalLine{AccountID: uuid.New(), Description: "Line", Currency: "USD", LineNumber: i + 1}
+		if amount.IsNegative() {
+			line.ForeignCredit = amount.Abs()
+		} else {
+			line.ForeignDebit = amount
+		}
+		line.ApplyExchangeRate(mustRate(t, rate), base)
+		entry.Lines = append(entry.Lines, line)
+	}
+	return entry
+}
+
+func TestBalanceConversionRounding(t *testing.T) {
+	tests := []struct {
+		name        string
+		rate        string
+		amounts     []string
+		wantLine    int    // Index of the line expected to absorb the difference; -1 for none
+		wantAmount  string // Its base amount afterwards
+		wantBalance bool
+	}{
+		{
+			name:        "three-way split rounds down",
+			rate:        "3.6725",
+			amounts:     []string{"100.00", "-33.33", "-33.33", "-33.34"},
+			wantLine:    0,
+			wantAmount:  "367.24",
+			wantBalance: true,
+		},
+		{
+			name:        "largest credit absorbs the difference",
+			rate:        "3.6725",
+			amounts:     []string{"33.33", "33.33", "33.34", "-100.00"},
+			wantLine:    3,
+			wantAmount:  "367.24",
+			wantBalance: true,
+		},
+		{
+			name:        "already balanced",
+			rate:        "3.6725",
+			amounts:     []string{"100.00", "-100.00"},
+			wantLine:    -1,
+			wantBalance: true,
+		},
+		{
+			name:        "unbalanced in the foreign currency is left alone",
+			rate:        "3.6725",
+			amounts:     []string{"100.00", "-99.99"},
+			wantLine:    -1,
+			wantBalance: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := foreignEntry(t, tt.rate, tt.amounts...)
+			before := append([]JournalLine(nil), entry.Lines...)
+
+			entry.BalanceConversionRounding()
+
+			if got := entry.IsBalanced(); got != tt.wantBalance {
+				t.Fatalf("IsBalanced() = %v, want %v (debit %s, credit %s)", got, tt.wantBalance, entry.TotalDebit, entry.TotalCredit)
+			}
+			for i, line := range entry.Lines {
+				if i == tt.wantLine {
+					if got := line.GetAmount(); got != mustAmount(t, tt.wantAmount) {
+						t.Errorf("line %d amount = %s, want %s", i+1, got, tt.wantAmount)
+					}
+					continue
+				}
+				if line.Debit != before[i].Debit || line.Credit != before[i].Credit {
+					t.Errorf("line %d changed from %s/%s to %s/%s", i+1, before[i].Debit, before[i].Credit, line.Debit, line.Credit)
+				}
+			}
+			if tt.wantBalance {
+				if err := entry.Validate(); err != nil {
+					t.Errorf("Validate() = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestBalanceConversionRoundingKeepsRateDifferences(t *testing.T) {
+	entry := foreignEntry(t, "3.6725", "100.00")
+	other := foreignEntry(t, "3.6700", "-100.00")
+	entry.Lines = append(entry.Lines, other.Lines...)
+
+	entry.BalanceConversionRounding()
+
+	if entry.IsBalanced() {
+		t.Fatal("lines converted at different rates should not be balanced by rounding")
+	}
+}
+
+func TestBalanceConversionRoundingIgnoresBaseLines(t *testing.T) {
+	entry := foreignEntry(t, "3.6725", "-33.33", "-33.33", "-33.34")
+	entry.Lines = append(entry.Lines, JournalLine{AccountID: uuid.New(), Description: "Base", Debit: mustAmount(t, "367.25"), LineNumber: 4})
+
+	entry.BalanceConversionRounding()
+
+	if entry.IsBalanced() {
+		t.Fatal("USD credits alone do not balance in USD, so nothing should be adjusted")
+	}
+	if entry.Lines[3].Debit != mustAmount(t, "367.25") {
+		t.Errorf("base line changed to %s", entry.Lines[3].Debit)
+	}
+}
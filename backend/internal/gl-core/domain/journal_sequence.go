@@ -0,0 +1,169 @@
+// backend/internal/gl-core/domain/journal_sequence.go
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SequenceResetPolicy controls when a journal sequence restarts at 1
+type SequenceResetPolicy string
+
+const (
+	SequenceResetYearly  SequenceResetPolicy = "YEARLY"  // Restarts each calendar year of the transaction date
+	SequenceResetMonthly SequenceResetPolicy = "MONTHLY" // Restarts each calendar month of the transaction date
+	SequenceResetNever   SequenceResetPolicy = "NEVER"   // Runs continuously
+)
+
+// IsValid checks if the reset policy is valid
+func (p SequenceResetPolicy) IsValid() bool {
+	switch p {
+	case SequenceResetYearly, SequenceResetMonthly, SequenceResetNever:
+		return true
+	}
+	return false
+}
+
+const (
+	// DefaultSequencePadding is the minimum number of digits in a sequence number
+	DefaultSequencePadding = 5
+
+	// draftEntryNumberPrefix marks the placeholder number an entry carries until it is posted
+	draftEntryNumberPrefix = "DRAFT-"
+)
+
+var sequencePrefixPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,9}$`)
+
+// defaultSequencePrefixes are used for journal types an organization has not configured
+var defaultSequencePrefixes = map[JournalType]string{
+	JournalTypeGeneral:     "JE",
+	JournalTypePayroll:     "PAY",
+	JournalTypeBank:        "BNK",
+	JournalTypeSales:       "SAL",
+	JournalTypePurchase:    "PUR",
+	JournalTypeReversal:    "REV",
+	JournalTypeClosing:     "CLS",
+	JournalTypeRevaluation: "FXR",
+	JournalTypeRecurring:   "REC",
+	JournalTypeOpening:     "OB",
+}
+
+// JournalSequence configures how entries of one journal type are numbered
+// within an organization, e.g. PAY-2026-00042. Numbers are allocated when an
+// entry is posted, inside the posting transaction, so posted numbers have no gaps.
+type JournalSequence struct {
+	ID             uuid.UUID           `json:"id"`
+	OrganizationID uuid.UUID           `json:"organization_id"`
+	JournalType    JournalType         `json:"journal_type"`
+	Prefix         string              `json:"prefix"`
+	ResetPolicy    SequenceResetPolicy `json:"reset_policy"`
+	Padding        int                 `json:"padding"`    // Minimum digits, zero padded
+	IsDefault      bool                `json:"is_default"` // Not configured; built-in settings apply
+	CreatedAt      time.Time           `json:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+}
+
+// DefaultJournalSequence returns the built-in numbering for a journal type
+func DefaultJournalSequence(orgID uuid.UUID, journalType JournalType) *JournalSequence {
+	prefix, ok := defaultSequencePrefixes[journalType]
+	if !ok {
+		prefix = defaultSequencePrefixes[JournalTypeGeneral]
+	}
+	return &JournalSequence{
+		OrganizationID: orgID,
+		JournalType:    journalType,
+		Prefix:         prefix,
+		ResetPolicy:    SequenceResetYearly,
+		Padding:        DefaultSequencePadding,
+		IsDefault:      true,
+	}
+}
+
+// NewJournalSequence creates a validated sequence configuration
+func NewJournalSequence(orgID uuid.UUID, journalType JournalType, prefix string, policy SequenceResetPolicy, padding int) (*JournalSequence, error) {
+	if padding == 0 {
+		padding = DefaultSequencePadding
+	}
+
+	now := time.Now()
+	s := &JournalSequence{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		JournalType:    journalType,
+		Prefix:         strings.ToUpper(strings.TrimSpace(prefix)),
+		ResetPolicy:    policy,
+		Padding:        padding,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Validate performs domain validation on JournalSequence
+func (s *JournalSequence) Validate() error {
+	if s.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrJournalSequenceInvalid)
+	}
+
+	if !s.JournalType.IsValid() {
+		return NewGLErrorf(ErrJournalTypeInvalid, "invalid journal type: %s", s.JournalType)
+	}
+
+	if !sequencePrefixPattern.MatchString(s.Prefix) {
+		return NewGLErrorf(ErrJournalSequenceInvalid,
+			"prefix %q must start with a letter and be at most 10 upper case letters and digits", s.Prefix)
+	}
+
+	if !s.ResetPolicy.IsValid() {
+		return NewGLErrorf(ErrJournalSequenceInvalid, "invalid reset policy: %s", s.ResetPolicy)
+	}
+
+	if s.Padding < 1 || s.Padding > 10 {
+		return NewGLError("padding must be between 1 and 10 digits", ErrJournalSequenceInvalid)
+	}
+
+	return nil
+}
+
+// PeriodKey identifies the counter a transaction date draws from: the year,
+// the year and month, or a single counter when the sequence never resets
+func (s *JournalSequence) PeriodKey(date time.Time) string {
+	switch s.ResetPolicy {
+	case SequenceResetMonthly:
+		return date.Format("2006-01")
+	case SequenceResetNever:
+		return ""
+	default:
+		return date.Format("2006")
+	}
+}
+
+// Format renders an entry number, e.g. JE-2026-00001, JE-202610-00001 or JE-00001
+func (s *JournalSequence) Format(date time.Time, number int64) string {
+	period := strings.ReplaceAll(s.PeriodKey(date), "-", "")
+	if period == "" {
+		return fmt.Sprintf("%s-%0*d", s.Prefix, s.Padding, number)
+	}
+	return fmt.Sprintf("%s-%s-%0*d", s.Prefix, period, s.Padding, number)
+}
+
+// NewDraftEntryNumber returns a placeholder number for an entry that has not
+// been posted. The real number is allocated from the journal sequence on posting.
+func NewDraftEntryNumber() string {
+	id := strings.ReplaceAll(uuid.NewString(), "-", "")
+	return draftEntryNumberPrefix + strings.ToUpper(id[:12])
+}
+
+// IsDraftEntryNumber checks if an entry number is a placeholder awaiting posting
+func IsDraftEntryNumber(entryNumber string) bool {
+	return strings.HasPrefix(entryNumber, draftEntryNumberPrefix)
+}
@@ -0,0 +1,70 @@
+// backend/internal/gl-core/domain/journal_type.go
+package domain
+
+// JournalType classifies journal entries by how they were produced. Each type
+// is numbered from its own sequence.
+type JournalType string
+
+const (
+	JournalTypeGeneral     JournalType = "GENERAL"     // Day-to-day entries
+	JournalTypePayroll     JournalType = "PAYROLL"     // Payroll postings
+	JournalTypeBank        JournalType = "BANK"        // Bank receipts, payments and charges
+	JournalTypeSales       JournalType = "SALES"       // Sales invoices and credit notes
+	JournalTypePurchase    JournalType = "PURCHASE"    // Supplier bills and debit notes
+	JournalTypeReversal    JournalType = "REVERSAL"    // Reversals of other entries
+	JournalTypeClosing     JournalType = "CLOSING"     // Year-end closing entries (and their reversals)
+	JournalTypeRevaluation JournalType = "REVALUATION" // Unrealized FX revaluation entries (and their reversals)
+	JournalTypeRecurring   JournalType = "RECURRING"   // Generated from recurring journal templates
+	JournalTypeOpening     JournalType = "OPENING"     // Opening balances at go-live (and their reversals)
+)
+
+// JournalTypes lists every journal type
+var JournalTypes = []JournalType{
+	JournalTypeGeneral,
+	JournalTypePayroll,
+	JournalTypeBank,
+	JournalTypeSales,
+	JournalTypePurchase,
+	JournalTypeReversal,
+	JournalTypeClosing,
+	JournalTypeRevaluation,
+	JournalTypeRecurring,
+	JournalTypeOpening,
+}
+
+// IsValid checks if the journal type is valid
+func (jt JournalType) IsValid() bool {
+	for _, t := range JournalTypes {
+		if jt == t {
+			return true
+		}
+	}
+	return false
+}
+
+// IsManual checks if users may create entries of this type directly. The
+// others are produced by reversals, year-end close, revaluation, templates
+// and the opening balances wizard.
+func (jt JournalType) IsManual() bool {
+	switch jt {
+	case JournalTypeGeneral, JournalTypePayroll, JournalTypeBank, JournalTypeSales, JournalTypePurchase:
+		return true
+	}
+	return false
+}
+
+// ReversalType returns the type a reversal of this type is recorded under.
+// Closing, revaluation and opening balance reversals keep their type so
+// reports and year-end reopening still recognise them.
+func (jt JournalType) ReversalType() JournalType {
+	switch jt {
+	case JournalTypeClosing, JournalTypeRevaluation, JournalTypeOpening:
+		return jt
+	}
+	return JournalTypeReversal
+}
+
+// String returns the string representation
+func (jt JournalType) String() string {
+	return string(jt)
+}
@@ -0,0 +1,238 @@
+// backend/internal/gl-core/domain/opening_balance_batch.go
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// OpeningBalanceStatus represents the status of a set of opening balances
+type OpeningBalanceStatus string
+
+const (
+	OpeningBalancePosted   OpeningBalanceStatus = "POSTED"   // Opening entry in effect
+	OpeningBalanceReversed OpeningBalanceStatus = "REVERSED" // Undone so the balances can be entered again
+)
+
+// OpeningBalanceBatch is the set of balances an organization brings into the
+// ledger on its go-live date, posted as a single OPENING entry. One batch is in
+// effect at a time; entering the balances again means reversing it first.
+type OpeningBalanceBatch struct {
+	ID                uuid.UUID            `json:"id"`
+	OrganizationID    uuid.UUID            `json:"organization_id"`
+	GoLiveDate        time.Time            `json:"go_live_date"`
+	Description       string               `json:"description"`
+	Status            OpeningBalanceStatus `json:"status"`
+	EntryID           uuid.UUID            `json:"entry_id"`
+	SuspenseAccountID *uuid.UUID           `json:"suspense_account_id,omitempty"`
+	SuspenseAmount    money.Amount         `json:"suspense_amount"` // Debit-positive; 0 when debits equalled credits
+	TotalDebit        money.Amount         `json:"total_debit"`     // Of the entry, suspense line included
+	TotalCredit       money.Amount         `json:"total_credit"`
+	Lines             []OpeningBalanceLine `json:"lines"`
+	Warnings          []string             `json:"warnings,omitempty"` // Not saved; returned when posting or previewing
+	CreatedBy         uuid.UUID            `json:"created_by"`
+	CreatedAt         time.Time            `json:"created_at"`
+	ReversalEntryID   *uuid.UUID           `json:"reversal_entry_id,omitempty"`
+	ReversedBy        *uuid.UUID           `json:"reversed_by,omitempty"`
+	ReversedAt        *time.Time           `json:"reversed_at,omitempty"`
+	ReversalReason    string               `json:"reversal_reason,omitempty"`
+}
+
+// OpeningBalanceLine is one account's balance at go-live, optionally for a
+// single customer or supplier
+type OpeningBalanceLine struct {
+	ID             uuid.UUID    `json:"id"`
+	LineNumber     int          `json:"line_number"`
+	AccountID      uuid.UUID    `json:"account_id"`
+	AccountCode    string       `json:"account_code"`
+	AccountName    string       `json:"account_name"`
+	PartyReference string       `json:"party_reference,omitempty"` // Customer or supplier reference
+	Description    string       `json:"description,omitempty"`
+	Debit          money.Amount `json:"debit"`
+	Credit         money.Amount `json:"credit"`
+}
+
+// NewOpeningBalanceBatch starts a batch for a go-live date, numbering its lines
+func NewOpeningBalanceBatch(orgID uuid.UUID, goLiveDate time.Time, description string, lines []OpeningBalanceLine, createdBy uuid.UUID) *OpeningBalanceBatch {
+	date := time.Date(goLiveDate.Year(), goLiveDate.Month(), goLiveDate.Day(), 0, 0, 0, 0, time.UTC)
+	if description == "" && !date.IsZero() {
+		description = "Opening balances as of " + date.Format("2006-01-02")
+	}
+
+	for i := range lines {
+		lines[i].ID = uuid.New()
+		lines[i].LineNumber = i + 1
+		if lines[i].Description == "" {
+			lines[i].Description = "Opening balance"
+		}
+	}
+
+	return &OpeningBalanceBatch{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		GoLiveDate:     date,
+		Description:    description,
+		Status:         OpeningBalancePosted,
+		Lines:          lines,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// Validate performs domain validation on OpeningBalanceBatch. Debits need not
+// equal credits here; Balance checks that.
+func (b *OpeningBalanceBatch) Validate() error {
+	if b.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrJournalOrgRequired)
+	}
+	if b.GoLiveDate.IsZero() {
+		return NewGLError("go-live date is required", ErrOpeningBalanceInvalid)
+	}
+	if len(b.Description) > 500 {
+		return NewGLError("description cannot exceed 500 characters", ErrOpeningBalanceInvalid)
+	}
+	if len(b.Lines) == 0 {
+		return NewGLError("at least one opening balance is required", ErrOpeningBalanceInvalid)
+	}
+
+	seen := make(map[string]int, len(b.Lines))
+	for _, line := range b.Lines {
+		if err := line.validate(); err != nil {
+			return NewGLErrorf(ErrOpeningBalanceInvalid, "line %d: %v", line.LineNumber, err)
+		}
+
+		// One balance per account and party, so a balance isn't brought in twice
+		key := line.AccountID.String() + "|" + line.PartyReference
+		if first, ok := seen[key]; ok {
+			return NewGLErrorf(ErrOpeningBalanceInvalid, "line %d repeats the account and party of line %d", line.LineNumber, first)
+		}
+		seen[key] = line.LineNumber
+	}
+
+	return nil
+}
+
+// validate checks a line the way the journal line it becomes will be checked
+func (l *OpeningBalanceLine) validate() error {
+	jl := JournalLine{AccountID: l.AccountID, Reference: l.PartyReference, Description: l.Description, Debit: l.Debit, Credit: l.Credit}
+	return jl.Validate()
+}
+
+// Difference returns total debits less total credits of the entered balances
+func (b *OpeningBalanceBatch) Difference() money.Amount {
+	var diff money.Amount
+	for _, line := range b.Lines {
+		diff += line.Debit - line.Credit
+	}
+	return diff
+}
+
+// Balance checks that debits equal credits. When they don't and a suspense
+// account is given, the difference is posted to it and a warning is added;
+// without one the batch is rejected. Totals include the suspense line.
+func (b *OpeningBalanceBatch) Balance(suspenseAccountID *uuid.UUID, suspenseCode string) error {
+	b.TotalDebit, b.TotalCredit = 0, 0
+	for _, line := range b.Lines {
+		b.TotalDebit += line.Debit
+		b.TotalCredit += line.Credit
+	}
+
+	diff := b.TotalDebit - b.TotalCredit
+	if diff == 0 {
+		return nil
+	}
+
+	if suspenseAccountID == nil {
+		return NewGLErrorf(ErrOpeningBalanceUnbalanced,
+			"opening balances do not balance: debits and credits differ by %s; correct them or choose a suspense account", diff.Abs())
+	}
+
+	b.SuspenseAccountID = suspenseAccountID
+	b.SuspenseAmount = -diff
+	if diff > 0 {
+		b.TotalCredit += diff
+	} else {
+		b.TotalDebit -= diff
+	}
+	b.Warnings = append(b.Warnings, fmt.Sprintf(
+		"debits and credits differ by %s; the difference was posted to suspense account %s and should be cleared",
+		diff.Abs(), suspenseCode))
+
+	return nil
+}
+
+// BuildEntry builds the posted OPENING entry dated on the go-live date. Each
+// line's party reference becomes the journal line reference.
+func (b *OpeningBalanceBatch) BuildEntry(entryNumber string) (*JournalEntry, error) {
+	now := time.Now()
+	entry := &JournalEntry{
+		ID:              uuid.New(),
+		OrganizationID:  b.OrganizationID,
+		EntryNumber:     entryNumber,
+		JournalType:     JournalTypeOpening,
+		TransactionDate: b.GoLiveDate,
+		Reference:       "OPEN-" + b.GoLiveDate.Format("2006-01-02"),
+		Description:     b.Description,
+		Status:          EntryStatusDraft,
+		CreatedBy:       b.CreatedBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	for _, line := range b.Lines {
+		entry.Lines = append(entry.Lines, JournalLine{
+			ID:          uuid.New(),
+			AccountID:   line.AccountID,
+			Reference:   line.PartyReference,
+			Description: line.Description,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+			LineNumber:  len(entry.Lines) + 1,
+		})
+	}
+
+	if b.SuspenseAmount != 0 {
+		debit, credit := splitBalance(b.SuspenseAmount)
+		entry.Lines = append(entry.Lines, JournalLine{
+			ID:          uuid.New(),
+			AccountID:   *b.SuspenseAccountID,
+			Description: "Opening balance difference",
+			Debit:       debit,
+			Credit:      credit,
+			LineNumber:  len(entry.Lines) + 1,
+		})
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	entry.CalculateTotals()
+	if err := entry.Post(b.CreatedBy); err != nil {
+		return nil, err
+	}
+
+	b.EntryID = entry.ID
+	b.TotalDebit = entry.TotalDebit
+	b.TotalCredit = entry.TotalCredit
+
+	return entry, nil
+}
+
+// Reverse marks the batch as undone
+func (b *OpeningBalanceBatch) Reverse(reversedBy uuid.UUID, reason string, reversalEntryID uuid.UUID) error {
+	if b.Status != OpeningBalancePosted {
+		return NewGLError("opening balances have already been reversed", ErrOpeningBalanceAlreadyReversed)
+	}
+
+	now := time.Now()
+	b.Status = OpeningBalanceReversed
+	b.ReversalEntryID = &reversalEntryID
+	b.ReversedBy = &reversedBy
+	b.ReversedAt = &now
+	b.ReversalReason = reason
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -51,13 +52,13 @@ func ValidateForPosting(entry *JournalEntry, accounts map[uuid.UUID]*GLAccount)
 	}
 
 	// Status validation
-	if entry.Status != EntryStatusDraft {
-		result.AddError(fmt.Sprintf("entry must be in DRAFT status to be posted (current: %s)", entry.Status))
+	if entry.Status != EntryStatusDraft && entry.Status != EntryStatusPendingApproval {
+		result.AddError(fmt.Sprintf("entry must be in DRAFT or PENDING_APPROVAL status to be posted (current: %s)", entry.Status))
 	}
 
 	// Balance validation
 	if !entry.IsBalanced() {
-		result.AddError(fmt.Sprintf("entry is not balanced: debits %.2f != credits %.2f", entry.TotalDebit, entry.TotalCredit))
+		result.AddError(fmt.Sprintf("entry is not balanced: debits %s != credits %s", entry.TotalDebit, entry.TotalCredit))
 	}
 
 	// Account existence and status validation
@@ -72,9 +73,13 @@ func ValidateForPosting(entry *JournalEntry, accounts map[uuid.UUID]*GLAccount)
 			result.AddError(fmt.Sprintf("line %d: account %s (%s) is inactive", i+1, account.Code, account.Name))
 		}
 
-		// Warn if posting to control account
-		if account.IsParentAccount() {
-			result.AddWarning(fmt.Sprintf("line %d: posting to control account %s (%s)", i+1, account.Code, account.Name))
+		if !account.BelongsTo(entry.OrganizationID) {
+			result.AddError(fmt.Sprintf("line %d: account %s (%s) belongs to another organization", i+1, account.Code, account.Name))
+		}
+
+		// Control accounts only summarize their children
+		if !account.IsPostable {
+			result.AddError(fmt.Sprintf("line %d: account %s (%s) is a control account and cannot be posted to", i+1, account.Code, account.Name))
 		}
 	}
 
@@ -93,12 +98,9 @@ func ValidateForPosting(entry *JournalEntry, accounts map[uuid.UUID]*GLAccount)
 }
 
 // ValidateBalance validates that debits equal credits
-func ValidateBalance(totalDebit, totalCredit float64) error {
-	tolerance := 0.01
-	difference := totalDebit - totalCredit
-
-	if difference < -tolerance || difference > tolerance {
-		return NewGLErrorf(ErrJournalNotBalanced, "entry is not balanced: debits %.2f != credits %.2f (difference: %.2f)", totalDebit, totalCredit, difference)
+func ValidateBalance(totalDebit, totalCredit money.Amount) error {
+	if totalDebit != totalCredit {
+		return NewGLErrorf(ErrJournalNotBalanced, "entry is not balanced: debits %s != credits %s (difference: %s)", totalDebit, totalCredit, totalDebit-totalCredit)
 	}
 
 	return nil
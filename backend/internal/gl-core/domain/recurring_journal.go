@@ -0,0 +1,327 @@
+// backend/internal/gl-core/domain/recurring_journal.go
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// RecurrenceFrequency is how often a recurring journal falls due
+type RecurrenceFrequency string
+
+const (
+	RecurrenceMonthly    RecurrenceFrequency = "MONTHLY"
+	RecurrenceQuarterly  RecurrenceFrequency = "QUARTERLY"
+	RecurrenceSemiAnnual RecurrenceFrequency = "SEMI_ANNUAL"
+	RecurrenceAnnual     RecurrenceFrequency = "ANNUAL"
+)
+
+// Months returns the number of months between occurrences (0 if invalid)
+func (f RecurrenceFrequency) Months() int {
+	switch f {
+	case RecurrenceMonthly:
+		return 1
+	case RecurrenceQuarterly:
+		return 3
+	case RecurrenceSemiAnnual:
+		return 6
+	case RecurrenceAnnual:
+		return 12
+	}
+	return 0
+}
+
+// RecurringTemplateStatus represents the lifecycle of a recurring journal template
+type RecurringTemplateStatus string
+
+const (
+	RecurringTemplateActive    RecurringTemplateStatus = "ACTIVE"    // Generates entries when due
+	RecurringTemplatePaused    RecurringTemplateStatus = "PAUSED"    // Skips occurrences until resumed
+	RecurringTemplateCompleted RecurringTemplateStatus = "COMPLETED" // Past its end date
+)
+
+// RecurringRunStatus records what happened to one occurrence of a template
+type RecurringRunStatus string
+
+const (
+	RecurringRunGenerated RecurringRunStatus = "GENERATED" // Journal entry created (and posted if AutoPost)
+	RecurringRunSkipped   RecurringRunStatus = "SKIPPED"   // Skipped by a user, no entry created
+)
+
+// maxRecurringOccurrences bounds schedule iteration (100 years of monthly occurrences)
+const maxRecurringOccurrences = 1200
+
+// RecurringJournalTemplate is a journal entry that is generated on a schedule,
+// e.g. monthly rent, depreciation or insurance amortisation
+type RecurringJournalTemplate struct {
+	ID             uuid.UUID               `json:"id"`
+	OrganizationID uuid.UUID               `json:"organization_id"`
+	Name           string                  `json:"name"`
+	Description    string                  `json:"description"` // Description of generated entries
+	Reference      string                  `json:"reference"`
+	Frequency      RecurrenceFrequency     `json:"frequency"`
+	DayOfMonth     int                     `json:"day_of_month"` // 1-31, clamped to the last day of shorter months
+	StartDate      time.Time               `json:"start_date"`
+	EndDate        *time.Time              `json:"end_date"` // nil for open-ended schedules
+	AutoPost       bool                    `json:"auto_post"`
+	Status         RecurringTemplateStatus `json:"status"`
+	NextRunDate    *time.Time              `json:"next_run_date"` // nil once completed
+	LastRunDate    *time.Time              `json:"last_run_date"`
+	Lines          []RecurringTemplateLine `json:"lines"`
+	CreatedBy      uuid.UUID               `json:"created_by"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+// RecurringTemplateLine is a line copied into every generated entry
+type RecurringTemplateLine struct {
+	ID          uuid.UUID      `json:"id"`
+	LineNumber  int            `json:"line_number"`
+	AccountID   uuid.UUID      `json:"account_id"`
+	Description string         `json:"description"`
+	Debit       money.Amount   `json:"debit"`
+	Credit      money.Amount   `json:"credit"`
+	Dimensions  LineDimensions `json:"dimensions,omitempty"`
+}
+
+// RecurringJournalRun records a generated or skipped occurrence of a template
+type RecurringJournalRun struct {
+	ID             uuid.UUID          `json:"id"`
+	TemplateID     uuid.UUID          `json:"template_id"`
+	OccurrenceDate time.Time          `json:"occurrence_date"`
+	Status         RecurringRunStatus `json:"status"`
+	JournalEntryID *uuid.UUID         `json:"journal_entry_id"` // nil when skipped
+	CreatedBy      uuid.UUID          `json:"created_by"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+// RecurringOccurrence is an upcoming occurrence shown in a schedule preview
+type RecurringOccurrence struct {
+	Date    time.Time `json:"date"`
+	Skipped bool      `json:"skipped"`
+}
+
+// Validate performs domain validation on the template header and lines
+func (t *RecurringJournalTemplate) Validate() error {
+	if t.OrganizationID == uuid.Nil {
+		return NewGLError("organization ID is required", ErrJournalOrgRequired)
+	}
+
+	if strings.TrimSpace(t.Name) == "" || len(t.Name) > 255 {
+		return NewGLError("template name is required and cannot exceed 255 characters", ErrRecurringTemplateInvalid)
+	}
+
+	if strings.TrimSpace(t.Description) == "" || len(t.Description) > 480 {
+		return NewGLError("description is required and cannot exceed 480 characters", ErrRecurringTemplateInvalid)
+	}
+
+	if t.Frequency.Months() == 0 {
+		return NewGLErrorf(ErrRecurringScheduleInvalid, "invalid frequency: %s", t.Frequency)
+	}
+
+	if t.DayOfMonth < 1 || t.DayOfMonth > 31 {
+		return NewGLError("day of month must be between 1 and 31", ErrRecurringScheduleInvalid)
+	}
+
+	if t.StartDate.IsZero() {
+		return NewGLError("start date is required", ErrRecurringScheduleInvalid)
+	}
+
+	if t.EndDate != nil && t.EndDate.Before(t.StartDate) {
+		return NewGLError("end date cannot be before start date", ErrRecurringScheduleInvalid)
+	}
+
+	if t.EndDate != nil && t.firstOccurrence().After(*t.EndDate) {
+		return NewGLError("schedule has no occurrences between start and end date", ErrRecurringScheduleInvalid)
+	}
+
+	// Lines must make a valid, balanced entry
+	entry := t.BuildEntry(t.StartDate, "VALIDATION")
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// occurrence returns the nth scheduled date counted from the start month
+func (t *RecurringJournalTemplate) occurrence(n int) time.Time {
+	first := time.Date(t.StartDate.Year(), t.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	month := first.AddDate(0, n*t.Frequency.Months(), 0)
+	lastDay := month.AddDate(0, 1, -1).Day()
+
+	day := t.DayOfMonth
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// firstOccurrence returns the first scheduled date on or after the start date
+func (t *RecurringJournalTemplate) firstOccurrence() time.Time {
+	start := truncateToDate(t.StartDate)
+	date := t.occurrence(0)
+	if date.Before(start) {
+		date = t.occurrence(1)
+	}
+	return date
+}
+
+// NextOccurrence returns the first scheduled date on or after a date,
+// or nil when the schedule ends before it
+func (t *RecurringJournalTemplate) NextOccurrence(onOrAfter time.Time) *time.Time {
+	from := truncateToDate(onOrAfter)
+	for n := 0; n < maxRecurringOccurrences; n++ {
+		date := t.occurrence(n)
+		if t.EndDate != nil && date.After(truncateToDate(*t.EndDate)) {
+			return nil
+		}
+		if date.Before(truncateToDate(t.StartDate)) || date.Before(from) {
+			continue
+		}
+		return &date
+	}
+	return nil
+}
+
+// IsOccurrence checks if a date is one of the template's scheduled dates
+func (t *RecurringJournalTemplate) IsOccurrence(date time.Time) bool {
+	next := t.NextOccurrence(date)
+	return next != nil && next.Equal(truncateToDate(date))
+}
+
+// Upcoming lists up to count occurrences from the next run date, marking the skipped ones
+func (t *RecurringJournalTemplate) Upcoming(count int, skipped map[time.Time]bool) []RecurringOccurrence {
+	var occurrences []RecurringOccurrence
+	if t.NextRunDate == nil {
+		return occurrences
+	}
+
+	date := t.NextOccurrence(*t.NextRunDate)
+	for date != nil && len(occurrences) < count {
+		occurrences = append(occurrences, RecurringOccurrence{Date: *date, Skipped: skipped[*date]})
+		date = t.NextOccurrence(date.AddDate(0, 0, 1))
+	}
+	return occurrences
+}
+
+// IsDue checks if an occurrence should be generated as of a date
+func (t *RecurringJournalTemplate) IsDue(asOf time.Time) bool {
+	return t.Status == RecurringTemplateActive && t.NextRunDate != nil && !t.NextRunDate.After(truncateToDate(asOf))
+}
+
+// Advance moves the schedule past an occurrence, completing the template after its last one
+func (t *RecurringJournalTemplate) Advance(occurrence time.Time) {
+	ran := truncateToDate(occurrence)
+	t.LastRunDate = &ran
+	t.NextRunDate = t.NextOccurrence(ran.AddDate(0, 0, 1))
+	if t.NextRunDate == nil {
+		t.Status = RecurringTemplateCompleted
+	}
+	t.UpdatedAt = time.Now()
+}
+
+// Pause stops the template generating entries
+func (t *RecurringJournalTemplate) Pause() error {
+	if t.Status != RecurringTemplateActive {
+		return NewGLErrorf(ErrRecurringTemplateInvalidState, "only active templates can be paused (status: %s)", t.Status)
+	}
+	t.Status = RecurringTemplatePaused
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Resume restarts a paused template. Occurrences that fell due while it was
+// paused are not generated; the schedule continues from today.
+func (t *RecurringJournalTemplate) Resume(today time.Time) error {
+	if t.Status != RecurringTemplatePaused {
+		return NewGLErrorf(ErrRecurringTemplateInvalidState, "only paused templates can be resumed (status: %s)", t.Status)
+	}
+
+	from := truncateToDate(today)
+	if t.NextRunDate != nil && t.NextRunDate.After(from) {
+		from = *t.NextRunDate
+	}
+
+	t.NextRunDate = t.NextOccurrence(from)
+	t.Status = RecurringTemplateActive
+	if t.NextRunDate == nil {
+		t.Status = RecurringTemplateCompleted
+	}
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Schedule sets the next run date from the start date, for a new or rescheduled template
+func (t *RecurringJournalTemplate) Schedule() {
+	t.NextRunDate = t.NextOccurrence(t.StartDate)
+	if t.NextRunDate == nil {
+		t.Status = RecurringTemplateCompleted
+	}
+}
+
+// BuildEntry builds the draft journal entry for an occurrence
+func (t *RecurringJournalTemplate) BuildEntry(occurrence time.Time, entryNumber string) *JournalEntry {
+	now := time.Now()
+	date := truncateToDate(occurrence)
+
+	reference := t.Reference
+	if reference == "" {
+		reference = "REC-" + date.Format("20060102")
+	}
+
+	entry := &JournalEntry{
+		OrganizationID:  t.OrganizationID,
+		EntryNumber:     entryNumber,
+		JournalType:     JournalTypeRecurring,
+		TransactionDate: date,
+		Reference:       reference,
+		Description:     fmt.Sprintf("%s (%s)", t.Description, date.Format("Jan 2006")),
+		Status:          EntryStatusDraft,
+		Lines:           make([]JournalLine, len(t.Lines)),
+		CreatedBy:       t.CreatedBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	for i, line := range t.Lines {
+		entry.Lines[i] = JournalLine{
+			AccountID:   line.AccountID,
+			Description: line.Description,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+			LineNumber:  i + 1,
+			Dimensions:  line.Dimensions,
+		}
+	}
+
+	entry.CalculateTotals()
+	return entry
+}
+
+// NewRecurringJournalRun records an occurrence as generated (entryID set) or skipped
+func NewRecurringJournalRun(templateID uuid.UUID, occurrence time.Time, entryID *uuid.UUID, createdBy uuid.UUID) *RecurringJournalRun {
+	status := RecurringRunGenerated
+	if entryID == nil {
+		status = RecurringRunSkipped
+	}
+	return &RecurringJournalRun{
+		ID:             uuid.New(),
+		TemplateID:     templateID,
+		OccurrenceDate: truncateToDate(occurrence),
+		Status:         status,
+		JournalEntryID: entryID,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// truncateToDate drops the time of day
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
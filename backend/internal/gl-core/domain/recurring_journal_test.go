@@ -0,0 +1,147 @@
+// backend/internal/gl-core/domain/recurring_journal_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func ymd(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRecurringNextOccurrence(t *testing.T) {
+	endOfYear := ymd(2025, 12, 31)
+
+	tests := []struct {
+		name      string
+		frequency RecurrenceFrequency
+		day       int
+		start     time.Time
+		end       *time.Time
+		onOrAfter time.Time
+		want      *time.Time // nil when the schedule has ended
+	}{
+		{name: "monthly from start", frequency: RecurrenceMonthly, day: 15, start: ymd(2025, 1, 1), onOrAfter: ymd(2025, 1, 1), want: ptrTime(ymd(2025, 1, 15))},
+		{name: "on the occurrence itself", frequency: RecurrenceMonthly, day: 15, start: ymd(2025, 1, 1), onOrAfter: ymd(2025, 3, 15), want: ptrTime(ymd(2025, 3, 15))},
+		{name: "day after an occurrence", frequency: RecurrenceMonthly, day: 15, start: ymd(2025, 1, 1), onOrAfter: ymd(2025, 3, 16), want: ptrTime(ymd(2025, 4, 15))},
+		{name: "start after the day of month", frequency: RecurrenceMonthly, day: 5, start: ymd(2025, 1, 20), onOrAfter: ymd(2025, 1, 1), want: ptrTime(ymd(2025, 2, 5))},
+		{name: "day 31 clamps to february", frequency: RecurrenceMonthly, day: 31, start: ymd(2025, 1, 1), onOrAfter: ymd(2025, 2, 1), want: ptrTime(ymd(2025, 2, 28))},
+		{name: "day 31 clamps to leap february", frequency: RecurrenceMonthly, day: 31, start: ymd(2024, 1, 1), onOrAfter: ymd(2024, 2, 1), want: ptrTime(ymd(2024, 2, 29))},
+		{name: "day 31 after a short month", frequency: RecurrenceMonthly, day: 31, start: ymd(2025, 1, 1), onOrAfter: ymd(2025, 3, 1), want: ptrTime(ymd(2025, 3, 31))},
+		{name: "quarterly counts from start month", frequency: RecurrenceQuarterly, day: 1, start: ymd(2025, 2, 1), onOrAfter: ymd(2025, 3, 1), want: ptrTime(ymd(2025, 5, 1))},
+		{name: "semi-annual", frequency: RecurrenceSemiAnnual, day: 30, start: ymd(2025, 2, 1), onOrAfter: ymd(2025, 3, 1), want: ptrTime(ymd(2025, 8, 30))},
+		{name: "annual across years", frequency: RecurrenceAnnual, day: 31, start: ymd(2025, 12, 1), onOrAfter: ymd(2026, 1, 1), want: ptrTime(ymd(2026, 12, 31))},
+		{name: "time of day ignored", frequency: RecurrenceMonthly, day: 15, start: ymd(2025, 1, 1), onOrAfter: time.Date(2025, 3, 15, 18, 30, 0, 0, time.UTC), want: ptrTime(ymd(2025, 3, 15))},
+		{name: "last occurrence on end date", frequency: RecurrenceMonthly, day: 31, start: ymd(2025, 1, 1), end: &endOfYear, onOrAfter: ymd(2025, 12, 1), want: ptrTime(ymd(2025, 12, 31))},
+		{name: "after end date", frequency: RecurrenceMonthly, day: 15, start: ymd(2025, 1, 1), end: &endOfYear, onOrAfter: ymd(2026, 1, 1), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := &RecurringJournalTemplate{Frequency: tt.frequency, DayOfMonth: tt.day, StartDate: tt.start, EndDate: tt.end}
+
+			got := tmpl.NextOccurrence(tt.onOrAfter)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Fatalf("NextOccurrence(%s) = %s, want nil", tt.onOrAfter.Format("2006-01-02"), got.Format("2006-01-02"))
+			case tt.want != nil && got == nil:
+				t.Fatalf("NextOccurrence(%s) = nil, want %s", tt.onOrAfter.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+			case tt.want != nil && !got.Equal(*tt.want):
+				t.Errorf("NextOccurrence(%s) = %s, want %s", tt.onOrAfter.Format("2006-01-02"), got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestRecurringAdvance(t *testing.T) {
+	end := ymd(2025, 3, 31)
+
+	tests := []struct {
+		name       string
+		end        *time.Time
+		occurrence time.Time
+		wantNext   *time.Time
+		wantStatus RecurringTemplateStatus
+	}{
+		{name: "moves to the next month", occurrence: ymd(2025, 1, 31), wantNext: ptrTime(ymd(2025, 2, 28)), wantStatus: RecurringTemplateActive},
+		{name: "clamped run moves on", occurrence: ymd(2025, 2, 28), wantNext: ptrTime(ymd(2025, 3, 31)), wantStatus: RecurringTemplateActive},
+		{name: "last run completes", end: &end, occurrence: ymd(2025, 3, 31), wantNext: nil, wantStatus: RecurringTemplateCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := &RecurringJournalTemplate{
+				Frequency:  RecurrenceMonthly,
+				DayOfMonth: 31,
+				StartDate:  ymd(2025, 1, 1),
+				EndDate:    tt.end,
+				Status:     RecurringTemplateActive,
+			}
+
+			tmpl.Advance(tt.occurrence)
+
+			if tmpl.LastRunDate == nil || !tmpl.LastRunDate.Equal(tt.occurrence) {
+				t.Errorf("LastRunDate = %v, want %s", tmpl.LastRunDate, tt.occurrence.Format("2006-01-02"))
+			}
+			if (tmpl.NextRunDate == nil) != (tt.wantNext == nil) || (tt.wantNext != nil && !tmpl.NextRunDate.Equal(*tt.wantNext)) {
+				t.Errorf("NextRunDate = %v, want %v", tmpl.NextRunDate, tt.wantNext)
+			}
+			if tmpl.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", tmpl.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRecurringResume(t *testing.T) {
+	end := ymd(2025, 6, 30)
+
+	tests := []struct {
+		name       string
+		nextRun    time.Time
+		today      time.Time
+		wantNext   *time.Time
+		wantStatus RecurringTemplateStatus
+	}{
+		{name: "missed runs are not generated", nextRun: ymd(2025, 2, 10), today: ymd(2025, 4, 20), wantNext: ptrTime(ymd(2025, 5, 10)), wantStatus: RecurringTemplateActive},
+		{name: "due today", nextRun: ymd(2025, 2, 10), today: ymd(2025, 4, 10), wantNext: ptrTime(ymd(2025, 4, 10)), wantStatus: RecurringTemplateActive},
+		{name: "resumed before the next run", nextRun: ymd(2025, 3, 10), today: ymd(2025, 2, 20), wantNext: ptrTime(ymd(2025, 3, 10)), wantStatus: RecurringTemplateActive},
+		{name: "resumed after the end date", nextRun: ymd(2025, 2, 10), today: ymd(2025, 7, 1), wantNext: nil, wantStatus: RecurringTemplateCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextRun := tt.nextRun
+			tmpl := &RecurringJournalTemplate{
+				Frequency:   RecurrenceMonthly,
+				DayOfMonth:  10,
+				StartDate:   ymd(2025, 1, 1),
+				EndDate:     &end,
+				Status:      RecurringTemplatePaused,
+				NextRunDate: &nextRun,
+			}
+
+			if err := tmpl.Resume(tt.today); err != nil {
+				t.Fatalf("Resume() error = %v", err)
+			}
+			if (tmpl.NextRunDate == nil) != (tt.wantNext == nil) || (tt.wantNext != nil && !tmpl.NextRunDate.Equal(*tt.wantNext)) {
+				t.Errorf("NextRunDate = %v, want %v", tmpl.NextRunDate, tt.wantNext)
+			}
+			if tmpl.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", tmpl.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRecurringResumeRequiresPaused(t *testing.T) {
+	tmpl := &RecurringJournalTemplate{Frequency: RecurrenceMonthly, DayOfMonth: 1, StartDate: ymd(2025, 1, 1), Status: RecurringTemplateActive}
+	if err := tmpl.Resume(ymd(2025, 2, 1)); err == nil {
+		t.Fatal("Resume() of an active template succeeded, want an error")
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
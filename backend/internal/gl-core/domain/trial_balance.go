@@ -0,0 +1,98 @@
+// backend/internal/gl-core/domain/trial_balance.go
+package domain
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// TrialBalanceLine represents one account row of a trial balance
+type TrialBalanceLine struct {
+	AccountID     uuid.UUID    `json:"account_id"`
+	Code          string       `json:"code"`
+	Name          string       `json:"name"`
+	Type          AccountType  `json:"type"`
+	ParentID      *uuid.UUID   `json:"parent_id,omitempty"`
+	Level         int          `json:"level"`        // Depth in the account tree (0 = root)
+	HasChildren   bool         `json:"has_children"` // True if amounts include rolled-up children
+	OpeningDebit  money.Amount `json:"opening_debit"`
+	OpeningCredit money.Amount `json:"opening_credit"`
+	PeriodDebit   money.Amount `json:"period_debit"`
+	PeriodCredit  money.Amount `json:"period_credit"`
+	ClosingDebit  money.Amount `json:"closing_debit"`
+	ClosingCredit money.Amount `json:"closing_credit"`
+}
+
+// TrialBalance represents a trial balance report for an organization
+type TrialBalance struct {
+	OrganizationID     uuid.UUID          `json:"organization_id"`
+	FromDate           *time.Time         `json:"from_date,omitempty"` // nil when reporting as of a date
+	ToDate             time.Time          `json:"to_date"`
+	Lines              []TrialBalanceLine `json:"lines"`
+	TotalOpeningDebit  money.Amount       `json:"total_opening_debit"`
+	TotalOpeningCredit money.Amount       `json:"total_opening_credit"`
+	TotalPeriodDebit   money.Amount       `json:"total_period_debit"`
+	TotalPeriodCredit  money.Amount       `json:"total_period_credit"`
+	TotalClosingDebit  money.Amount       `json:"total_closing_debit"`
+	TotalClosingCredit money.Amount       `json:"total_closing_credit"`
+	Dimensions         LineDimensions     `json:"dimensions,omitempty"` // Dimension filter the report was run with
+	GeneratedAt        time.Time          `json:"generated_at"`
+}
+
+// OpeningBalance returns the net opening balance (positive = debit)
+func (l *TrialBalanceLine) OpeningBalance() money.Amount {
+	return l.OpeningDebit - l.OpeningCredit
+}
+
+// ClosingBalance returns the net closing balance (positive = debit)
+func (l *TrialBalanceLine) ClosingBalance() money.Amount {
+	return l.OpeningBalance() + l.PeriodDebit - l.PeriodCredit
+}
+
+// IsZero checks if the line has no opening balance and no period activity
+func (l *TrialBalanceLine) IsZero() bool {
+	return l.OpeningDebit == 0 && l.OpeningCredit == 0 && l.PeriodDebit == 0 && l.PeriodCredit == 0
+}
+
+// Finalize nets the opening balance onto one side and derives the closing balance.
+// Expects OpeningDebit/OpeningCredit to hold raw sums of debits and credits.
+func (l *TrialBalanceLine) Finalize() {
+	opening := l.OpeningBalance()
+	l.OpeningDebit, l.OpeningCredit = splitBalance(opening)
+	l.ClosingDebit, l.ClosingCredit = splitBalance(opening + l.PeriodDebit - l.PeriodCredit)
+}
+
+// CalculateTotals calculates report totals from top-level lines only,
+// so rolled-up parent amounts are not counted twice
+func (tb *TrialBalance) CalculateTotals() {
+	tb.TotalOpeningDebit, tb.TotalOpeningCredit = 0, 0
+	tb.TotalPeriodDebit, tb.TotalPeriodCredit = 0, 0
+	tb.TotalClosingDebit, tb.TotalClosingCredit = 0, 0
+
+	for _, line := range tb.Lines {
+		if line.Level != 0 {
+			continue
+		}
+		tb.TotalOpeningDebit += line.OpeningDebit
+		tb.TotalOpeningCredit += line.OpeningCredit
+		tb.TotalPeriodDebit += line.PeriodDebit
+		tb.TotalPeriodCredit += line.PeriodCredit
+		tb.TotalClosingDebit += line.ClosingDebit
+		tb.TotalClosingCredit += line.ClosingCredit
+	}
+}
+
+// IsBalanced checks if closing debits equal closing credits
+func (tb *TrialBalance) IsBalanced() bool {
+	return ValidateBalance(tb.TotalClosingDebit, tb.TotalClosingCredit) == nil
+}
+
+// splitBalance presents a net balance as a (debit, credit) pair
+func splitBalance(net money.Amount) (money.Amount, money.Amount) {
+	if net >= 0 {
+		return net, 0
+	}
+	return 0, -net
+}
@@ -0,0 +1,190 @@
+// backend/internal/gl-core/domain/year_end_close.go
+package domain
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// YearEndCloseStatus represents the status of a year-end close
+type YearEndCloseStatus string
+
+const (
+	YearEndCloseActive   YearEndCloseStatus = "ACTIVE"   // Closing entry in effect
+	YearEndCloseReversed YearEndCloseStatus = "REVERSED" // Undone when the year was reopened
+)
+
+// YearEndClose records the closing of a fiscal year into retained earnings
+type YearEndClose struct {
+	ID                        uuid.UUID          `json:"id"`
+	OrganizationID            uuid.UUID          `json:"organization_id"`
+	FiscalYearID              uuid.UUID          `json:"fiscal_year_id"`
+	NextFiscalYearID          uuid.UUID          `json:"next_fiscal_year_id"`
+	RetainedEarningsAccountID uuid.UUID          `json:"retained_earnings_account_id"`
+	ClosingEntryID            *uuid.UUID         `json:"closing_entry_id,omitempty"` // nil when there was nothing to close
+	ReversalEntryID           *uuid.UUID         `json:"reversal_entry_id,omitempty"`
+	NetIncome                 money.Amount       `json:"net_income"`
+	Status                    YearEndCloseStatus `json:"status"`
+	OpeningBalances           []OpeningBalance   `json:"opening_balances"`
+	ClosedBy                  uuid.UUID          `json:"closed_by"`
+	ClosedAt                  time.Time          `json:"closed_at"`
+	ReversedBy                *uuid.UUID         `json:"reversed_by,omitempty"`
+	ReversedAt                *time.Time         `json:"reversed_at,omitempty"`
+	ReversalReason            string             `json:"reversal_reason,omitempty"`
+}
+
+// OpeningBalance is a balance sheet account's balance brought forward into a fiscal year
+type OpeningBalance struct {
+	ID             uuid.UUID    `json:"id"`
+	FiscalYearID   uuid.UUID    `json:"fiscal_year_id"`
+	OrganizationID uuid.UUID    `json:"organization_id"`
+	AccountID      uuid.UUID    `json:"account_id"`
+	YearEndCloseID uuid.UUID    `json:"year_end_close_id"`
+	Debit          money.Amount `json:"debit"`
+	Credit         money.Amount `json:"credit"`
+}
+
+// IsProfitAndLoss checks if balances of this account type are closed at year end
+func (t AccountType) IsProfitAndLoss() bool {
+	return t == AccountTypeRevenue || t == AccountTypeExpense
+}
+
+// BuildClosingEntry builds a posted closing entry that zeroes every revenue and
+// expense balance against the retained earnings account. Balances are the raw
+// per-account activity up to the fiscal year end. Returns a nil entry when all
+// revenue and expense accounts are already zero. Net income is credit-positive.
+func BuildClosingEntry(
+	fy *FiscalYear,
+	balances []TrialBalanceLine,
+	retainedEarningsID uuid.UUID,
+	entryNumber string,
+	closedBy uuid.UUID,
+) (*JournalEntry, money.Amount, error) {
+	now := time.Now()
+	entry := &JournalEntry{
+		ID:              uuid.New(),
+		OrganizationID:  fy.OrganizationID,
+		EntryNumber:     entryNumber,
+		JournalType:     JournalTypeClosing,
+		TransactionDate: fy.EndDate,
+		Reference:       "CLOSE-" + fy.Name,
+		Description:     "Year-end closing entry for " + fy.Name,
+		Status:          EntryStatusDraft,
+		CreatedBy:       closedBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	var netDebit money.Amount
+	for _, line := range balances {
+		if !line.Type.IsProfitAndLoss() {
+			continue
+		}
+
+		balance := line.ClosingBalance().RoundTo(LedgerDecimalPlaces)
+		if balance == 0 {
+			continue
+		}
+		netDebit += balance
+
+		closing := JournalLine{
+			ID:          uuid.New(),
+			AccountID:   line.AccountID,
+			Description: "Close " + line.Code + " " + line.Name,
+			LineNumber:  len(entry.Lines) + 1,
+		}
+		// Post the opposite side to bring the account to zero
+		if balance > 0 {
+			closing.Credit = balance
+		} else {
+			closing.Debit = -balance
+		}
+		entry.Lines = append(entry.Lines, closing)
+	}
+
+	if len(entry.Lines) == 0 {
+		return nil, 0, nil
+	}
+
+	netIncome := (-netDebit).RoundTo(LedgerDecimalPlaces)
+	if netIncome != 0 {
+		retained := JournalLine{
+			ID:          uuid.New(),
+			AccountID:   retainedEarningsID,
+			Description: "Net income for " + fy.Name,
+			LineNumber:  len(entry.Lines) + 1,
+		}
+		if netIncome > 0 {
+			retained.Credit = netIncome
+		} else {
+			retained.Debit = -netIncome
+		}
+		entry.Lines = append(entry.Lines, retained)
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, 0, err
+	}
+	entry.CalculateTotals()
+
+	if err := entry.Post(closedBy); err != nil {
+		return nil, 0, err
+	}
+
+	return entry, netIncome, nil
+}
+
+// BuildOpeningBalances brings every balance sheet account's post-closing balance
+// forward into the next fiscal year
+func BuildOpeningBalances(
+	yc *YearEndClose,
+	balances []TrialBalanceLine,
+) []OpeningBalance {
+	var opening []OpeningBalance
+	for _, line := range balances {
+		if line.Type.IsProfitAndLoss() {
+			continue
+		}
+
+		balance := line.ClosingBalance()
+		if line.AccountID == yc.RetainedEarningsAccountID {
+			balance -= yc.NetIncome
+		}
+
+		balance = balance.RoundTo(LedgerDecimalPlaces)
+		if balance == 0 {
+			continue
+		}
+
+		debit, credit := splitBalance(balance)
+		opening = append(opening, OpeningBalance{
+			ID:             uuid.New(),
+			FiscalYearID:   yc.NextFiscalYearID,
+			OrganizationID: yc.OrganizationID,
+			AccountID:      line.AccountID,
+			YearEndCloseID: yc.ID,
+			Debit:          debit,
+			Credit:         credit,
+		})
+	}
+	return opening
+}
+
+// Reverse marks the close as undone when its fiscal year is reopened
+func (yc *YearEndClose) Reverse(reversedBy uuid.UUID, reason string, reversalEntryID *uuid.UUID) error {
+	if yc.Status != YearEndCloseActive {
+		return NewGLError("year-end close has already been reversed", ErrYearEndNotClosed)
+	}
+
+	now := time.Now()
+	yc.Status = YearEndCloseReversed
+	yc.ReversalEntryID = reversalEntryID
+	yc.ReversedBy = &reversedBy
+	yc.ReversedAt = &now
+	yc.ReversalReason = reason
+	yc.OpeningBalances = nil
+
+	return nil
+}
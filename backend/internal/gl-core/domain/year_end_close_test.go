@@ -0,0 +1,248 @@
+// backend/internal/gl-core/domain/year_end_close_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// activity is an account's debits and credits for the year
+type activity struct {
+	code   string
+	typ    AccountType
+	debit  string
+	credit string
+}
+
+// closeLines builds trial balance lines with an account ID per code
+func closeLines(t *testing.T, accounts map[string]uuid.UUID, rows []activity) []TrialBalanceLine {
+	t.Helper()
+	lines := make([]TrialBalanceLine, len(rows))
+	for i, r := range rows {
+		if _, ok := accounts[r.code]; !ok {
+			accounts[r.code] = uuid.New()
+		}
+		lines[i] = TrialBalanceLine{
+			AccountID:    accounts[r.code],
+			Code:         r.code,
+			Name:         "Account " + r.code,
+			Type:         r.typ,
+			PeriodDebit:  mustAmount(t, r.debit),
+			PeriodCredit: mustAmount(t, r.credit),
+		}
+	}
+	return lines
+}
+
+func closingYear() *FiscalYear {
+	return &FiscalYear{
+		ID:             uuid.New(),
+		OrganizationID: uuid.New(),
+		Name:           "FY2025",
+		StartDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:        time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBuildClosingEntry(t *testing.T) {
+	const retained = "RE"
+
+	tests := []struct {
+		name          string
+		rows          []activity
+		wantNil       bool
+		wantNetIncome string
+		// wantLines maps account codes to the debit and credit posted to them
+		wantLines map[string][2]string
+	}{
+		{
+			name: "profit credits retained earnings",
+			rows: []activity{
+				{code: "4000", typ: AccountTypeRevenue, debit: "0", credit: "1000"},
+				{code: "5000", typ: AccountTypeExpense, debit: "600", credit: "0"},
+			},
+			wantNetIncome: "400",
+			wantLines: map[string][2]string{
+				"4000":   {"1000", "0"},
+				"5000":   {"0", "600"},
+				retained: {"0", "400"},
+			},
+		},
+		{
+			name: "loss debits retained earnings",
+			rows: []activity{
+				{code: "4000", typ: AccountTypeRevenue, debit: "0", credit: "300"},
+				{code: "5000", typ: AccountTypeExpense, debit: "500", credit: "0"},
+			},
+			wantNetIncome: "-200",
+			wantLines: map[string][2]string{
+				"4000":   {"300", "0"},
+				"5000":   {"0", "500"},
+				retained: {"200", "0"},
+			},
+		},
+		{
+			name: "break-even has no retained earnings line",
+			rows: []activity{
+				{code: "4000", typ: AccountTypeRevenue, debit: "0", credit: "750"},
+				{code: "5000", typ: AccountTypeExpense, debit: "750", credit: "0"},
+			},
+			wantNetIncome: "0",
+			wantLines: map[string][2]string{
+				"4000": {"750", "0"},
+				"5000": {"0", "750"},
+			},
+		},
+		{
+			name: "contra balances close on their own side",
+			rows: []activity{
+				{code: "4000", typ: AccountTypeRevenue, debit: "0", credit: "1000"},
+				{code: "4900", typ: AccountTypeRevenue, debit: "50", credit: "0"}, // Sales returns
+				{code: "5000", typ: AccountTypeExpense, debit: "400", credit: "100"},
+			},
+			wantNetIncome: "650",
+			wantLines: map[string][2]string{
+				"4000":   {"1000", "0"},
+				"4900":   {"0", "50"},
+				"5000":   {"0", "300"},
+				retained: {"0", "650"},
+			},
+		},
+		{
+			name: "balance sheet accounts are left open",
+			rows: []activity{
+				{code: "1000", typ: AccountTypeAsset, debit: "5000", credit: "0"},
+				{code: "2000", typ: AccountTypeLiability, debit: "0", credit: "2000"},
+				{code: "4000", typ: AccountTypeRevenue, debit: "0", credit: "3000"},
+			},
+			wantNetIncome: "3000",
+			wantLines: map[string][2]string{
+				"4000":   {"3000", "0"},
+				retained: {"0", "3000"},
+			},
+		},
+		{
+			name: "nothing to close",
+			rows: []activity{
+				{code: "1000", typ: AccountTypeAsset, debit: "5000", credit: "0"},
+				{code: "4000", typ: AccountTypeRevenue, debit: "200", credit: "200"},
+			},
+			wantNil: true,
+		},
+		{
+			name: "sub-cent balances are not closed",
+			rows: []activity{
+				{code: "5000", typ: AccountTypeExpense, debit: "0.004", credit: "0"},
+			},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fy := closingYear()
+			accounts := map[string]uuid.UUID{retained: uuid.New()}
+			balances := closeLines(t, accounts, tt.rows)
+			closedBy := uuid.New()
+
+			entry, netIncome, err := BuildClosingEntry(fy, balances, accounts[retained], NewDraftEntryNumber(), closedBy)
+			if err != nil {
+				t.Fatalf("BuildClosingEntry() error = %v", err)
+			}
+			if tt.wantNil {
+				if entry != nil {
+					t.Fatalf("BuildClosingEntry() = entry with %d lines, want nil", len(entry.Lines))
+				}
+				return
+			}
+			if entry == nil {
+				t.Fatal("BuildClosingEntry() = nil, want an entry")
+			}
+
+			if want := mustAmount(t, tt.wantNetIncome); netIncome != want {
+				t.Errorf("net income = %s, want %s", netIncome, want)
+			}
+			if entry.Status != EntryStatusPosted || entry.JournalType != JournalTypeClosing {
+				t.Errorf("entry is %s %s, want POSTED CLOSING", entry.Status, entry.JournalType)
+			}
+			if !entry.TransactionDate.Equal(fy.EndDate) {
+				t.Errorf("entry dated %s, want the fiscal year end", entry.TransactionDate.Format("2006-01-02"))
+			}
+			if entry.TotalDebit != entry.TotalCredit {
+				t.Errorf("entry is unbalanced: debits %s, credits %s", entry.TotalDebit, entry.TotalCredit)
+			}
+
+			byAccount := make(map[uuid.UUID]JournalLine, len(entry.Lines))
+			for _, line := range entry.Lines {
+				byAccount[line.AccountID] = line
+			}
+			if len(byAccount) != len(tt.wantLines) {
+				t.Errorf("entry has %d lines, want %d", len(byAccount), len(tt.wantLines))
+			}
+			for code, want := range tt.wantLines {
+				line, ok := byAccount[accounts[code]]
+				if !ok {
+					t.Errorf("no closing line for %s", code)
+					continue
+				}
+				if line.Debit != mustAmount(t, want[0]) || line.Credit != mustAmount(t, want[1]) {
+					t.Errorf("%s closed with debit %s credit %s, want debit %s credit %s",
+						code, line.Debit, line.Credit, want[0], want[1])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildOpeningBalances(t *testing.T) {
+	accounts := map[string]uuid.UUID{}
+	balances := closeLines(t, accounts, []activity{
+		{code: "1000", typ: AccountTypeAsset, debit: "5000", credit: "1000"},
+		{code: "2000", typ: AccountTypeLiability, debit: "0", credit: "2500"},
+		{code: "3100", typ: AccountTypeEquity, debit: "0", credit: "100"}, // Retained earnings before the close
+		{code: "3200", typ: AccountTypeEquity, debit: "300", credit: "300"},
+		{code: "4000", typ: AccountTypeRevenue, debit: "0", credit: "900"},
+		{code: "5000", typ: AccountTypeExpense, debit: "500", credit: "0"},
+	})
+
+	yc := &YearEndClose{
+		ID:                        uuid.New(),
+		OrganizationID:            uuid.New(),
+		NextFiscalYearID:          uuid.New(),
+		RetainedEarningsAccountID: accounts["3100"],
+		NetIncome:                 mustAmount(t, "400"),
+	}
+
+	want := map[string][2]money.Amount{
+		"1000": {mustAmount(t, "4000"), 0},
+		"2000": {0, mustAmount(t, "2500")},
+		"3100": {0, mustAmount(t, "500")}, // Includes the year's net income
+	}
+
+	opening := BuildOpeningBalances(yc, balances)
+	if len(opening) != len(want) {
+		t.Fatalf("got %d opening balances, want %d", len(opening), len(want))
+	}
+	for code, amounts := range want {
+		found := false
+		for _, ob := range opening {
+			if ob.AccountID != accounts[code] {
+				continue
+			}
+			found = true
+			if ob.Debit != amounts[0] || ob.Credit != amounts[1] {
+				t.Errorf("%s opens with debit %s credit %s, want debit %s credit %s",
+					code, ob.Debit, ob.Credit, amounts[0], amounts[1])
+			}
+			if ob.FiscalYearID != yc.NextFiscalYearID || ob.YearEndCloseID != yc.ID {
+				t.Errorf("%s opening balance is not linked to the next year and the close", code)
+			}
+		}
+		if !found {
+			t.Errorf("no opening balance for %s", code)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"time"
 )
 
 type AccountHandler struct {
@@ -33,10 +34,15 @@ func (h *AccountHandler) CreateAccount(c *gin.Context) {
 	}
 
 	account := domain.GLAccount{
-		Code:       req.Code,
-		Name:       req.Name,
-		Type:       req.Type,
-		ParentCode: req.ParentCode,
+		OrganizationID: req.OrganizationID,
+		Code:           req.Code,
+		Name:           req.Name,
+		Type:           req.Type,
+		ParentCode:     req.ParentCode,
+		IsPostable:     true,
+	}
+	if req.IsPostable != nil {
+		account.IsPostable = *req.IsPostable
 	}
 
 	created, err := h.service.CreateAccount(c.Request.Context(), account)
@@ -77,8 +83,18 @@ func (h *AccountHandler) GetAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, mapper.ToAccountResponse(account))
 }
 
-// GetAccountByCode handles GET /accounts/code/:code
+// GetAccountByCode handles GET /accounts/code/:code?organization_id=
+// Codes are unique within an organization's chart of accounts
 func (h *AccountHandler) GetAccountByCode(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
 	code := c.Param("code")
 	if code == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -90,7 +106,7 @@ func (h *AccountHandler) GetAccountByCode(c *gin.Context) {
 
 	includeInactive := c.DefaultQuery("include_inactive", "false") == "true"
 
-	account, err := h.service.GetAccountByCode(c.Request.Context(), code, !includeInactive)
+	account, err := h.service.GetAccountByCode(c.Request.Context(), orgID, code, !includeInactive)
 	if err != nil {
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{
 			Error:   "Account not found",
@@ -102,11 +118,20 @@ func (h *AccountHandler) GetAccountByCode(c *gin.Context) {
 	c.JSON(http.StatusOK, mapper.ToAccountResponse(account))
 }
 
-// ListAccounts handles GET /accounts
+// ListAccounts handles GET /accounts?organization_id=
 func (h *AccountHandler) ListAccounts(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
 	includeInactive := c.DefaultQuery("include_inactive", "false") == "true"
 
-	accounts, err := h.service.ListAccounts(c.Request.Context(), !includeInactive)
+	accounts, err := h.service.ListAccounts(c.Request.Context(), orgID, !includeInactive)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 			Error:   "Failed to list accounts",
@@ -160,6 +185,10 @@ func (h *AccountHandler) UpdateAccount(c *gin.Context) {
 		Name:       req.Name,
 		Type:       existing.Type, // Type cannot be changed
 		ParentCode: req.ParentCode,
+		IsPostable: existing.IsPostable,
+	}
+	if req.IsPostable != nil {
+		account.IsPostable = *req.IsPostable
 	}
 
 	updated, err := h.service.UpdateAccount(c.Request.Context(), account)
@@ -255,10 +284,20 @@ func (h *AccountHandler) SoftDeleteAccount(c *gin.Context) {
 	})
 }
 
-// SearchAccounts handles GET /accounts/search
+// SearchAccounts handles GET /accounts/search?organization_id=
 func (h *AccountHandler) SearchAccounts(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
 	params := repository.GLAccountSearchParams{
-		Name: c.Query("name"),
+		OrganizationID: orgID,
+		Name:           c.Query("name"),
 	}
 
 	// Parse account type if provided
@@ -289,3 +328,134 @@ func (h *AccountHandler) SearchAccounts(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// GetAccountTree handles GET /accounts/tree?organization_id=&as_of_date=&include_inactive=
+// Balances are posted balances up to as_of_date (default today), positive = debit
+func (h *AccountHandler) GetAccountTree(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	asOfDate := time.Now()
+	if value := c.Query("as_of_date"); value != "" {
+		asOfDate, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid as_of_date format",
+				Message: "Use YYYY-MM-DD format",
+			})
+			return
+		}
+	}
+
+	includeInactive := c.DefaultQuery("include_inactive", "false") == "true"
+
+	tree, err := h.service.GetAccountTree(c.Request.Context(), orgID, asOfDate, includeInactive)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to get account tree",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAccountTreeResponse(tree))
+}
+
+// MoveAccount handles POST /accounts/:id/move
+func (h *AccountHandler) MoveAccount(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid account ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.MoveAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	moved, err := h.service.MoveAccount(c.Request.Context(), id, req.ParentID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to move account",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAccountResponse(moved))
+}
+
+// MergeAccount handles POST /accounts/:id/merge
+// Re-points the account's journal lines to the target account and deactivates it
+func (h *AccountHandler) MergeAccount(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid account ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.MergeAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	merge, err := h.service.MergeAccount(c.Request.Context(), id, req.TargetAccountID, req.Reason, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to merge account",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAccountMergeResponse(merge))
+}
+
+// ListAccountMerges handles GET /accounts/merges?organization_id=
+func (h *AccountHandler) ListAccountMerges(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	merges, err := h.service.ListAccountMerges(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list account merges",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := make([]dto.AccountMergeResponse, len(merges))
+	for i, merge := range merges {
+		response[i] = mapper.ToAccountMergeResponse(merge)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
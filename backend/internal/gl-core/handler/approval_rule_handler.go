@@ -0,0 +1,183 @@
+// backend/internal/gl-core/handler/approval_rule_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ApprovalRuleHandler struct {
+	service service.ApprovalRuleServiceInterface
+}
+
+// NewApprovalRuleHandler creates a new approval rule handler
+func NewApprovalRuleHandler(service service.ApprovalRuleServiceInterface) *ApprovalRuleHandler {
+	return &ApprovalRuleHandler{service: service}
+}
+
+// CreateRule handles POST /approval-rules
+func (h *ApprovalRuleHandler) CreateRule(c *gin.Context) {
+	var req dto.ApprovalRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rule, ok := toApprovalRule(c, req)
+	if !ok {
+		return
+	}
+	rule.OrganizationID = orgID
+	rule.CreatedBy = getUserIDFromContext(c)
+
+	created, err := h.service.CreateRule(c.Request.Context(), rule)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create approval rule",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToApprovalRuleResponse(created))
+}
+
+// UpdateRule handles PUT /approval-rules/:id
+func (h *ApprovalRuleHandler) UpdateRule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid approval rule ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.ApprovalRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	existing, err := h.service.GetRule(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Approval rule not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rule, ok := toApprovalRule(c, req)
+	if !ok {
+		return
+	}
+	rule.ID = id
+	rule.IsActive = existing.IsActive
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+
+	updated, err := h.service.UpdateRule(c.Request.Context(), rule)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update approval rule",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToApprovalRuleResponse(updated))
+}
+
+// GetRule handles GET /approval-rules/:id
+func (h *ApprovalRuleHandler) GetRule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid approval rule ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rule, err := h.service.GetRule(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Approval rule not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToApprovalRuleResponse(rule))
+}
+
+// ListRules handles GET /approval-rules?organization_id=
+func (h *ApprovalRuleHandler) ListRules(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rules, err := h.service.ListRules(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list approval rules",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToApprovalRuleListResponse(rules))
+}
+
+// toApprovalRule converts a request to a domain rule, writing the error
+// response and returning false when an account ID is invalid
+func toApprovalRule(c *gin.Context, req dto.ApprovalRuleRequest) (*domain.ApprovalRule, bool) {
+	rule := &domain.ApprovalRule{
+		Name:                     req.Name,
+		MinAmount:                req.MinAmount,
+		AccountIDs:               make([]uuid.UUID, len(req.AccountIDs)),
+		RequireDifferentApprover: req.RequireDifferentApprover,
+	}
+
+	for i, value := range req.AccountIDs {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid account ID",
+				Message: err.Error(),
+			})
+			return nil, false
+		}
+		rule.AccountIDs[i] = id
+	}
+
+	return rule, true
+}
@@ -0,0 +1,172 @@
+// backend/internal/gl-core/handler/attachment_handler.go
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AttachmentHandler struct {
+	service service.AttachmentServiceInterface
+}
+
+// NewAttachmentHandler creates a new attachment handler
+func NewAttachmentHandler(service service.AttachmentServiceInterface) *AttachmentHandler {
+	return &AttachmentHandler{service: service}
+}
+
+// UploadEntryAttachment handles POST /journal-entries/:id/attachments (multipart "file")
+func (h *AttachmentHandler) UploadEntryAttachment(c *gin.Context) {
+	entryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid entry ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, domain.MaxAttachmentSize+1<<20)
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "File is required",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to read file",
+			Message: err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.service.AttachToEntry(c.Request.Context(), entryID, header.Filename, file, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to attach document",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToAttachmentResponse(attachment))
+}
+
+// ListEntryAttachments handles GET /journal-entries/:id/attachments
+func (h *AttachmentHandler) ListEntryAttachments(c *gin.Context) {
+	h.listAttachments(c, domain.AttachmentEntityJournalEntry)
+}
+
+// ListImportAttachments handles GET /import/logs/:id/attachments
+func (h *AttachmentHandler) ListImportAttachments(c *gin.Context) {
+	h.listAttachments(c, domain.AttachmentEntityImport)
+}
+
+// GetAttachment handles GET /attachments/:id
+func (h *AttachmentHandler) GetAttachment(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid attachment ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	attachment, err := h.service.GetAttachment(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Attachment not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAttachmentResponse(attachment))
+}
+
+// DownloadAttachment handles GET /attachments/:id/download
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid attachment ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	attachment, data, err := h.service.Download(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to download attachment",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", attachment.FileName))
+	c.Header("X-Content-SHA256", attachment.Checksum)
+	c.Data(http.StatusOK, attachment.ContentType, data)
+}
+
+// DeleteAttachment handles DELETE /attachments/:id
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid attachment ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.DeleteAttachment(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to delete attachment",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Success: true,
+		Message: "Attachment deleted successfully",
+	})
+}
+
+// listAttachments lists the attachments of the entry or import in the :id path parameter
+func (h *AttachmentHandler) listAttachments(c *gin.Context, entityType domain.AttachmentEntityType) {
+	entityID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	attachments, err := h.service.ListAttachments(c.Request.Context(), entityType, entityID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list attachments",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAttachmentListResponse(attachments))
+}
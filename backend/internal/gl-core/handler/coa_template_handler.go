@@ -0,0 +1,96 @@
+// backend/internal/gl-core/handler/coa_template_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type COATemplateHandler struct {
+	service service.COATemplateServiceInterface
+}
+
+// NewCOATemplateHandler creates a new chart of accounts template handler
+func NewCOATemplateHandler(service service.COATemplateServiceInterface) *COATemplateHandler {
+	return &COATemplateHandler{service: service}
+}
+
+// ListTemplates handles GET /coa-templates
+func (h *COATemplateHandler) ListTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, mapper.ToCOATemplateListResponse(h.service.ListTemplates()))
+}
+
+// GetTemplate handles GET /coa-templates/:code
+func (h *COATemplateHandler) GetTemplate(c *gin.Context) {
+	template, err := h.service.GetTemplate(c.Param("code"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Template not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCOATemplateResponse(template))
+}
+
+// ListApplications handles GET /coa-templates/applications?organization_id=
+func (h *COATemplateHandler) ListApplications(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	apps, err := h.service.ListApplications(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list template applications",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToCOATemplateApplicationListResponse(apps))
+}
+
+// ApplyTemplate handles POST /coa-templates/:code/apply
+// Provisions the organization's chart of accounts, or upgrades it to the template's current version
+func (h *COATemplateHandler) ApplyTemplate(c *gin.Context) {
+	var req dto.ApplyCOATemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	app, err := h.service.ApplyTemplate(c.Request.Context(), orgID, c.Param("code"), getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to apply template",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToCOATemplateApplicationResponse(app))
+}
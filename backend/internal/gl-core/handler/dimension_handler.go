@@ -0,0 +1,225 @@
+// backend/internal/gl-core/handler/dimension_handler.go
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DimensionHandler struct {
+	service service.DimensionServiceInterface
+}
+
+// NewDimensionHandler creates a new analytic dimension handler
+func NewDimensionHandler(service service.DimensionServiceInterface) *DimensionHandler {
+	return &DimensionHandler{service: service}
+}
+
+// CreateDimension handles POST /dimensions
+func (h *DimensionHandler) CreateDimension(c *gin.Context) {
+	var req dto.CreateDimensionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	dimension, err := h.service.CreateDimension(c.Request.Context(), orgID, req.Code, req.Name, toAccountTypes(req.RequiredFor))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create dimension",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToDimensionResponse(dimension))
+}
+
+// ListDimensions handles GET /dimensions?organization_id=
+func (h *DimensionHandler) ListDimensions(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	dimensions, err := h.service.ListDimensions(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list dimensions",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToDimensionListResponse(dimensions))
+}
+
+// GetDimension handles GET /dimensions/:id
+func (h *DimensionHandler) GetDimension(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid dimension ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	dimension, err := h.service.GetDimension(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Dimension not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToDimensionResponse(dimension))
+}
+
+// UpdateDimension handles PUT /dimensions/:id
+func (h *DimensionHandler) UpdateDimension(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid dimension ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.UpdateDimensionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	existing, err := h.service.GetDimension(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Dimension not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	isActive := existing.IsActive
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	dimension, err := h.service.UpdateDimension(c.Request.Context(), id, req.Name, toAccountTypes(req.RequiredFor), isActive)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update dimension",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToDimensionResponse(dimension))
+}
+
+// AddValue handles POST /dimensions/:id/values
+func (h *DimensionHandler) AddValue(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid dimension ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.CreateDimensionValueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	value, err := h.service.AddValue(c.Request.Context(), id, req.Code, req.Name)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to add dimension value",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToDimensionValueResponse(value))
+}
+
+// UpdateValue handles PUT /dimensions/values/:valueId
+func (h *DimensionHandler) UpdateValue(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("valueId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid dimension value ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.UpdateDimensionValueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	value, err := h.service.UpdateValue(c.Request.Context(), id, req.Name, isActive)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update dimension value",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToDimensionValueResponse(value))
+}
+
+// toAccountTypes converts request account type names to domain account types
+func toAccountTypes(values []string) []domain.AccountType {
+	types := make([]domain.AccountType, 0, len(values))
+	for _, v := range values {
+		types = append(types, domain.AccountType(strings.ToUpper(strings.TrimSpace(v))))
+	}
+	return types
+}
@@ -3,33 +3,72 @@ package dto
 
 import (
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
 // CreateAccountRequest represents the request body for creating an account
 type CreateAccountRequest struct {
+	OrganizationID uuid.UUID  `json:"organization_id" binding:"required"`
 	Code     string             `json:"code" binding:"required"`
 	Name     string             `json:"name" binding:"required"`
 	Type     domain.AccountType `json:"type" binding:"required"`
 	ParentCode *uuid.UUID         `json:"parent_id"`
+	IsPostable *bool              `json:"is_postable"` // Defaults to true; false for control accounts
 }
 
 // UpdateAccountRequest represents the request body for updating an account
 type UpdateAccountRequest struct {
 	Name     string     `json:"name" binding:"required"`
 	ParentCode *uuid.UUID `json:"parent_id"`
+	IsPostable *bool      `json:"is_postable"` // Unchanged when omitted
 }
 
 // AccountResponse represents the response structure for account operations
 type AccountResponse struct {
 	ID        uuid.UUID          `json:"id"`
+	OrganizationID uuid.UUID     `json:"organization_id"`
 	Code      string             `json:"code"`
 	Name      string             `json:"name"`
 	Type      domain.AccountType `json:"type"`
 	ParentCode  *uuid.UUID         `json:"parent_id,omitempty"`
 	IsActive  bool               `json:"is_active"`
+	IsPostable bool              `json:"is_postable"`
 	CreatedAt string             `json:"created_at"`
 	UpdatedAt string             `json:"updated_at"`
 }
 
 
+
+// MoveAccountRequest represents the request body for re-parenting an account
+type MoveAccountRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"` // Omit or null to make a top-level account
+}
+
+// MergeAccountRequest represents the request body for merging an account into another
+type MergeAccountRequest struct {
+	TargetAccountID uuid.UUID `json:"target_account_id" binding:"required"`
+	Reason          string    `json:"reason" binding:"required"`
+}
+
+// AccountTreeNodeResponse represents an account and its children in the chart of accounts tree
+type AccountTreeNodeResponse struct {
+	AccountResponse
+	Balance          money.Amount              `json:"balance"`
+	AggregateBalance money.Amount              `json:"aggregate_balance"`
+	Children         []AccountTreeNodeResponse `json:"children"`
+}
+
+// AccountMergeResponse represents the audit record of an account merge
+type AccountMergeResponse struct {
+	ID              string `json:"id"`
+	OrganizationID  string `json:"organization_id"`
+	SourceAccountID string `json:"source_account_id"`
+	SourceCode      string `json:"source_code"`
+	TargetAccountID string `json:"target_account_id"`
+	TargetCode      string `json:"target_code"`
+	LinesMoved      int64  `json:"lines_moved"`
+	Reason          string `json:"reason"`
+	MergedBy        string `json:"merged_by"`
+	MergedAt        string `json:"merged_at"`
+}
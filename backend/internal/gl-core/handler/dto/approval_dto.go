@@ -0,0 +1,43 @@
+// backend/internal/gl-core/handler/dto/approval_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// ApprovalActionRequest represents the request body for submitting, approving or rejecting an entry
+type ApprovalActionRequest struct {
+	Comment string `json:"comment"` // Required when rejecting
+}
+
+// JournalEntryApprovalResponse represents one step of an entry's approval history
+type JournalEntryApprovalResponse struct {
+	ID             string `json:"id"`
+	JournalEntryID string `json:"journal_entry_id"`
+	Action         string `json:"action"`
+	UserID         string `json:"user_id"`
+	Comment        string `json:"comment"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// ApprovalRuleRequest represents the request body for creating or updating an approval rule
+type ApprovalRuleRequest struct {
+	OrganizationID           string       `json:"organization_id"` // Required on create
+	Name                     string       `json:"name" binding:"required"`
+	MinAmount                money.Amount `json:"min_amount"`  // Entries totalling at least this much; 0 for any amount
+	AccountIDs               []string     `json:"account_ids"` // Entries touching any of these accounts; empty for any account
+	RequireDifferentApprover bool         `json:"require_different_approver"`
+	IsActive                 *bool        `json:"is_active"` // Update only; unchanged when omitted
+}
+
+// ApprovalRuleResponse represents an approval rule
+type ApprovalRuleResponse struct {
+	ID                       string       `json:"id"`
+	OrganizationID           string       `json:"organization_id"`
+	Name                     string       `json:"name"`
+	MinAmount                money.Amount `json:"min_amount"`
+	AccountIDs               []string     `json:"account_ids"`
+	RequireDifferentApprover bool         `json:"require_different_approver"`
+	IsActive                 bool         `json:"is_active"`
+	CreatedBy                string       `json:"created_by"`
+	CreatedAt                string       `json:"created_at"`
+	UpdatedAt                string       `json:"updated_at"`
+}
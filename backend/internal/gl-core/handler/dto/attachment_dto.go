@@ -0,0 +1,16 @@
+// backend/internal/gl-core/handler/dto/attachment_dto.go
+package dto
+
+// AttachmentResponse represents a supporting document's metadata
+type AttachmentResponse struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organization_id"`
+	EntityType     string `json:"entity_type"` // JOURNAL_ENTRY or IMPORT
+	EntityID       string `json:"entity_id"`
+	FileName       string `json:"file_name"`
+	ContentType    string `json:"content_type"`
+	SizeBytes      int64  `json:"size_bytes"`
+	Checksum       string `json:"checksum"` // Hex SHA-256 of the content
+	UploadedBy     string `json:"uploaded_by"`
+	CreatedAt      string `json:"created_at"`
+}
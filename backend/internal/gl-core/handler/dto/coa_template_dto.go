@@ -0,0 +1,56 @@
+// backend/internal/gl-core/handler/dto/coa_template_dto.go
+package dto
+
+// COATemplateSummaryResponse represents a template in the template list
+type COATemplateSummaryResponse struct {
+	Code         string   `json:"code"`
+	Version      int      `json:"version"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Industries   []string `json:"industries"`
+	AccountCount int      `json:"account_count"`
+}
+
+// COATemplateResponse represents a template with its accounts, tax accounts and payroll mappings
+type COATemplateResponse struct {
+	COATemplateSummaryResponse
+	Accounts        []COATemplateAccountResponse        `json:"accounts"`
+	TaxAccounts     []COATemplateAccountResponse        `json:"tax_accounts"`
+	PayrollMappings []COATemplatePayrollMappingResponse `json:"payroll_mappings"`
+}
+
+// COATemplateAccountResponse represents one account in a template
+type COATemplateAccountResponse struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	ParentCode string `json:"parent_code,omitempty"`
+	IsPostable bool   `json:"is_postable"`
+	TaxRole    string `json:"tax_role,omitempty"`
+}
+
+// COATemplatePayrollMappingResponse represents a template's default payroll GL mapping
+type COATemplatePayrollMappingResponse struct {
+	ComponentType string `json:"component_type"`
+	ComponentName string `json:"component_name"`
+	DebitCode     string `json:"debit_code"`
+	CreditCode    string `json:"credit_code"`
+	Description   string `json:"description"`
+}
+
+// ApplyCOATemplateRequest represents the request body for applying or upgrading a template
+type ApplyCOATemplateRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required"`
+}
+
+// COATemplateApplicationResponse represents a template version applied to an organization
+type COATemplateApplicationResponse struct {
+	ID              string  `json:"id"`
+	OrganizationID  string  `json:"organization_id"`
+	TemplateCode    string  `json:"template_code"`
+	Version         int     `json:"version"`
+	AccountsCreated int     `json:"accounts_created"`
+	MappingsCreated int     `json:"mappings_created"`
+	AppliedBy       *string `json:"applied_by,omitempty"`
+	AppliedAt       string  `json:"applied_at"`
+}
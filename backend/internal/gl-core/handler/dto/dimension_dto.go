@@ -0,0 +1,51 @@
+// backend/internal/gl-core/handler/dto/dimension_dto.go
+package dto
+
+// CreateDimensionRequest represents the request body for defining an analytic dimension
+type CreateDimensionRequest struct {
+	OrganizationID string   `json:"organization_id" binding:"required"`
+	Code           string   `json:"code" binding:"required"` // e.g. COST_CENTER, DEPARTMENT, PROJECT, SERVICE_LINE
+	Name           string   `json:"name" binding:"required"`
+	RequiredFor    []string `json:"required_for"` // Account types whose lines must carry a value
+}
+
+// UpdateDimensionRequest represents the request body for updating a dimension
+type UpdateDimensionRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	RequiredFor []string `json:"required_for"`
+	IsActive    *bool    `json:"is_active"` // Unchanged when omitted
+}
+
+// CreateDimensionValueRequest represents the request body for adding a dimension value
+type CreateDimensionValueRequest struct {
+	Code string `json:"code" binding:"required"`
+	Name string `json:"name" binding:"required"`
+}
+
+// UpdateDimensionValueRequest represents the request body for updating a dimension value
+type UpdateDimensionValueRequest struct {
+	Name     string `json:"name" binding:"required"`
+	IsActive *bool  `json:"is_active"` // Unchanged when omitted
+}
+
+// DimensionResponse represents a dimension and its values
+type DimensionResponse struct {
+	ID             string                   `json:"id"`
+	OrganizationID string                   `json:"organization_id"`
+	Code           string                   `json:"code"`
+	Name           string                   `json:"name"`
+	RequiredFor    []string                 `json:"required_for"`
+	IsActive       bool                     `json:"is_active"`
+	Values         []DimensionValueResponse `json:"values"`
+	CreatedAt      string                   `json:"created_at"`
+	UpdatedAt      string                   `json:"updated_at"`
+}
+
+// DimensionValueResponse represents a dimension value
+type DimensionValueResponse struct {
+	ID          string `json:"id"`
+	DimensionID string `json:"dimension_id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	IsActive    bool   `json:"is_active"`
+}
@@ -0,0 +1,27 @@
+// backend/internal/gl-core/handler/dto/exchange_rate_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// SetExchangeRateRequest represents the request body for recording an exchange rate
+type SetExchangeRateRequest struct {
+	OrganizationID string     `json:"organization_id" binding:"required"`
+	FromCurrency   string     `json:"from_currency" binding:"required"`
+	ToCurrency     string     `json:"to_currency"` // Defaults to the organization's base currency
+	Rate           money.Rate `json:"rate" binding:"required"`
+	EffectiveDate  string     `json:"effective_date" binding:"required"` // YYYY-MM-DD
+}
+
+// ExchangeRateResponse represents the response for an exchange rate
+type ExchangeRateResponse struct {
+	ID             string     `json:"id"`
+	OrganizationID string     `json:"organization_id"`
+	FromCurrency   string     `json:"from_currency"`
+	ToCurrency     string     `json:"to_currency"`
+	Rate           money.Rate `json:"rate"`
+	EffectiveDate  string     `json:"effective_date"`
+	Source         string     `json:"source"`
+	CreatedBy      string     `json:"created_by"`
+	CreatedAt      string     `json:"created_at"`
+	UpdatedAt      string     `json:"updated_at"`
+}
@@ -0,0 +1,91 @@
+// backend/internal/gl-core/handler/dto/fiscal_period_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// CreateFiscalYearRequest represents the request body for creating a fiscal year
+type CreateFiscalYearRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required"`
+	Name           string `json:"name"`                          // Defaults to FY<end year>
+	StartDate      string `json:"start_date" binding:"required"` // YYYY-MM-DD, first day of a month
+}
+
+// PeriodStatusRequest represents the request body for closing or reopening a period
+type PeriodStatusRequest struct {
+	Reason string `json:"reason"` // Required when reopening
+}
+
+// CloseFiscalYearRequest represents the request body for a year-end close
+type CloseFiscalYearRequest struct {
+	RetainedEarningsAccountID string `json:"retained_earnings_account_id" binding:"required"`
+}
+
+// ReopenFiscalYearRequest represents the request body for reopening a closed fiscal year
+type ReopenFiscalYearRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// FiscalYearResponse represents the response for a fiscal year
+type FiscalYearResponse struct {
+	ID             string                     `json:"id"`
+	OrganizationID string                     `json:"organization_id"`
+	Name           string                     `json:"name"`
+	StartDate      string                     `json:"start_date"`
+	EndDate        string                     `json:"end_date"`
+	Status         string                     `json:"status"`
+	Periods        []AccountingPeriodResponse `json:"periods,omitempty"`
+	CreatedBy      string                     `json:"created_by"`
+	CreatedAt      string                     `json:"created_at"`
+	UpdatedAt      string                     `json:"updated_at"`
+}
+
+// AccountingPeriodResponse represents the response for an accounting period
+type AccountingPeriodResponse struct {
+	ID           string  `json:"id"`
+	FiscalYearID string  `json:"fiscal_year_id"`
+	PeriodNumber int     `json:"period_number"`
+	Name         string  `json:"name"`
+	StartDate    string  `json:"start_date"`
+	EndDate      string  `json:"end_date"`
+	Status       string  `json:"status"`
+	ClosedBy     *string `json:"closed_by,omitempty"`
+	ClosedAt     *string `json:"closed_at,omitempty"`
+}
+
+// PeriodEventResponse represents a period status change in the response
+type PeriodEventResponse struct {
+	ID          string `json:"id"`
+	PeriodID    string `json:"period_id"`
+	Action      string `json:"action"`
+	FromStatus  string `json:"from_status"`
+	ToStatus    string `json:"to_status"`
+	Reason      string `json:"reason,omitempty"`
+	PerformedBy string `json:"performed_by"`
+	PerformedAt string `json:"performed_at"`
+}
+
+// YearEndCloseResponse represents the response for a year-end close
+type YearEndCloseResponse struct {
+	ID                        string                   `json:"id"`
+	OrganizationID            string                   `json:"organization_id"`
+	FiscalYearID              string                   `json:"fiscal_year_id"`
+	NextFiscalYearID          string                   `json:"next_fiscal_year_id"`
+	RetainedEarningsAccountID string                   `json:"retained_earnings_account_id"`
+	ClosingEntryID            *string                  `json:"closing_entry_id,omitempty"`
+	ReversalEntryID           *string                  `json:"reversal_entry_id,omitempty"`
+	NetIncome                 money.Amount             `json:"net_income"`
+	Status                    string                   `json:"status"`
+	OpeningBalances           []OpeningBalanceResponse `json:"opening_balances"`
+	ClosedBy                  string                   `json:"closed_by"`
+	ClosedAt                  string                   `json:"closed_at"`
+	ReversedBy                *string                  `json:"reversed_by,omitempty"`
+	ReversedAt                *string                  `json:"reversed_at,omitempty"`
+	ReversalReason            string                   `json:"reversal_reason,omitempty"`
+}
+
+// OpeningBalanceResponse represents an account balance brought forward into a fiscal year
+type OpeningBalanceResponse struct {
+	AccountID string       `json:"account_id"`
+	Debit     money.Amount `json:"debit"`
+	Credit    money.Amount `json:"credit"`
+}
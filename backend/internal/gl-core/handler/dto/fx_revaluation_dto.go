@@ -0,0 +1,59 @@
+// backend/internal/gl-core/handler/dto/fx_revaluation_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// UpdateFXRevaluationSettingsRequest represents the request body for setting revaluation accounts
+type UpdateFXRevaluationSettingsRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required"`
+	GainAccountID  string `json:"gain_account_id" binding:"required"`
+	LossAccountID  string `json:"loss_account_id" binding:"required"`
+}
+
+// FXRevaluationSettingsResponse represents an organization's revaluation accounts
+type FXRevaluationSettingsResponse struct {
+	OrganizationID string `json:"organization_id"`
+	GainAccountID  string `json:"gain_account_id"`
+	LossAccountID  string `json:"loss_account_id"`
+	UpdatedBy      string `json:"updated_by"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+// RunFXRevaluationRequest represents the request body for previewing or running a revaluation
+type RunFXRevaluationRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required"`
+	AsOfDate       string `json:"as_of_date" binding:"required"` // YYYY-MM-DD, usually a period end
+}
+
+// FXRevaluationResponse represents a revaluation run (or preview) and its report
+type FXRevaluationResponse struct {
+	ID                 string                      `json:"id"`
+	OrganizationID     string                      `json:"organization_id"`
+	AsOfDate           string                      `json:"as_of_date"`
+	ReversalDate       string                      `json:"reversal_date"`
+	BaseCurrency       string                      `json:"base_currency"`
+	GainAccountID      string                      `json:"gain_account_id"`
+	LossAccountID      string                      `json:"loss_account_id"`
+	RevaluationEntryID *string                     `json:"revaluation_entry_id,omitempty"`
+	ReversalEntryID    *string                     `json:"reversal_entry_id,omitempty"`
+	TotalGain          money.Amount                `json:"total_gain"`
+	TotalLoss          money.Amount                `json:"total_loss"`
+	NetGain            money.Amount                `json:"net_gain"`
+	Lines              []FXRevaluationLineResponse `json:"lines,omitempty"`
+	CreatedBy          string                      `json:"created_by"`
+	CreatedAt          string                      `json:"created_at"`
+}
+
+// FXRevaluationLineResponse represents one account's balance before and after revaluation
+type FXRevaluationLineResponse struct {
+	AccountID       string       `json:"account_id"`
+	AccountCode     string       `json:"account_code"`
+	AccountName     string       `json:"account_name"`
+	AccountType     string       `json:"account_type"`
+	Currency        string       `json:"currency"`
+	ForeignBalance  money.Amount `json:"foreign_balance"`
+	ClosingRate     money.Rate   `json:"closing_rate"`
+	BookBalance     money.Amount `json:"book_balance"`
+	RevaluedBalance money.Amount `json:"revalued_balance"`
+	Adjustment      money.Amount `json:"adjustment"`
+}
@@ -1,22 +1,34 @@
 // backend/internal/gl-core/handler/dto/journal_entry_dto.go
 package dto
 
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
 // CreateJournalEntryRequest represents the request body for creating a journal entry
 type CreateJournalEntryRequest struct {
     OrganizationID  string               `json:"organization_id" binding:"required"`
+    JournalType     string               `json:"journal_type"`                        // GENERAL (default), PAYROLL, BANK, SALES or PURCHASE
     TransactionDate string               `json:"transaction_date" binding:"required"` // YYYY-MM-DD
     Reference       string               `json:"reference"`
     Description     string               `json:"description" binding:"required"`
+    AutoReverseDate string               `json:"auto_reverse_date"` // YYYY-MM-DD; accruals are reversed automatically on this date
     Lines           []JournalLineRequest `json:"lines" binding:"required,min=2"`
 }
 
-// JournalLineRequest represents a journal line in the request
+// JournalLineRequest represents a journal line in the request.
+// Foreign currency lines set currency and foreign_debit/foreign_credit instead of
+// debit/credit; exchange_rate defaults to the rate in effect on the transaction date.
+// dimensions tags the line with analytic values, e.g. {"COST_CENTER": "CC-100"}.
 type JournalLineRequest struct {
-    AccountID   string  `json:"account_id" binding:"required"`
-    Reference   string  `json:"reference"`
-    Description string  `json:"description" binding:"required"`
-    Debit       float64 `json:"debit"`
-    Credit      float64 `json:"credit"`
+    AccountID     string            `json:"account_id" binding:"required"`
+    Reference     string            `json:"reference"`
+    Description   string            `json:"description" binding:"required"`
+    Debit         money.Amount      `json:"debit"`
+    Credit        money.Amount      `json:"credit"`
+    Currency      string            `json:"currency"`
+    ForeignDebit  money.Amount      `json:"foreign_debit"`
+    ForeignCredit money.Amount      `json:"foreign_credit"`
+    ExchangeRate  money.Rate        `json:"exchange_rate"`
+    Dimensions    map[string]string `json:"dimensions"`
 }
 
 // UpdateJournalEntryRequest represents the request body for updating a journal entry
@@ -24,6 +36,7 @@ type UpdateJournalEntryRequest struct {
     TransactionDate string               `json:"transaction_date" binding:"required"` // YYYY-MM-DD
     Reference       string               `json:"reference"`
     Description     string               `json:"description" binding:"required"`
+    AutoReverseDate string               `json:"auto_reverse_date"` // YYYY-MM-DD; accruals are reversed automatically on this date
     Lines           []JournalLineRequest `json:"lines" binding:"required,min=2"`
 }
 
@@ -32,13 +45,17 @@ type JournalEntryResponse struct {
     ID              string                `json:"id"`
     OrganizationID  string                `json:"organization_id"`
     EntryNumber     string                `json:"entry_number"`
+    JournalType     string                `json:"journal_type"`
     TransactionDate string                `json:"transaction_date"`
     PostingDate     *string               `json:"posting_date"`
     Reference       string                `json:"reference"`
     Description     string                `json:"description"`
     Status          string                `json:"status"`
-    TotalDebit      float64               `json:"total_debit"`
-    TotalCredit     float64               `json:"total_credit"`
+    AutoReverseDate *string               `json:"auto_reverse_date"`
+    ReversalOf      *string               `json:"reversal_of,omitempty"`
+    ImportLogID     *string               `json:"import_log_id,omitempty"`
+    TotalDebit      money.Amount          `json:"total_debit"`
+    TotalCredit     money.Amount          `json:"total_credit"`
     Lines           []JournalLineResponse `json:"lines"`
     CreatedAt       string                `json:"created_at"`
     UpdatedAt       string                `json:"updated_at"`
@@ -46,13 +63,18 @@ type JournalEntryResponse struct {
 
 // JournalLineResponse represents a journal line in the response
 type JournalLineResponse struct {
-    ID          string  `json:"id"`
-    AccountID   string  `json:"account_id"`
-    LineNumber  int     `json:"line_number"`
-    Reference   string  `json:"reference"`
-    Description string  `json:"description"`
-    Debit       float64 `json:"debit"`
-    Credit      float64 `json:"credit"`
+    ID            string            `json:"id"`
+    AccountID     string            `json:"account_id"`
+    LineNumber    int               `json:"line_number"`
+    Reference     string            `json:"reference"`
+    Description   string            `json:"description"`
+    Debit         money.Amount      `json:"debit"`
+    Credit        money.Amount      `json:"credit"`
+    Currency      string            `json:"currency,omitempty"`
+    ForeignDebit  *money.Amount     `json:"foreign_debit,omitempty"`
+    ForeignCredit *money.Amount     `json:"foreign_credit,omitempty"`
+    ExchangeRate  *money.Rate       `json:"exchange_rate,omitempty"`
+    Dimensions    map[string]string `json:"dimensions,omitempty"`
 }
 
 // SuccessResponse represents a success response
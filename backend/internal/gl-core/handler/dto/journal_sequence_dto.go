@@ -0,0 +1,28 @@
+// backend/internal/gl-core/handler/dto/journal_sequence_dto.go
+package dto
+
+// SaveJournalSequenceRequest represents the request body for configuring a journal type's numbering
+type SaveJournalSequenceRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required"`
+	Prefix         string `json:"prefix" binding:"required"`       // e.g. PAY
+	ResetPolicy    string `json:"reset_policy" binding:"required"` // YEARLY, MONTHLY or NEVER
+	Padding        int    `json:"padding"`                         // Minimum digits; defaults to 5
+}
+
+// JournalSequenceResponse represents a journal type's numbering
+type JournalSequenceResponse struct {
+	OrganizationID string `json:"organization_id"`
+	JournalType    string `json:"journal_type"`
+	Prefix         string `json:"prefix"`
+	ResetPolicy    string `json:"reset_policy"`
+	Padding        int    `json:"padding"`
+	IsDefault      bool   `json:"is_default"` // Not configured; built-in numbering applies
+	Example        string `json:"example"`    // Format of the first number for today
+}
+
+// NextEntryNumberResponse represents a preview of the next entry number
+type NextEntryNumberResponse struct {
+	JournalType string `json:"journal_type"`
+	Date        string `json:"date"`
+	EntryNumber string `json:"entry_number"` // Not reserved; allocated when an entry is posted
+}
@@ -0,0 +1,64 @@
+// backend/internal/gl-core/handler/dto/opening_balance_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// PostOpeningBalancesRequest represents the request body for previewing or posting opening balances
+type PostOpeningBalancesRequest struct {
+	OrganizationID    string                      `json:"organization_id" binding:"required"`
+	GoLiveDate        string                      `json:"go_live_date" binding:"required"` // YYYY-MM-DD
+	Description       string                      `json:"description"`
+	SuspenseAccountID string                      `json:"suspense_account_id"` // Optional; takes any difference between debits and credits
+	Lines             []OpeningBalanceLineRequest `json:"lines" binding:"required,min=1"`
+}
+
+// OpeningBalanceLineRequest represents one account's balance at go-live.
+// The account is given by account_id or account_code. party_reference splits
+// an account's balance by customer or supplier.
+type OpeningBalanceLineRequest struct {
+	AccountID      string       `json:"account_id"`
+	AccountCode    string       `json:"account_code"`
+	PartyReference string       `json:"party_reference"`
+	Description    string       `json:"description"`
+	Debit          money.Amount `json:"debit"`
+	Credit         money.Amount `json:"credit"`
+}
+
+// ReverseOpeningBalancesRequest represents the request body for reversing opening balances
+type ReverseOpeningBalancesRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// OpeningBalancesResponse represents a set of opening balances (or a preview)
+type OpeningBalancesResponse struct {
+	ID                string                       `json:"id"`
+	OrganizationID    string                       `json:"organization_id"`
+	GoLiveDate        string                       `json:"go_live_date"`
+	Description       string                       `json:"description"`
+	Status            string                       `json:"status"`
+	EntryID           *string                      `json:"entry_id,omitempty"`
+	SuspenseAccountID *string                      `json:"suspense_account_id,omitempty"`
+	SuspenseAmount    money.Amount                 `json:"suspense_amount"`
+	TotalDebit        money.Amount                 `json:"total_debit"`
+	TotalCredit       money.Amount                 `json:"total_credit"`
+	Lines             []OpeningBalanceLineResponse `json:"lines,omitempty"`
+	Warnings          []string                     `json:"warnings,omitempty"`
+	CreatedBy         string                       `json:"created_by"`
+	CreatedAt         string                       `json:"created_at"`
+	ReversalEntryID   *string                      `json:"reversal_entry_id,omitempty"`
+	ReversedBy        *string                      `json:"reversed_by,omitempty"`
+	ReversedAt        *string                      `json:"reversed_at,omitempty"`
+	ReversalReason    string                       `json:"reversal_reason,omitempty"`
+}
+
+// OpeningBalanceLineResponse represents one account's balance at go-live
+type OpeningBalanceLineResponse struct {
+	LineNumber     int          `json:"line_number"`
+	AccountID      string       `json:"account_id"`
+	AccountCode    string       `json:"account_code"`
+	AccountName    string       `json:"account_name"`
+	PartyReference string       `json:"party_reference,omitempty"`
+	Description    string       `json:"description"`
+	Debit          money.Amount `json:"debit"`
+	Credit         money.Amount `json:"credit"`
+}
@@ -0,0 +1,86 @@
+// backend/internal/gl-core/handler/dto/recurring_journal_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// RecurringJournalRequest represents the request body for creating or updating a recurring journal template
+type RecurringJournalRequest struct {
+	OrganizationID string                        `json:"organization_id"` // Required on create
+	Name           string                        `json:"name" binding:"required"`
+	Description    string                        `json:"description" binding:"required"` // Generated entries add the month, e.g. "Office rent (Jan 2026)"
+	Reference      string                        `json:"reference"`
+	Frequency      string                        `json:"frequency" binding:"required"`    // MONTHLY, QUARTERLY, SEMI_ANNUAL, ANNUAL
+	DayOfMonth     int                           `json:"day_of_month" binding:"required"` // 1-31, clamped to the month end
+	StartDate      string                        `json:"start_date" binding:"required"`   // YYYY-MM-DD
+	EndDate        string                        `json:"end_date"`                        // YYYY-MM-DD, empty for open-ended
+	AutoPost       bool                          `json:"auto_post"`                       // Post generated entries, or submit them when an approval rule applies
+	Lines          []RecurringJournalLineRequest `json:"lines" binding:"required,min=2"`
+}
+
+// RecurringJournalLineRequest represents a template line in the request
+type RecurringJournalLineRequest struct {
+	AccountID   string            `json:"account_id" binding:"required"`
+	Description string            `json:"description" binding:"required"`
+	Debit       money.Amount      `json:"debit"`
+	Credit      money.Amount      `json:"credit"`
+	Dimensions  map[string]string `json:"dimensions"`
+}
+
+// SkipOccurrenceRequest represents the request body for skipping an occurrence
+type SkipOccurrenceRequest struct {
+	OccurrenceDate string `json:"occurrence_date" binding:"required"` // YYYY-MM-DD
+}
+
+// GenerateRecurringJournalRequest represents the request body for generating due occurrences now
+type GenerateRecurringJournalRequest struct {
+	AsOfDate string `json:"as_of_date"` // YYYY-MM-DD, defaults to today
+}
+
+// RecurringJournalResponse represents a recurring journal template
+type RecurringJournalResponse struct {
+	ID             string                         `json:"id"`
+	OrganizationID string                         `json:"organization_id"`
+	Name           string                         `json:"name"`
+	Description    string                         `json:"description"`
+	Reference      string                         `json:"reference"`
+	Frequency      string                         `json:"frequency"`
+	DayOfMonth     int                            `json:"day_of_month"`
+	StartDate      string                         `json:"start_date"`
+	EndDate        *string                        `json:"end_date"`
+	AutoPost       bool                           `json:"auto_post"`
+	Status         string                         `json:"status"`
+	NextRunDate    *string                        `json:"next_run_date"`
+	LastRunDate    *string                        `json:"last_run_date"`
+	Lines          []RecurringJournalLineResponse `json:"lines"`
+	CreatedBy      string                         `json:"created_by"`
+	CreatedAt      string                         `json:"created_at"`
+	UpdatedAt      string                         `json:"updated_at"`
+}
+
+// RecurringJournalLineResponse represents a template line
+type RecurringJournalLineResponse struct {
+	ID          string            `json:"id"`
+	LineNumber  int               `json:"line_number"`
+	AccountID   string            `json:"account_id"`
+	Description string            `json:"description"`
+	Debit       money.Amount      `json:"debit"`
+	Credit      money.Amount      `json:"credit"`
+	Dimensions  map[string]string `json:"dimensions,omitempty"`
+}
+
+// RecurringOccurrenceResponse represents an upcoming occurrence in a schedule preview
+type RecurringOccurrenceResponse struct {
+	Date    string `json:"date"`
+	Skipped bool   `json:"skipped"`
+}
+
+// RecurringJournalRunResponse represents a generated or skipped occurrence
+type RecurringJournalRunResponse struct {
+	ID             string  `json:"id"`
+	TemplateID     string  `json:"template_id"`
+	OccurrenceDate string  `json:"occurrence_date"`
+	Status         string  `json:"status"`
+	JournalEntryID *string `json:"journal_entry_id"`
+	CreatedBy      string  `json:"created_by"`
+	CreatedAt      string  `json:"created_at"`
+}
@@ -0,0 +1,114 @@
+// backend/internal/gl-core/handler/dto/report_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// TrialBalanceResponse represents the response for a trial balance report
+type TrialBalanceResponse struct {
+	OrganizationID     string                     `json:"organization_id"`
+	FromDate           *string                    `json:"from_date,omitempty"`
+	ToDate             string                     `json:"to_date"`
+	Lines              []TrialBalanceLineResponse `json:"lines"`
+	TotalOpeningDebit  money.Amount               `json:"total_opening_debit"`
+	TotalOpeningCredit money.Amount               `json:"total_opening_credit"`
+	TotalPeriodDebit   money.Amount               `json:"total_period_debit"`
+	TotalPeriodCredit  money.Amount               `json:"total_period_credit"`
+	TotalClosingDebit  money.Amount               `json:"total_closing_debit"`
+	TotalClosingCredit money.Amount               `json:"total_closing_credit"`
+	IsBalanced         bool                       `json:"is_balanced"`
+	Dimensions         map[string]string          `json:"dimensions,omitempty"`
+	GeneratedAt        string                     `json:"generated_at"`
+}
+
+// TrialBalanceLineResponse represents an account row in the trial balance response
+type TrialBalanceLineResponse struct {
+	AccountID     string       `json:"account_id"`
+	Code          string       `json:"code"`
+	Name          string       `json:"name"`
+	Type          string       `json:"type"`
+	ParentID      *string      `json:"parent_id,omitempty"`
+	Level         int          `json:"level"`
+	HasChildren   bool         `json:"has_children"`
+	OpeningDebit  money.Amount `json:"opening_debit"`
+	OpeningCredit money.Amount `json:"opening_credit"`
+	PeriodDebit   money.Amount `json:"period_debit"`
+	PeriodCredit  money.Amount `json:"period_credit"`
+	ClosingDebit  money.Amount `json:"closing_debit"`
+	ClosingCredit money.Amount `json:"closing_credit"`
+}
+
+// FinancialStatementResponse represents the response for an income statement or balance sheet
+type FinancialStatementResponse struct {
+	Type           string                     `json:"type"`
+	OrganizationID string                     `json:"organization_id"`
+	Columns        []StatementColumnResponse  `json:"columns"`
+	Sections       []StatementSectionResponse `json:"sections"`
+	Summary        []StatementLineResponse    `json:"summary"`
+	Dimensions     map[string]string          `json:"dimensions,omitempty"`
+	GeneratedAt    string                     `json:"generated_at"`
+}
+
+// StatementColumnResponse represents an amount column in a statement response
+type StatementColumnResponse struct {
+	Label    string  `json:"label"`
+	FromDate *string `json:"from_date,omitempty"`
+	ToDate   string  `json:"to_date"`
+}
+
+// StatementSectionResponse represents a statement section in the response
+type StatementSectionResponse struct {
+	Type   string                  `json:"type"`
+	Title  string                  `json:"title"`
+	Lines  []StatementLineResponse `json:"lines"`
+	Totals []money.Amount          `json:"totals"`
+}
+
+// StatementLineResponse represents a statement line in the response
+type StatementLineResponse struct {
+	AccountID  *string        `json:"account_id,omitempty"`
+	Code       string         `json:"code,omitempty"`
+	Name       string         `json:"name"`
+	Level      int            `json:"level"`
+	IsSubtotal bool           `json:"is_subtotal"`
+	Amounts    []money.Amount `json:"amounts"`
+}
+
+// AccountStatementResponse represents an account statement in the response
+type AccountStatementResponse struct {
+	OrganizationID string                         `json:"organization_id"`
+	AccountID      string                         `json:"account_id"`
+	AccountCode    string                         `json:"account_code"`
+	AccountName    string                         `json:"account_name"`
+	AccountType    string                         `json:"account_type"`
+	FromDate       string                         `json:"from_date"`
+	ToDate         string                         `json:"to_date"`
+	OpeningBalance money.Amount                   `json:"opening_balance"`
+	TotalDebit     money.Amount                   `json:"total_debit"`
+	TotalCredit    money.Amount                   `json:"total_credit"`
+	ClosingBalance money.Amount                   `json:"closing_balance"`
+	Lines          []AccountStatementLineResponse `json:"lines"`
+	Dimensions     map[string]string              `json:"dimensions,omitempty"`
+	GeneratedAt    string                         `json:"generated_at"`
+}
+
+// AccountStatementLineResponse represents an account statement line in the response
+type AccountStatementLineResponse struct {
+	JournalEntryID  string                   `json:"journal_entry_id"`
+	LineID          string                   `json:"line_id"`
+	EntryNumber     string                   `json:"entry_number"`
+	JournalType     string                   `json:"journal_type"`
+	TransactionDate string                   `json:"transaction_date"`
+	Reference       string                   `json:"reference"`
+	Description     string                   `json:"description"`
+	CounterAccounts []CounterAccountResponse `json:"counter_accounts"`
+	Debit           money.Amount             `json:"debit"`
+	Credit          money.Amount             `json:"credit"`
+	Balance         money.Amount             `json:"balance"`
+}
+
+// CounterAccountResponse represents an account on the other side of a statement line
+type CounterAccountResponse struct {
+	AccountID string `json:"account_id"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+}
@@ -0,0 +1,183 @@
+// backend/internal/gl-core/handler/exchange_rate_handler.go
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ExchangeRateHandler struct {
+	service service.ExchangeRateServiceInterface
+}
+
+// NewExchangeRateHandler creates a new exchange rate handler
+func NewExchangeRateHandler(service service.ExchangeRateServiceInterface) *ExchangeRateHandler {
+	return &ExchangeRateHandler{service: service}
+}
+
+// SetExchangeRate handles POST /exchange-rates
+// Replaces the rate if one already exists for the pair and date
+func (h *ExchangeRateHandler) SetExchangeRate(c *gin.Context) {
+	var req dto.SetExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid effective date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return
+	}
+
+	rate, err := h.service.SetRate(c.Request.Context(), orgID, req.FromCurrency, req.ToCurrency, req.Rate, effectiveDate, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to set exchange rate",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToExchangeRateResponse(rate))
+}
+
+// ListExchangeRates handles GET /exchange-rates?organization_id=&from_currency=&to_currency=&from_date=&to_date=
+func (h *ExchangeRateHandler) ListExchangeRates(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	filter := repository.ExchangeRateFilter{
+		OrganizationID: orgID,
+		FromCurrency:   c.Query("from_currency"),
+		ToCurrency:     c.Query("to_currency"),
+	}
+	if filter.FromDate, err = parseOptionalDate(c, "from_date"); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid from_date format",
+			Message: err.Error(),
+		})
+		return
+	}
+	if filter.ToDate, err = parseOptionalDate(c, "to_date"); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid to_date format",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rates, err := h.service.ListRates(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list exchange rates",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToExchangeRateListResponse(rates))
+}
+
+// GetEffectiveRate handles GET /exchange-rates/lookup?organization_id=&from_currency=&to_currency=&date=
+// to_currency defaults to the organization's base currency and date to today
+func (h *ExchangeRateHandler) GetEffectiveRate(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	date := time.Now().Truncate(24 * time.Hour)
+	if value := c.Query("date"); value != "" {
+		date, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid date format",
+				Message: "Use YYYY-MM-DD format",
+			})
+			return
+		}
+	}
+
+	rate, err := h.service.GetRate(c.Request.Context(), orgID, c.Query("from_currency"), c.Query("to_currency"), date)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Exchange rate not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToExchangeRateResponse(rate))
+}
+
+// DeleteExchangeRate handles DELETE /exchange-rates/:id
+func (h *ExchangeRateHandler) DeleteExchangeRate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid exchange rate ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.service.DeleteRate(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Failed to delete exchange rate",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Success: true,
+		Message: "Exchange rate deleted",
+	})
+}
+
+// parseOptionalDate reads an optional YYYY-MM-DD query param
+func parseOptionalDate(c *gin.Context, param string) (*time.Time, error) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be in YYYY-MM-DD format", param)
+	}
+	return &parsed, nil
+}
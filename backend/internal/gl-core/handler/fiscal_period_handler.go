@@ -0,0 +1,316 @@
+// backend/internal/gl-core/handler/fiscal_period_handler.go
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type FiscalPeriodHandler struct {
+	service      service.FiscalPeriodServiceInterface
+	closeService service.YearEndCloseServiceInterface
+}
+
+// NewFiscalPeriodHandler creates a new fiscal period handler
+func NewFiscalPeriodHandler(
+	service service.FiscalPeriodServiceInterface,
+	closeService service.YearEndCloseServiceInterface,
+) *FiscalPeriodHandler {
+	return &FiscalPeriodHandler{
+		service:      service,
+		closeService: closeService,
+	}
+}
+
+// CreateFiscalYear handles POST /fiscal-years
+func (h *FiscalPeriodHandler) CreateFiscalYear(c *gin.Context) {
+	var req dto.CreateFiscalYearRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid start date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return
+	}
+
+	fy, err := h.service.CreateFiscalYear(c.Request.Context(), orgID, req.Name, startDate, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create fiscal year",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToFiscalYearResponse(fy))
+}
+
+// ListFiscalYears handles GET /fiscal-years?organization_id=
+func (h *FiscalPeriodHandler) ListFiscalYears(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	years, err := h.service.ListFiscalYears(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list fiscal years",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToFiscalYearListResponse(years))
+}
+
+// GetFiscalYear handles GET /fiscal-years/:id
+func (h *FiscalPeriodHandler) GetFiscalYear(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid fiscal year ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	fy, err := h.service.GetFiscalYear(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Fiscal year not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToFiscalYearResponse(fy))
+}
+
+// CloseFiscalYear handles POST /fiscal-years/:id/close
+// Posts the year-end closing entry; repeating the call returns the existing close
+func (h *FiscalPeriodHandler) CloseFiscalYear(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid fiscal year ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.CloseFiscalYearRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	retainedEarningsID, err := uuid.Parse(req.RetainedEarningsAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid retained earnings account ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	yc, err := h.closeService.CloseFiscalYear(c.Request.Context(), id, retainedEarningsID, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to close fiscal year",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToYearEndCloseResponse(yc))
+}
+
+// ReopenFiscalYear handles POST /fiscal-years/:id/reopen
+// Reverses the year-end closing entry and its opening balances
+func (h *FiscalPeriodHandler) ReopenFiscalYear(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid fiscal year ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.ReopenFiscalYearRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	yc, err := h.closeService.ReopenFiscalYear(c.Request.Context(), id, getUserIDFromContext(c), req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to reopen fiscal year",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToYearEndCloseResponse(yc))
+}
+
+// GetYearEndClose handles GET /fiscal-years/:id/closing
+func (h *FiscalPeriodHandler) GetYearEndClose(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid fiscal year ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	yc, err := h.closeService.GetYearEndClose(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Year-end close not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToYearEndCloseResponse(yc))
+}
+
+// GetOpeningBalances handles GET /fiscal-years/:id/opening-balances
+func (h *FiscalPeriodHandler) GetOpeningBalances(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid fiscal year ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	balances, err := h.closeService.GetOpeningBalances(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to get opening balances",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToOpeningBalanceListResponse(balances))
+}
+
+// GetPeriodHistory handles GET /periods/:id/history
+func (h *FiscalPeriodHandler) GetPeriodHistory(c *gin.Context) {
+	periodID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid period ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	events, err := h.service.GetPeriodHistory(c.Request.Context(), periodID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Failed to get period history",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToPeriodEventListResponse(events))
+}
+
+// SoftClosePeriod handles POST /periods/:id/soft-close
+func (h *FiscalPeriodHandler) SoftClosePeriod(c *gin.Context) {
+	h.changePeriodStatus(c, "Failed to soft-close period", h.service.SoftClosePeriod)
+}
+
+// ClosePeriod handles POST /periods/:id/close
+func (h *FiscalPeriodHandler) ClosePeriod(c *gin.Context) {
+	h.changePeriodStatus(c, "Failed to close period", h.service.ClosePeriod)
+}
+
+// ReopenPeriod handles POST /periods/:id/reopen
+func (h *FiscalPeriodHandler) ReopenPeriod(c *gin.Context) {
+	h.changePeriodStatus(c, "Failed to reopen period", h.service.ReopenPeriod)
+}
+
+// changePeriodStatus parses the period ID and reason and applies a status change
+func (h *FiscalPeriodHandler) changePeriodStatus(
+	c *gin.Context,
+	failure string,
+	change func(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error),
+) {
+	periodID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid period ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Body is optional for close actions
+	var req dto.PeriodStatusRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid request body",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	period, err := change(c.Request.Context(), periodID, getUserIDFromContext(c), req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   failure,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAccountingPeriodResponse(period))
+}
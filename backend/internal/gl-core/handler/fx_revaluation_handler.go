@@ -0,0 +1,227 @@
+// backend/internal/gl-core/handler/fx_revaluation_handler.go
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type FXRevaluationHandler struct {
+	service service.FXRevaluationServiceInterface
+}
+
+// NewFXRevaluationHandler creates a new FX revaluation handler
+func NewFXRevaluationHandler(service service.FXRevaluationServiceInterface) *FXRevaluationHandler {
+	return &FXRevaluationHandler{service: service}
+}
+
+// GetSettings handles GET /fx-revaluations/settings?organization_id=
+func (h *FXRevaluationHandler) GetSettings(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	settings, err := h.service.GetSettings(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "FX revaluation settings not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToFXRevaluationSettingsResponse(settings))
+}
+
+// UpdateSettings handles PUT /fx-revaluations/settings
+func (h *FXRevaluationHandler) UpdateSettings(c *gin.Context) {
+	var req dto.UpdateFXRevaluationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ids := make([]uuid.UUID, 3)
+	for i, value := range []string{req.OrganizationID, req.GainAccountID, req.LossAccountID} {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid ID",
+				Message: fmt.Sprintf("%s: %v", value, err),
+			})
+			return
+		}
+		ids[i] = id
+	}
+
+	settings, err := h.service.UpdateSettings(c.Request.Context(), ids[0], ids[1], ids[2], getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update FX revaluation settings",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToFXRevaluationSettingsResponse(settings))
+}
+
+// Preview handles POST /fx-revaluations/preview
+// Returns the before and after values without posting anything
+func (h *FXRevaluationHandler) Preview(c *gin.Context) {
+	orgID, asOfDate, ok := bindRunFXRevaluation(c)
+	if !ok {
+		return
+	}
+
+	rev, err := h.service.Preview(c.Request.Context(), orgID, asOfDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to preview FX revaluation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToFXRevaluationResponse(rev))
+}
+
+// Run handles POST /fx-revaluations
+// Posts the unrealized gain/loss entry as of the date and its reversal on the following day
+func (h *FXRevaluationHandler) Run(c *gin.Context) {
+	orgID, asOfDate, ok := bindRunFXRevaluation(c)
+	if !ok {
+		return
+	}
+
+	rev, err := h.service.Run(c.Request.Context(), orgID, asOfDate, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to run FX revaluation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToFXRevaluationResponse(rev))
+}
+
+// ListRevaluations handles GET /fx-revaluations?organization_id=
+func (h *FXRevaluationHandler) ListRevaluations(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	runs, err := h.service.ListRevaluations(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list FX revaluations",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToFXRevaluationListResponse(runs))
+}
+
+// GetRevaluation handles GET /fx-revaluations/:id
+// Optional query param: format (csv, xlsx, pdf) to download the report
+func (h *FXRevaluationHandler) GetRevaluation(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid revaluation ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rev, err := h.service.GetRevaluation(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "FX revaluation not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		c.JSON(http.StatusOK, mapper.ToFXRevaluationResponse(rev))
+		return
+	}
+
+	exportFormat, err := service.ParseExportFormat(format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid export format",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	data, err := service.ExportFXRevaluation(rev, exportFormat)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to export FX revaluation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	fileName := fmt.Sprintf("fx_revaluation_%s.%s", rev.AsOfDate.Format("2006-01-02"), exportFormat)
+	sendExport(c, fileName, exportFormat, data)
+}
+
+// bindRunFXRevaluation reads the organization and as-of date of a preview or run request,
+// writing the error response and returning false when they are invalid
+func bindRunFXRevaluation(c *gin.Context) (uuid.UUID, time.Time, bool) {
+	var req dto.RunFXRevaluationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return uuid.Nil, time.Time{}, false
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, time.Time{}, false
+	}
+
+	asOfDate, err := time.Parse("2006-01-02", req.AsOfDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid as_of_date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return uuid.Nil, time.Time{}, false
+	}
+
+	return orgID, asOfDate, true
+}
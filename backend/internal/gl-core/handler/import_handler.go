@@ -1,50 +1,60 @@
 package handler
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
 )
 
 // ImportHandler handles Excel import requests
 type ImportHandler struct {
 	importService *service.ImportService
+	jobs          *service.ImportJobService
 }
 
 // NewImportHandler creates a new ImportHandler
-func NewImportHandler(importService *service.ImportService) *ImportHandler {
+func NewImportHandler(importService *service.ImportService, jobs *service.ImportJobService) *ImportHandler {
 	return &ImportHandler{
 		importService: importService,
+		jobs:          jobs,
 	}
 }
 
 // ImportChartOfAccountsRequest represents the request for importing chart of accounts
 type ImportChartOfAccountsRequest struct {
+	OrganizationID string `form:"organization_id" binding:"required"`
 	LegacySystem   string `form:"legacy_system"`
 	LegacyVersion  string `form:"legacy_version"`
 	SkipDuplicates bool   `form:"skip_duplicates"`
 	UpdateExisting bool   `form:"update_existing"`
 	ValidateOnly   bool   `form:"validate_only"`
 	MigrationNotes string `form:"migration_notes"`
+	TypeMappings   string `form:"type_mappings"` // JSON object of legacy group or type to account type
 }
 
 // ImportChartOfAccounts handles chart of accounts import
-// @Summary Import chart of accounts from Excel
-// @Description Upload Excel file to import chart of accounts
+// @Summary Import chart of accounts
+// @Description Upload an Excel workbook, or a Tally XML or QuickBooks IIF/CSV export, to import chart of accounts. The import runs in the background; poll the returned job for progress and the result. Legacy ledger groups and account types map to account types by built-in rules that type_mappings can override.
 // @Tags Import
 // @Accept multipart/form-data
 // @Produce json
-// @Param file formData file true "Excel file"
+// @Param file formData file true "Excel workbook, Tally XML export or QuickBooks IIF/CSV export"
+// @Param organization_id formData string true "Organization whose chart of accounts is imported"
 // @Param legacy_system formData string false "Legacy system (tally, quickbooks, excel)"
 // @Param skip_duplicates formData bool false "Skip duplicate accounts"
 // @Param update_existing formData bool false "Update existing accounts"
 // @Param validate_only formData bool false "Only validate, don't import"
-// @Success 200 {object} service.ImportResult
+// @Param type_mappings formData string false "JSON object mapping legacy ledger groups or account types to account types"
+// @Success 202 {object} domain.ImportJob
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /api/v1/gl/import/accounts [post]
@@ -70,21 +80,10 @@ func (h *ImportHandler) ImportChartOfAccounts(c *gin.Context) {
 	defer file.Close()
 
 	// Validate file extension
-	ext := filepath.Ext(header.Filename)
-	if ext != ".xlsx" && ext != ".xls" {
+	if !importExtensions[strings.ToLower(filepath.Ext(header.Filename))] {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "Invalid file format",
-			Message: "Only .xlsx and .xls files are supported",
-		})
-		return
-	}
-
-	// Save uploaded file temporarily
-	tempFile := filepath.Join("/tmp", fmt.Sprintf("import_%s_%s", uuid.New().String(), header.Filename))
-	if err := c.SaveUploadedFile(header, tempFile); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to save file",
-			Message: err.Error(),
+			Message: "Only .xlsx, .xls, Tally .xml and QuickBooks .iif/.csv files are supported",
 		})
 		return
 	}
@@ -105,56 +104,71 @@ func (h *ImportHandler) ImportChartOfAccounts(c *gin.Context) {
 		userID = uuid.New() // Fallback for testing
 	}
 
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	typeMappings, err := parseTypeMappings(req.TypeMappings)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid type mappings",
+			Message: err.Error(),
+		})
+		return
+	}
+
 	// Prepare import options
 	options := service.ImportOptions{
+		OrganizationID: orgID,
 		LegacySystem:   req.LegacySystem,
 		LegacyVersion:  req.LegacyVersion,
+		TypeMappings:   typeMappings,
 		SkipDuplicates: req.SkipDuplicates,
 		UpdateExisting: req.UpdateExisting,
 		ValidateOnly:   req.ValidateOnly,
 		MigrationNotes: req.MigrationNotes,
 	}
 
-	// Perform import
-	result, err := h.importService.ImportChartOfAccounts(
-		c.Request.Context(),
-		tempFile,
-		header.Filename,
-		header.Size,
-		userID,
-		options,
-	)
-
+	// Queue the import; the client polls the job for progress and the result
+	job, err := h.jobs.Submit(c.Request.Context(), domain.ImportTypeAccounts, header.Filename, header.Size, file, userID, options)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Import failed",
+			Error:   "Failed to queue import",
 			Message: err.Error(),
 		})
 		return
 	}
 
-	// Return result
-	c.JSON(http.StatusOK, result)
+	c.JSON(http.StatusAccepted, job)
 }
 
 // ImportJournalEntriesRequest represents the request for importing journal entries
 type ImportJournalEntriesRequest struct {
+	OrganizationID string `form:"organization_id" binding:"required"`
 	LegacySystem   string `form:"legacy_system"`
 	LegacyVersion  string `form:"legacy_version"`
 	ValidateOnly   bool   `form:"validate_only"`
+	AutoPost       bool   `form:"auto_post"`
 	MigrationNotes string `form:"migration_notes"`
 }
 
 // ImportJournalEntries handles journal entries import
-// @Summary Import journal entries from Excel
-// @Description Upload Excel file to import journal entries
+// @Summary Import journal entries
+// @Description Upload an Excel workbook, or Tally vouchers or QuickBooks transactions exported as XML or IIF/CSV, to import journal entries. The import runs in the background; poll the returned job for progress and the result. Legacy accounts are matched by name.
 // @Tags Import
 // @Accept multipart/form-data
 // @Produce json
-// @Param file formData file true "Excel file"
+// @Param file formData file true "Excel workbook, Tally XML export or QuickBooks IIF/CSV export"
+// @Param organization_id formData string true "Organization the entries belong to"
 // @Param legacy_system formData string false "Legacy system"
 // @Param validate_only formData bool false "Only validate, don't import"
-// @Success 200 {object} service.ImportResult
+// @Param auto_post formData bool false "Post the imported entries instead of saving drafts"
+// @Success 202 {object} domain.ImportJob
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /api/v1/gl/import/journal-entries [post]
@@ -180,21 +194,10 @@ func (h *ImportHandler) ImportJournalEntries(c *gin.Context) {
 	defer file.Close()
 
 	// Validate file extension
-	ext := filepath.Ext(header.Filename)
-	if ext != ".xlsx" && ext != ".xls" {
+	if !importExtensions[strings.ToLower(filepath.Ext(header.Filename))] {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error:   "Invalid file format",
-			Message: "Only .xlsx and .xls files are supported",
-		})
-		return
-	}
-
-	// Save uploaded file temporarily
-	tempFile := filepath.Join("/tmp", fmt.Sprintf("import_%s_%s", uuid.New().String(), header.Filename))
-	if err := c.SaveUploadedFile(header, tempFile); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to save file",
-			Message: err.Error(),
+			Message: "Only .xlsx, .xls, Tally .xml and QuickBooks .iif/.csv files are supported",
 		})
 		return
 	}
@@ -215,34 +218,267 @@ func (h *ImportHandler) ImportJournalEntries(c *gin.Context) {
 		userID = uuid.New() // Fallback for testing
 	}
 
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
 	// Prepare import options
 	options := service.ImportOptions{
+		OrganizationID: orgID,
 		LegacySystem:   req.LegacySystem,
 		LegacyVersion:  req.LegacyVersion,
 		ValidateOnly:   req.ValidateOnly,
+		AutoPost:       req.AutoPost,
 		MigrationNotes: req.MigrationNotes,
 	}
 
-	// Perform import
-	result, err := h.importService.ImportJournalEntries(
-		c.Request.Context(),
-		tempFile,
-		header.Filename,
-		header.Size,
-		userID,
-		options,
-	)
+	// Queue the import; the client polls the job for progress and the result
+	job, err := h.jobs.Submit(c.Request.Context(), domain.ImportTypeJournalEntries, header.Filename, header.Size, file, userID, options)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to queue import",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// ListImports lists past import runs
+// @Summary List imports
+// @Description List an organization's chart of accounts and journal entry import runs, newest first
+// @Tags Import
+// @Produce json
+// @Param organization_id query string true "Organization"
+// @Param type query string false "Import type (ACCOUNTS, JOURNAL_ENTRIES)"
+// @Param limit query int false "Page size (default 50, max 100)"
+// @Param offset query int false "Offset"
+// @Success 200 {array} domain.ImportBatch
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/gl/import/batches [get]
+func (h *ImportHandler) ListImports(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	importType := domain.ImportType(strings.ToUpper(c.Query("type")))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
+	batches, err := h.importService.ListImports(c.Request.Context(), orgID, importType, limit, offset)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Import failed",
+			Error:   "Failed to list imports",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, batches)
+}
+
+// GetImport returns one import run with its row errors
+// @Summary Get import
+// @Description Get an import run with its row errors
+// @Tags Import
+// @Produce json
+// @Param id path string true "Import log ID"
+// @Param organization_id query string true "Organization"
+// @Success 200 {object} domain.ImportBatch
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/gl/import/batches/{id} [get]
+func (h *ImportHandler) GetImport(c *gin.Context) {
+	orgID, batchID, ok := parseImportBatchParams(c)
+	if !ok {
+		return
+	}
+
+	batch, err := h.importService.GetImport(c.Request.Context(), orgID, batchID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Import not found",
 			Message: err.Error(),
 		})
 		return
 	}
 
-	// Return result
-	c.JSON(http.StatusOK, result)
+	c.JSON(http.StatusOK, batch)
+}
+
+// DownloadErrorReport downloads a workbook with an import run's failing rows annotated
+// @Summary Download import error report
+// @Description Download the uploaded workbook with failing rows highlighted and an Import Errors column
+// @Tags Import
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param id path string true "Import log ID"
+// @Param organization_id query string true "Organization"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/gl/import/batches/{id}/error-report [get]
+func (h *ImportHandler) DownloadErrorReport(c *gin.Context) {
+	orgID, batchID, ok := parseImportBatchParams(c)
+	if !ok {
+		return
+	}
+
+	data, fileName, err := h.importService.ErrorReport(c.Request.Context(), orgID, batchID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Failed to build error report",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, service.ExportFormatXLSX.ContentType(), data)
+}
+
+// RollbackImport undoes an import run
+// @Summary Roll back an import
+// @Description Deactivate the accounts or void the journal entries an import run created, when that is safe
+// @Tags Import
+// @Produce json
+// @Param id path string true "Import log ID"
+// @Param organization_id query string true "Organization"
+// @Success 200 {object} domain.ImportBatch
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/gl/import/batches/{id}/rollback [post]
+func (h *ImportHandler) RollbackImport(c *gin.Context) {
+	orgID, batchID, ok := parseImportBatchParams(c)
+	if !ok {
+		return
+	}
+
+	batch, err := h.importService.RollbackImport(c.Request.Context(), orgID, batchID, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Failed to roll back import",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, batch)
+}
+
+// parseImportBatchParams reads the import log ID path param and organization_id
+// query param, writing a 400 response when either is invalid
+func parseImportBatchParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	batchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid import log ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return orgID, batchID, true
+}
+
+// GetImportJob returns a background import's status and progress
+// @Summary Get import job
+// @Description Poll a background import. Once the job has finished, result holds the import result.
+// @Tags Import
+// @Produce json
+// @Param id path string true "Import job ID"
+// @Param organization_id query string true "Organization"
+// @Success 200 {object} domain.ImportJob
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /api/v1/gl/import/jobs/{id} [get]
+func (h *ImportHandler) GetImportJob(c *gin.Context) {
+	orgID, jobID, ok := parseImportJobParams(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.jobs.GetJob(c.Request.Context(), orgID, jobID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error:   "Import job not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// CancelImportJob cancels a background import
+// @Summary Cancel import job
+// @Description Cancel a queued import, or stop a running one. A stopped journal entry import saves nothing; accounts a stopped chart import already created are kept and can be rolled back.
+// @Tags Import
+// @Produce json
+// @Param id path string true "Import job ID"
+// @Param organization_id query string true "Organization"
+// @Success 200 {object} domain.ImportJob
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/gl/import/jobs/{id}/cancel [post]
+func (h *ImportHandler) CancelImportJob(c *gin.Context) {
+	orgID, jobID, ok := parseImportJobParams(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.jobs.CancelJob(c.Request.Context(), orgID, jobID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Failed to cancel import job",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// parseImportJobParams reads the job ID path param and organization_id query
+// param, writing a 400 response when either is invalid
+func parseImportJobParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid import job ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return orgID, jobID, true
 }
 
 // DownloadTemplate downloads an import template
@@ -280,4 +516,25 @@ func (h *ImportHandler) DownloadTemplate(c *gin.Context) {
 	c.FileAttachment(filePath, filepath.Base(filePath))
 }
 
+// importExtensions are the upload formats the import endpoints accept
+var importExtensions = map[string]bool{
+	".xlsx": true,
+	".xls":  true,
+	".xml":  true, // Tally
+	".iif":  true, // QuickBooks Desktop
+	".csv":  true, // QuickBooks report export
+}
+
+// parseTypeMappings reads the optional type_mappings form field
+func parseTypeMappings(value string) (map[string]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var mappings map[string]string
+	if err := json.Unmarshal([]byte(value), &mappings); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
 // ErrorResponse represents an error response
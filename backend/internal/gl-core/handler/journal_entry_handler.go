@@ -2,14 +2,17 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/chaitu35/costeasy/backend/pkg/contextx"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -54,15 +57,35 @@ func (h *JournalEntryHandler) CreateJournalEntry(c *gin.Context) {
 		return
 	}
 
+	autoReverseDate, ok := parseAutoReverseDate(c, req.AutoReverseDate)
+	if !ok {
+		return
+	}
+
+	// Reversal, closing, revaluation and recurring entries are system generated
+	journalType := domain.JournalTypeGeneral
+	if req.JournalType != "" {
+		journalType = domain.JournalType(strings.ToUpper(req.JournalType))
+	}
+	if !journalType.IsManual() {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid journal type",
+			Message: "Use GENERAL, PAYROLL, BANK, SALES or PURCHASE",
+		})
+		return
+	}
+
 	// Get user ID from context (set by auth middleware)
 	userID := getUserIDFromContext(c)
 
 	// Convert request to domain model
 	entry := &domain.JournalEntry{
 		OrganizationID:  orgID,
+		JournalType:     journalType,
 		TransactionDate: transactionDate,
 		Reference:       req.Reference,
 		Description:     req.Description,
+		AutoReverseDate: autoReverseDate,
 		CreatedBy:       userID,
 		Lines:           make([]domain.JournalLine, len(req.Lines)),
 	}
@@ -78,11 +101,16 @@ func (h *JournalEntryHandler) CreateJournalEntry(c *gin.Context) {
 		}
 
 		entry.Lines[i] = domain.JournalLine{
-			AccountID:   accountID,
-			Reference:   line.Reference,
-			Description: line.Description,
-			Debit:       line.Debit,
-			Credit:      line.Credit,
+			AccountID:     accountID,
+			Reference:     line.Reference,
+			Description:   line.Description,
+			Debit:         line.Debit,
+			Credit:        line.Credit,
+			Currency:      line.Currency,
+			ForeignDebit:  line.ForeignDebit,
+			ForeignCredit: line.ForeignCredit,
+			ExchangeRate:  line.ExchangeRate,
+			Dimensions:    domain.LineDimensions(line.Dimensions),
 		}
 	}
 
@@ -131,6 +159,11 @@ func (h *JournalEntryHandler) UpdateJournalEntry(c *gin.Context) {
 		return
 	}
 
+	autoReverseDate, ok := parseAutoReverseDate(c, req.AutoReverseDate)
+	if !ok {
+		return
+	}
+
 	// Get existing entry
 	existingEntry, err := h.service.GetEntry(c.Request.Context(), entryID)
 	if err != nil {
@@ -145,6 +178,7 @@ func (h *JournalEntryHandler) UpdateJournalEntry(c *gin.Context) {
 	existingEntry.TransactionDate = transactionDate
 	existingEntry.Reference = req.Reference
 	existingEntry.Description = req.Description
+	existingEntry.AutoReverseDate = autoReverseDate
 	existingEntry.Lines = make([]domain.JournalLine, len(req.Lines))
 
 	for i, line := range req.Lines {
@@ -158,11 +192,16 @@ func (h *JournalEntryHandler) UpdateJournalEntry(c *gin.Context) {
 		}
 
 		existingEntry.Lines[i] = domain.JournalLine{
-			AccountID:   accountID,
-			Reference:   line.Reference,
-			Description: line.Description,
-			Debit:       line.Debit,
-			Credit:      line.Credit,
+			AccountID:     accountID,
+			Reference:     line.Reference,
+			Description:   line.Description,
+			Debit:         line.Debit,
+			Credit:        line.Credit,
+			Currency:      line.Currency,
+			ForeignDebit:  line.ForeignDebit,
+			ForeignCredit: line.ForeignCredit,
+			ExchangeRate:  line.ExchangeRate,
+			Dimensions:    domain.LineDimensions(line.Dimensions),
 		}
 	}
 
@@ -299,7 +338,8 @@ func (h *JournalEntryHandler) VoidJournalEntry(c *gin.Context) {
 	})
 }
 
-// ReverseJournalEntry creates a reversal entry
+// ReverseJournalEntry creates a draft reversal entry; the original is marked
+// REVERSED when the reversal posts
 func (h *JournalEntryHandler) ReverseJournalEntry(c *gin.Context) {
 	entryID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -325,6 +365,80 @@ func (h *JournalEntryHandler) ReverseJournalEntry(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
+// SubmitJournalEntry sends a draft entry for approval
+func (h *JournalEntryHandler) SubmitJournalEntry(c *gin.Context) {
+	h.handleApprovalAction(c, h.service.SubmitEntry, "Failed to submit journal entry")
+}
+
+// ApproveJournalEntry approves and posts a pending entry
+func (h *JournalEntryHandler) ApproveJournalEntry(c *gin.Context) {
+	h.handleApprovalAction(c, h.service.ApproveEntry, "Failed to approve journal entry")
+}
+
+// RejectJournalEntry returns a pending entry to DRAFT
+func (h *JournalEntryHandler) RejectJournalEntry(c *gin.Context) {
+	h.handleApprovalAction(c, h.service.RejectEntry, "Failed to reject journal entry")
+}
+
+// ListJournalEntryApprovals lists an entry's approval history
+func (h *JournalEntryHandler) ListJournalEntryApprovals(c *gin.Context) {
+	entryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid entry ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	approvals, err := h.service.ListApprovals(c.Request.Context(), entryID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list approval history",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToJournalEntryApprovalListResponse(approvals))
+}
+
+// handleApprovalAction runs a submit, approve or reject action for the current user
+func (h *JournalEntryHandler) handleApprovalAction(
+	c *gin.Context,
+	action func(ctx context.Context, entryID, userID uuid.UUID, comment string) (*domain.JournalEntry, error),
+	failure string,
+) {
+	entryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid entry ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var req dto.ApprovalActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	entry, err := action(c.Request.Context(), entryID, getUserIDFromContext(c), req.Comment)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   failure,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToJournalEntryResponse(entry))
+}
+
 // DeleteJournalEntry deletes a draft entry
 func (h *JournalEntryHandler) DeleteJournalEntry(c *gin.Context) {
 	entryID, err := uuid.Parse(c.Param("id"))
@@ -351,9 +465,31 @@ func (h *JournalEntryHandler) DeleteJournalEntry(c *gin.Context) {
 	})
 }
 
+// parseAutoReverseDate parses the optional auto-reverse date, writing the error
+// response and returning false when it is invalid
+func parseAutoReverseDate(c *gin.Context, value string) (*time.Time, bool) {
+	if value == "" {
+		return nil, true
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid auto-reverse date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return nil, false
+	}
+
+	return &date, true
+}
+
 // Helper function
 func getUserIDFromContext(c *gin.Context) uuid.UUID {
-	// TODO: Extract user ID from JWT token or session
-	// For now, return a dummy UUID
+	if uc, ok := contextx.Get(c.Request.Context()); ok {
+		return uc.UserID
+	}
+	// TODO: Require authentication on all gl-core routes
+	// Unauthenticated routes fall back to a dummy UUID
 	return uuid.New()
 }
@@ -0,0 +1,127 @@
+// backend/internal/gl-core/handler/journal_sequence_handler.go
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type JournalSequenceHandler struct {
+	service service.JournalSequenceServiceInterface
+}
+
+// NewJournalSequenceHandler creates a new journal sequence handler
+func NewJournalSequenceHandler(service service.JournalSequenceServiceInterface) *JournalSequenceHandler {
+	return &JournalSequenceHandler{service: service}
+}
+
+// ListSequences handles GET /journal-sequences?organization_id=
+func (h *JournalSequenceHandler) ListSequences(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	sequences, err := h.service.ListSequences(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list journal sequences",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToJournalSequenceListResponse(sequences))
+}
+
+// SaveSequence handles PUT /journal-sequences/:type
+func (h *JournalSequenceHandler) SaveSequence(c *gin.Context) {
+	var req dto.SaveJournalSequenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	seq, err := h.service.SaveSequence(
+		c.Request.Context(),
+		orgID,
+		domain.JournalType(strings.ToUpper(c.Param("type"))),
+		req.Prefix,
+		domain.SequenceResetPolicy(strings.ToUpper(req.ResetPolicy)),
+		req.Padding,
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to save journal sequence",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToJournalSequenceResponse(seq))
+}
+
+// PreviewNextNumber handles GET /journal-sequences/:type/next?organization_id=&date=
+// date defaults to today.
+func (h *JournalSequenceHandler) PreviewNextNumber(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	date := time.Now()
+	if value := c.Query("date"); value != "" {
+		date, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid date format",
+				Message: "Use YYYY-MM-DD format",
+			})
+			return
+		}
+	}
+
+	journalType := domain.JournalType(strings.ToUpper(c.Param("type")))
+	entryNumber, err := h.service.PreviewNextNumber(c.Request.Context(), orgID, journalType, date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to preview entry number",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NextEntryNumberResponse{
+		JournalType: string(journalType),
+		Date:        date.Format("2006-01-02"),
+		EntryNumber: entryNumber,
+	})
+}
@@ -8,13 +8,44 @@ import (
 // ToAccountResponse converts domain.Account to AccountResponse
 func ToAccountResponse(account domain.GLAccount) dto.AccountResponse {
 	return dto.AccountResponse{
-		ID:         account.ID,
-		Code:       account.Code,
-		Name:       account.Name,
-		Type:       account.Type,
-		ParentCode: account.ParentCode,
-		IsActive:   account.IsActive,
-		CreatedAt:  account.CreateAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:  account.UpdateAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:             account.ID,
+		OrganizationID: account.OrganizationID,
+		Code:           account.Code,
+		Name:           account.Name,
+		Type:           account.Type,
+		ParentCode:     account.ParentCode,
+		IsActive:       account.IsActive,
+		CreatedAt:      account.CreateAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      account.UpdateAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToAccountTreeResponse converts an account tree
+func ToAccountTreeResponse(nodes []*domain.AccountTreeNode) []dto.AccountTreeNodeResponse {
+	responses := make([]dto.AccountTreeNodeResponse, len(nodes))
+	for i, node := range nodes {
+		responses[i] = dto.AccountTreeNodeResponse{
+			AccountResponse:  ToAccountResponse(node.Account),
+			Balance:          node.Balance,
+			AggregateBalance: node.AggregateBalance,
+			Children:         ToAccountTreeResponse(node.Children),
+		}
+	}
+	return responses
+}
+
+// ToAccountMergeResponse converts domain.AccountMerge to AccountMergeResponse
+func ToAccountMergeResponse(m *domain.AccountMerge) dto.AccountMergeResponse {
+	return dto.AccountMergeResponse{
+		ID:              m.ID.String(),
+		OrganizationID:  m.OrganizationID.String(),
+		SourceAccountID: m.SourceAccountID.String(),
+		SourceCode:      m.SourceCode,
+		TargetAccountID: m.TargetAccountID.String(),
+		TargetCode:      m.TargetCode,
+		LinesMoved:      m.LinesMoved,
+		Reason:          m.Reason,
+		MergedBy:        m.MergedBy.String(),
+		MergedAt:        m.MergedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
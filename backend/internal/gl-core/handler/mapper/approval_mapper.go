@@ -0,0 +1,54 @@
+// backend/internal/gl-core/handler/mapper/approval_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToApprovalRuleResponse converts domain.ApprovalRule to ApprovalRuleResponse
+func ToApprovalRuleResponse(rule *domain.ApprovalRule) dto.ApprovalRuleResponse {
+	response := dto.ApprovalRuleResponse{
+		ID:                       rule.ID.String(),
+		OrganizationID:           rule.OrganizationID.String(),
+		Name:                     rule.Name,
+		MinAmount:                rule.MinAmount,
+		AccountIDs:               make([]string, len(rule.AccountIDs)),
+		RequireDifferentApprover: rule.RequireDifferentApprover,
+		IsActive:                 rule.IsActive,
+		CreatedBy:                rule.CreatedBy.String(),
+		CreatedAt:                rule.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:                rule.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	for i, id := range rule.AccountIDs {
+		response.AccountIDs[i] = id.String()
+	}
+
+	return response
+}
+
+// ToApprovalRuleListResponse converts approval rules to responses
+func ToApprovalRuleListResponse(rules []*domain.ApprovalRule) []dto.ApprovalRuleResponse {
+	responses := make([]dto.ApprovalRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = ToApprovalRuleResponse(rule)
+	}
+	return responses
+}
+
+// ToJournalEntryApprovalListResponse converts an entry's approval history to responses
+func ToJournalEntryApprovalListResponse(approvals []*domain.JournalEntryApproval) []dto.JournalEntryApprovalResponse {
+	responses := make([]dto.JournalEntryApprovalResponse, len(approvals))
+	for i, a := range approvals {
+		responses[i] = dto.JournalEntryApprovalResponse{
+			ID:             a.ID.String(),
+			JournalEntryID: a.JournalEntryID.String(),
+			Action:         string(a.Action),
+			UserID:         a.UserID.String(),
+			Comment:        a.Comment,
+			CreatedAt:      a.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return responses
+}
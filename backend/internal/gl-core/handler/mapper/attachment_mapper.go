@@ -0,0 +1,32 @@
+// backend/internal/gl-core/handler/mapper/attachment_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToAttachmentResponse converts domain.Attachment to AttachmentResponse
+func ToAttachmentResponse(a *domain.Attachment) dto.AttachmentResponse {
+	return dto.AttachmentResponse{
+		ID:             a.ID.String(),
+		OrganizationID: a.OrganizationID.String(),
+		EntityType:     string(a.EntityType),
+		EntityID:       a.EntityID.String(),
+		FileName:       a.FileName,
+		ContentType:    a.ContentType,
+		SizeBytes:      a.SizeBytes,
+		Checksum:       a.Checksum,
+		UploadedBy:     a.UploadedBy.String(),
+		CreatedAt:      a.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToAttachmentListResponse converts attachments to responses
+func ToAttachmentListResponse(attachments []*domain.Attachment) []dto.AttachmentResponse {
+	responses := make([]dto.AttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		responses[i] = ToAttachmentResponse(a)
+	}
+	return responses
+}
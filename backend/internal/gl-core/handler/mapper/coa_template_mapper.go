@@ -0,0 +1,92 @@
+// backend/internal/gl-core/handler/mapper/coa_template_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/google/uuid"
+)
+
+// ToCOATemplateSummaryResponse converts domain.COATemplate to COATemplateSummaryResponse
+func ToCOATemplateSummaryResponse(t *domain.COATemplate) dto.COATemplateSummaryResponse {
+	return dto.COATemplateSummaryResponse{
+		Code:         t.Code,
+		Version:      t.Version,
+		Name:         t.Name,
+		Description:  t.Description,
+		Industries:   t.Industries,
+		AccountCount: len(t.Accounts),
+	}
+}
+
+// ToCOATemplateListResponse converts templates to summary responses
+func ToCOATemplateListResponse(templates []domain.COATemplate) []dto.COATemplateSummaryResponse {
+	responses := make([]dto.COATemplateSummaryResponse, len(templates))
+	for i := range templates {
+		responses[i] = ToCOATemplateSummaryResponse(&templates[i])
+	}
+	return responses
+}
+
+// ToCOATemplateResponse converts domain.COATemplate to COATemplateResponse
+func ToCOATemplateResponse(t *domain.COATemplate) dto.COATemplateResponse {
+	response := dto.COATemplateResponse{
+		COATemplateSummaryResponse: ToCOATemplateSummaryResponse(t),
+		Accounts:                   toCOATemplateAccountResponses(t.Accounts),
+		TaxAccounts:                toCOATemplateAccountResponses(t.TaxAccounts()),
+	}
+
+	for _, m := range t.PayrollMappings {
+		response.PayrollMappings = append(response.PayrollMappings, dto.COATemplatePayrollMappingResponse{
+			ComponentType: m.ComponentType,
+			ComponentName: m.ComponentName,
+			DebitCode:     m.DebitCode,
+			CreditCode:    m.CreditCode,
+			Description:   m.Description,
+		})
+	}
+
+	return response
+}
+
+func toCOATemplateAccountResponses(accounts []domain.COATemplateAccount) []dto.COATemplateAccountResponse {
+	responses := make([]dto.COATemplateAccountResponse, len(accounts))
+	for i, a := range accounts {
+		responses[i] = dto.COATemplateAccountResponse{
+			Code:       a.Code,
+			Name:       a.Name,
+			Type:       string(a.Type),
+			ParentCode: a.ParentCode,
+			IsPostable: a.IsPostable,
+			TaxRole:    string(a.TaxRole),
+		}
+	}
+	return responses
+}
+
+// ToCOATemplateApplicationResponse converts domain.COATemplateApplication to COATemplateApplicationResponse
+func ToCOATemplateApplicationResponse(app *domain.COATemplateApplication) dto.COATemplateApplicationResponse {
+	response := dto.COATemplateApplicationResponse{
+		ID:              app.ID.String(),
+		OrganizationID:  app.OrganizationID.String(),
+		TemplateCode:    app.TemplateCode,
+		Version:         app.Version,
+		AccountsCreated: app.AccountsCreated,
+		MappingsCreated: app.MappingsCreated,
+		AppliedAt:       app.AppliedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if app.AppliedBy != uuid.Nil {
+		appliedBy := app.AppliedBy.String()
+		response.AppliedBy = &appliedBy
+	}
+	return response
+}
+
+// ToCOATemplateApplicationListResponse converts template applications to responses
+func ToCOATemplateApplicationListResponse(apps []*domain.COATemplateApplication) []dto.COATemplateApplicationResponse {
+	responses := make([]dto.COATemplateApplicationResponse, len(apps))
+	for i, app := range apps {
+		responses[i] = ToCOATemplateApplicationResponse(app)
+	}
+	return responses
+}
@@ -0,0 +1,51 @@
+// backend/internal/gl-core/handler/mapper/dimension_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToDimensionResponse converts domain.Dimension to DimensionResponse
+func ToDimensionResponse(d *domain.Dimension) dto.DimensionResponse {
+	response := dto.DimensionResponse{
+		ID:             d.ID.String(),
+		OrganizationID: d.OrganizationID.String(),
+		Code:           d.Code,
+		Name:           d.Name,
+		RequiredFor:    make([]string, len(d.RequiredFor)),
+		IsActive:       d.IsActive,
+		Values:         make([]dto.DimensionValueResponse, len(d.Values)),
+		CreatedAt:      d.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      d.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	for i, t := range d.RequiredFor {
+		response.RequiredFor[i] = string(t)
+	}
+	for i := range d.Values {
+		response.Values[i] = ToDimensionValueResponse(&d.Values[i])
+	}
+
+	return response
+}
+
+// ToDimensionListResponse converts dimensions to responses
+func ToDimensionListResponse(dimensions []*domain.Dimension) []dto.DimensionResponse {
+	responses := make([]dto.DimensionResponse, len(dimensions))
+	for i, d := range dimensions {
+		responses[i] = ToDimensionResponse(d)
+	}
+	return responses
+}
+
+// ToDimensionValueResponse converts domain.DimensionValue to DimensionValueResponse
+func ToDimensionValueResponse(v *domain.DimensionValue) dto.DimensionValueResponse {
+	return dto.DimensionValueResponse{
+		ID:          v.ID.String(),
+		DimensionID: v.DimensionID.String(),
+		Code:        v.Code,
+		Name:        v.Name,
+		IsActive:    v.IsActive,
+	}
+}
@@ -0,0 +1,32 @@
+// backend/internal/gl-core/handler/mapper/exchange_rate_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToExchangeRateResponse converts domain.ExchangeRate to ExchangeRateResponse
+func ToExchangeRateResponse(rate *domain.ExchangeRate) dto.ExchangeRateResponse {
+	return dto.ExchangeRateResponse{
+		ID:             rate.ID.String(),
+		OrganizationID: rate.OrganizationID.String(),
+		FromCurrency:   rate.FromCurrency,
+		ToCurrency:     rate.ToCurrency,
+		Rate:           rate.Rate,
+		EffectiveDate:  rate.EffectiveDate.Format("2006-01-02"),
+		Source:         string(rate.Source),
+		CreatedBy:      rate.CreatedBy.String(),
+		CreatedAt:      rate.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      rate.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToExchangeRateListResponse converts a list of exchange rates
+func ToExchangeRateListResponse(rates []*domain.ExchangeRate) []dto.ExchangeRateResponse {
+	responses := make([]dto.ExchangeRateResponse, len(rates))
+	for i, rate := range rates {
+		responses[i] = ToExchangeRateResponse(rate)
+	}
+	return responses
+}
@@ -0,0 +1,128 @@
+// backend/internal/gl-core/handler/mapper/fiscal_period_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToFiscalYearResponse converts domain.FiscalYear to FiscalYearResponse
+func ToFiscalYearResponse(fy *domain.FiscalYear) dto.FiscalYearResponse {
+	response := dto.FiscalYearResponse{
+		ID:             fy.ID.String(),
+		OrganizationID: fy.OrganizationID.String(),
+		Name:           fy.Name,
+		StartDate:      fy.StartDate.Format("2006-01-02"),
+		EndDate:        fy.EndDate.Format("2006-01-02"),
+		Status:         string(fy.Status),
+		CreatedBy:      fy.CreatedBy.String(),
+		CreatedAt:      fy.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      fy.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	for i := range fy.Periods {
+		response.Periods = append(response.Periods, ToAccountingPeriodResponse(&fy.Periods[i]))
+	}
+
+	return response
+}
+
+// ToFiscalYearListResponse converts a list of fiscal years
+func ToFiscalYearListResponse(years []*domain.FiscalYear) []dto.FiscalYearResponse {
+	responses := make([]dto.FiscalYearResponse, len(years))
+	for i, fy := range years {
+		responses[i] = ToFiscalYearResponse(fy)
+	}
+	return responses
+}
+
+// ToAccountingPeriodResponse converts domain.AccountingPeriod to AccountingPeriodResponse
+func ToAccountingPeriodResponse(p *domain.AccountingPeriod) dto.AccountingPeriodResponse {
+	response := dto.AccountingPeriodResponse{
+		ID:           p.ID.String(),
+		FiscalYearID: p.FiscalYearID.String(),
+		PeriodNumber: p.PeriodNumber,
+		Name:         p.Name,
+		StartDate:    p.StartDate.Format("2006-01-02"),
+		EndDate:      p.EndDate.Format("2006-01-02"),
+		Status:       string(p.Status),
+	}
+
+	if p.ClosedBy != nil {
+		closedBy := p.ClosedBy.String()
+		response.ClosedBy = &closedBy
+	}
+	if p.ClosedAt != nil {
+		closedAt := p.ClosedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ClosedAt = &closedAt
+	}
+
+	return response
+}
+
+// ToPeriodEventListResponse converts a period's status history
+func ToPeriodEventListResponse(events []domain.PeriodEvent) []dto.PeriodEventResponse {
+	responses := make([]dto.PeriodEventResponse, len(events))
+	for i, e := range events {
+		responses[i] = dto.PeriodEventResponse{
+			ID:          e.ID.String(),
+			PeriodID:    e.PeriodID.String(),
+			Action:      string(e.Action),
+			FromStatus:  string(e.FromStatus),
+			ToStatus:    string(e.ToStatus),
+			Reason:      e.Reason,
+			PerformedBy: e.PerformedBy.String(),
+			PerformedAt: e.PerformedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return responses
+}
+
+// ToYearEndCloseResponse converts domain.YearEndClose to YearEndCloseResponse
+func ToYearEndCloseResponse(yc *domain.YearEndClose) dto.YearEndCloseResponse {
+	response := dto.YearEndCloseResponse{
+		ID:                        yc.ID.String(),
+		OrganizationID:            yc.OrganizationID.String(),
+		FiscalYearID:              yc.FiscalYearID.String(),
+		NextFiscalYearID:          yc.NextFiscalYearID.String(),
+		RetainedEarningsAccountID: yc.RetainedEarningsAccountID.String(),
+		NetIncome:                 yc.NetIncome,
+		Status:                    string(yc.Status),
+		OpeningBalances:           ToOpeningBalanceListResponse(yc.OpeningBalances),
+		ClosedBy:                  yc.ClosedBy.String(),
+		ClosedAt:                  yc.ClosedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ReversalReason:            yc.ReversalReason,
+	}
+
+	if yc.ClosingEntryID != nil {
+		id := yc.ClosingEntryID.String()
+		response.ClosingEntryID = &id
+	}
+	if yc.ReversalEntryID != nil {
+		id := yc.ReversalEntryID.String()
+		response.ReversalEntryID = &id
+	}
+	if yc.ReversedBy != nil {
+		reversedBy := yc.ReversedBy.String()
+		response.ReversedBy = &reversedBy
+	}
+	if yc.ReversedAt != nil {
+		reversedAt := yc.ReversedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ReversedAt = &reversedAt
+	}
+
+	return response
+}
+
+// ToOpeningBalanceListResponse converts a list of opening balances
+func ToOpeningBalanceListResponse(balances []domain.OpeningBalance) []dto.OpeningBalanceResponse {
+	responses := make([]dto.OpeningBalanceResponse, len(balances))
+	for i, ob := range balances {
+		responses[i] = dto.OpeningBalanceResponse{
+			AccountID: ob.AccountID.String(),
+			Debit:     ob.Debit,
+			Credit:    ob.Credit,
+		}
+	}
+	return responses
+}
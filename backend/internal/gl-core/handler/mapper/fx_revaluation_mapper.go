@@ -0,0 +1,71 @@
+// backend/internal/gl-core/handler/mapper/fx_revaluation_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToFXRevaluationSettingsResponse converts domain.FXRevaluationSettings to FXRevaluationSettingsResponse
+func ToFXRevaluationSettingsResponse(s *domain.FXRevaluationSettings) dto.FXRevaluationSettingsResponse {
+	return dto.FXRevaluationSettingsResponse{
+		OrganizationID: s.OrganizationID.String(),
+		GainAccountID:  s.GainAccountID.String(),
+		LossAccountID:  s.LossAccountID.String(),
+		UpdatedBy:      s.UpdatedBy.String(),
+		UpdatedAt:      s.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToFXRevaluationResponse converts domain.FXRevaluation to FXRevaluationResponse
+func ToFXRevaluationResponse(rev *domain.FXRevaluation) dto.FXRevaluationResponse {
+	response := dto.FXRevaluationResponse{
+		ID:             rev.ID.String(),
+		OrganizationID: rev.OrganizationID.String(),
+		AsOfDate:       rev.AsOfDate.Format("2006-01-02"),
+		ReversalDate:   rev.ReversalDate.Format("2006-01-02"),
+		BaseCurrency:   rev.BaseCurrency,
+		GainAccountID:  rev.GainAccountID.String(),
+		LossAccountID:  rev.LossAccountID.String(),
+		TotalGain:      rev.TotalGain,
+		TotalLoss:      rev.TotalLoss,
+		NetGain:        rev.NetGain(),
+		CreatedBy:      rev.CreatedBy.String(),
+		CreatedAt:      rev.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if rev.RevaluationEntryID != nil {
+		id := rev.RevaluationEntryID.String()
+		response.RevaluationEntryID = &id
+	}
+	if rev.ReversalEntryID != nil {
+		id := rev.ReversalEntryID.String()
+		response.ReversalEntryID = &id
+	}
+
+	for _, line := range rev.Lines {
+		response.Lines = append(response.Lines, dto.FXRevaluationLineResponse{
+			AccountID:       line.AccountID.String(),
+			AccountCode:     line.AccountCode,
+			AccountName:     line.AccountName,
+			AccountType:     string(line.AccountType),
+			Currency:        line.Currency,
+			ForeignBalance:  line.ForeignBalance,
+			ClosingRate:     line.ClosingRate,
+			BookBalance:     line.BookBalance,
+			RevaluedBalance: line.RevaluedBalance,
+			Adjustment:      line.Adjustment,
+		})
+	}
+
+	return response
+}
+
+// ToFXRevaluationListResponse converts a list of revaluation runs
+func ToFXRevaluationListResponse(runs []*domain.FXRevaluation) []dto.FXRevaluationResponse {
+	responses := make([]dto.FXRevaluationResponse, len(runs))
+	for i, rev := range runs {
+		responses[i] = ToFXRevaluationResponse(rev)
+	}
+	return responses
+}
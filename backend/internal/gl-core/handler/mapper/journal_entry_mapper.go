@@ -14,6 +14,18 @@ func ToJournalEntryResponse(entry *domain.JournalEntry) dto.JournalEntryResponse
 		postingDate = &pd
 	}
 
+	var reversalOf *string
+	if entry.ReversalOf != nil {
+		id := entry.ReversalOf.String()
+		reversalOf = &id
+	}
+
+	var importLogID *string
+	if entry.ImportLogID != nil {
+		id := entry.ImportLogID.String()
+		importLogID = &id
+	}
+
 	lines := make([]dto.JournalLineResponse, len(entry.Lines))
 	for i, line := range entry.Lines {
 		lines[i] = dto.JournalLineResponse{
@@ -24,6 +36,14 @@ func ToJournalEntryResponse(entry *domain.JournalEntry) dto.JournalEntryResponse
 			Description: line.Description,
 			Debit:       line.Debit,
 			Credit:      line.Credit,
+			Dimensions:  line.Dimensions,
+		}
+		if line.IsForeignCurrency() {
+			foreignDebit, foreignCredit, rate := line.ForeignDebit, line.ForeignCredit, line.ExchangeRate
+			lines[i].Currency = line.Currency
+			lines[i].ForeignDebit = &foreignDebit
+			lines[i].ForeignCredit = &foreignCredit
+			lines[i].ExchangeRate = &rate
 		}
 	}
 
@@ -31,11 +51,15 @@ func ToJournalEntryResponse(entry *domain.JournalEntry) dto.JournalEntryResponse
 		ID:              entry.ID.String(),
 		OrganizationID:  entry.OrganizationID.String(),
 		EntryNumber:     entry.EntryNumber,
+		JournalType:     string(entry.JournalType),
 		TransactionDate: entry.TransactionDate.Format("2006-01-02"),
 		PostingDate:     postingDate,
 		Reference:       entry.Reference,
 		Description:     entry.Description,
 		Status:          string(entry.Status),
+		AutoReverseDate: formatOptionalDate(entry.AutoReverseDate),
+		ReversalOf:      reversalOf,
+		ImportLogID:     importLogID,
 		TotalDebit:      entry.TotalDebit,
 		TotalCredit:     entry.TotalCredit,
 		Lines:           lines,
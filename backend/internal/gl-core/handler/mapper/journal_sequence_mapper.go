@@ -0,0 +1,31 @@
+// backend/internal/gl-core/handler/mapper/journal_sequence_mapper.go
+package mapper
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToJournalSequenceResponse converts domain.JournalSequence to JournalSequenceResponse
+func ToJournalSequenceResponse(seq *domain.JournalSequence) dto.JournalSequenceResponse {
+	return dto.JournalSequenceResponse{
+		OrganizationID: seq.OrganizationID.String(),
+		JournalType:    string(seq.JournalType),
+		Prefix:         seq.Prefix,
+		ResetPolicy:    string(seq.ResetPolicy),
+		Padding:        seq.Padding,
+		IsDefault:      seq.IsDefault,
+		Example:        seq.Format(time.Now(), 1),
+	}
+}
+
+// ToJournalSequenceListResponse converts sequences to responses
+func ToJournalSequenceListResponse(sequences []*domain.JournalSequence) []dto.JournalSequenceResponse {
+	responses := make([]dto.JournalSequenceResponse, len(sequences))
+	for i, seq := range sequences {
+		responses[i] = ToJournalSequenceResponse(seq)
+	}
+	return responses
+}
@@ -0,0 +1,71 @@
+// backend/internal/gl-core/handler/mapper/opening_balance_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/google/uuid"
+)
+
+// ToOpeningBalancesResponse converts domain.OpeningBalanceBatch to OpeningBalancesResponse
+func ToOpeningBalancesResponse(b *domain.OpeningBalanceBatch) dto.OpeningBalancesResponse {
+	response := dto.OpeningBalancesResponse{
+		ID:             b.ID.String(),
+		OrganizationID: b.OrganizationID.String(),
+		GoLiveDate:     b.GoLiveDate.Format("2006-01-02"),
+		Description:    b.Description,
+		Status:         string(b.Status),
+		SuspenseAmount: b.SuspenseAmount,
+		TotalDebit:     b.TotalDebit,
+		TotalCredit:    b.TotalCredit,
+		Warnings:       b.Warnings,
+		CreatedBy:      b.CreatedBy.String(),
+		CreatedAt:      b.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ReversalReason: b.ReversalReason,
+	}
+
+	if b.EntryID != uuid.Nil {
+		id := b.EntryID.String()
+		response.EntryID = &id
+	}
+	if b.SuspenseAccountID != nil {
+		id := b.SuspenseAccountID.String()
+		response.SuspenseAccountID = &id
+	}
+	if b.ReversalEntryID != nil {
+		id := b.ReversalEntryID.String()
+		response.ReversalEntryID = &id
+	}
+	if b.ReversedBy != nil {
+		id := b.ReversedBy.String()
+		response.ReversedBy = &id
+	}
+	if b.ReversedAt != nil {
+		at := b.ReversedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ReversedAt = &at
+	}
+
+	for _, line := range b.Lines {
+		response.Lines = append(response.Lines, dto.OpeningBalanceLineResponse{
+			LineNumber:     line.LineNumber,
+			AccountID:      line.AccountID.String(),
+			AccountCode:    line.AccountCode,
+			AccountName:    line.AccountName,
+			PartyReference: line.PartyReference,
+			Description:    line.Description,
+			Debit:          line.Debit,
+			Credit:         line.Credit,
+		})
+	}
+
+	return response
+}
+
+// ToOpeningBalancesListResponse converts a list of opening balance batches
+func ToOpeningBalancesListResponse(batches []*domain.OpeningBalanceBatch) []dto.OpeningBalancesResponse {
+	responses := make([]dto.OpeningBalancesResponse, len(batches))
+	for i, b := range batches {
+		responses[i] = ToOpeningBalancesResponse(b)
+	}
+	return responses
+}
@@ -0,0 +1,104 @@
+// backend/internal/gl-core/handler/mapper/recurring_journal_mapper.go
+package mapper
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToRecurringJournalResponse converts domain.RecurringJournalTemplate to RecurringJournalResponse
+func ToRecurringJournalResponse(t *domain.RecurringJournalTemplate) dto.RecurringJournalResponse {
+	response := dto.RecurringJournalResponse{
+		ID:             t.ID.String(),
+		OrganizationID: t.OrganizationID.String(),
+		Name:           t.Name,
+		Description:    t.Description,
+		Reference:      t.Reference,
+		Frequency:      string(t.Frequency),
+		DayOfMonth:     t.DayOfMonth,
+		StartDate:      t.StartDate.Format("2006-01-02"),
+		EndDate:        formatOptionalDate(t.EndDate),
+		AutoPost:       t.AutoPost,
+		Status:         string(t.Status),
+		NextRunDate:    formatOptionalDate(t.NextRunDate),
+		LastRunDate:    formatOptionalDate(t.LastRunDate),
+		Lines:          make([]dto.RecurringJournalLineResponse, len(t.Lines)),
+		CreatedBy:      t.CreatedBy.String(),
+		CreatedAt:      t.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      t.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	for i, line := range t.Lines {
+		response.Lines[i] = dto.RecurringJournalLineResponse{
+			ID:          line.ID.String(),
+			LineNumber:  line.LineNumber,
+			AccountID:   line.AccountID.String(),
+			Description: line.Description,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+			Dimensions:  line.Dimensions,
+		}
+	}
+
+	return response
+}
+
+// ToRecurringJournalListResponse converts templates to responses
+func ToRecurringJournalListResponse(templates []*domain.RecurringJournalTemplate) []dto.RecurringJournalResponse {
+	responses := make([]dto.RecurringJournalResponse, len(templates))
+	for i, t := range templates {
+		responses[i] = ToRecurringJournalResponse(t)
+	}
+	return responses
+}
+
+// ToRecurringOccurrenceListResponse converts upcoming occurrences to responses
+func ToRecurringOccurrenceListResponse(occurrences []domain.RecurringOccurrence) []dto.RecurringOccurrenceResponse {
+	responses := make([]dto.RecurringOccurrenceResponse, len(occurrences))
+	for i, o := range occurrences {
+		responses[i] = dto.RecurringOccurrenceResponse{
+			Date:    o.Date.Format("2006-01-02"),
+			Skipped: o.Skipped,
+		}
+	}
+	return responses
+}
+
+// ToRecurringJournalRunResponse converts domain.RecurringJournalRun to RecurringJournalRunResponse
+func ToRecurringJournalRunResponse(run *domain.RecurringJournalRun) dto.RecurringJournalRunResponse {
+	response := dto.RecurringJournalRunResponse{
+		ID:             run.ID.String(),
+		TemplateID:     run.TemplateID.String(),
+		OccurrenceDate: run.OccurrenceDate.Format("2006-01-02"),
+		Status:         string(run.Status),
+		CreatedBy:      run.CreatedBy.String(),
+		CreatedAt:      run.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if run.JournalEntryID != nil {
+		id := run.JournalEntryID.String()
+		response.JournalEntryID = &id
+	}
+
+	return response
+}
+
+// ToRecurringJournalRunListResponse converts runs to responses
+func ToRecurringJournalRunListResponse(runs []*domain.RecurringJournalRun) []dto.RecurringJournalRunResponse {
+	responses := make([]dto.RecurringJournalRunResponse, len(runs))
+	for i, run := range runs {
+		responses[i] = ToRecurringJournalRunResponse(run)
+	}
+	return responses
+}
+
+// formatOptionalDate formats a nullable date as YYYY-MM-DD
+func formatOptionalDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format("2006-01-02")
+	return &formatted
+}
@@ -0,0 +1,160 @@
+// backend/internal/gl-core/handler/mapper/report_mapper.go
+package mapper
+
+import (
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+)
+
+// ToTrialBalanceResponse converts domain.TrialBalance to TrialBalanceResponse
+func ToTrialBalanceResponse(tb *domain.TrialBalance) dto.TrialBalanceResponse {
+	var fromDate *string
+	if tb.FromDate != nil {
+		fd := tb.FromDate.Format("2006-01-02")
+		fromDate = &fd
+	}
+
+	lines := make([]dto.TrialBalanceLineResponse, len(tb.Lines))
+	for i, line := range tb.Lines {
+		var parentID *string
+		if line.ParentID != nil {
+			pid := line.ParentID.String()
+			parentID = &pid
+		}
+
+		lines[i] = dto.TrialBalanceLineResponse{
+			AccountID:     line.AccountID.String(),
+			Code:          line.Code,
+			Name:          line.Name,
+			Type:          string(line.Type),
+			ParentID:      parentID,
+			Level:         line.Level,
+			HasChildren:   line.HasChildren,
+			OpeningDebit:  line.OpeningDebit,
+			OpeningCredit: line.OpeningCredit,
+			PeriodDebit:   line.PeriodDebit,
+			PeriodCredit:  line.PeriodCredit,
+			ClosingDebit:  line.ClosingDebit,
+			ClosingCredit: line.ClosingCredit,
+		}
+	}
+
+	return dto.TrialBalanceResponse{
+		OrganizationID:     tb.OrganizationID.String(),
+		FromDate:           fromDate,
+		ToDate:             tb.ToDate.Format("2006-01-02"),
+		Lines:              lines,
+		TotalOpeningDebit:  tb.TotalOpeningDebit,
+		TotalOpeningCredit: tb.TotalOpeningCredit,
+		TotalPeriodDebit:   tb.TotalPeriodDebit,
+		TotalPeriodCredit:  tb.TotalPeriodCredit,
+		TotalClosingDebit:  tb.TotalClosingDebit,
+		TotalClosingCredit: tb.TotalClosingCredit,
+		IsBalanced:         tb.IsBalanced(),
+		Dimensions:         tb.Dimensions,
+		GeneratedAt:        tb.GeneratedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToFinancialStatementResponse converts domain.FinancialStatement to FinancialStatementResponse
+func ToFinancialStatementResponse(stmt *domain.FinancialStatement) dto.FinancialStatementResponse {
+	columns := make([]dto.StatementColumnResponse, len(stmt.Columns))
+	for i, col := range stmt.Columns {
+		var fromDate *string
+		if col.FromDate != nil {
+			fd := col.FromDate.Format("2006-01-02")
+			fromDate = &fd
+		}
+		columns[i] = dto.StatementColumnResponse{
+			Label:    col.Label,
+			FromDate: fromDate,
+			ToDate:   col.ToDate.Format("2006-01-02"),
+		}
+	}
+
+	sections := make([]dto.StatementSectionResponse, len(stmt.Sections))
+	for i, section := range stmt.Sections {
+		sections[i] = dto.StatementSectionResponse{
+			Type:   string(section.Type),
+			Title:  section.Title,
+			Lines:  toStatementLineResponses(section.Lines),
+			Totals: section.Totals,
+		}
+	}
+
+	return dto.FinancialStatementResponse{
+		Type:           string(stmt.Type),
+		OrganizationID: stmt.OrganizationID.String(),
+		Columns:        columns,
+		Sections:       sections,
+		Summary:        toStatementLineResponses(stmt.Summary),
+		Dimensions:     stmt.Dimensions,
+		GeneratedAt:    stmt.GeneratedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func toStatementLineResponses(lines []domain.StatementLine) []dto.StatementLineResponse {
+	responses := make([]dto.StatementLineResponse, len(lines))
+	for i, line := range lines {
+		var accountID *string
+		if line.AccountID != nil {
+			id := line.AccountID.String()
+			accountID = &id
+		}
+		responses[i] = dto.StatementLineResponse{
+			AccountID:  accountID,
+			Code:       line.Code,
+			Name:       line.Name,
+			Level:      line.Level,
+			IsSubtotal: line.IsSubtotal,
+			Amounts:    line.Amounts,
+		}
+	}
+	return responses
+}
+
+// ToAccountStatementResponse converts domain.AccountStatement to AccountStatementResponse
+func ToAccountStatementResponse(stmt *domain.AccountStatement) dto.AccountStatementResponse {
+	lines := make([]dto.AccountStatementLineResponse, len(stmt.Lines))
+	for i, line := range stmt.Lines {
+		counters := make([]dto.CounterAccountResponse, len(line.CounterAccounts))
+		for j, counter := range line.CounterAccounts {
+			counters[j] = dto.CounterAccountResponse{
+				AccountID: counter.AccountID.String(),
+				Code:      counter.Code,
+				Name:      counter.Name,
+			}
+		}
+
+		lines[i] = dto.AccountStatementLineResponse{
+			JournalEntryID:  line.JournalEntryID.String(),
+			LineID:          line.LineID.String(),
+			EntryNumber:     line.EntryNumber,
+			JournalType:     string(line.JournalType),
+			TransactionDate: line.TransactionDate.Format("2006-01-02"),
+			Reference:       line.Reference,
+			Description:     line.Description,
+			CounterAccounts: counters,
+			Debit:           line.Debit,
+			Credit:          line.Credit,
+			Balance:         line.Balance,
+		}
+	}
+
+	return dto.AccountStatementResponse{
+		OrganizationID: stmt.OrganizationID.String(),
+		AccountID:      stmt.AccountID.String(),
+		AccountCode:    stmt.AccountCode,
+		AccountName:    stmt.AccountName,
+		AccountType:    string(stmt.AccountType),
+		FromDate:       stmt.FromDate.Format("2006-01-02"),
+		ToDate:         stmt.ToDate.Format("2006-01-02"),
+		OpeningBalance: stmt.OpeningBalance,
+		TotalDebit:     stmt.TotalDebit,
+		TotalCredit:    stmt.TotalCredit,
+		ClosingBalance: stmt.ClosingBalance,
+		Lines:          lines,
+		Dimensions:     stmt.Dimensions,
+		GeneratedAt:    stmt.GeneratedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
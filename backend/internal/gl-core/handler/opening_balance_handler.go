@@ -0,0 +1,228 @@
+// backend/internal/gl-core/handler/opening_balance_handler.go
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OpeningBalanceHandler struct {
+	service service.OpeningBalanceServiceInterface
+}
+
+// NewOpeningBalanceHandler creates a new opening balance handler
+func NewOpeningBalanceHandler(service service.OpeningBalanceServiceInterface) *OpeningBalanceHandler {
+	return &OpeningBalanceHandler{service: service}
+}
+
+// Preview handles POST /opening-balances/preview
+// Checks the balances and shows any suspense line and warnings without posting
+func (h *OpeningBalanceHandler) Preview(c *gin.Context) {
+	batch, suspenseAccountID, ok := bindOpeningBalances(c)
+	if !ok {
+		return
+	}
+
+	batch, err := h.service.Preview(c.Request.Context(), batch, suspenseAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to preview opening balances",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToOpeningBalancesResponse(batch))
+}
+
+// Post handles POST /opening-balances
+// Posts the balances as a single OPENING entry dated on the go-live date
+func (h *OpeningBalanceHandler) Post(c *gin.Context) {
+	batch, suspenseAccountID, ok := bindOpeningBalances(c)
+	if !ok {
+		return
+	}
+
+	batch, err := h.service.Post(c.Request.Context(), batch, suspenseAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to post opening balances",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToOpeningBalancesResponse(batch))
+}
+
+// ListOpeningBalances handles GET /opening-balances?organization_id=
+func (h *OpeningBalanceHandler) ListOpeningBalances(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	batches, err := h.service.ListOpeningBalances(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list opening balances",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToOpeningBalancesListResponse(batches))
+}
+
+// GetOpeningBalances handles GET /opening-balances/:id?organization_id=
+func (h *OpeningBalanceHandler) GetOpeningBalances(c *gin.Context) {
+	orgID, id, ok := parseOpeningBalanceParams(c)
+	if !ok {
+		return
+	}
+
+	batch, err := h.service.GetOpeningBalances(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Opening balances not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToOpeningBalancesResponse(batch))
+}
+
+// ReverseOpeningBalances handles POST /opening-balances/:id/reverse?organization_id=
+// Opening entries cannot be reversed through the journal entry endpoints
+func (h *OpeningBalanceHandler) ReverseOpeningBalances(c *gin.Context) {
+	orgID, id, ok := parseOpeningBalanceParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReverseOpeningBalancesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	batch, err := h.service.Reverse(c.Request.Context(), orgID, id, getUserIDFromContext(c), req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to reverse opening balances",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToOpeningBalancesResponse(batch))
+}
+
+// bindOpeningBalances reads a preview or post request into a batch, writing
+// the error response and returning false when it is invalid
+func bindOpeningBalances(c *gin.Context) (*domain.OpeningBalanceBatch, *uuid.UUID, bool) {
+	var req dto.PostOpeningBalancesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	orgID, err := uuid.Parse(req.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	goLiveDate, err := time.Parse("2006-01-02", req.GoLiveDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid go_live_date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return nil, nil, false
+	}
+
+	var suspenseAccountID *uuid.UUID
+	if req.SuspenseAccountID != "" {
+		id, err := uuid.Parse(req.SuspenseAccountID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid suspense account ID",
+				Message: err.Error(),
+			})
+			return nil, nil, false
+		}
+		suspenseAccountID = &id
+	}
+
+	lines := make([]domain.OpeningBalanceLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = domain.OpeningBalanceLine{
+			AccountCode:    line.AccountCode,
+			PartyReference: line.PartyReference,
+			Description:    line.Description,
+			Debit:          line.Debit,
+			Credit:         line.Credit,
+		}
+		if line.AccountID != "" {
+			accountID, err := uuid.Parse(line.AccountID)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+					Error:   "Invalid account ID",
+					Message: fmt.Sprintf("line %d: %v", i+1, err),
+				})
+				return nil, nil, false
+			}
+			lines[i].AccountID = accountID
+		}
+	}
+
+	batch := domain.NewOpeningBalanceBatch(orgID, goLiveDate, req.Description, lines, getUserIDFromContext(c))
+	return batch, suspenseAccountID, true
+}
+
+// parseOpeningBalanceParams reads the batch ID and organization of a request,
+// writing the error response and returning false when they are invalid
+func parseOpeningBalanceParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid opening balances ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return orgID, id, true
+}
@@ -0,0 +1,376 @@
+// backend/internal/gl-core/handler/recurring_journal_handler.go
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RecurringJournalHandler struct {
+	service service.RecurringJournalServiceInterface
+}
+
+// NewRecurringJournalHandler creates a new recurring journal handler
+func NewRecurringJournalHandler(service service.RecurringJournalServiceInterface) *RecurringJournalHandler {
+	return &RecurringJournalHandler{service: service}
+}
+
+// CreateTemplate handles POST /recurring-journals
+func (h *RecurringJournalHandler) CreateTemplate(c *gin.Context) {
+	template, ok := bindRecurringJournal(c)
+	if !ok {
+		return
+	}
+
+	if template.OrganizationID == uuid.Nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: "organization_id is required",
+		})
+		return
+	}
+	template.CreatedBy = getUserIDFromContext(c)
+
+	created, err := h.service.CreateTemplate(c.Request.Context(), template)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create recurring journal",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToRecurringJournalResponse(created))
+}
+
+// UpdateTemplate handles PUT /recurring-journals/:id
+func (h *RecurringJournalHandler) UpdateTemplate(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	template, ok := bindRecurringJournal(c)
+	if !ok {
+		return
+	}
+	template.ID = id
+
+	updated, err := h.service.UpdateTemplate(c.Request.Context(), template)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update recurring journal",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalResponse(updated))
+}
+
+// ListTemplates handles GET /recurring-journals?organization_id=
+func (h *RecurringJournalHandler) ListTemplates(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	templates, err := h.service.ListTemplates(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list recurring journals",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalListResponse(templates))
+}
+
+// GetTemplate handles GET /recurring-journals/:id
+func (h *RecurringJournalHandler) GetTemplate(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.service.GetTemplate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Recurring journal not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalResponse(template))
+}
+
+// PreviewOccurrences handles GET /recurring-journals/:id/occurrences
+// Optional query param: count (default 12, max 60)
+func (h *RecurringJournalHandler) PreviewOccurrences(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "12"))
+	if err != nil || count < 1 || count > 60 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid count",
+			Message: "count must be between 1 and 60",
+		})
+		return
+	}
+
+	occurrences, err := h.service.PreviewOccurrences(c.Request.Context(), id, count)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Failed to preview occurrences",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringOccurrenceListResponse(occurrences))
+}
+
+// SkipOccurrence handles POST /recurring-journals/:id/skip
+func (h *RecurringJournalHandler) SkipOccurrence(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SkipOccurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	occurrence, err := time.Parse("2006-01-02", req.OccurrenceDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid occurrence_date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return
+	}
+
+	run, err := h.service.SkipOccurrence(c.Request.Context(), id, occurrence, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to skip occurrence",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapper.ToRecurringJournalRunResponse(run))
+}
+
+// PauseTemplate handles POST /recurring-journals/:id/pause
+func (h *RecurringJournalHandler) PauseTemplate(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.service.PauseTemplate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to pause recurring journal",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalResponse(template))
+}
+
+// ResumeTemplate handles POST /recurring-journals/:id/resume
+func (h *RecurringJournalHandler) ResumeTemplate(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.service.ResumeTemplate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to resume recurring journal",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalResponse(template))
+}
+
+// ListRuns handles GET /recurring-journals/:id/runs
+func (h *RecurringJournalHandler) ListRuns(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	runs, err := h.service.ListRuns(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list recurring journal runs",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalRunListResponse(runs))
+}
+
+// Generate handles POST /recurring-journals/:id/generate
+// Generates occurrences due on or before as_of_date (default today) without waiting for the scheduler
+func (h *RecurringJournalHandler) Generate(c *gin.Context) {
+	id, ok := parseRecurringJournalID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GenerateRecurringJournalRequest
+	if err := c.ShouldBindJSON(&req); err != nil && c.Request.ContentLength > 0 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	asOf := time.Now()
+	if req.AsOfDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.AsOfDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid as_of_date format",
+				Message: "Use YYYY-MM-DD format",
+			})
+			return
+		}
+		asOf = parsed
+	}
+
+	runs, err := h.service.GenerateTemplate(c.Request.Context(), id, asOf)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to generate recurring journal entries",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToRecurringJournalRunListResponse(runs))
+}
+
+// parseRecurringJournalID reads the :id path param, writing the error response and returning false when invalid
+func parseRecurringJournalID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid recurring journal ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// bindRecurringJournal converts a create or update request to a domain template,
+// writing the error response and returning false when it is invalid
+func bindRecurringJournal(c *gin.Context) (*domain.RecurringJournalTemplate, bool) {
+	var req dto.RecurringJournalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return nil, false
+	}
+
+	template := &domain.RecurringJournalTemplate{
+		Name:        req.Name,
+		Description: req.Description,
+		Reference:   req.Reference,
+		Frequency:   domain.RecurrenceFrequency(strings.ToUpper(req.Frequency)),
+		DayOfMonth:  req.DayOfMonth,
+		AutoPost:    req.AutoPost,
+		Lines:       make([]domain.RecurringTemplateLine, len(req.Lines)),
+	}
+
+	if req.OrganizationID != "" {
+		orgID, err := uuid.Parse(req.OrganizationID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid organization ID",
+				Message: err.Error(),
+			})
+			return nil, false
+		}
+		template.OrganizationID = orgID
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid start_date format",
+			Message: "Use YYYY-MM-DD format",
+		})
+		return nil, false
+	}
+	template.StartDate = startDate
+
+	if req.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid end_date format",
+				Message: "Use YYYY-MM-DD format",
+			})
+			return nil, false
+		}
+		template.EndDate = &endDate
+	}
+
+	for i, line := range req.Lines {
+		accountID, err := uuid.Parse(line.AccountID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid account ID",
+				Message: err.Error(),
+			})
+			return nil, false
+		}
+
+		template.Lines[i] = domain.RecurringTemplateLine{
+			AccountID:   accountID,
+			Description: line.Description,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+			Dimensions:  domain.LineDimensions(line.Dimensions),
+		}
+	}
+
+	return template, true
+}
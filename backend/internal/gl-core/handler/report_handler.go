@@ -0,0 +1,325 @@
+// backend/internal/gl-core/handler/report_handler.go
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler/mapper"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	service service.ReportServiceInterface
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(service service.ReportServiceInterface) *ReportHandler {
+	return &ReportHandler{service: service}
+}
+
+// GetTrialBalance handles GET /reports/trial-balance
+// Query params: organization_id, as_of or from_date/to_date (YYYY-MM-DD),
+// roll_up (default true), include_zero, dimensions[CODE]=VALUE, format (csv, xlsx, pdf)
+func (h *ReportHandler) GetTrialBalance(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	fromDate, toDate, err := parseReportDates(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid date",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	params := service.TrialBalanceParams{
+		OrganizationID: orgID,
+		FromDate:       fromDate,
+		ToDate:         toDate,
+		RollUp:         c.DefaultQuery("roll_up", "true") == "true",
+		IncludeZero:    c.DefaultQuery("include_zero", "false") == "true",
+		Dimensions:     parseDimensionFilter(c),
+	}
+
+	tb, err := h.service.GetTrialBalance(c.Request.Context(), params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to generate trial balance",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if format := c.Query("format"); format != "" {
+		exportFormat, err := service.ParseExportFormat(format)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid export format",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		data, err := service.ExportTrialBalance(tb, exportFormat)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "Failed to export trial balance",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		fileName := fmt.Sprintf("trial_balance_%s.%s", toDate.Format("20060102"), exportFormat)
+		sendExport(c, fileName, exportFormat, data)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToTrialBalanceResponse(tb))
+}
+
+// GetIncomeStatement handles GET /reports/income-statement
+// Query params: organization_id, from_date, to_date (YYYY-MM-DD),
+// comparison (PRIOR_PERIOD, PRIOR_YEAR), dimensions[CODE]=VALUE (e.g. a
+// departmental P&L with dimensions[DEPARTMENT]=RADIOLOGY), format (csv, xlsx, pdf)
+func (h *ReportHandler) GetIncomeStatement(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	fromDate, toDate, err := parseReportDates(c)
+	if err != nil || fromDate == nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid date range",
+			Message: "from_date and to_date are required in YYYY-MM-DD format",
+		})
+		return
+	}
+
+	params := service.IncomeStatementParams{
+		OrganizationID: orgID,
+		FromDate:       *fromDate,
+		ToDate:         toDate,
+		Comparison:     domain.ComparisonType(strings.ToUpper(c.Query("comparison"))),
+		Dimensions:     parseDimensionFilter(c),
+	}
+
+	stmt, err := h.service.GetIncomeStatement(c.Request.Context(), params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to generate income statement",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	h.respondStatement(c, stmt, fmt.Sprintf("income_statement_%s", toDate.Format("20060102")))
+}
+
+// GetBalanceSheet handles GET /reports/balance-sheet
+// Query params: organization_id, as_of (YYYY-MM-DD), comparison (PRIOR_PERIOD, PRIOR_YEAR),
+// fiscal_year_start_month (1-12), dimensions[CODE]=VALUE, format (csv, xlsx, pdf)
+func (h *ReportHandler) GetBalanceSheet(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	_, asOf, err := parseReportDates(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid date",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	startMonth, _ := strconv.Atoi(c.DefaultQuery("fiscal_year_start_month", "1"))
+
+	params := service.BalanceSheetParams{
+		OrganizationID:       orgID,
+		AsOf:                 asOf,
+		Comparison:           domain.ComparisonType(strings.ToUpper(c.Query("comparison"))),
+		FiscalYearStartMonth: time.Month(startMonth),
+		Dimensions:           parseDimensionFilter(c),
+	}
+
+	stmt, err := h.service.GetBalanceSheet(c.Request.Context(), params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to generate balance sheet",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	h.respondStatement(c, stmt, fmt.Sprintf("balance_sheet_%s", asOf.Format("20060102")))
+}
+
+// GetAccountStatement handles GET /reports/account-statement
+// Query params: organization_id, account_id, from_date, to_date (YYYY-MM-DD),
+// dimensions[CODE]=VALUE, format (csv, xlsx, pdf). Each line carries its
+// journal_entry_id so the entry can be opened from the statement.
+func (h *ReportHandler) GetAccountStatement(c *gin.Context) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	accountID, err := uuid.Parse(c.Query("account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid account ID",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	fromDate, toDate, err := parseReportDates(c)
+	if err != nil || fromDate == nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid date range",
+			Message: "from_date and to_date are required in YYYY-MM-DD format",
+		})
+		return
+	}
+
+	params := service.AccountStatementParams{
+		OrganizationID: orgID,
+		AccountID:      accountID,
+		FromDate:       *fromDate,
+		ToDate:         toDate,
+		Dimensions:     parseDimensionFilter(c),
+	}
+
+	stmt, err := h.service.GetAccountStatement(c.Request.Context(), params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to generate account statement",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if format := c.Query("format"); format != "" {
+		exportFormat, err := service.ParseExportFormat(format)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid export format",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		data, err := service.ExportAccountStatement(stmt, exportFormat)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "Failed to export account statement",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		fileName := fmt.Sprintf("account_statement_%s_%s.%s", stmt.AccountCode, toDate.Format("20060102"), exportFormat)
+		sendExport(c, fileName, exportFormat, data)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapper.ToAccountStatementResponse(stmt))
+}
+
+// respondStatement writes a financial statement as JSON or as an export when format is set
+func (h *ReportHandler) respondStatement(c *gin.Context, stmt *domain.FinancialStatement, baseName string) {
+	format := c.Query("format")
+	if format == "" {
+		c.JSON(http.StatusOK, mapper.ToFinancialStatementResponse(stmt))
+		return
+	}
+
+	exportFormat, err := service.ParseExportFormat(format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid export format",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	data, err := service.ExportFinancialStatement(stmt, exportFormat)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to export statement",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	sendExport(c, fmt.Sprintf("%s.%s", baseName, exportFormat), exportFormat, data)
+}
+
+// parseReportDates reads as_of or from_date/to_date query params (YYYY-MM-DD).
+// to_date defaults to today when neither as_of nor to_date is given.
+func parseReportDates(c *gin.Context) (*time.Time, time.Time, error) {
+	toDate := time.Now().Truncate(24 * time.Hour)
+
+	toStr := c.Query("to_date")
+	if toStr == "" {
+		toStr = c.Query("as_of")
+	}
+	if toStr != "" {
+		parsed, err := time.Parse("2006-01-02", toStr)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("to_date must be in YYYY-MM-DD format")
+		}
+		toDate = parsed
+	}
+
+	var fromDate *time.Time
+	if fromStr := c.Query("from_date"); fromStr != "" {
+		parsed, err := time.Parse("2006-01-02", fromStr)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("from_date must be in YYYY-MM-DD format")
+		}
+		fromDate = &parsed
+	}
+
+	return fromDate, toDate, nil
+}
+
+// parseDimensionFilter reads dimensions[CODE]=VALUE query params into a line filter
+func parseDimensionFilter(c *gin.Context) domain.LineDimensions {
+	return domain.LineDimensions(c.QueryMap("dimensions")).Normalize()
+}
+
+// sendExport writes an exported report as a file download
+func sendExport(c *gin.Context, fileName string, format service.ExportFormat, data []byte) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, format.ContentType(), data)
+}
@@ -8,16 +8,19 @@ import (
 
 	//"github.com/chaitu35/costeasy/backend/database"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type GLAccountSearchParams struct {
-	Name        string
-	Type        *domain.AccountType
-	IsActive    *bool
-	CreatedFrom *time.Time
-	CreatedTo   *time.Time
+	OrganizationID uuid.UUID // Required: searches are scoped to one chart of accounts
+	Name           string
+	Type           *domain.AccountType
+	IsActive       *bool
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
 }
 
 var _ GLAccountRepositoryInterface = (*GLAccountRepository)(nil)
@@ -42,34 +45,38 @@ func (r *GLAccountRepository) CreateGLAccount(ctx context.Context, GLAccount dom
 	}
 
 	query := `
-        INSERT INTO gl_accounts (code, name, type, parent_code, is_active)
-        VALUES ($1, $2, $3, $4, $5)
+        INSERT INTO gl_accounts (organization_id, code, name, type, parent_code, is_active, is_postable)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
         RETURNING id, created_at, updated_at
     `
 	var newGLAccount domain.GLAccount
 	err = r.pool.QueryRow(ctx, query,
+		GLAccount.OrganizationID,
 		GLAccount.Code,
 		GLAccount.Name,
 		GLAccount.Type,
 		GLAccount.ParentCode,
 		true,
+		GLAccount.IsPostable,
 	).Scan(&newGLAccount.ID, &newGLAccount.CreateAt, &newGLAccount.UpdateAt)
 	if err != nil {
 		return domain.GLAccount{}, err
 	}
 
+	newGLAccount.OrganizationID = GLAccount.OrganizationID
 	newGLAccount.Code = GLAccount.Code
 	newGLAccount.Name = GLAccount.Name
 	newGLAccount.Type = GLAccount.Type
 	newGLAccount.ParentCode = GLAccount.ParentCode
 	newGLAccount.IsActive = true
+	newGLAccount.IsPostable = GLAccount.IsPostable
 
 	return newGLAccount, nil
 }
 
 func (r *GLAccountRepository) GetGLAccountByID(ctx context.Context, id uuid.UUID, includeInactive bool) (domain.GLAccount, error) {
 	query := `
-        SELECT id, code, name, type, parent_code, is_active, created_at, updated_at 
+        SELECT id, organization_id, code, name, type, parent_code, is_active, is_postable, created_at, updated_at 
         FROM gl_accounts WHERE id = $1
     `
 	if !includeInactive {
@@ -79,11 +86,13 @@ func (r *GLAccountRepository) GetGLAccountByID(ctx context.Context, id uuid.UUID
 	var GLAccount domain.GLAccount
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&GLAccount.ID,
+		&GLAccount.OrganizationID,
 		&GLAccount.Code,
 		&GLAccount.Name,
 		&GLAccount.Type,
 		&GLAccount.ParentCode,
 		&GLAccount.IsActive,
+		&GLAccount.IsPostable,
 		&GLAccount.CreateAt,
 		&GLAccount.UpdateAt,
 	)
@@ -93,24 +102,26 @@ func (r *GLAccountRepository) GetGLAccountByID(ctx context.Context, id uuid.UUID
 	return GLAccount, nil
 }
 
-func (r *GLAccountRepository) GetGLAccountByCode(ctx context.Context, code string, includeInactive bool) (domain.GLAccount, error) {
+func (r *GLAccountRepository) GetGLAccountByCode(ctx context.Context, orgID uuid.UUID, code string, includeInactive bool) (domain.GLAccount, error) {
 	query := `
-		SELECT id, code, name, type, parent_code, is_active, created_at, updated_at
-		FROM gl_accounts WHERE code = $1
+		SELECT id, organization_id, code, name, type, parent_code, is_active, is_postable, created_at, updated_at
+		FROM gl_accounts WHERE organization_id = $1 AND code = $2
 	`
 	if !includeInactive {
 		query += " AND is_active = TRUE"
 	}
 
 	var GLAccount domain.GLAccount
-	err := r.pool.QueryRow(ctx, query, code).Scan(
+	err := r.pool.QueryRow(ctx, query, orgID, code).Scan(
 
 		&GLAccount.ID,
+		&GLAccount.OrganizationID,
 		&GLAccount.Code,
 		&GLAccount.Name,
 		&GLAccount.Type,
 		&GLAccount.ParentCode,
 		&GLAccount.IsActive,
+		&GLAccount.IsPostable,
 		&GLAccount.CreateAt,
 		&GLAccount.UpdateAt,
 	)
@@ -139,16 +150,18 @@ func (r *GLAccountRepository) ActivateGLAccount(ctx context.Context, id uuid.UUI
 func (r *GLAccountRepository) UpdateGLAccount(ctx context.Context, GLAccount domain.GLAccount) (domain.GLAccount, error) {
 	query := `
 		UPDATE gl_accounts 
-		SET name = $1, parent_code = $2, updated_at = NOW()
-		WHERE id = $3
-		RETURNING code, type, is_active, created_at, updated_at
+		SET name = $1, parent_code = $2, is_postable = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING organization_id, code, type, is_active, created_at, updated_at
 	`
 	var updatedGLAccount domain.GLAccount
 	err := r.pool.QueryRow(ctx, query,
 		GLAccount.Name,
 		GLAccount.ParentCode,
+		GLAccount.IsPostable,
 		GLAccount.ID,
 	).Scan(
+		&updatedGLAccount.OrganizationID,
 		&updatedGLAccount.Code,
 		&updatedGLAccount.Type,
 		&updatedGLAccount.IsActive,
@@ -162,20 +175,22 @@ func (r *GLAccountRepository) UpdateGLAccount(ctx context.Context, GLAccount dom
 	updatedGLAccount.ID = GLAccount.ID
 	updatedGLAccount.Name = GLAccount.Name
 	updatedGLAccount.ParentCode = GLAccount.ParentCode
+	updatedGLAccount.IsPostable = GLAccount.IsPostable
 
 	return updatedGLAccount, nil
 }
 
-func (r *GLAccountRepository) ListGLAccounts(ctx context.Context, includeInactive bool) ([]domain.GLAccount, error) {
+func (r *GLAccountRepository) ListGLAccounts(ctx context.Context, orgID uuid.UUID, includeInactive bool) ([]domain.GLAccount, error) {
 	query := `
-		SELECT id, code, name, type, parent_code, is_active, created_at, updated_at
-		FROM gl_accounts
+		SELECT id, organization_id, code, name, type, parent_code, is_active, is_postable, created_at, updated_at
+		FROM gl_accounts WHERE organization_id = $1
 	`
 	if !includeInactive {
-		query += " WHERE is_active = TRUE"
+		query += " AND is_active = TRUE"
 	}
+	query += " ORDER BY code"
 
-	rows, err := r.pool.Query(ctx, query)
+	rows, err := r.pool.Query(ctx, query, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -186,11 +201,13 @@ func (r *GLAccountRepository) ListGLAccounts(ctx context.Context, includeInactiv
 		var GLAccount domain.GLAccount
 		err := rows.Scan(
 			&GLAccount.ID,
+			&GLAccount.OrganizationID,
 			&GLAccount.Code,
 			&GLAccount.Name,
 			&GLAccount.Type,
 			&GLAccount.ParentCode,
 			&GLAccount.IsActive,
+			&GLAccount.IsPostable,
 			&GLAccount.CreateAt,
 			&GLAccount.UpdateAt,
 		)
@@ -205,11 +222,11 @@ func (r *GLAccountRepository) ListGLAccounts(ctx context.Context, includeInactiv
 
 func (r *GLAccountRepository) SearchGLAccounts(ctx context.Context, params GLAccountSearchParams) ([]domain.GLAccount, error) {
 	baseQuery := `
-        SELECT id, code, name, type, parent_code, is_active, created_at, updated_at
-        FROM gl_accounts WHERE 1=1
+        SELECT id, organization_id, code, name, type, parent_code, is_active, is_postable, created_at, updated_at
+        FROM gl_accounts WHERE organization_id = $1
     `
-	args := []interface{}{}
-	argIdx := 1
+	args := []interface{}{params.OrganizationID}
+	argIdx := 2
 
 	// Build conditions dynamically
 	if params.Name != "" {
@@ -241,6 +258,8 @@ func (r *GLAccountRepository) SearchGLAccounts(ctx context.Context, params GLAcc
 		argIdx++
 	}
 
+	baseQuery += " ORDER BY code"
+
 	rows, err := r.pool.Query(ctx, baseQuery, args...)
 	if err != nil {
 		return nil, err
@@ -252,11 +271,13 @@ func (r *GLAccountRepository) SearchGLAccounts(ctx context.Context, params GLAcc
 		var GLAccount domain.GLAccount
 		err := rows.Scan(
 			&GLAccount.ID,
+			&GLAccount.OrganizationID,
 			&GLAccount.Code,
 			&GLAccount.Name,
 			&GLAccount.Type,
 			&GLAccount.ParentCode,
 			&GLAccount.IsActive,
+			&GLAccount.IsPostable,
 			&GLAccount.CreateAt,
 			&GLAccount.UpdateAt,
 		)
@@ -298,7 +319,7 @@ func (r *GLAccountRepository) HasChildGLAccounts(ctx context.Context, GLAccountI
 	query := `
         SELECT COUNT(*) 
         FROM gl_accounts 
-        WHERE parent_code = (SELECT code FROM gl_accounts WHERE id = $1)
+        WHERE parent_code = $1 AND is_active = TRUE
     `
 	err := r.pool.QueryRow(ctx, query, GLAccountID).Scan(&count)
 	if err != nil {
@@ -306,3 +327,266 @@ func (r *GLAccountRepository) HasChildGLAccounts(ctx context.Context, GLAccountI
 	}
 	return count > 0, nil
 }
+
+// GetAccountBalances returns each account's net posted balance (positive = debit) up to a date
+func (r *GLAccountRepository) GetAccountBalances(ctx context.Context, orgID uuid.UUID, asOfDate time.Time) (map[uuid.UUID]money.Amount, error) {
+	query := `
+        SELECT jl.account_id, SUM(jl.debit - jl.credit)
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        WHERE je.organization_id = $1
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND je.transaction_date <= $2
+        GROUP BY jl.account_id
+    `
+
+	rows, err := r.pool.Query(ctx, query, orgID, asOfDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account balances: %w", err)
+	}
+	defer rows.Close()
+
+	balances := make(map[uuid.UUID]money.Amount)
+	for rows.Next() {
+		var accountID uuid.UUID
+		var balance money.Amount
+		if err := rows.Scan(&accountID, &balance); err != nil {
+			return nil, fmt.Errorf("failed to scan account balance: %w", err)
+		}
+		balances[accountID] = balance
+	}
+
+	return balances, rows.Err()
+}
+
+// MergeGLAccounts moves the source account's history onto the target in one
+// transaction: journal lines and opening balances are re-pointed, children are
+// re-parented, the source is deactivated and the merge is recorded. Recurring
+// templates, FX revaluation settings, approval rules, payroll mappings and
+// customers that post to the source are switched to the target. The merge is
+// refused when any source line falls in a closed period or fiscal year, or
+// when the source backs a bank account.
+func (r *GLAccountRepository) MergeGLAccounts(ctx context.Context, merge *domain.AccountMerge) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := ensureMergeablePeriods(ctx, tx, merge.SourceAccountID); err != nil {
+		return err
+	}
+
+	var bankAccount string
+	err = tx.QueryRow(ctx, `
+        SELECT name FROM bank_accounts WHERE gl_account_id = $1
+    `, merge.SourceAccountID).Scan(&bankAccount)
+	if err == nil {
+		return domain.NewGLErrorf(domain.ErrAccountMergeInvalid,
+			"account %s backs bank account %s and cannot be merged", merge.SourceCode, bankAccount)
+	}
+	if err != pgx.ErrNoRows {
+		return fmt.Errorf("failed to check bank accounts: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+        UPDATE journal_lines SET account_id = $2 WHERE account_id = $1
+    `, merge.SourceAccountID, merge.TargetAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to re-point journal lines: %w", err)
+	}
+	merge.LinesMoved = tag.RowsAffected()
+
+	// Opening balances are unique per fiscal year and account: fold the source
+	// into any existing target row, then move the rest
+	_, err = tx.Exec(ctx, `
+        UPDATE fiscal_year_opening_balances t
+        SET debit = t.debit + s.debit, credit = t.credit + s.credit
+        FROM fiscal_year_opening_balances s
+        WHERE s.account_id = $1 AND t.account_id = $2 AND t.fiscal_year_id = s.fiscal_year_id
+    `, merge.SourceAccountID, merge.TargetAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to merge opening balances: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+        DELETE FROM fiscal_year_opening_balances s
+        WHERE s.account_id = $1
+          AND EXISTS (
+              SELECT 1 FROM fiscal_year_opening_balances t
+              WHERE t.account_id = $2 AND t.fiscal_year_id = s.fiscal_year_id
+          )
+    `, merge.SourceAccountID, merge.TargetAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to merge opening balances: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE fiscal_year_opening_balances SET account_id = $2 WHERE account_id = $1
+    `, merge.SourceAccountID, merge.TargetAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to move opening balances: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE gl_accounts SET parent_code = $2, updated_at = NOW() WHERE parent_code = $1
+    `, merge.SourceAccountID, merge.TargetAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to re-parent child accounts: %w", err)
+	}
+
+	if err := repointAccountReferences(ctx, tx, merge.SourceAccountID, merge.TargetAccountID); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE gl_accounts SET is_active = FALSE, updated_at = NOW() WHERE id = $1
+    `, merge.SourceAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate merged account: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO gl_account_merges (
+            id, organization_id, source_account_id, source_code, target_account_id, target_code,
+            lines_moved, reason, merged_by, merged_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `,
+		merge.ID,
+		merge.OrganizationID,
+		merge.SourceAccountID,
+		merge.SourceCode,
+		merge.TargetAccountID,
+		merge.TargetCode,
+		merge.LinesMoved,
+		merge.Reason,
+		merge.MergedBy,
+		merge.MergedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record account merge: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ensureMergeablePeriods refuses a merge when any of the source account's
+// journal lines falls in an accounting period or fiscal year that is not open.
+// The periods are share-locked so they cannot be closed while the merge runs.
+func ensureMergeablePeriods(ctx context.Context, tx pgx.Tx, accountID uuid.UUID) error {
+	rows, err := tx.Query(ctx, `
+        SELECT ap.name, ap.status, fy.name, fy.status
+        FROM accounting_periods ap
+        JOIN fiscal_years fy ON fy.id = ap.fiscal_year_id
+        WHERE EXISTS (
+            SELECT 1
+            FROM journal_lines jl
+            JOIN journal_entries je ON je.id = jl.journal_entry_id
+            WHERE jl.account_id = $1
+              AND je.organization_id = ap.organization_id
+              AND je.transaction_date BETWEEN ap.start_date AND ap.end_date
+        )
+        ORDER BY ap.start_date
+        FOR SHARE OF ap, fy
+    `, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to check accounting periods: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var periodName, yearName string
+		var periodStatus, yearStatus domain.PeriodStatus
+		if err := rows.Scan(&periodName, &periodStatus, &yearName, &yearStatus); err != nil {
+			return fmt.Errorf("failed to scan accounting period: %w", err)
+		}
+		if !yearStatus.AllowsPosting() {
+			return domain.NewGLErrorf(domain.ErrAccountMergeInvalid,
+				"the account has journal lines in fiscal year %s, which is %s", yearName, yearStatus)
+		}
+		if !periodStatus.AllowsPosting() {
+			return domain.NewGLErrorf(domain.ErrAccountMergeInvalid,
+				"the account has journal lines in accounting period %s, which is %s", periodName, periodStatus)
+		}
+	}
+
+	return rows.Err()
+}
+
+// repointAccountReferences switches the settings and templates that post to
+// the source account over to the target, so nothing keeps posting to an
+// account the merge deactivates. Documents already posted keep their history.
+func repointAccountReferences(ctx context.Context, tx pgx.Tx, sourceID, targetID uuid.UUID) error {
+	updates := []struct {
+		what  string
+		query string
+	}{
+		{"recurring journal lines", `UPDATE gl_recurring_journal_lines SET account_id = $2 WHERE account_id = $1`},
+		{"FX revaluation gain account", `UPDATE gl_fx_revaluation_settings SET gain_account_id = $2, updated_at = NOW() WHERE gain_account_id = $1`},
+		{"FX revaluation loss account", `UPDATE gl_fx_revaluation_settings SET loss_account_id = $2, updated_at = NOW() WHERE loss_account_id = $1`},
+		{"approval rules", `
+            UPDATE gl_approval_rules
+            SET account_ids = CASE
+                    WHEN $2 = ANY(account_ids) THEN array_remove(account_ids, $1)
+                    ELSE array_replace(account_ids, $1, $2)
+                END,
+                updated_at = NOW()
+            WHERE $1 = ANY(account_ids)
+        `},
+		{"payroll debit mappings", `UPDATE payroll_gl_mappings SET debit_account_id = $2, updated_at = NOW() WHERE debit_account_id = $1`},
+		{"payroll credit mappings", `UPDATE payroll_gl_mappings SET credit_account_id = $2, updated_at = NOW() WHERE credit_account_id = $1`},
+		{"customer receivable accounts", `UPDATE customers SET receivable_account_id = $2, updated_at = NOW() WHERE receivable_account_id = $1`},
+	}
+
+	for _, u := range updates {
+		if _, err := tx.Exec(ctx, u.query, sourceID, targetID); err != nil {
+			return fmt.Errorf("failed to re-point %s: %w", u.what, err)
+		}
+	}
+
+	return nil
+}
+
+// ListAccountMerges lists an organization's account merges, most recent first
+func (r *GLAccountRepository) ListAccountMerges(ctx context.Context, orgID uuid.UUID) ([]*domain.AccountMerge, error) {
+	query := `
+        SELECT id, organization_id, source_account_id, source_code, target_account_id, target_code,
+               lines_moved, reason, merged_by, merged_at
+        FROM gl_account_merges
+        WHERE organization_id = $1
+        ORDER BY merged_at DESC
+    `
+
+	rows, err := r.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account merges: %w", err)
+	}
+	defer rows.Close()
+
+	var merges []*domain.AccountMerge
+	for rows.Next() {
+		m := &domain.AccountMerge{}
+		err := rows.Scan(
+			&m.ID,
+			&m.OrganizationID,
+			&m.SourceAccountID,
+			&m.SourceCode,
+			&m.TargetAccountID,
+			&m.TargetCode,
+			&m.LinesMoved,
+			&m.Reason,
+			&m.MergedBy,
+			&m.MergedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account merge: %w", err)
+		}
+		merges = append(merges, m)
+	}
+
+	return merges, rows.Err()
+}
@@ -2,16 +2,18 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
 type GLAccountRepositoryInterface interface {
 	CreateGLAccount(ctx context.Context, GLAccount domain.GLAccount) (domain.GLAccount, error)
 	GetGLAccountByID(ctx context.Context, id uuid.UUID, includeInactive bool) (domain.GLAccount, error)
-	GetGLAccountByCode(ctx context.Context, code string, includeInactive bool) (domain.GLAccount, error)
-	ListGLAccounts(ctx context.Context, includeInactive bool) ([]domain.GLAccount, error)
+	GetGLAccountByCode(ctx context.Context, orgID uuid.UUID, code string, includeInactive bool) (domain.GLAccount, error)
+	ListGLAccounts(ctx context.Context, orgID uuid.UUID, includeInactive bool) ([]domain.GLAccount, error)
 	UpdateGLAccount(ctx context.Context, GLAccount domain.GLAccount) (domain.GLAccount, error)
 	DeactivateGLAccount(ctx context.Context, id uuid.UUID) error
 	ActivateGLAccount(ctx context.Context, id uuid.UUID) error
@@ -19,4 +21,7 @@ type GLAccountRepositoryInterface interface {
 	SearchGLAccounts(ctx context.Context, params GLAccountSearchParams) ([]domain.GLAccount, error)
 	HasActiveTransactions(ctx context.Context, GLAccountID uuid.UUID) (bool, error)
 	HasChildGLAccounts(ctx context.Context, GLAccountID uuid.UUID) (bool, error)
+	GetAccountBalances(ctx context.Context, orgID uuid.UUID, asOfDate time.Time) (map[uuid.UUID]money.Amount, error)
+	MergeGLAccounts(ctx context.Context, merge *domain.AccountMerge) error
+	ListAccountMerges(ctx context.Context, orgID uuid.UUID) ([]*domain.AccountMerge, error)
 }
@@ -0,0 +1,183 @@
+// backend/internal/gl-core/repository/approval_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ApprovalRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewApprovalRepository creates a new approval repository
+func NewApprovalRepository(pool *pgxpool.Pool) *ApprovalRepository {
+	return &ApprovalRepository{pool: pool}
+}
+
+const approvalRuleColumns = `
+        id, organization_id, name, min_amount, account_ids, require_different_approver,
+        is_active, created_by, created_at, updated_at
+`
+
+// CreateRule saves a new approval rule
+func (r *ApprovalRepository) CreateRule(ctx context.Context, rule *domain.ApprovalRule) error {
+	query := `
+        INSERT INTO gl_approval_rules (` + approvalRuleColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		rule.ID,
+		rule.OrganizationID,
+		rule.Name,
+		rule.MinAmount,
+		accountIDs(rule.AccountIDs),
+		rule.RequireDifferentApprover,
+		rule.IsActive,
+		rule.CreatedBy,
+		rule.CreatedAt,
+		rule.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create approval rule: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateRule saves a rule's name, conditions and status
+func (r *ApprovalRepository) UpdateRule(ctx context.Context, rule *domain.ApprovalRule) error {
+	query := `
+        UPDATE gl_approval_rules
+        SET name = $2, min_amount = $3, account_ids = $4, require_different_approver = $5,
+            is_active = $6, updated_at = $7
+        WHERE id = $1
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		rule.ID,
+		rule.Name,
+		rule.MinAmount,
+		accountIDs(rule.AccountIDs),
+		rule.RequireDifferentApprover,
+		rule.IsActive,
+		rule.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update approval rule: %w", err)
+	}
+
+	return nil
+}
+
+// GetRuleByID retrieves an approval rule
+func (r *ApprovalRepository) GetRuleByID(ctx context.Context, id uuid.UUID) (*domain.ApprovalRule, error) {
+	rules, err := r.queryRules(ctx, "SELECT"+approvalRuleColumns+"FROM gl_approval_rules WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, domain.NewGLError("approval rule not found", domain.ErrApprovalRuleNotFound)
+	}
+	return rules[0], nil
+}
+
+// ListRules lists an organization's approval rules ordered by name
+func (r *ApprovalRepository) ListRules(ctx context.Context, orgID uuid.UUID) ([]*domain.ApprovalRule, error) {
+	query := "SELECT" + approvalRuleColumns + `
+        FROM gl_approval_rules
+        WHERE organization_id = $1
+        ORDER BY name
+    `
+
+	return r.queryRules(ctx, query, orgID)
+}
+
+// insertApprovalAction saves a submit, approve or reject action within the
+// transaction that changes the entry's status
+func insertApprovalAction(ctx context.Context, tx pgx.Tx, a *domain.JournalEntryApproval) error {
+	query := `
+        INSERT INTO journal_entry_approvals (id, journal_entry_id, action, user_id, comment, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+    `
+
+	_, err := tx.Exec(ctx, query, a.ID, a.JournalEntryID, a.Action, a.UserID, a.Comment, a.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record approval action: %w", err)
+	}
+
+	return nil
+}
+
+// ListActions lists an entry's approval history, oldest first
+func (r *ApprovalRepository) ListActions(ctx context.Context, entryID uuid.UUID) ([]*domain.JournalEntryApproval, error) {
+	query := `
+        SELECT id, journal_entry_id, action, user_id, comment, created_at
+        FROM journal_entry_approvals
+        WHERE journal_entry_id = $1
+        ORDER BY created_at
+    `
+
+	rows, err := r.pool.Query(ctx, query, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []*domain.JournalEntryApproval
+	for rows.Next() {
+		a := &domain.JournalEntryApproval{}
+		if err := rows.Scan(&a.ID, &a.JournalEntryID, &a.Action, &a.UserID, &a.Comment, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan approval action: %w", err)
+		}
+		actions = append(actions, a)
+	}
+
+	return actions, rows.Err()
+}
+
+// queryRules runs a query selecting approvalRuleColumns
+func (r *ApprovalRepository) queryRules(ctx context.Context, query string, args ...interface{}) ([]*domain.ApprovalRule, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query approval rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*domain.ApprovalRule
+	for rows.Next() {
+		rule := &domain.ApprovalRule{}
+		err := rows.Scan(
+			&rule.ID,
+			&rule.OrganizationID,
+			&rule.Name,
+			&rule.MinAmount,
+			&rule.AccountIDs,
+			&rule.RequireDifferentApprover,
+			&rule.IsActive,
+			&rule.CreatedBy,
+			&rule.CreatedAt,
+			&rule.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan approval rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// accountIDs returns an empty slice for nil so the UUID[] column is never NULL
+func accountIDs(ids []uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return []uuid.UUID{}
+	}
+	return ids
+}
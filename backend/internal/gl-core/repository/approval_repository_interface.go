@@ -0,0 +1,27 @@
+// backend/internal/gl-core/repository/approval_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// ApprovalRepositoryInterface defines data access for journal entry approval rules and history
+type ApprovalRepositoryInterface interface {
+	// CreateRule saves a new approval rule
+	CreateRule(ctx context.Context, rule *domain.ApprovalRule) error
+
+	// UpdateRule saves a rule's name, conditions and status
+	UpdateRule(ctx context.Context, rule *domain.ApprovalRule) error
+
+	// GetRuleByID retrieves an approval rule
+	GetRuleByID(ctx context.Context, id uuid.UUID) (*domain.ApprovalRule, error)
+
+	// ListRules lists an organization's approval rules ordered by name
+	ListRules(ctx context.Context, orgID uuid.UUID) ([]*domain.ApprovalRule, error)
+
+	// ListActions lists an entry's approval history, oldest first
+	ListActions(ctx context.Context, entryID uuid.UUID) ([]*domain.JournalEntryApproval, error)
+}
@@ -0,0 +1,117 @@
+// backend/internal/gl-core/repository/attachment_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AttachmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttachmentRepository creates a new attachment repository
+func NewAttachmentRepository(pool *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{pool: pool}
+}
+
+const attachmentColumns = `
+        id, organization_id, entity_type, entity_id, file_name, content_type,
+        size_bytes, checksum, storage_key, uploaded_by, created_at
+`
+
+// Create saves a new attachment
+func (r *AttachmentRepository) Create(ctx context.Context, a *domain.Attachment) error {
+	query := `
+        INSERT INTO gl_attachments (` + attachmentColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		a.ID,
+		a.OrganizationID,
+		a.EntityType,
+		a.EntityID,
+		a.FileName,
+		a.ContentType,
+		a.SizeBytes,
+		a.Checksum,
+		a.StorageKey,
+		a.UploadedBy,
+		a.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an attachment
+func (r *AttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+	attachments, err := r.queryAttachments(ctx, "SELECT"+attachmentColumns+"FROM gl_attachments WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(attachments) == 0 {
+		return nil, domain.NewGLError("attachment not found", domain.ErrAttachmentNotFound)
+	}
+	return attachments[0], nil
+}
+
+// ListByEntity lists the attachments of a journal entry or import, oldest first
+func (r *AttachmentRepository) ListByEntity(ctx context.Context, entityType domain.AttachmentEntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+	query := "SELECT" + attachmentColumns + `
+        FROM gl_attachments
+        WHERE entity_type = $1 AND entity_id = $2
+        ORDER BY created_at
+    `
+
+	return r.queryAttachments(ctx, query, entityType, entityID)
+}
+
+// Delete removes an attachment's metadata
+func (r *AttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM gl_attachments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// queryAttachments runs a query selecting attachmentColumns
+func (r *AttachmentRepository) queryAttachments(ctx context.Context, query string, args ...interface{}) ([]*domain.Attachment, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*domain.Attachment
+	for rows.Next() {
+		a := &domain.Attachment{}
+		err := rows.Scan(
+			&a.ID,
+			&a.OrganizationID,
+			&a.EntityType,
+			&a.EntityID,
+			&a.FileName,
+			&a.ContentType,
+			&a.SizeBytes,
+			&a.Checksum,
+			&a.StorageKey,
+			&a.UploadedBy,
+			&a.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+
+	return attachments, rows.Err()
+}
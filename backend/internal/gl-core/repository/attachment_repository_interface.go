@@ -0,0 +1,25 @@
+// backend/internal/gl-core/repository/attachment_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// AttachmentRepositoryInterface defines data access for supporting document metadata.
+// The documents themselves live in a storage.BlobStore.
+type AttachmentRepositoryInterface interface {
+	// Create saves a new attachment
+	Create(ctx context.Context, attachment *domain.Attachment) error
+
+	// GetByID retrieves an attachment
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error)
+
+	// ListByEntity lists the attachments of a journal entry or import, oldest first
+	ListByEntity(ctx context.Context, entityType domain.AttachmentEntityType, entityID uuid.UUID) ([]*domain.Attachment, error)
+
+	// Delete removes an attachment's metadata
+	Delete(ctx context.Context, id uuid.UUID) error
+}
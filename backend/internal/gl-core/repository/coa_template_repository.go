@@ -0,0 +1,172 @@
+// backend/internal/gl-core/repository/coa_template_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type COATemplateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCOATemplateRepository creates a new chart of accounts template repository
+func NewCOATemplateRepository(pool *pgxpool.Pool) *COATemplateRepository {
+	return &COATemplateRepository{pool: pool}
+}
+
+const templateApplicationColumns = `
+        id, organization_id, template_code, version, accounts_created,
+        mappings_created, applied_by, applied_at
+`
+
+// GetLatestApplication retrieves the organization's most recent template application, or nil if none
+func (r *COATemplateRepository) GetLatestApplication(ctx context.Context, orgID uuid.UUID) (*domain.COATemplateApplication, error) {
+	apps, err := r.queryApplications(ctx, `
+        SELECT `+templateApplicationColumns+`
+        FROM gl_coa_template_applications
+        WHERE organization_id = $1
+        ORDER BY applied_at DESC
+        LIMIT 1
+    `, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(apps) == 0 {
+		return nil, nil
+	}
+	return apps[0], nil
+}
+
+// ListApplications lists an organization's template applications, most recent first
+func (r *COATemplateRepository) ListApplications(ctx context.Context, orgID uuid.UUID) ([]*domain.COATemplateApplication, error) {
+	return r.queryApplications(ctx, `
+        SELECT `+templateApplicationColumns+`
+        FROM gl_coa_template_applications
+        WHERE organization_id = $1
+        ORDER BY applied_at DESC
+    `, orgID)
+}
+
+// Apply saves the accounts and payroll mappings a template adds, and records
+// the application, in one transaction. Existing payroll mappings are kept.
+func (r *COATemplateRepository) Apply(ctx context.Context, app *domain.COATemplateApplication, accounts []domain.GLAccount, mappings []domain.PayrollGLMapping) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, a := range accounts {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO gl_accounts (
+                id, organization_id, code, name, type, parent_code, is_active, is_postable,
+                created_at, updated_at
+            ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        `,
+			a.ID,
+			a.OrganizationID,
+			a.Code,
+			a.Name,
+			a.Type,
+			a.ParentCode,
+			a.IsActive,
+			a.IsPostable,
+			a.CreateAt,
+			a.UpdateAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create account %s: %w", a.Code, err)
+		}
+	}
+	app.AccountsCreated = len(accounts)
+
+	app.MappingsCreated = 0
+	for _, m := range mappings {
+		tag, err := tx.Exec(ctx, `
+            INSERT INTO payroll_gl_mappings (
+                id, organization_id, component_type, component_name,
+                debit_account_id, credit_account_id, description
+            ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+            ON CONFLICT (organization_id, component_name) DO NOTHING
+        `,
+			m.ID,
+			m.OrganizationID,
+			m.ComponentType,
+			m.ComponentName,
+			m.DebitAccountID,
+			m.CreditAccountID,
+			m.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create payroll mapping %s: %w", m.ComponentName, err)
+		}
+		app.MappingsCreated += int(tag.RowsAffected())
+	}
+
+	// Applied by is empty when the template was provisioned on organization creation
+	var appliedBy *uuid.UUID
+	if app.AppliedBy != uuid.Nil {
+		appliedBy = &app.AppliedBy
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO gl_coa_template_applications (`+templateApplicationColumns+`)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `,
+		app.ID,
+		app.OrganizationID,
+		app.TemplateCode,
+		app.Version,
+		app.AccountsCreated,
+		app.MappingsCreated,
+		appliedBy,
+		app.AppliedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record template application: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *COATemplateRepository) queryApplications(ctx context.Context, query string, args ...interface{}) ([]*domain.COATemplateApplication, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query template applications: %w", err)
+	}
+	defer rows.Close()
+
+	var apps []*domain.COATemplateApplication
+	for rows.Next() {
+		app := &domain.COATemplateApplication{}
+		var appliedBy *uuid.UUID
+		err := rows.Scan(
+			&app.ID,
+			&app.OrganizationID,
+			&app.TemplateCode,
+			&app.Version,
+			&app.AccountsCreated,
+			&app.MappingsCreated,
+			&appliedBy,
+			&app.AppliedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template application: %w", err)
+		}
+		if appliedBy != nil {
+			app.AppliedBy = *appliedBy
+		}
+		apps = append(apps, app)
+	}
+
+	return apps, rows.Err()
+}
@@ -0,0 +1,21 @@
+// backend/internal/gl-core/repository/coa_template_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// COATemplateRepositoryInterface defines data access for chart of accounts template provisioning
+type COATemplateRepositoryInterface interface {
+	// GetLatestApplication retrieves the organization's most recent template application (nil if none)
+	GetLatestApplication(ctx context.Context, orgID uuid.UUID) (*domain.COATemplateApplication, error)
+
+	// ListApplications lists an organization's template applications, most recent first
+	ListApplications(ctx context.Context, orgID uuid.UUID) ([]*domain.COATemplateApplication, error)
+
+	// Apply saves new accounts, payroll mappings and the application record in one transaction
+	Apply(ctx context.Context, app *domain.COATemplateApplication, accounts []domain.GLAccount, mappings []domain.PayrollGLMapping) error
+}
@@ -0,0 +1,240 @@
+// backend/internal/gl-core/repository/dimension_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DimensionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDimensionRepository creates a new analytic dimension repository
+func NewDimensionRepository(pool *pgxpool.Pool) *DimensionRepository {
+	return &DimensionRepository{pool: pool}
+}
+
+const dimensionColumns = `
+        id, organization_id, code, name, required_for, is_active, created_at, updated_at
+`
+
+const dimensionValueColumns = `
+        id, dimension_id, code, name, is_active, created_at, updated_at
+`
+
+// CreateDimension saves a new dimension
+func (r *DimensionRepository) CreateDimension(ctx context.Context, d *domain.Dimension) error {
+	query := `
+        INSERT INTO gl_dimensions (` + dimensionColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		d.ID,
+		d.OrganizationID,
+		d.Code,
+		d.Name,
+		accountTypeStrings(d.RequiredFor),
+		d.IsActive,
+		d.CreatedAt,
+		d.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create dimension: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDimension saves a dimension's name, required account types and status
+func (r *DimensionRepository) UpdateDimension(ctx context.Context, d *domain.Dimension) error {
+	query := `
+        UPDATE gl_dimensions
+        SET name = $2, required_for = $3, is_active = $4, updated_at = $5
+        WHERE id = $1
+    `
+
+	_, err := r.pool.Exec(ctx, query, d.ID, d.Name, accountTypeStrings(d.RequiredFor), d.IsActive, d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update dimension: %w", err)
+	}
+
+	return nil
+}
+
+// GetDimensionByID retrieves a dimension with its values
+func (r *DimensionRepository) GetDimensionByID(ctx context.Context, id uuid.UUID) (*domain.Dimension, error) {
+	dims, err := r.queryDimensions(ctx, `
+        SELECT `+dimensionColumns+`
+        FROM gl_dimensions
+        WHERE id = $1
+    `, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(dims) == 0 {
+		return nil, domain.NewGLError("dimension not found", domain.ErrDimensionNotFound)
+	}
+	return dims[0], nil
+}
+
+// ListDimensions lists an organization's dimensions with their values, ordered by code
+func (r *DimensionRepository) ListDimensions(ctx context.Context, orgID uuid.UUID) ([]*domain.Dimension, error) {
+	return r.queryDimensions(ctx, `
+        SELECT `+dimensionColumns+`
+        FROM gl_dimensions
+        WHERE organization_id = $1
+        ORDER BY code
+    `, orgID)
+}
+
+// CreateValue saves a new dimension value
+func (r *DimensionRepository) CreateValue(ctx context.Context, v *domain.DimensionValue) error {
+	query := `
+        INSERT INTO gl_dimension_values (` + dimensionValueColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+    `
+
+	_, err := r.pool.Exec(ctx, query, v.ID, v.DimensionID, v.Code, v.Name, v.IsActive, v.CreatedAt, v.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create dimension value: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateValue saves a dimension value's name and status
+func (r *DimensionRepository) UpdateValue(ctx context.Context, v *domain.DimensionValue) error {
+	query := `
+        UPDATE gl_dimension_values
+        SET name = $2, is_active = $3, updated_at = $4
+        WHERE id = $1
+    `
+
+	_, err := r.pool.Exec(ctx, query, v.ID, v.Name, v.IsActive, v.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update dimension value: %w", err)
+	}
+
+	return nil
+}
+
+// GetValueByID retrieves a dimension value
+func (r *DimensionRepository) GetValueByID(ctx context.Context, id uuid.UUID) (*domain.DimensionValue, error) {
+	query := `
+        SELECT ` + dimensionValueColumns + `
+        FROM gl_dimension_values
+        WHERE id = $1
+    `
+
+	v := &domain.DimensionValue{}
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&v.ID,
+		&v.DimensionID,
+		&v.Code,
+		&v.Name,
+		&v.IsActive,
+		&v.CreatedAt,
+		&v.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.NewGLError("dimension value not found", domain.ErrDimensionNotFound)
+		}
+		return nil, fmt.Errorf("failed to get dimension value: %w", err)
+	}
+
+	return v, nil
+}
+
+// queryDimensions runs a dimension query and loads each dimension's values
+func (r *DimensionRepository) queryDimensions(ctx context.Context, query string, args ...interface{}) ([]*domain.Dimension, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dimensions: %w", err)
+	}
+	defer rows.Close()
+
+	var dims []*domain.Dimension
+	byID := make(map[uuid.UUID]*domain.Dimension)
+	for rows.Next() {
+		d := &domain.Dimension{}
+		var requiredFor []string
+		err := rows.Scan(
+			&d.ID,
+			&d.OrganizationID,
+			&d.Code,
+			&d.Name,
+			&requiredFor,
+			&d.IsActive,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dimension: %w", err)
+		}
+		for _, t := range requiredFor {
+			d.RequiredFor = append(d.RequiredFor, domain.AccountType(t))
+		}
+		dims = append(dims, d)
+		byID[d.ID] = d
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(dims) == 0 {
+		return dims, nil
+	}
+
+	ids := make([]uuid.UUID, len(dims))
+	for i, d := range dims {
+		ids[i] = d.ID
+	}
+
+	valueRows, err := r.pool.Query(ctx, `
+        SELECT `+dimensionValueColumns+`
+        FROM gl_dimension_values
+        WHERE dimension_id = ANY($1)
+        ORDER BY code
+    `, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dimension values: %w", err)
+	}
+	defer valueRows.Close()
+
+	for valueRows.Next() {
+		var v domain.DimensionValue
+		err := valueRows.Scan(
+			&v.ID,
+			&v.DimensionID,
+			&v.Code,
+			&v.Name,
+			&v.IsActive,
+			&v.CreatedAt,
+			&v.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dimension value: %w", err)
+		}
+		if d, ok := byID[v.DimensionID]; ok {
+			d.Values = append(d.Values, v)
+		}
+	}
+
+	return dims, valueRows.Err()
+}
+
+// accountTypeStrings converts account types for a TEXT[] column
+func accountTypeStrings(types []domain.AccountType) []string {
+	values := make([]string, len(types))
+	for i, t := range types {
+		values[i] = string(t)
+	}
+	return values
+}
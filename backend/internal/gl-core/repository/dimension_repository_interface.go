@@ -0,0 +1,33 @@
+// backend/internal/gl-core/repository/dimension_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// DimensionRepositoryInterface defines data access for analytic dimensions and their values
+type DimensionRepositoryInterface interface {
+	// CreateDimension saves a new dimension
+	CreateDimension(ctx context.Context, dimension *domain.Dimension) error
+
+	// UpdateDimension saves a dimension's name, required account types and status
+	UpdateDimension(ctx context.Context, dimension *domain.Dimension) error
+
+	// GetDimensionByID retrieves a dimension with its values
+	GetDimensionByID(ctx context.Context, id uuid.UUID) (*domain.Dimension, error)
+
+	// ListDimensions lists an organization's dimensions with their values, ordered by code
+	ListDimensions(ctx context.Context, orgID uuid.UUID) ([]*domain.Dimension, error)
+
+	// CreateValue saves a new dimension value
+	CreateValue(ctx context.Context, value *domain.DimensionValue) error
+
+	// UpdateValue saves a dimension value's name and status
+	UpdateValue(ctx context.Context, value *domain.DimensionValue) error
+
+	// GetValueByID retrieves a dimension value
+	GetValueByID(ctx context.Context, id uuid.UUID) (*domain.DimensionValue, error)
+}
@@ -0,0 +1,160 @@
+// backend/internal/gl-core/repository/exchange_rate_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExchangeRateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewExchangeRateRepository creates a new exchange rate repository
+func NewExchangeRateRepository(pool *pgxpool.Pool) *ExchangeRateRepository {
+	return &ExchangeRateRepository{pool: pool}
+}
+
+const exchangeRateColumns = `
+        id, organization_id, from_currency, to_currency, rate, effective_date,
+        source, created_by, created_at, updated_at
+`
+
+// Save creates a rate, replacing any rate for the same pair and date.
+// On replace the stored ID and creation details are kept.
+func (r *ExchangeRateRepository) Save(ctx context.Context, rate *domain.ExchangeRate) error {
+	query := `
+        INSERT INTO gl_exchange_rates (` + exchangeRateColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        ON CONFLICT (organization_id, from_currency, to_currency, effective_date)
+        DO UPDATE SET rate = EXCLUDED.rate, source = EXCLUDED.source, updated_at = EXCLUDED.updated_at
+        RETURNING id, created_by, created_at
+    `
+
+	err := r.pool.QueryRow(ctx, query,
+		rate.ID,
+		rate.OrganizationID,
+		rate.FromCurrency,
+		rate.ToCurrency,
+		rate.Rate,
+		rate.EffectiveDate,
+		rate.Source,
+		rate.CreatedBy,
+		rate.CreatedAt,
+		rate.UpdatedAt,
+	).Scan(&rate.ID, &rate.CreatedBy, &rate.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save exchange rate: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an exchange rate by ID
+func (r *ExchangeRateRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ExchangeRate, error) {
+	rates, err := r.queryRates(ctx, "SELECT"+exchangeRateColumns+"FROM gl_exchange_rates WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("exchange rate not found")
+	}
+	return rates[0], nil
+}
+
+// GetEffectiveRate retrieves the latest rate for a pair on or before a date, or nil if none exists
+func (r *ExchangeRateRepository) GetEffectiveRate(ctx context.Context, orgID uuid.UUID, from, to string, date time.Time) (*domain.ExchangeRate, error) {
+	query := "SELECT" + exchangeRateColumns + `
+        FROM gl_exchange_rates
+        WHERE organization_id = $1 AND from_currency = $2 AND to_currency = $3 AND effective_date <= $4
+        ORDER BY effective_date DESC
+        LIMIT 1
+    `
+
+	rates, err := r.queryRates(ctx, query, orgID, from, to, date)
+	if err != nil {
+		return nil, err
+	}
+	if len(rates) == 0 {
+		return nil, nil
+	}
+	return rates[0], nil
+}
+
+// List lists rates matching a filter, most recent first
+func (r *ExchangeRateRepository) List(ctx context.Context, filter ExchangeRateFilter) ([]*domain.ExchangeRate, error) {
+	query := "SELECT" + exchangeRateColumns + `
+        FROM gl_exchange_rates
+        WHERE organization_id = $1
+          AND ($2 = '' OR from_currency = $2)
+          AND ($3 = '' OR to_currency = $3)
+          AND ($4::date IS NULL OR effective_date >= $4)
+          AND ($5::date IS NULL OR effective_date <= $5)
+        ORDER BY effective_date DESC, from_currency, to_currency
+    `
+
+	return r.queryRates(ctx, query, filter.OrganizationID, filter.FromCurrency, filter.ToCurrency, filter.FromDate, filter.ToDate)
+}
+
+// Delete removes an exchange rate
+func (r *ExchangeRateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM gl_exchange_rates WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete exchange rate: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("exchange rate not found")
+	}
+	return nil
+}
+
+// GetBaseCurrency retrieves the organization's base currency code
+func (r *ExchangeRateRepository) GetBaseCurrency(ctx context.Context, orgID uuid.UUID) (string, error) {
+	var currency string
+	err := r.pool.QueryRow(ctx, "SELECT currency FROM organizations WHERE id = $1", orgID).Scan(&currency)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", fmt.Errorf("organization not found")
+		}
+		return "", fmt.Errorf("failed to get organization currency: %w", err)
+	}
+	return currency, nil
+}
+
+// queryRates runs a query selecting exchangeRateColumns
+func (r *ExchangeRateRepository) queryRates(ctx context.Context, query string, args ...interface{}) ([]*domain.ExchangeRate, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*domain.ExchangeRate
+	for rows.Next() {
+		rate := &domain.ExchangeRate{}
+		err := rows.Scan(
+			&rate.ID,
+			&rate.OrganizationID,
+			&rate.FromCurrency,
+			&rate.ToCurrency,
+			&rate.Rate,
+			&rate.EffectiveDate,
+			&rate.Source,
+			&rate.CreatedBy,
+			&rate.CreatedAt,
+			&rate.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
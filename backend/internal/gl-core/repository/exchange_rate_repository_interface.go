@@ -0,0 +1,40 @@
+// backend/internal/gl-core/repository/exchange_rate_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// ExchangeRateRepositoryInterface defines data access for exchange rates
+type ExchangeRateRepositoryInterface interface {
+	// Save creates a rate, replacing any rate for the same pair and date
+	Save(ctx context.Context, rate *domain.ExchangeRate) error
+
+	// GetByID retrieves an exchange rate by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ExchangeRate, error)
+
+	// GetEffectiveRate retrieves the latest rate on or before a date (nil if none exists)
+	GetEffectiveRate(ctx context.Context, orgID uuid.UUID, from, to string, date time.Time) (*domain.ExchangeRate, error)
+
+	// List lists rates matching a filter, most recent first
+	List(ctx context.Context, filter ExchangeRateFilter) ([]*domain.ExchangeRate, error)
+
+	// Delete removes an exchange rate
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetBaseCurrency retrieves the organization's base currency code
+	GetBaseCurrency(ctx context.Context, orgID uuid.UUID) (string, error)
+}
+
+// ExchangeRateFilter selects exchange rates; empty fields match everything
+type ExchangeRateFilter struct {
+	OrganizationID uuid.UUID
+	FromCurrency   string
+	ToCurrency     string
+	FromDate       *time.Time
+	ToDate         *time.Time
+}
@@ -0,0 +1,396 @@
+// backend/internal/gl-core/repository/fiscal_period_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FiscalPeriodRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFiscalPeriodRepository creates a new fiscal period repository
+func NewFiscalPeriodRepository(pool *pgxpool.Pool) *FiscalPeriodRepository {
+	return &FiscalPeriodRepository{pool: pool}
+}
+
+const periodColumns = `
+        id, fiscal_year_id, organization_id, period_number, name,
+        start_date, end_date, status, closed_by, closed_at, created_at, updated_at
+`
+
+// CreateFiscalYear creates a fiscal year with its periods in a transaction
+func (r *FiscalPeriodRepository) CreateFiscalYear(ctx context.Context, fy *domain.FiscalYear) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertFiscalYear(ctx, tx, fy); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// insertFiscalYear inserts a fiscal year and its periods within a transaction
+func insertFiscalYear(ctx context.Context, tx pgx.Tx, fy *domain.FiscalYear) error {
+	yearQuery := `
+        INSERT INTO fiscal_years (
+            id, organization_id, name, start_date, end_date, status,
+            created_by, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    `
+
+	_, err := tx.Exec(ctx, yearQuery,
+		fy.ID,
+		fy.OrganizationID,
+		fy.Name,
+		fy.StartDate,
+		fy.EndDate,
+		fy.Status,
+		fy.CreatedBy,
+		fy.CreatedAt,
+		fy.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert fiscal year: %w", err)
+	}
+
+	periodQuery := `
+        INSERT INTO accounting_periods (
+            id, fiscal_year_id, organization_id, period_number, name,
+            start_date, end_date, status, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+
+	for _, p := range fy.Periods {
+		_, err = tx.Exec(ctx, periodQuery,
+			p.ID,
+			fy.ID,
+			p.OrganizationID,
+			p.PeriodNumber,
+			p.Name,
+			p.StartDate,
+			p.EndDate,
+			p.Status,
+			p.CreatedAt,
+			p.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert accounting period: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetFiscalYearByID retrieves a fiscal year with its periods
+func (r *FiscalPeriodRepository) GetFiscalYearByID(ctx context.Context, id uuid.UUID) (*domain.FiscalYear, error) {
+	query := `
+        SELECT id, organization_id, name, start_date, end_date, status,
+               created_by, created_at, updated_at
+        FROM fiscal_years
+        WHERE id = $1
+    `
+
+	fy := &domain.FiscalYear{}
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&fy.ID,
+		&fy.OrganizationID,
+		&fy.Name,
+		&fy.StartDate,
+		&fy.EndDate,
+		&fy.Status,
+		&fy.CreatedBy,
+		&fy.CreatedAt,
+		&fy.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("fiscal year not found")
+		}
+		return nil, fmt.Errorf("failed to get fiscal year: %w", err)
+	}
+
+	periods, err := r.queryPeriods(ctx, "SELECT"+periodColumns+"FROM accounting_periods WHERE fiscal_year_id = $1 ORDER BY period_number", id)
+	if err != nil {
+		return nil, err
+	}
+	fy.Periods = periods
+
+	return fy, nil
+}
+
+// GetFiscalYearByDate retrieves the fiscal year containing a date, or nil if none is defined
+func (r *FiscalPeriodRepository) GetFiscalYearByDate(ctx context.Context, orgID uuid.UUID, date time.Time) (*domain.FiscalYear, error) {
+	query := `
+        SELECT id
+        FROM fiscal_years
+        WHERE organization_id = $1 AND start_date <= $2 AND end_date >= $2
+    `
+
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, query, orgID, date).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get fiscal year: %w", err)
+	}
+
+	return r.GetFiscalYearByID(ctx, id)
+}
+
+// ListFiscalYears lists fiscal years for an organization (without periods)
+func (r *FiscalPeriodRepository) ListFiscalYears(ctx context.Context, orgID uuid.UUID) ([]*domain.FiscalYear, error) {
+	query := `
+        SELECT id, organization_id, name, start_date, end_date, status,
+               created_by, created_at, updated_at
+        FROM fiscal_years
+        WHERE organization_id = $1
+        ORDER BY start_date DESC
+    `
+
+	rows, err := r.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fiscal years: %w", err)
+	}
+	defer rows.Close()
+
+	var years []*domain.FiscalYear
+	for rows.Next() {
+		fy := &domain.FiscalYear{}
+		err := rows.Scan(
+			&fy.ID,
+			&fy.OrganizationID,
+			&fy.Name,
+			&fy.StartDate,
+			&fy.EndDate,
+			&fy.Status,
+			&fy.CreatedBy,
+			&fy.CreatedAt,
+			&fy.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fiscal year: %w", err)
+		}
+		years = append(years, fy)
+	}
+
+	return years, rows.Err()
+}
+
+// HasOverlappingFiscalYear checks if a fiscal year overlaps the given date range
+func (r *FiscalPeriodRepository) HasOverlappingFiscalYear(ctx context.Context, orgID uuid.UUID, startDate, endDate time.Time) (bool, error) {
+	query := `
+        SELECT COUNT(*)
+        FROM fiscal_years
+        WHERE organization_id = $1
+          AND start_date <= $3
+          AND end_date >= $2
+    `
+
+	var count int
+	if err := r.pool.QueryRow(ctx, query, orgID, startDate, endDate).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to check overlapping fiscal years: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// GetPeriodByID retrieves an accounting period by ID
+func (r *FiscalPeriodRepository) GetPeriodByID(ctx context.Context, id uuid.UUID) (*domain.AccountingPeriod, error) {
+	periods, err := r.queryPeriods(ctx, "SELECT"+periodColumns+"FROM accounting_periods WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("accounting period not found")
+	}
+	return &periods[0], nil
+}
+
+// GetPeriodByDate retrieves the period containing a date, or nil if none is defined
+func (r *FiscalPeriodRepository) GetPeriodByDate(ctx context.Context, orgID uuid.UUID, date time.Time) (*domain.AccountingPeriod, error) {
+	periods, err := r.queryPeriods(ctx,
+		"SELECT"+periodColumns+"FROM accounting_periods WHERE organization_id = $1 AND start_date <= $2 AND end_date >= $2",
+		orgID, date,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(periods) == 0 {
+		return nil, nil
+	}
+	return &periods[0], nil
+}
+
+// lockOpenPeriod locks the accounting period containing a date and checks it
+// still allows posting, so the period cannot be closed between the check and
+// the post committing. Dates outside every period are not restricted.
+func lockOpenPeriod(ctx context.Context, tx pgx.Tx, orgID uuid.UUID, date time.Time) error {
+	period := domain.AccountingPeriod{}
+	err := tx.QueryRow(ctx, `
+        SELECT name, status
+        FROM accounting_periods
+        WHERE organization_id = $1 AND start_date <= $2 AND end_date >= $2
+        FOR UPDATE
+    `, orgID, date).Scan(&period.Name, &period.Status)
+	if err == pgx.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock accounting period: %w", err)
+	}
+
+	return period.EnsurePostingAllowed()
+}
+
+// UpdatePeriodStatus saves a period's new status and records the event in a transaction
+func (r *FiscalPeriodRepository) UpdatePeriodStatus(ctx context.Context, period *domain.AccountingPeriod, event *domain.PeriodEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updatePeriodStatus(ctx, tx, period, event); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// updatePeriodStatus saves a period's status and inserts its event within a transaction
+func updatePeriodStatus(ctx context.Context, tx pgx.Tx, period *domain.AccountingPeriod, event *domain.PeriodEvent) error {
+	updateQuery := `
+        UPDATE accounting_periods
+        SET status = $2, closed_by = $3, closed_at = $4, updated_at = $5
+        WHERE id = $1
+    `
+
+	_, err := tx.Exec(ctx, updateQuery,
+		period.ID,
+		period.Status,
+		period.ClosedBy,
+		period.ClosedAt,
+		period.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update period status: %w", err)
+	}
+
+	eventQuery := `
+        INSERT INTO accounting_period_events (
+            id, period_id, organization_id, action, from_status, to_status,
+            reason, performed_by, performed_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    `
+
+	_, err = tx.Exec(ctx, eventQuery,
+		event.ID,
+		event.PeriodID,
+		event.OrganizationID,
+		event.Action,
+		event.FromStatus,
+		event.ToStatus,
+		event.Reason,
+		event.PerformedBy,
+		event.PerformedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert period event: %w", err)
+	}
+
+	return nil
+}
+
+// ListPeriodEvents lists the status history of a period, newest first
+func (r *FiscalPeriodRepository) ListPeriodEvents(ctx context.Context, periodID uuid.UUID) ([]domain.PeriodEvent, error) {
+	query := `
+        SELECT id, period_id, organization_id, action, from_status, to_status,
+               COALESCE(reason, ''), performed_by, performed_at
+        FROM accounting_period_events
+        WHERE period_id = $1
+        ORDER BY performed_at DESC
+    `
+
+	rows, err := r.pool.Query(ctx, query, periodID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list period events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.PeriodEvent
+	for rows.Next() {
+		var e domain.PeriodEvent
+		err := rows.Scan(
+			&e.ID,
+			&e.PeriodID,
+			&e.OrganizationID,
+			&e.Action,
+			&e.FromStatus,
+			&e.ToStatus,
+			&e.Reason,
+			&e.PerformedBy,
+			&e.PerformedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan period event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// queryPeriods runs a period query and scans the result rows
+func (r *FiscalPeriodRepository) queryPeriods(ctx context.Context, query string, args ...interface{}) ([]domain.AccountingPeriod, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounting periods: %w", err)
+	}
+	defer rows.Close()
+
+	var periods []domain.AccountingPeriod
+	for rows.Next() {
+		var p domain.AccountingPeriod
+		err := rows.Scan(
+			&p.ID,
+			&p.FiscalYearID,
+			&p.OrganizationID,
+			&p.PeriodNumber,
+			&p.Name,
+			&p.StartDate,
+			&p.EndDate,
+			&p.Status,
+			&p.ClosedBy,
+			&p.ClosedAt,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan accounting period: %w", err)
+		}
+		periods = append(periods, p)
+	}
+
+	return periods, rows.Err()
+}
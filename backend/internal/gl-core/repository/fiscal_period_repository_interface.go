@@ -0,0 +1,40 @@
+// backend/internal/gl-core/repository/fiscal_period_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// FiscalPeriodRepositoryInterface defines data access for fiscal years and accounting periods
+type FiscalPeriodRepositoryInterface interface {
+	// CreateFiscalYear creates a fiscal year with its periods
+	CreateFiscalYear(ctx context.Context, fy *domain.FiscalYear) error
+
+	// GetFiscalYearByID retrieves a fiscal year with its periods
+	GetFiscalYearByID(ctx context.Context, id uuid.UUID) (*domain.FiscalYear, error)
+
+	// GetFiscalYearByDate retrieves the fiscal year containing a date (nil if none is defined)
+	GetFiscalYearByDate(ctx context.Context, orgID uuid.UUID, date time.Time) (*domain.FiscalYear, error)
+
+	// ListFiscalYears lists fiscal years for an organization
+	ListFiscalYears(ctx context.Context, orgID uuid.UUID) ([]*domain.FiscalYear, error)
+
+	// HasOverlappingFiscalYear checks if a fiscal year overlaps the given date range
+	HasOverlappingFiscalYear(ctx context.Context, orgID uuid.UUID, startDate, endDate time.Time) (bool, error)
+
+	// GetPeriodByID retrieves an accounting period by ID
+	GetPeriodByID(ctx context.Context, id uuid.UUID) (*domain.AccountingPeriod, error)
+
+	// GetPeriodByDate retrieves the period containing a date (nil if none is defined)
+	GetPeriodByDate(ctx context.Context, orgID uuid.UUID, date time.Time) (*domain.AccountingPeriod, error)
+
+	// UpdatePeriodStatus saves a period status change and its event
+	UpdatePeriodStatus(ctx context.Context, period *domain.AccountingPeriod, event *domain.PeriodEvent) error
+
+	// ListPeriodEvents lists the status history of a period
+	ListPeriodEvents(ctx context.Context, periodID uuid.UUID) ([]domain.PeriodEvent, error)
+}
@@ -0,0 +1,321 @@
+// backend/internal/gl-core/repository/fx_revaluation_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FXRevaluationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFXRevaluationRepository creates a new FX revaluation repository
+func NewFXRevaluationRepository(pool *pgxpool.Pool) *FXRevaluationRepository {
+	return &FXRevaluationRepository{pool: pool}
+}
+
+const revaluationColumns = `
+        id, organization_id, as_of_date, reversal_date, base_currency,
+        gain_account_id, loss_account_id, revaluation_entry_id, reversal_entry_id,
+        total_gain, total_loss, created_by, created_at
+`
+
+// GetSettings retrieves an organization's revaluation accounts, or nil if not configured
+func (r *FXRevaluationRepository) GetSettings(ctx context.Context, orgID uuid.UUID) (*domain.FXRevaluationSettings, error) {
+	query := `
+        SELECT organization_id, gain_account_id, loss_account_id, updated_by, updated_at
+        FROM gl_fx_revaluation_settings
+        WHERE organization_id = $1
+    `
+
+	s := &domain.FXRevaluationSettings{}
+	err := r.pool.QueryRow(ctx, query, orgID).Scan(
+		&s.OrganizationID,
+		&s.GainAccountID,
+		&s.LossAccountID,
+		&s.UpdatedBy,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get revaluation settings: %w", err)
+	}
+
+	return s, nil
+}
+
+// SaveSettings creates or replaces an organization's revaluation accounts
+func (r *FXRevaluationRepository) SaveSettings(ctx context.Context, s *domain.FXRevaluationSettings) error {
+	query := `
+        INSERT INTO gl_fx_revaluation_settings (
+            organization_id, gain_account_id, loss_account_id, updated_by, updated_at
+        ) VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (organization_id) DO UPDATE SET
+            gain_account_id = EXCLUDED.gain_account_id,
+            loss_account_id = EXCLUDED.loss_account_id,
+            updated_by = EXCLUDED.updated_by,
+            updated_at = EXCLUDED.updated_at
+    `
+
+	_, err := r.pool.Exec(ctx, query, s.OrganizationID, s.GainAccountID, s.LossAccountID, s.UpdatedBy, s.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save revaluation settings: %w", err)
+	}
+
+	return nil
+}
+
+// GetForeignBalances sums posted foreign currency lines per account and currency up to a date.
+// Book balances only include foreign currency lines, so earlier revaluation
+// adjustments (posted in the base currency) never feed into the next run.
+func (r *FXRevaluationRepository) GetForeignBalances(ctx context.Context, orgID uuid.UUID, asOfDate time.Time) ([]domain.FXBalance, error) {
+	query := `
+        SELECT a.id, a.code, a.name, a.type, jl.currency,
+               SUM(jl.foreign_debit - jl.foreign_credit) AS foreign_balance,
+               SUM(jl.debit - jl.credit)                 AS book_balance
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        INNER JOIN gl_accounts a ON a.id = jl.account_id
+        WHERE je.organization_id = $1
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND je.transaction_date <= $2
+          AND jl.currency IS NOT NULL
+        GROUP BY a.id, a.code, a.name, a.type, jl.currency
+        HAVING SUM(jl.foreign_debit - jl.foreign_credit) <> 0 OR SUM(jl.debit - jl.credit) <> 0
+        ORDER BY a.code, jl.currency
+    `
+
+	rows, err := r.pool.Query(ctx, query, orgID, asOfDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get foreign currency balances: %w", err)
+	}
+	defer rows.Close()
+
+	var balances []domain.FXBalance
+	for rows.Next() {
+		var b domain.FXBalance
+		err := rows.Scan(
+			&b.AccountID,
+			&b.Code,
+			&b.Name,
+			&b.Type,
+			&b.Currency,
+			&b.ForeignBalance,
+			&b.BookBalance,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan foreign currency balance: %w", err)
+		}
+		balances = append(balances, b)
+	}
+
+	return balances, rows.Err()
+}
+
+// Create saves a revaluation run in one transaction: the posted revaluation
+// entry and its reversal (when there was anything to adjust), the run and its lines
+func (r *FXRevaluationRepository) Create(ctx context.Context, rev *domain.FXRevaluation, entry, reversal *domain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, je := range []*domain.JournalEntry{entry, reversal} {
+		if je == nil {
+			continue
+		}
+		if err := insertJournalEntry(ctx, tx, je); err != nil {
+			return err
+		}
+	}
+
+	runQuery := `
+        INSERT INTO gl_fx_revaluations (` + revaluationColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    `
+
+	_, err = tx.Exec(ctx, runQuery,
+		rev.ID,
+		rev.OrganizationID,
+		rev.AsOfDate,
+		rev.ReversalDate,
+		rev.BaseCurrency,
+		rev.GainAccountID,
+		rev.LossAccountID,
+		rev.RevaluationEntryID,
+		rev.ReversalEntryID,
+		rev.TotalGain,
+		rev.TotalLoss,
+		rev.CreatedBy,
+		rev.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert revaluation: %w", err)
+	}
+
+	lineQuery := `
+        INSERT INTO gl_fx_revaluation_lines (
+            id, revaluation_id, account_id, currency, foreign_balance, closing_rate,
+            book_balance, revalued_balance, adjustment
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    `
+
+	for _, line := range rev.Lines {
+		_, err = tx.Exec(ctx, lineQuery,
+			line.ID,
+			rev.ID,
+			line.AccountID,
+			line.Currency,
+			line.ForeignBalance,
+			line.ClosingRate,
+			line.BookBalance,
+			line.RevaluedBalance,
+			line.Adjustment,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert revaluation line: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a revaluation run with its lines
+func (r *FXRevaluationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.FXRevaluation, error) {
+	runs, err := r.queryRuns(ctx, "SELECT"+revaluationColumns+"FROM gl_fx_revaluations WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, fmt.Errorf("revaluation not found")
+	}
+
+	rev := runs[0]
+	rev.Lines, err = r.listLines(ctx, rev.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rev, nil
+}
+
+// GetLatest retrieves the organization's most recent run, or nil if none
+func (r *FXRevaluationRepository) GetLatest(ctx context.Context, orgID uuid.UUID) (*domain.FXRevaluation, error) {
+	query := "SELECT" + revaluationColumns + `
+        FROM gl_fx_revaluations
+        WHERE organization_id = $1
+        ORDER BY as_of_date DESC, created_at DESC
+        LIMIT 1
+    `
+
+	runs, err := r.queryRuns(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, nil
+	}
+	return runs[0], nil
+}
+
+// List lists an organization's runs without lines, most recent first
+func (r *FXRevaluationRepository) List(ctx context.Context, orgID uuid.UUID) ([]*domain.FXRevaluation, error) {
+	query := "SELECT" + revaluationColumns + `
+        FROM gl_fx_revaluations
+        WHERE organization_id = $1
+        ORDER BY as_of_date DESC, created_at DESC
+    `
+
+	return r.queryRuns(ctx, query, orgID)
+}
+
+// queryRuns runs a query selecting revaluationColumns
+func (r *FXRevaluationRepository) queryRuns(ctx context.Context, query string, args ...interface{}) ([]*domain.FXRevaluation, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query revaluations: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*domain.FXRevaluation
+	for rows.Next() {
+		rev := &domain.FXRevaluation{}
+		err := rows.Scan(
+			&rev.ID,
+			&rev.OrganizationID,
+			&rev.AsOfDate,
+			&rev.ReversalDate,
+			&rev.BaseCurrency,
+			&rev.GainAccountID,
+			&rev.LossAccountID,
+			&rev.RevaluationEntryID,
+			&rev.ReversalEntryID,
+			&rev.TotalGain,
+			&rev.TotalLoss,
+			&rev.CreatedBy,
+			&rev.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan revaluation: %w", err)
+		}
+		runs = append(runs, rev)
+	}
+
+	return runs, rows.Err()
+}
+
+// listLines lists a run's lines with their account details
+func (r *FXRevaluationRepository) listLines(ctx context.Context, revaluationID uuid.UUID) ([]domain.FXRevaluationLine, error) {
+	query := `
+        SELECT l.id, l.account_id, a.code, a.name, a.type, l.currency,
+               l.foreign_balance, l.closing_rate, l.book_balance, l.revalued_balance, l.adjustment
+        FROM gl_fx_revaluation_lines l
+        INNER JOIN gl_accounts a ON a.id = l.account_id
+        WHERE l.revaluation_id = $1
+        ORDER BY a.code, l.currency
+    `
+
+	rows, err := r.pool.Query(ctx, query, revaluationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revaluation lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []domain.FXRevaluationLine
+	for rows.Next() {
+		var line domain.FXRevaluationLine
+		err := rows.Scan(
+			&line.ID,
+			&line.AccountID,
+			&line.AccountCode,
+			&line.AccountName,
+			&line.AccountType,
+			&line.Currency,
+			&line.ForeignBalance,
+			&line.ClosingRate,
+			&line.BookBalance,
+			&line.RevaluedBalance,
+			&line.Adjustment,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan revaluation line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
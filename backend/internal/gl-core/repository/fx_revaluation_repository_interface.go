@@ -0,0 +1,34 @@
+// backend/internal/gl-core/repository/fx_revaluation_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// FXRevaluationRepositoryInterface defines data access for FX revaluation runs
+type FXRevaluationRepositoryInterface interface {
+	// GetSettings retrieves an organization's revaluation accounts (nil if not configured)
+	GetSettings(ctx context.Context, orgID uuid.UUID) (*domain.FXRevaluationSettings, error)
+
+	// SaveSettings creates or replaces an organization's revaluation accounts
+	SaveSettings(ctx context.Context, settings *domain.FXRevaluationSettings) error
+
+	// GetForeignBalances sums posted foreign currency lines per account and currency up to a date
+	GetForeignBalances(ctx context.Context, orgID uuid.UUID, asOfDate time.Time) ([]domain.FXBalance, error)
+
+	// Create saves a revaluation run with its posted entry and reversal in one transaction
+	Create(ctx context.Context, rev *domain.FXRevaluation, entry, reversal *domain.JournalEntry) error
+
+	// GetByID retrieves a revaluation run with its lines
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.FXRevaluation, error)
+
+	// GetLatest retrieves the organization's most recent run (nil if none)
+	GetLatest(ctx context.Context, orgID uuid.UUID) (*domain.FXRevaluation, error)
+
+	// List lists an organization's runs without lines, most recent first
+	List(ctx context.Context, orgID uuid.UUID) ([]*domain.FXRevaluation, error)
+}
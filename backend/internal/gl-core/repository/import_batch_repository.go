@@ -0,0 +1,337 @@
+// backend/internal/gl-core/repository/import_batch_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ImportBatchRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewImportBatchRepository creates a new import batch repository
+func NewImportBatchRepository(pool *pgxpool.Pool) *ImportBatchRepository {
+	return &ImportBatchRepository{pool: pool}
+}
+
+const importBatchColumns = `
+        id, organization_id, import_type, file_name, file_size, COALESCE(legacy_system, ''),
+        status, validate_only, auto_post, total_rows, success_count, error_count, warning_count,
+        imported_ids, COALESCE(failure_reason, ''), COALESCE(migration_notes, ''),
+        imported_by, created_at, completed_at, rolled_back_by, rolled_back_at
+`
+
+// Create saves an import run and its row errors in one transaction
+func (r *ImportBatchRepository) Create(ctx context.Context, b *domain.ImportBatch) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	importedIDs := b.ImportedIDs
+	if importedIDs == nil {
+		importedIDs = []uuid.UUID{}
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO gl_import_batches (
+            id, organization_id, import_type, file_name, file_size, legacy_system,
+            status, validate_only, auto_post, total_rows, success_count, error_count, warning_count,
+            imported_ids, failure_reason, migration_notes, imported_by, created_at, completed_at
+        ) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, $10, $11, $12, $13, $14,
+                  NULLIF($15, ''), NULLIF($16, ''), $17, $18, $19)
+    `,
+		b.ID,
+		b.OrganizationID,
+		b.ImportType,
+		b.FileName,
+		b.FileSize,
+		b.LegacySystem,
+		b.Status,
+		b.ValidateOnly,
+		b.AutoPost,
+		b.TotalRows,
+		b.SuccessCount,
+		b.ErrorCount,
+		b.WarningCount,
+		importedIDs,
+		b.FailureReason,
+		b.MigrationNotes,
+		b.ImportedBy,
+		b.CreatedAt,
+		b.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create import batch: %w", err)
+	}
+
+	errorQuery := `
+        INSERT INTO gl_import_errors (
+            batch_id, row_number, column_name, field, value, error_code, message, legacy_code
+        ) VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, NULLIF($8, ''))
+    `
+	for _, e := range b.Errors {
+		_, err := tx.Exec(ctx, errorQuery,
+			b.ID,
+			e.Row,
+			e.Column,
+			e.Field,
+			e.Value,
+			e.Code,
+			e.Message,
+			e.LegacyCode,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create import error: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an import run with its row errors in row order
+func (r *ImportBatchRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ImportBatch, error) {
+	batches, err := r.queryBatches(ctx, "SELECT"+importBatchColumns+"FROM gl_import_batches WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(batches) == 0 {
+		return nil, domain.NewGLError("import not found", domain.ErrImportNotFound)
+	}
+	batch := batches[0]
+
+	rows, err := r.pool.Query(ctx, `
+        SELECT row_number, COALESCE(column_name, ''), field, COALESCE(value, ''),
+               error_code, message, COALESCE(legacy_code, '')
+        FROM gl_import_errors
+        WHERE batch_id = $1
+        ORDER BY row_number, created_at
+    `, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import errors: %w", err)
+	}
+	defer rows.Close()
+
+	batch.Errors = []domain.ImportBatchError{}
+	for rows.Next() {
+		var e domain.ImportBatchError
+		if err := rows.Scan(&e.Row, &e.Column, &e.Field, &e.Value, &e.Code, &e.Message, &e.LegacyCode); err != nil {
+			return nil, fmt.Errorf("failed to scan import error: %w", err)
+		}
+		batch.Errors = append(batch.Errors, e)
+	}
+
+	return batch, rows.Err()
+}
+
+// List lists an organization's import runs, newest first. An empty import
+// type lists runs of every type.
+func (r *ImportBatchRepository) List(ctx context.Context, orgID uuid.UUID, importType domain.ImportType, limit, offset int) ([]*domain.ImportBatch, error) {
+	query := "SELECT" + importBatchColumns + `
+        FROM gl_import_batches
+        WHERE organization_id = $1
+          AND ($2 = '' OR import_type = $2)
+        ORDER BY created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	return r.queryBatches(ctx, query, orgID, string(importType), limit, offset)
+}
+
+// RollbackAccounts deactivates the accounts an import run created and marks
+// the run rolled back in one transaction. Whether the accounts have been
+// posted to is checked again within the transaction; if any has, nothing is
+// changed.
+func (r *ImportBatchRepository) RollbackAccounts(ctx context.Context, b *domain.ImportBatch) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var code string
+	err = tx.QueryRow(ctx, `
+        SELECT a.code
+        FROM gl_accounts a
+        WHERE a.organization_id = $1 AND a.id = ANY($2)
+          AND EXISTS (SELECT 1 FROM journal_lines l WHERE l.account_id = a.id)
+        LIMIT 1
+    `, b.OrganizationID, b.ImportedIDs).Scan(&code)
+	if err == nil {
+		return domain.NewGLErrorf(domain.ErrImportCannotRollback,
+			"account %s has journal lines and cannot be rolled back", code)
+	}
+	if err != pgx.ErrNoRows {
+		return fmt.Errorf("failed to check imported accounts: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE gl_accounts SET is_active = FALSE, updated_at = NOW()
+        WHERE organization_id = $1 AND id = ANY($2) AND is_active
+    `, b.OrganizationID, b.ImportedIDs)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate imported accounts: %w", err)
+	}
+
+	if err := markRolledBack(ctx, tx, b); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackJournalEntries voids the journal entries an import run created and
+// marks the run rolled back in one transaction. The entries are locked first,
+// as are the periods of those posted; if any entry has been reversed since,
+// or a posted entry's period no longer allows posting, nothing is voided.
+func (r *ImportBatchRepository) RollbackJournalEntries(ctx context.Context, b *domain.ImportBatch) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+        SELECT entry_number, status, transaction_date
+        FROM journal_entries
+        WHERE organization_id = $1 AND import_log_id = $2
+        FOR UPDATE
+    `, b.OrganizationID, b.ID)
+	if err != nil {
+		return fmt.Errorf("failed to lock imported entries: %w", err)
+	}
+	var entries []domain.JournalEntry
+	for rows.Next() {
+		var e domain.JournalEntry
+		if err := rows.Scan(&e.EntryNumber, &e.Status, &e.TransactionDate); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan imported entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to lock imported entries: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return domain.NewGLError("no journal entries found for import", domain.ErrImportNotFound)
+	}
+
+	reversed := 0
+	for _, e := range entries {
+		if e.Status == domain.EntryStatusReversed {
+			reversed++
+		}
+	}
+	if reversed > 0 {
+		return domain.NewGLErrorf(domain.ErrImportCannotRollback,
+			"%d imported entries have been reversed; rolling back would leave their reversals unmatched", reversed)
+	}
+
+	// Voiding posted entries changes the ledger for their original dates
+	for _, e := range entries {
+		if e.Status != domain.EntryStatusPosted {
+			continue
+		}
+		if err := lockOpenPeriod(ctx, tx, b.OrganizationID, e.TransactionDate); err != nil {
+			return fmt.Errorf("entry %s: %w", e.EntryNumber, err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE journal_entries
+        SET status = 'VOID', updated_at = NOW()
+        WHERE organization_id = $1 AND import_log_id = $2 AND status <> 'VOID'
+    `, b.OrganizationID, b.ID)
+	if err != nil {
+		return fmt.Errorf("failed to void imported entries: %w", err)
+	}
+
+	if err := markRolledBack(ctx, tx, b); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// markRolledBack records that an import run has been rolled back within a
+// transaction. Guarded on status so a run is not rolled back twice
+// concurrently.
+func markRolledBack(ctx context.Context, tx pgx.Tx, b *domain.ImportBatch) error {
+	tag, err := tx.Exec(ctx, `
+        UPDATE gl_import_batches
+        SET status = $2, rolled_back_by = $3, rolled_back_at = $4
+        WHERE id = $1 AND status IN ('success', 'partial', 'cancelled')
+    `, b.ID, b.Status, b.RolledBackBy, b.RolledBackAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark import rolled back: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.NewGLError("import has already been rolled back", domain.ErrImportCannotRollback)
+	}
+
+	return nil
+}
+
+// queryBatches runs a query selecting importBatchColumns
+func (r *ImportBatchRepository) queryBatches(ctx context.Context, query string, args ...interface{}) ([]*domain.ImportBatch, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query import batches: %w", err)
+	}
+	defer rows.Close()
+
+	var batches []*domain.ImportBatch
+	for rows.Next() {
+		b := &domain.ImportBatch{}
+		err := rows.Scan(
+			&b.ID,
+			&b.OrganizationID,
+			&b.ImportType,
+			&b.FileName,
+			&b.FileSize,
+			&b.LegacySystem,
+			&b.Status,
+			&b.ValidateOnly,
+			&b.AutoPost,
+			&b.TotalRows,
+			&b.SuccessCount,
+			&b.ErrorCount,
+			&b.WarningCount,
+			&b.ImportedIDs,
+			&b.FailureReason,
+			&b.MigrationNotes,
+			&b.ImportedBy,
+			&b.CreatedAt,
+			&b.CompletedAt,
+			&b.RolledBackBy,
+			&b.RolledBackAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan import batch: %w", err)
+		}
+		batches = append(batches, b)
+	}
+
+	return batches, rows.Err()
+}
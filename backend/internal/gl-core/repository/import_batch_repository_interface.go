@@ -0,0 +1,27 @@
+// backend/internal/gl-core/repository/import_batch_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// ImportBatchRepositoryInterface defines data access for the GL import log
+type ImportBatchRepositoryInterface interface {
+	// Create saves an import run with its row errors
+	Create(ctx context.Context, batch *domain.ImportBatch) error
+
+	// GetByID retrieves an import run with its row errors
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ImportBatch, error)
+
+	// List lists an organization's import runs, newest first, optionally of one type
+	List(ctx context.Context, orgID uuid.UUID, importType domain.ImportType, limit, offset int) ([]*domain.ImportBatch, error)
+
+	// RollbackAccounts deactivates the accounts an import run created and marks it rolled back
+	RollbackAccounts(ctx context.Context, batch *domain.ImportBatch) error
+
+	// RollbackJournalEntries voids the entries an import run created and marks it rolled back
+	RollbackJournalEntries(ctx context.Context, batch *domain.ImportBatch) error
+}
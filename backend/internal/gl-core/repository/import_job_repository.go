@@ -0,0 +1,208 @@
+// backend/internal/gl-core/repository/import_job_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ImportJobRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewImportJobRepository creates a new import job repository
+func NewImportJobRepository(pool *pgxpool.Pool) *ImportJobRepository {
+	return &ImportJobRepository{pool: pool}
+}
+
+const importJobColumns = `
+        id, organization_id, import_type, status, file_name, file_size, import_log_id,
+        attachment_id, options, processed_rows, total_rows, progress, cancel_requested,
+        result, COALESCE(error_message, ''), created_by, created_at, updated_at,
+        started_at, completed_at
+`
+
+// Create saves a queued job
+func (r *ImportJobRepository) Create(ctx context.Context, j *domain.ImportJob) error {
+	_, err := r.pool.Exec(ctx, `
+        INSERT INTO gl_import_jobs (
+            id, organization_id, import_type, status, file_name, file_size, import_log_id,
+            attachment_id, options, created_by, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+    `,
+		j.ID,
+		j.OrganizationID,
+		j.ImportType,
+		j.Status,
+		j.FileName,
+		j.FileSize,
+		j.ImportLogID,
+		j.AttachmentID,
+		[]byte(j.Options),
+		j.CreatedBy,
+		j.CreatedAt,
+		j.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create import job: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a job
+func (r *ImportJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ImportJob, error) {
+	job, err := r.scanJob(r.pool.QueryRow(ctx, "SELECT"+importJobColumns+"FROM gl_import_jobs WHERE id = $1", id))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewGLError("import job not found", domain.ErrImportJobNotFound)
+	}
+	return job, err
+}
+
+// ClaimNext marks the oldest queued job as running and returns it. SKIP
+// LOCKED lets workers in several processes claim jobs without blocking on
+// each other.
+func (r *ImportJobRepository) ClaimNext(ctx context.Context) (*domain.ImportJob, error) {
+	job, err := r.scanJob(r.pool.QueryRow(ctx, `
+        UPDATE gl_import_jobs
+        SET status = 'RUNNING', started_at = NOW(), updated_at = NOW()
+        WHERE id = (
+            SELECT id FROM gl_import_jobs
+            WHERE status = 'QUEUED'
+            ORDER BY created_at
+            LIMIT 1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING`+importJobColumns))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return job, err
+}
+
+// UpdateProgress records a running job's progress, which also serves as its
+// heartbeat, and returns whether cancellation has been requested
+func (r *ImportJobRepository) UpdateProgress(ctx context.Context, id uuid.UUID, processed, total, progress int) (bool, error) {
+	var cancelRequested bool
+	err := r.pool.QueryRow(ctx, `
+        UPDATE gl_import_jobs
+        SET processed_rows = $2, total_rows = $3, progress = $4, updated_at = NOW()
+        WHERE id = $1
+        RETURNING cancel_requested
+    `, id, processed, total, progress).Scan(&cancelRequested)
+	if err != nil {
+		return false, fmt.Errorf("failed to update import job progress: %w", err)
+	}
+
+	return cancelRequested, nil
+}
+
+// RequestCancel saves a cancellation request. Guarded on status so a job
+// that finished in the meantime is left alone.
+func (r *ImportJobRepository) RequestCancel(ctx context.Context, j *domain.ImportJob) error {
+	tag, err := r.pool.Exec(ctx, `
+        UPDATE gl_import_jobs
+        SET cancel_requested = TRUE,
+            status = CASE WHEN status = 'QUEUED' THEN 'CANCELLED' ELSE status END,
+            completed_at = CASE WHEN status = 'QUEUED' THEN NOW() ELSE completed_at END,
+            updated_at = NOW()
+        WHERE id = $1 AND status IN ('QUEUED', 'RUNNING')
+    `, j.ID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel import job: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.NewGLError("import job has already finished", domain.ErrImportJobFinished)
+	}
+
+	return nil
+}
+
+// Finish records the outcome of a job
+func (r *ImportJobRepository) Finish(ctx context.Context, j *domain.ImportJob) error {
+	var result []byte
+	if len(j.Result) > 0 {
+		result = j.Result
+	}
+
+	_, err := r.pool.Exec(ctx, `
+        UPDATE gl_import_jobs
+        SET status = $2, processed_rows = $3, total_rows = $4, progress = $5,
+            result = $6, error_message = NULLIF($7, ''), completed_at = $8, updated_at = NOW()
+        WHERE id = $1
+    `,
+		j.ID,
+		j.Status,
+		j.ProcessedRows,
+		j.TotalRows,
+		j.Progress,
+		result,
+		j.ErrorMessage,
+		j.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to finish import job: %w", err)
+	}
+
+	return nil
+}
+
+// FailStale fails running jobs whose worker stopped reporting progress,
+// such as jobs left running when a process was restarted
+func (r *ImportJobRepository) FailStale(ctx context.Context, before time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, `
+        UPDATE gl_import_jobs
+        SET status = 'FAILED', error_message = 'import stopped responding before it finished',
+            completed_at = NOW(), updated_at = NOW()
+        WHERE status = 'RUNNING' AND updated_at < $1
+    `, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail stale import jobs: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// scanJob scans a row selecting importJobColumns
+func (r *ImportJobRepository) scanJob(row pgx.Row) (*domain.ImportJob, error) {
+	j := &domain.ImportJob{}
+	var options, result []byte
+	err := row.Scan(
+		&j.ID,
+		&j.OrganizationID,
+		&j.ImportType,
+		&j.Status,
+		&j.FileName,
+		&j.FileSize,
+		&j.ImportLogID,
+		&j.AttachmentID,
+		&options,
+		&j.ProcessedRows,
+		&j.TotalRows,
+		&j.Progress,
+		&j.CancelRequested,
+		&result,
+		&j.ErrorMessage,
+		&j.CreatedBy,
+		&j.CreatedAt,
+		&j.UpdatedAt,
+		&j.StartedAt,
+		&j.CompletedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan import job: %w", err)
+	}
+	j.Options = options
+	j.Result = result
+
+	return j, nil
+}
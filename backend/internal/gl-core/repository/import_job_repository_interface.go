@@ -0,0 +1,34 @@
+// backend/internal/gl-core/repository/import_job_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// ImportJobRepositoryInterface defines data access for background import jobs
+type ImportJobRepositoryInterface interface {
+	// Create saves a queued job
+	Create(ctx context.Context, job *domain.ImportJob) error
+
+	// GetByID retrieves a job
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ImportJob, error)
+
+	// ClaimNext marks the oldest queued job as running and returns it, or nil when none is queued
+	ClaimNext(ctx context.Context) (*domain.ImportJob, error)
+
+	// UpdateProgress records a running job's progress and reports whether it should stop
+	UpdateProgress(ctx context.Context, id uuid.UUID, processed, total, progress int) (bool, error)
+
+	// RequestCancel saves a cancellation request on a job that has not finished
+	RequestCancel(ctx context.Context, job *domain.ImportJob) error
+
+	// Finish records the outcome of a job
+	Finish(ctx context.Context, job *domain.ImportJob) error
+
+	// FailStale fails running jobs that have not reported progress since the given time
+	FailStale(ctx context.Context, before time.Time) (int, error)
+}
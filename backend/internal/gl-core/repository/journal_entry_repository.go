@@ -29,20 +29,81 @@ func (r *JournalEntryRepository) Create(ctx context.Context, entry *domain.Journ
 	}
 	defer tx.Rollback(ctx)
 
+	if err := insertJournalEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateBatch creates several journal entries with their lines in one
+// transaction, so either all of them are saved or none are
+func (r *JournalEntryRepository) CreateBatch(ctx context.Context, entries []*domain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, entry := range entries {
+		if err := insertJournalEntry(ctx, tx, entry); err != nil {
+			return fmt.Errorf("entry %s: %w", entry.Reference, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// InsertJournalEntry inserts an entry within a caller's transaction, so other
+// modules can post an entry atomically with their own records. A posted
+// entry is refused if its accounting period no longer allows posting.
+func InsertJournalEntry(ctx context.Context, tx pgx.Tx, entry *domain.JournalEntry) error {
+	return insertJournalEntry(ctx, tx, entry)
+}
+
+// insertJournalEntry inserts an entry header and its lines within a transaction.
+// Entries inserted already posted have their period checked under a row lock
+// and are numbered from their journal sequence.
+func insertJournalEntry(ctx context.Context, tx pgx.Tx, entry *domain.JournalEntry) error {
+	if entry.JournalType == "" {
+		entry.JournalType = domain.JournalTypeGeneral
+	}
+
+	if entry.Status == domain.EntryStatusPosted {
+		if err := lockOpenPeriod(ctx, tx, entry.OrganizationID, entry.TransactionDate); err != nil {
+			return err
+		}
+	}
+
+	if entry.NeedsEntryNumber() {
+		if err := allocateEntryNumber(ctx, tx, entry); err != nil {
+			return err
+		}
+	}
+
 	// Insert journal entry header
 	entryQuery := `
         INSERT INTO journal_entries (
-            id, organization_id, entry_number, transaction_date, posting_date,
+            id, organization_id, entry_number, journal_type, transaction_date, posting_date,
             reference, description, status, total_debit, total_credit,
-            created_by, posted_by, reversed_by, reversal_of,
-            created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+            created_by, posted_by, reversed_by, reversal_of, auto_reverse_date,
+            import_log_id, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
     `
 
-	_, err = tx.Exec(ctx, entryQuery,
+	_, err := tx.Exec(ctx, entryQuery,
 		entry.ID,
 		entry.OrganizationID,
 		entry.EntryNumber,
+		entry.JournalType,
 		entry.TransactionDate,
 		entry.PostingDate,
 		entry.Reference,
@@ -54,6 +115,8 @@ func (r *JournalEntryRepository) Create(ctx context.Context, entry *domain.Journ
 		entry.PostedBy,
 		entry.ReversedBy,
 		entry.ReversalOf,
+		entry.AutoReverseDate,
+		entry.ImportLogID,
 		entry.CreatedAt,
 		entry.UpdatedAt,
 	)
@@ -62,16 +125,26 @@ func (r *JournalEntryRepository) Create(ctx context.Context, entry *domain.Journ
 		return fmt.Errorf("failed to insert journal entry: %w", err)
 	}
 
-	// Insert journal lines
+	return insertJournalLines(ctx, tx, entry)
+}
+
+// insertJournalLines inserts an entry's lines within a transaction
+func insertJournalLines(ctx context.Context, tx pgx.Tx, entry *domain.JournalEntry) error {
 	lineQuery := `
         INSERT INTO journal_lines (
             id, journal_entry_id, account_id, line_number,
-            reference, description, debit, credit
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+            reference, description, debit, credit,
+            currency, foreign_debit, foreign_credit, exchange_rate, dimensions
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, $11, NULLIF($12::numeric, 0), $13)
     `
 
 	for _, line := range entry.Lines {
-		_, err = tx.Exec(ctx, lineQuery,
+		dimensions := line.Dimensions
+		if dimensions == nil {
+			dimensions = domain.LineDimensions{}
+		}
+
+		_, err := tx.Exec(ctx, lineQuery,
 			line.ID,
 			entry.ID,
 			line.AccountID,
@@ -80,6 +153,11 @@ func (r *JournalEntryRepository) Create(ctx context.Context, entry *domain.Journ
 			line.Description,
 			line.Debit,
 			line.Credit,
+			line.Currency,
+			line.ForeignDebit,
+			line.ForeignCredit,
+			line.ExchangeRate,
+			dimensions,
 		)
 
 		if err != nil {
@@ -87,6 +165,23 @@ func (r *JournalEntryRepository) Create(ctx context.Context, entry *domain.Journ
 		}
 	}
 
+	return nil
+}
+
+// Update updates an existing journal entry. An entry being posted is refused
+// if its period has closed, and is numbered from its journal sequence, in the
+// same transaction.
+func (r *JournalEntryRepository) Update(ctx context.Context, entry *domain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateJournalEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -94,14 +189,58 @@ func (r *JournalEntryRepository) Create(ctx context.Context, entry *domain.Journ
 	return nil
 }
 
-// Update updates an existing journal entry
-func (r *JournalEntryRepository) Update(ctx context.Context, entry *domain.JournalEntry) error {
+// UpdateWithApproval saves an entry submitted, approved or rejected together
+// with the approval action, so the status and its history cannot disagree
+func (r *JournalEntryRepository) UpdateWithApproval(ctx context.Context, entry *domain.JournalEntry, approval *domain.JournalEntryApproval) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
+	if err := updateJournalEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if err := insertApprovalAction(ctx, tx, approval); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// updateJournalEntry saves an entry header and replaces its lines within a
+// transaction. An entry being posted has its period re-checked under a row
+// lock and is numbered; a reversal being posted marks its original REVERSED.
+func updateJournalEntry(ctx context.Context, tx pgx.Tx, entry *domain.JournalEntry) error {
+	if entry.Status == domain.EntryStatusPosted {
+		if err := lockOpenPeriod(ctx, tx, entry.OrganizationID, entry.TransactionDate); err != nil {
+			return err
+		}
+		if entry.IsReversal() {
+			marked, err := markReversed(ctx, tx, *entry.ReversalOf, entry.CreatedBy, time.Now())
+			if err != nil {
+				return err
+			}
+			if !marked {
+				return domain.NewGLError("the entry this reverses is no longer posted", domain.ErrJournalCannotReverse)
+			}
+		}
+	}
+
+	if entry.NeedsEntryNumber() {
+		if err := lockDraftNumber(ctx, tx, entry.ID); err != nil {
+			return err
+		}
+		if err := allocateEntryNumber(ctx, tx, entry); err != nil {
+			return err
+		}
+	}
+
 	// Update journal entry header
 	entryQuery := `
         UPDATE journal_entries
@@ -114,13 +253,16 @@ func (r *JournalEntryRepository) Update(ctx context.Context, entry *domain.Journ
             total_credit = $8,
             posted_by = $9,
             reversed_by = $10,
-            updated_at = $11
+            auto_reverse_date = $11,
+            updated_at = $12,
+            entry_number = $13,
+            journal_type = $14
         WHERE id = $1
     `
 
 	entry.UpdatedAt = time.Now()
 
-	_, err = tx.Exec(ctx, entryQuery,
+	_, err := tx.Exec(ctx, entryQuery,
 		entry.ID,
 		entry.TransactionDate,
 		entry.PostingDate,
@@ -131,7 +273,10 @@ func (r *JournalEntryRepository) Update(ctx context.Context, entry *domain.Journ
 		entry.TotalCredit,
 		entry.PostedBy,
 		entry.ReversedBy,
+		entry.AutoReverseDate,
 		entry.UpdatedAt,
+		entry.EntryNumber,
+		entry.JournalType,
 	)
 
 	if err != nil {
@@ -145,32 +290,8 @@ func (r *JournalEntryRepository) Update(ctx context.Context, entry *domain.Journ
 	}
 
 	// Re-insert lines
-	lineQuery := `
-        INSERT INTO journal_lines (
-            id, journal_entry_id, account_id, line_number,
-            reference, description, debit, credit
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-    `
-
-	for _, line := range entry.Lines {
-		_, err = tx.Exec(ctx, lineQuery,
-			line.ID,
-			entry.ID,
-			line.AccountID,
-			line.LineNumber,
-			line.Reference,
-			line.Description,
-			line.Debit,
-			line.Credit,
-		)
-
-		if err != nil {
-			return fmt.Errorf("failed to insert journal line: %w", err)
-		}
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if err := insertJournalLines(ctx, tx, entry); err != nil {
+		return err
 	}
 
 	return nil
@@ -196,10 +317,10 @@ func (r *JournalEntryRepository) Delete(ctx context.Context, entryID uuid.UUID)
 func (r *JournalEntryRepository) GetByID(ctx context.Context, entryID uuid.UUID) (*domain.JournalEntry, error) {
 	// Get entry header
 	entryQuery := `
-        SELECT id, organization_id, entry_number, transaction_date, posting_date,
+        SELECT id, organization_id, entry_number, journal_type, transaction_date, posting_date,
                reference, description, status, total_debit, total_credit,
-               created_by, posted_by, reversed_by, reversal_of,
-               created_at, updated_at
+               created_by, posted_by, reversed_by, reversal_of, auto_reverse_date,
+               import_log_id, created_at, updated_at
         FROM journal_entries
         WHERE id = $1
     `
@@ -212,6 +333,7 @@ func (r *JournalEntryRepository) GetByID(ctx context.Context, entryID uuid.UUID)
 		&entry.ID,
 		&entry.OrganizationID,
 		&entry.EntryNumber,
+		&entry.JournalType,
 		&entry.TransactionDate,
 		&postingDate,
 		&entry.Reference,
@@ -223,6 +345,8 @@ func (r *JournalEntryRepository) GetByID(ctx context.Context, entryID uuid.UUID)
 		&postedBy,
 		&reversedBy,
 		&reversalOf,
+		&entry.AutoReverseDate,
+		&entry.ImportLogID,
 		&entry.CreatedAt,
 		&entry.UpdatedAt,
 	)
@@ -242,7 +366,8 @@ func (r *JournalEntryRepository) GetByID(ctx context.Context, entryID uuid.UUID)
 
 	// Get entry lines
 	linesQuery := `
-        SELECT id, account_id, line_number, reference, description, debit, credit
+        SELECT id, account_id, line_number, reference, description, debit, credit,
+               COALESCE(currency, ''), foreign_debit, foreign_credit, COALESCE(exchange_rate, 0), dimensions
         FROM journal_lines
         WHERE journal_entry_id = $1
         ORDER BY line_number
@@ -265,6 +390,11 @@ func (r *JournalEntryRepository) GetByID(ctx context.Context, entryID uuid.UUID)
 			&line.Description,
 			&line.Debit,
 			&line.Credit,
+			&line.Currency,
+			&line.ForeignDebit,
+			&line.ForeignCredit,
+			&line.ExchangeRate,
+			&line.Dimensions,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan journal line: %w", err)
@@ -399,24 +529,128 @@ func (r *JournalEntryRepository) ListByDateRange(ctx context.Context, orgID uuid
 	return entries, nil
 }
 
-// GetNextEntryNumber generates the next entry number for a given date
-func (r *JournalEntryRepository) GetNextEntryNumber(ctx context.Context, orgID uuid.UUID, date string) (int, error) {
+// ListDueAutoReversals lists posted entries of all organizations whose auto-reverse date is on or before a date
+func (r *JournalEntryRepository) ListDueAutoReversals(ctx context.Context, asOf time.Time) ([]*domain.JournalEntry, error) {
 	query := `
-        SELECT COUNT(*) + 1
+        SELECT id
         FROM journal_entries
-        WHERE organization_id = $1
-          AND entry_number LIKE $2
+        WHERE status = 'POSTED'
+          AND auto_reverse_date IS NOT NULL
+          AND auto_reverse_date <= $1
+        ORDER BY auto_reverse_date, entry_number
     `
 
-	pattern := fmt.Sprintf("JE-%s-%%", date)
+	rows, err := r.pool.Query(ctx, query, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due auto-reversals: %w", err)
+	}
+	defer rows.Close()
+
+	var entryIDs []uuid.UUID
+	for rows.Next() {
+		var entryID uuid.UUID
+		if err := rows.Scan(&entryID); err != nil {
+			return nil, fmt.Errorf("failed to scan entry ID: %w", err)
+		}
+		entryIDs = append(entryIDs, entryID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.JournalEntry, 0, len(entryIDs))
+	for _, entryID := range entryIDs {
+		entry, err := r.GetByID(ctx, entryID)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// SaveReversal inserts a reversal entry and marks the original REVERSED in one transaction
+func (r *JournalEntryRepository) SaveReversal(ctx context.Context, original, reversal *domain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertJournalEntry(ctx, tx, reversal); err != nil {
+		return err
+	}
 
-	var sequence int
-	err := r.pool.QueryRow(ctx, query, orgID, pattern).Scan(&sequence)
+	marked, err := markReversed(ctx, tx, original.ID, *original.ReversedBy, original.UpdatedAt)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get next entry number: %w", err)
+		return err
 	}
+	if !marked {
+		return fmt.Errorf("entry %s is no longer posted", original.EntryNumber)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
 
-	return sequence, nil
+// markReversed marks a posted entry REVERSED within the transaction posting
+// its reversal. It is guarded on status so an entry reversed concurrently is
+// not reversed twice; false is returned when the entry is no longer posted.
+func markReversed(ctx context.Context, tx pgx.Tx, entryID, reversedBy uuid.UUID, updatedAt time.Time) (bool, error) {
+	tag, err := tx.Exec(ctx, `
+        UPDATE journal_entries
+        SET status = 'REVERSED', reversed_by = $2, updated_at = $3
+        WHERE id = $1 AND status = 'POSTED'
+    `, entryID, reversedBy, updatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to update original entry: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListByImportLog lists the entries an import run created, in entry number order
+func (r *JournalEntryRepository) ListByImportLog(ctx context.Context, orgID, importLogID uuid.UUID) ([]*domain.JournalEntry, error) {
+	query := `
+        SELECT id
+        FROM journal_entries
+        WHERE organization_id = $1 AND import_log_id = $2
+        ORDER BY transaction_date, entry_number
+    `
+
+	rows, err := r.pool.Query(ctx, query, orgID, importLogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list imported entries: %w", err)
+	}
+
+	var entryIDs []uuid.UUID
+	for rows.Next() {
+		var entryID uuid.UUID
+		if err := rows.Scan(&entryID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan entry ID: %w", err)
+		}
+		entryIDs = append(entryIDs, entryID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list imported entries: %w", err)
+	}
+
+	entries := make([]*domain.JournalEntry, 0, len(entryIDs))
+	for _, entryID := range entryIDs {
+		entry, err := r.GetByID(ctx, entryID)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
 }
 
 // CountByOrganization counts total entries for an organization
@@ -3,6 +3,7 @@ package repository
 
 import (
     "context"
+    "time"
 
     "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
     "github.com/google/uuid"
@@ -13,9 +14,15 @@ type JournalEntryRepositoryInterface interface {
     // Create creates a new journal entry with its lines
     Create(ctx context.Context, entry *domain.JournalEntry) error
 
-    // Update updates an existing journal entry
+    // CreateBatch creates several journal entries with their lines in one transaction
+    CreateBatch(ctx context.Context, entries []*domain.JournalEntry) error
+
+    // Update updates an existing journal entry, numbering it if it is being posted
     Update(ctx context.Context, entry *domain.JournalEntry) error
 
+    // UpdateWithApproval updates an entry and records the approval action that changed it in one transaction
+    UpdateWithApproval(ctx context.Context, entry *domain.JournalEntry, approval *domain.JournalEntryApproval) error
+
     // Delete soft deletes a journal entry
     Delete(ctx context.Context, entryID uuid.UUID) error
 
@@ -34,8 +41,14 @@ type JournalEntryRepositoryInterface interface {
     // ListByDateRange lists journal entries within a date range
     ListByDateRange(ctx context.Context, orgID uuid.UUID, startDate, endDate string, limit, offset int) ([]*domain.JournalEntry, error)
 
-    // GetNextEntryNumber generates the next entry number for a given date
-    GetNextEntryNumber(ctx context.Context, orgID uuid.UUID, date string) (int, error)
+    // ListDueAutoReversals lists posted entries whose auto-reverse date is on or before a date
+    ListDueAutoReversals(ctx context.Context, asOf time.Time) ([]*domain.JournalEntry, error)
+
+    // SaveReversal inserts a reversal entry and marks the original REVERSED in one transaction
+    SaveReversal(ctx context.Context, original, reversal *domain.JournalEntry) error
+
+    // ListByImportLog lists the entries an import run created
+    ListByImportLog(ctx context.Context, orgID, importLogID uuid.UUID) ([]*domain.JournalEntry, error)
 
     // CountByOrganization counts total entries for an organization
     CountByOrganization(ctx context.Context, orgID uuid.UUID) (int, error)
@@ -22,7 +22,8 @@ func NewJournalLineRepository(pool *pgxpool.Pool) *JournalLineRepository {
 // GetLinesByEntryID retrieves all lines for a journal entry
 func (r *JournalLineRepository) GetLinesByEntryID(ctx context.Context, entryID uuid.UUID) ([]domain.JournalLine, error) {
 	query := `
-        SELECT id, account_id, line_number, reference, description, debit, credit
+        SELECT id, account_id, line_number, reference, description, debit, credit,
+               COALESCE(currency, ''), foreign_debit, foreign_credit, COALESCE(exchange_rate, 0), dimensions
         FROM journal_lines
         WHERE journal_entry_id = $1
         ORDER BY line_number
@@ -45,6 +46,11 @@ func (r *JournalLineRepository) GetLinesByEntryID(ctx context.Context, entryID u
 			&line.Description,
 			&line.Debit,
 			&line.Credit,
+			&line.Currency,
+			&line.ForeignDebit,
+			&line.ForeignCredit,
+			&line.ExchangeRate,
+			&line.Dimensions,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan journal line: %w", err)
@@ -58,7 +64,8 @@ func (r *JournalLineRepository) GetLinesByEntryID(ctx context.Context, entryID u
 // GetLinesByAccountID retrieves all lines for a specific account
 func (r *JournalLineRepository) GetLinesByAccountID(ctx context.Context, accountID uuid.UUID, limit, offset int) ([]domain.JournalLine, error) {
 	query := `
-        SELECT jl.id, jl.account_id, jl.line_number, jl.reference, jl.description, jl.debit, jl.credit
+        SELECT jl.id, jl.account_id, jl.line_number, jl.reference, jl.description, jl.debit, jl.credit,
+               COALESCE(jl.currency, ''), jl.foreign_debit, jl.foreign_credit, COALESCE(jl.exchange_rate, 0), jl.dimensions
         FROM journal_lines jl
         INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
         WHERE jl.account_id = $1 AND je.status = 'POSTED'
@@ -83,6 +90,11 @@ func (r *JournalLineRepository) GetLinesByAccountID(ctx context.Context, account
 			&line.Description,
 			&line.Debit,
 			&line.Credit,
+			&line.Currency,
+			&line.ForeignDebit,
+			&line.ForeignCredit,
+			&line.ExchangeRate,
+			&line.Dimensions,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan journal line: %w", err)
@@ -96,7 +108,8 @@ func (r *JournalLineRepository) GetLinesByAccountID(ctx context.Context, account
 // GetLinesByReference retrieves lines by reference code
 func (r *JournalLineRepository) GetLinesByReference(ctx context.Context, orgID uuid.UUID, reference string) ([]domain.JournalLine, error) {
 	query := `
-        SELECT jl.id, jl.account_id, jl.line_number, jl.reference, jl.description, jl.debit, jl.credit
+        SELECT jl.id, jl.account_id, jl.line_number, jl.reference, jl.description, jl.debit, jl.credit,
+               COALESCE(jl.currency, ''), jl.foreign_debit, jl.foreign_credit, COALESCE(jl.exchange_rate, 0), jl.dimensions
         FROM journal_lines jl
         INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
         WHERE je.organization_id = $1 AND jl.reference = $2 AND je.status = 'POSTED'
@@ -120,6 +133,11 @@ func (r *JournalLineRepository) GetLinesByReference(ctx context.Context, orgID u
 			&line.Description,
 			&line.Debit,
 			&line.Credit,
+			&line.Currency,
+			&line.ForeignDebit,
+			&line.ForeignCredit,
+			&line.ExchangeRate,
+			&line.Dimensions,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan journal line: %w", err)
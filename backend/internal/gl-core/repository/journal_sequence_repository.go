@@ -0,0 +1,190 @@
+// backend/internal/gl-core/repository/journal_sequence_repository.go
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type JournalSequenceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewJournalSequenceRepository creates a new journal sequence repository
+func NewJournalSequenceRepository(pool *pgxpool.Pool) *JournalSequenceRepository {
+	return &JournalSequenceRepository{pool: pool}
+}
+
+const journalSequenceColumns = `
+        id, organization_id, journal_type, prefix, reset_policy, padding, created_at, updated_at
+`
+
+// sequenceQuerier is satisfied by both the pool and a transaction
+type sequenceQuerier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// SaveSequence creates or replaces the numbering for a journal type
+func (r *JournalSequenceRepository) SaveSequence(ctx context.Context, seq *domain.JournalSequence) error {
+	query := `
+        INSERT INTO gl_journal_sequences (` + journalSequenceColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        ON CONFLICT (organization_id, journal_type) DO UPDATE
+        SET prefix = EXCLUDED.prefix,
+            reset_policy = EXCLUDED.reset_policy,
+            padding = EXCLUDED.padding,
+            updated_at = EXCLUDED.updated_at
+        RETURNING id, created_at
+    `
+
+	err := r.pool.QueryRow(ctx, query,
+		seq.ID,
+		seq.OrganizationID,
+		seq.JournalType,
+		seq.Prefix,
+		seq.ResetPolicy,
+		seq.Padding,
+		seq.CreatedAt,
+		seq.UpdatedAt,
+	).Scan(&seq.ID, &seq.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save journal sequence: %w", err)
+	}
+
+	return nil
+}
+
+// GetSequence retrieves the numbering for a journal type, falling back to the
+// built-in default when the organization has not configured it
+func (r *JournalSequenceRepository) GetSequence(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType) (*domain.JournalSequence, error) {
+	return getJournalSequence(ctx, r.pool, orgID, journalType)
+}
+
+// ListSequences lists an organization's configured sequences
+func (r *JournalSequenceRepository) ListSequences(ctx context.Context, orgID uuid.UUID) ([]*domain.JournalSequence, error) {
+	query := "SELECT" + journalSequenceColumns + `
+        FROM gl_journal_sequences
+        WHERE organization_id = $1
+        ORDER BY journal_type
+    `
+
+	return queryJournalSequences(ctx, r.pool, query, orgID)
+}
+
+// PeekNextNumber returns the number the next entry in a sequence period would
+// take. It reserves nothing; the number is only allocated on posting.
+func (r *JournalSequenceRepository) PeekNextNumber(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType, periodKey string) (int64, error) {
+	query := `
+        SELECT COALESCE(MAX(last_number), 0) + 1
+        FROM gl_journal_sequence_counters
+        WHERE organization_id = $1 AND journal_type = $2 AND period_key = $3
+    `
+
+	var next int64
+	if err := r.pool.QueryRow(ctx, query, orgID, journalType, periodKey).Scan(&next); err != nil {
+		return 0, fmt.Errorf("failed to read journal sequence counter: %w", err)
+	}
+
+	return next, nil
+}
+
+// allocateEntryNumber replaces a posted entry's draft placeholder with the next
+// number from its journal sequence. The counter row stays locked until tx ends,
+// so concurrent postings queue behind each other and a rolled back posting
+// gives its number back.
+func allocateEntryNumber(ctx context.Context, tx pgx.Tx, entry *domain.JournalEntry) error {
+	seq, err := getJournalSequence(ctx, tx, entry.OrganizationID, entry.JournalType)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO gl_journal_sequence_counters (organization_id, journal_type, period_key, last_number, updated_at)
+        VALUES ($1, $2, $3, 1, NOW())
+        ON CONFLICT (organization_id, journal_type, period_key) DO UPDATE
+        SET last_number = gl_journal_sequence_counters.last_number + 1,
+            updated_at = NOW()
+        RETURNING last_number
+    `
+
+	var number int64
+	err = tx.QueryRow(ctx, query, entry.OrganizationID, entry.JournalType, seq.PeriodKey(entry.TransactionDate)).Scan(&number)
+	if err != nil {
+		return fmt.Errorf("failed to allocate entry number: %w", err)
+	}
+
+	entry.EntryNumber = seq.Format(entry.TransactionDate, number)
+	return nil
+}
+
+// getJournalSequence loads a configured sequence or the built-in default
+func getJournalSequence(ctx context.Context, q sequenceQuerier, orgID uuid.UUID, journalType domain.JournalType) (*domain.JournalSequence, error) {
+	query := "SELECT" + journalSequenceColumns + `
+        FROM gl_journal_sequences
+        WHERE organization_id = $1 AND journal_type = $2
+    `
+
+	seqs, err := queryJournalSequences(ctx, q, query, orgID, journalType)
+	if err != nil {
+		return nil, err
+	}
+	if len(seqs) == 0 {
+		return domain.DefaultJournalSequence(orgID, journalType), nil
+	}
+	return seqs[0], nil
+}
+
+// queryJournalSequences runs a query selecting journalSequenceColumns
+func queryJournalSequences(ctx context.Context, q sequenceQuerier, query string, args ...interface{}) ([]*domain.JournalSequence, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query journal sequences: %w", err)
+	}
+	defer rows.Close()
+
+	var seqs []*domain.JournalSequence
+	for rows.Next() {
+		seq := &domain.JournalSequence{}
+		err := rows.Scan(
+			&seq.ID,
+			&seq.OrganizationID,
+			&seq.JournalType,
+			&seq.Prefix,
+			&seq.ResetPolicy,
+			&seq.Padding,
+			&seq.CreatedAt,
+			&seq.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal sequence: %w", err)
+		}
+		seqs = append(seqs, seq)
+	}
+
+	return seqs, rows.Err()
+}
+
+// lockDraftNumber locks an entry's row and checks it still carries its draft
+// placeholder, so two concurrent postings of the same draft cannot both take a number
+func lockDraftNumber(ctx context.Context, tx pgx.Tx, entryID uuid.UUID) error {
+	var stored string
+	err := tx.QueryRow(ctx, "SELECT entry_number FROM journal_entries WHERE id = $1 FOR UPDATE", entryID).Scan(&stored)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("journal entry %s not found", entryID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock journal entry: %w", err)
+	}
+
+	if !domain.IsDraftEntryNumber(stored) {
+		return domain.NewGLErrorf(domain.ErrJournalAlreadyNumbered, "entry has already been posted as %s", stored)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+// backend/internal/gl-core/repository/journal_sequence_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// JournalSequenceRepositoryInterface defines data access for journal numbering sequences
+type JournalSequenceRepositoryInterface interface {
+	// SaveSequence creates or replaces the numbering for a journal type
+	SaveSequence(ctx context.Context, seq *domain.JournalSequence) error
+
+	// GetSequence retrieves the numbering for a journal type, or the built-in default
+	GetSequence(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType) (*domain.JournalSequence, error)
+
+	// ListSequences lists an organization's configured sequences
+	ListSequences(ctx context.Context, orgID uuid.UUID) ([]*domain.JournalSequence, error)
+
+	// PeekNextNumber returns the number the next entry in a sequence period would take, without reserving it
+	PeekNextNumber(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType, periodKey string) (int64, error)
+}
@@ -0,0 +1,268 @@
+// backend/internal/gl-core/repository/opening_balance_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OpeningBalanceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOpeningBalanceRepository creates a new opening balance repository
+func NewOpeningBalanceRepository(pool *pgxpool.Pool) *OpeningBalanceRepository {
+	return &OpeningBalanceRepository{pool: pool}
+}
+
+const openingBalanceColumns = `
+        id, organization_id, go_live_date, description, status, entry_id,
+        suspense_account_id, suspense_amount, total_debit, total_credit,
+        created_by, created_at, reversal_entry_id, reversed_by, reversed_at,
+        COALESCE(reversal_reason, '')
+`
+
+// Create saves a batch with its lines and posted opening entry in one transaction
+func (r *OpeningBalanceRepository) Create(ctx context.Context, b *domain.OpeningBalanceBatch, entry *domain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertJournalEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	batchQuery := `
+        INSERT INTO gl_opening_balances (
+            id, organization_id, go_live_date, description, status, entry_id,
+            suspense_account_id, suspense_amount, total_debit, total_credit,
+            created_by, created_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+    `
+
+	_, err = tx.Exec(ctx, batchQuery,
+		b.ID,
+		b.OrganizationID,
+		b.GoLiveDate,
+		b.Description,
+		b.Status,
+		b.EntryID,
+		b.SuspenseAccountID,
+		b.SuspenseAmount,
+		b.TotalDebit,
+		b.TotalCredit,
+		b.CreatedBy,
+		b.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert opening balances: %w", err)
+	}
+
+	lineQuery := `
+        INSERT INTO gl_opening_balance_lines (
+            id, opening_balance_id, line_number, account_id, party_reference,
+            description, debit, credit
+        ) VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8)
+    `
+
+	for _, line := range b.Lines {
+		_, err = tx.Exec(ctx, lineQuery,
+			line.ID,
+			b.ID,
+			line.LineNumber,
+			line.AccountID,
+			line.PartyReference,
+			line.Description,
+			line.Debit,
+			line.Credit,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert opening balance line: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a batch with its lines
+func (r *OpeningBalanceRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.OpeningBalanceBatch, error) {
+	batches, err := r.queryBatches(ctx, "SELECT"+openingBalanceColumns+"FROM gl_opening_balances WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(batches) == 0 {
+		return nil, domain.NewGLError("opening balances not found", domain.ErrOpeningBalanceNotFound)
+	}
+
+	b := batches[0]
+	b.Lines, err = r.listLines(ctx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// GetPosted retrieves the organization's batch in effect, or nil if none
+func (r *OpeningBalanceRepository) GetPosted(ctx context.Context, orgID uuid.UUID) (*domain.OpeningBalanceBatch, error) {
+	query := "SELECT" + openingBalanceColumns + `
+        FROM gl_opening_balances
+        WHERE organization_id = $1 AND status = 'POSTED'
+    `
+
+	batches, err := r.queryBatches(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(batches) == 0 {
+		return nil, nil
+	}
+	return batches[0], nil
+}
+
+// List lists an organization's batches without lines, most recent first
+func (r *OpeningBalanceRepository) List(ctx context.Context, orgID uuid.UUID) ([]*domain.OpeningBalanceBatch, error) {
+	query := "SELECT" + openingBalanceColumns + `
+        FROM gl_opening_balances
+        WHERE organization_id = $1
+        ORDER BY created_at DESC
+    `
+
+	return r.queryBatches(ctx, query, orgID)
+}
+
+// Reverse saves a reversed batch with its posted reversal entry and marks the
+// opening entry reversed, in one transaction
+func (r *OpeningBalanceRepository) Reverse(ctx context.Context, b *domain.OpeningBalanceBatch, entry, reversal *domain.JournalEntry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertJournalEntry(ctx, tx, reversal); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE journal_entries
+        SET status = $2, reversed_by = $3, updated_at = $4
+        WHERE id = $1
+    `, entry.ID, entry.Status, entry.ReversedBy, entry.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update opening entry: %w", err)
+	}
+
+	batchQuery := `
+        UPDATE gl_opening_balances
+        SET status = $2, reversal_entry_id = $3, reversed_by = $4,
+            reversed_at = $5, reversal_reason = $6
+        WHERE id = $1
+    `
+
+	_, err = tx.Exec(ctx, batchQuery,
+		b.ID,
+		b.Status,
+		b.ReversalEntryID,
+		b.ReversedBy,
+		b.ReversedAt,
+		b.ReversalReason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update opening balances: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// queryBatches runs a query selecting openingBalanceColumns
+func (r *OpeningBalanceRepository) queryBatches(ctx context.Context, query string, args ...interface{}) ([]*domain.OpeningBalanceBatch, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query opening balances: %w", err)
+	}
+	defer rows.Close()
+
+	var batches []*domain.OpeningBalanceBatch
+	for rows.Next() {
+		b := &domain.OpeningBalanceBatch{}
+		err := rows.Scan(
+			&b.ID,
+			&b.OrganizationID,
+			&b.GoLiveDate,
+			&b.Description,
+			&b.Status,
+			&b.EntryID,
+			&b.SuspenseAccountID,
+			&b.SuspenseAmount,
+			&b.TotalDebit,
+			&b.TotalCredit,
+			&b.CreatedBy,
+			&b.CreatedAt,
+			&b.ReversalEntryID,
+			&b.ReversedBy,
+			&b.ReversedAt,
+			&b.ReversalReason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan opening balances: %w", err)
+		}
+		batches = append(batches, b)
+	}
+
+	return batches, rows.Err()
+}
+
+// listLines lists a batch's lines with their account details
+func (r *OpeningBalanceRepository) listLines(ctx context.Context, batchID uuid.UUID) ([]domain.OpeningBalanceLine, error) {
+	query := `
+        SELECT l.id, l.line_number, l.account_id, a.code, a.name,
+               COALESCE(l.party_reference, ''), l.description, l.debit, l.credit
+        FROM gl_opening_balance_lines l
+        INNER JOIN gl_accounts a ON a.id = l.account_id
+        WHERE l.opening_balance_id = $1
+        ORDER BY l.line_number
+    `
+
+	rows, err := r.pool.Query(ctx, query, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening balance lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []domain.OpeningBalanceLine
+	for rows.Next() {
+		var line domain.OpeningBalanceLine
+		err := rows.Scan(
+			&line.ID,
+			&line.LineNumber,
+			&line.AccountID,
+			&line.AccountCode,
+			&line.AccountName,
+			&line.PartyReference,
+			&line.Description,
+			&line.Debit,
+			&line.Credit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan opening balance line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
@@ -0,0 +1,27 @@
+// backend/internal/gl-core/repository/opening_balance_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// OpeningBalanceRepositoryInterface defines data access for go-live opening balances
+type OpeningBalanceRepositoryInterface interface {
+	// Create saves a batch with its lines and posted opening entry in one transaction
+	Create(ctx context.Context, batch *domain.OpeningBalanceBatch, entry *domain.JournalEntry) error
+
+	// GetByID retrieves a batch with its lines
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.OpeningBalanceBatch, error)
+
+	// GetPosted retrieves the organization's batch in effect (nil if none)
+	GetPosted(ctx context.Context, orgID uuid.UUID) (*domain.OpeningBalanceBatch, error)
+
+	// List lists an organization's batches without lines, most recent first
+	List(ctx context.Context, orgID uuid.UUID) ([]*domain.OpeningBalanceBatch, error)
+
+	// Reverse saves a reversed batch with its reversal entry in one transaction
+	Reverse(ctx context.Context, batch *domain.OpeningBalanceBatch, entry, reversal *domain.JournalEntry) error
+}
@@ -0,0 +1,459 @@
+// backend/internal/gl-core/repository/recurring_journal_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RecurringJournalRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRecurringJournalRepository creates a new recurring journal repository
+func NewRecurringJournalRepository(pool *pgxpool.Pool) *RecurringJournalRepository {
+	return &RecurringJournalRepository{pool: pool}
+}
+
+const recurringTemplateColumns = `
+        id, organization_id, name, description, reference, frequency, day_of_month,
+        start_date, end_date, auto_post, status, next_run_date, last_run_date,
+        created_by, created_at, updated_at
+`
+
+const recurringRunColumns = `
+        id, template_id, occurrence_date, status, journal_entry_id, created_by, created_at
+`
+
+// Create saves a new template with its lines in one transaction
+func (r *RecurringJournalRepository) Create(ctx context.Context, t *domain.RecurringJournalTemplate) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO gl_recurring_journals (` + recurringTemplateColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+    `
+
+	_, err = tx.Exec(ctx, query,
+		t.ID,
+		t.OrganizationID,
+		t.Name,
+		t.Description,
+		t.Reference,
+		t.Frequency,
+		t.DayOfMonth,
+		t.StartDate,
+		t.EndDate,
+		t.AutoPost,
+		t.Status,
+		t.NextRunDate,
+		t.LastRunDate,
+		t.CreatedBy,
+		t.CreatedAt,
+		t.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create recurring journal: %w", err)
+	}
+
+	if err := insertRecurringLines(ctx, tx, t); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves a template's header, schedule and lines, replacing the lines
+func (r *RecurringJournalRepository) Update(ctx context.Context, t *domain.RecurringJournalTemplate) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE gl_recurring_journals
+        SET name = $2, description = $3, reference = $4, frequency = $5, day_of_month = $6,
+            start_date = $7, end_date = $8, auto_post = $9, status = $10,
+            next_run_date = $11, updated_at = $12
+        WHERE id = $1
+    `
+
+	_, err = tx.Exec(ctx, query,
+		t.ID,
+		t.Name,
+		t.Description,
+		t.Reference,
+		t.Frequency,
+		t.DayOfMonth,
+		t.StartDate,
+		t.EndDate,
+		t.AutoPost,
+		t.Status,
+		t.NextRunDate,
+		t.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update recurring journal: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM gl_recurring_journal_lines WHERE template_id = $1`, t.ID); err != nil {
+		return fmt.Errorf("failed to delete recurring journal lines: %w", err)
+	}
+
+	if err := insertRecurringLines(ctx, tx, t); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+const updateRecurringScheduleQuery = `
+        UPDATE gl_recurring_journals
+        SET status = $2, next_run_date = $3, last_run_date = $4, updated_at = $5
+        WHERE id = $1
+    `
+
+// UpdateSchedule saves a template's status, next and last run dates
+func (r *RecurringJournalRepository) UpdateSchedule(ctx context.Context, t *domain.RecurringJournalTemplate) error {
+	_, err := r.pool.Exec(ctx, updateRecurringScheduleQuery, t.ID, t.Status, t.NextRunDate, t.LastRunDate, t.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update recurring journal schedule: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a template with its lines
+func (r *RecurringJournalRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error) {
+	templates, err := r.queryTemplates(ctx, "SELECT"+recurringTemplateColumns+"FROM gl_recurring_journals WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, domain.NewGLError("recurring journal not found", domain.ErrRecurringTemplateNotFound)
+	}
+	return templates[0], nil
+}
+
+// List lists an organization's templates with their lines, ordered by name
+func (r *RecurringJournalRepository) List(ctx context.Context, orgID uuid.UUID) ([]*domain.RecurringJournalTemplate, error) {
+	query := "SELECT" + recurringTemplateColumns + `
+        FROM gl_recurring_journals
+        WHERE organization_id = $1
+        ORDER BY name
+    `
+
+	return r.queryTemplates(ctx, query, orgID)
+}
+
+// ListDue lists active templates of all organizations whose next run date is on or before a date
+func (r *RecurringJournalRepository) ListDue(ctx context.Context, asOf time.Time) ([]*domain.RecurringJournalTemplate, error) {
+	query := "SELECT" + recurringTemplateColumns + `
+        FROM gl_recurring_journals
+        WHERE status = 'ACTIVE' AND next_run_date <= $1
+        ORDER BY next_run_date, organization_id
+    `
+
+	return r.queryTemplates(ctx, query, asOf)
+}
+
+// CreateRun records a generated or skipped occurrence
+func (r *RecurringJournalRepository) CreateRun(ctx context.Context, run *domain.RecurringJournalRun) error {
+	query := `
+        INSERT INTO gl_recurring_journal_runs (` + recurringRunColumns + `)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		run.ID,
+		run.TemplateID,
+		run.OccurrenceDate,
+		run.Status,
+		run.JournalEntryID,
+		run.CreatedBy,
+		run.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record recurring journal run: %w", err)
+	}
+
+	return nil
+}
+
+// GenerateRun claims a template occurrence and saves the entry generated for
+// it and the template's advanced schedule in one transaction. The run row is
+// inserted first, so a concurrent generator of the same occurrence waits on
+// the unique key and then claims nothing; false is returned in that case.
+func (r *RecurringJournalRepository) GenerateRun(ctx context.Context, t *domain.RecurringJournalTemplate, run *domain.RecurringJournalRun, entry *domain.JournalEntry) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The entry does not exist yet, so the run is claimed without it
+	claim := `
+        INSERT INTO gl_recurring_journal_runs (` + recurringRunColumns + `)
+        VALUES ($1, $2, $3, $4, NULL, $5, $6)
+        ON CONFLICT (template_id, occurrence_date) DO NOTHING
+    `
+
+	tag, err := tx.Exec(ctx, claim,
+		run.ID,
+		run.TemplateID,
+		run.OccurrenceDate,
+		run.Status,
+		run.CreatedBy,
+		run.CreatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim recurring journal run: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if err := insertJournalEntry(ctx, tx, entry); err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE gl_recurring_journal_runs SET journal_entry_id = $2 WHERE id = $1", run.ID, entry.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to link recurring journal run: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, updateRecurringScheduleQuery, t.ID, t.Status, t.NextRunDate, t.LastRunDate, t.UpdatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to update recurring journal schedule: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetRun retrieves the run for a template occurrence, or nil if none
+func (r *RecurringJournalRepository) GetRun(ctx context.Context, templateID uuid.UUID, occurrence time.Time) (*domain.RecurringJournalRun, error) {
+	query := "SELECT" + recurringRunColumns + `
+        FROM gl_recurring_journal_runs
+        WHERE template_id = $1 AND occurrence_date = $2
+    `
+
+	run := &domain.RecurringJournalRun{}
+	err := r.pool.QueryRow(ctx, query, templateID, occurrence).Scan(
+		&run.ID,
+		&run.TemplateID,
+		&run.OccurrenceDate,
+		&run.Status,
+		&run.JournalEntryID,
+		&run.CreatedBy,
+		&run.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get recurring journal run: %w", err)
+	}
+
+	return run, nil
+}
+
+// ListRuns lists a template's runs, most recent occurrence first
+func (r *RecurringJournalRepository) ListRuns(ctx context.Context, templateID uuid.UUID) ([]*domain.RecurringJournalRun, error) {
+	query := "SELECT" + recurringRunColumns + `
+        FROM gl_recurring_journal_runs
+        WHERE template_id = $1
+        ORDER BY occurrence_date DESC
+    `
+
+	rows, err := r.pool.Query(ctx, query, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring journal runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*domain.RecurringJournalRun
+	for rows.Next() {
+		run := &domain.RecurringJournalRun{}
+		err := rows.Scan(
+			&run.ID,
+			&run.TemplateID,
+			&run.OccurrenceDate,
+			&run.Status,
+			&run.JournalEntryID,
+			&run.CreatedBy,
+			&run.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recurring journal run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+// ListSkipped lists a template's skipped occurrence dates on or after a date
+func (r *RecurringJournalRepository) ListSkipped(ctx context.Context, templateID uuid.UUID, from time.Time) ([]time.Time, error) {
+	query := `
+        SELECT occurrence_date
+        FROM gl_recurring_journal_runs
+        WHERE template_id = $1 AND status = 'SKIPPED' AND occurrence_date >= $2
+        ORDER BY occurrence_date
+    `
+
+	rows, err := r.pool.Query(ctx, query, templateID, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list skipped occurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var dates []time.Time
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, fmt.Errorf("failed to scan skipped occurrence: %w", err)
+		}
+		dates = append(dates, date)
+	}
+
+	return dates, rows.Err()
+}
+
+// queryTemplates runs a query selecting recurringTemplateColumns and loads each template's lines
+func (r *RecurringJournalRepository) queryTemplates(ctx context.Context, query string, args ...interface{}) ([]*domain.RecurringJournalTemplate, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recurring journals: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*domain.RecurringJournalTemplate
+	for rows.Next() {
+		t := &domain.RecurringJournalTemplate{}
+		err := rows.Scan(
+			&t.ID,
+			&t.OrganizationID,
+			&t.Name,
+			&t.Description,
+			&t.Reference,
+			&t.Frequency,
+			&t.DayOfMonth,
+			&t.StartDate,
+			&t.EndDate,
+			&t.AutoPost,
+			&t.Status,
+			&t.NextRunDate,
+			&t.LastRunDate,
+			&t.CreatedBy,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recurring journal: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range templates {
+		t.Lines, err = r.listLines(ctx, t.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+// listLines lists a template's lines in order
+func (r *RecurringJournalRepository) listLines(ctx context.Context, templateID uuid.UUID) ([]domain.RecurringTemplateLine, error) {
+	query := `
+        SELECT id, line_number, account_id, description, debit, credit, dimensions
+        FROM gl_recurring_journal_lines
+        WHERE template_id = $1
+        ORDER BY line_number
+    `
+
+	rows, err := r.pool.Query(ctx, query, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring journal lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []domain.RecurringTemplateLine
+	for rows.Next() {
+		var line domain.RecurringTemplateLine
+		err := rows.Scan(
+			&line.ID,
+			&line.LineNumber,
+			&line.AccountID,
+			&line.Description,
+			&line.Debit,
+			&line.Credit,
+			&line.Dimensions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recurring journal line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
+
+// insertRecurringLines inserts a template's lines within a transaction
+func insertRecurringLines(ctx context.Context, tx pgx.Tx, t *domain.RecurringJournalTemplate) error {
+	query := `
+        INSERT INTO gl_recurring_journal_lines (
+            id, template_id, line_number, account_id, description, debit, credit, dimensions
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+
+	for _, line := range t.Lines {
+		dimensions := line.Dimensions
+		if dimensions == nil {
+			dimensions = domain.LineDimensions{}
+		}
+
+		_, err := tx.Exec(ctx, query,
+			line.ID,
+			t.ID,
+			line.LineNumber,
+			line.AccountID,
+			line.Description,
+			line.Debit,
+			line.Credit,
+			dimensions,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert recurring journal line: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// backend/internal/gl-core/repository/recurring_journal_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// RecurringJournalRepositoryInterface defines data access for recurring journal templates
+type RecurringJournalRepositoryInterface interface {
+	// Create saves a new template with its lines
+	Create(ctx context.Context, template *domain.RecurringJournalTemplate) error
+
+	// Update saves a template's header, schedule and lines
+	Update(ctx context.Context, template *domain.RecurringJournalTemplate) error
+
+	// UpdateSchedule saves a template's status, next and last run dates
+	UpdateSchedule(ctx context.Context, template *domain.RecurringJournalTemplate) error
+
+	// GetByID retrieves a template with its lines
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error)
+
+	// List lists an organization's templates with their lines, ordered by name
+	List(ctx context.Context, orgID uuid.UUID) ([]*domain.RecurringJournalTemplate, error)
+
+	// ListDue lists active templates of all organizations whose next run date is on or before a date
+	ListDue(ctx context.Context, asOf time.Time) ([]*domain.RecurringJournalTemplate, error)
+
+	// CreateRun records a generated or skipped occurrence
+	CreateRun(ctx context.Context, run *domain.RecurringJournalRun) error
+
+	// GenerateRun claims a template occurrence and saves the entry generated for it
+	// and the template's advanced schedule in one transaction. It returns false,
+	// saving nothing, when the occurrence has already been claimed.
+	GenerateRun(ctx context.Context, template *domain.RecurringJournalTemplate, run *domain.RecurringJournalRun, entry *domain.JournalEntry) (bool, error)
+
+	// GetRun retrieves the run for a template occurrence (nil if none)
+	GetRun(ctx context.Context, templateID uuid.UUID, occurrence time.Time) (*domain.RecurringJournalRun, error)
+
+	// ListRuns lists a template's runs, most recent occurrence first
+	ListRuns(ctx context.Context, templateID uuid.UUID) ([]*domain.RecurringJournalRun, error)
+
+	// ListSkipped lists a template's skipped occurrence dates on or after a date
+	ListSkipped(ctx context.Context, templateID uuid.UUID, from time.Time) ([]time.Time, error)
+}
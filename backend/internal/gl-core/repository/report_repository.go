@@ -0,0 +1,183 @@
+// backend/internal/gl-core/repository/report_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(pool *pgxpool.Pool) *ReportRepository {
+	return &ReportRepository{pool: pool}
+}
+
+// GetAccountActivity returns raw debit/credit sums per account for posted entries.
+// Lines dated before FromDate are summed into the opening columns, lines dated
+// between FromDate and ToDate (inclusive) into the period columns. A dimension
+// filter restricts the sums to matching lines; accounts are still all listed.
+func (r *ReportRepository) GetAccountActivity(ctx context.Context, filter AccountActivityFilter) ([]domain.TrialBalanceLine, error) {
+	query := `
+        SELECT a.id, a.code, a.name, a.type, a.parent_code,
+               COALESCE(SUM(CASE WHEN t.transaction_date < $2 THEN t.debit END), 0)  AS opening_debit,
+               COALESCE(SUM(CASE WHEN t.transaction_date < $2 THEN t.credit END), 0) AS opening_credit,
+               COALESCE(SUM(CASE WHEN t.transaction_date >= $2 THEN t.debit END), 0)  AS period_debit,
+               COALESCE(SUM(CASE WHEN t.transaction_date >= $2 THEN t.credit END), 0) AS period_credit
+        FROM gl_accounts a
+        LEFT JOIN (
+            SELECT jl.account_id, je.transaction_date, jl.debit, jl.credit
+            FROM journal_lines jl
+            INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+            WHERE je.organization_id = $1
+              AND je.status IN ('POSTED', 'REVERSED')
+              AND je.transaction_date <= $3
+              AND (NOT $4 OR je.journal_type <> 'CLOSING')
+              AND ($5::jsonb IS NULL OR jl.dimensions @> $5::jsonb)
+        ) t ON t.account_id = a.id
+        WHERE a.organization_id = $1
+        GROUP BY a.id, a.code, a.name, a.type, a.parent_code
+        ORDER BY a.code
+    `
+
+	rows, err := r.pool.Query(ctx, query,
+		filter.OrganizationID,
+		filter.FromDate,
+		filter.ToDate,
+		filter.ExcludeClosing,
+		filter.Dimensions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account activity: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []domain.TrialBalanceLine
+	for rows.Next() {
+		var line domain.TrialBalanceLine
+		err := rows.Scan(
+			&line.AccountID,
+			&line.Code,
+			&line.Name,
+			&line.Type,
+			&line.ParentID,
+			&line.OpeningDebit,
+			&line.OpeningCredit,
+			&line.PeriodDebit,
+			&line.PeriodCredit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account activity: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
+
+// GetAccountStatement returns the account's balance from posted entries dated
+// before FromDate and its posted lines dated FromDate through ToDate, ordered
+// by date and entry number. Each line lists the other accounts on its entry.
+// Running balances are left to the caller.
+func (r *ReportRepository) GetAccountStatement(ctx context.Context, filter AccountStatementFilter) (*domain.AccountStatement, error) {
+	stmt := &domain.AccountStatement{
+		OrganizationID: filter.OrganizationID,
+		AccountID:      filter.AccountID,
+		FromDate:       filter.FromDate,
+		ToDate:         filter.ToDate,
+		Dimensions:     filter.Dimensions,
+	}
+
+	err := r.pool.QueryRow(ctx, `
+        SELECT code, name, type
+        FROM gl_accounts
+        WHERE id = $1 AND organization_id = $2
+    `, filter.AccountID, filter.OrganizationID).Scan(&stmt.AccountCode, &stmt.AccountName, &stmt.AccountType)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.NewGLError("account not found in organization", domain.ErrAccountOrgMismatch)
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	err = r.pool.QueryRow(ctx, `
+        SELECT COALESCE(SUM(jl.debit - jl.credit), 0)
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        WHERE je.organization_id = $1
+          AND jl.account_id = $2
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND je.transaction_date < $3
+          AND ($4::jsonb IS NULL OR jl.dimensions @> $4::jsonb)
+    `, filter.OrganizationID, filter.AccountID, filter.FromDate, filter.Dimensions).Scan(&stmt.OpeningBalance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opening balance: %w", err)
+	}
+
+	query := `
+        SELECT je.id, jl.id, je.entry_number, je.journal_type, je.transaction_date,
+               COALESCE(NULLIF(jl.reference, ''), je.reference, ''),
+               COALESCE(NULLIF(jl.description, ''), je.description),
+               COALESCE((
+                   SELECT json_agg(json_build_object('account_id', a.id, 'code', a.code, 'name', a.name) ORDER BY a.code)
+                   FROM gl_accounts a
+                   WHERE a.id IN (
+                       SELECT other.account_id
+                       FROM journal_lines other
+                       WHERE other.journal_entry_id = je.id
+                         AND other.account_id <> jl.account_id
+                   )
+               ), '[]'::json),
+               jl.debit, jl.credit
+        FROM journal_lines jl
+        INNER JOIN journal_entries je ON jl.journal_entry_id = je.id
+        WHERE je.organization_id = $1
+          AND jl.account_id = $2
+          AND je.status IN ('POSTED', 'REVERSED')
+          AND je.transaction_date >= $3
+          AND je.transaction_date <= $4
+          AND ($5::jsonb IS NULL OR jl.dimensions @> $5::jsonb)
+        ORDER BY je.transaction_date, je.entry_number, jl.line_number
+    `
+
+	rows, err := r.pool.Query(ctx, query,
+		filter.OrganizationID,
+		filter.AccountID,
+		filter.FromDate,
+		filter.ToDate,
+		filter.Dimensions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account statement lines: %w", err)
+	}
+	defer rows.Close()
+
+	stmt.Lines = []domain.AccountStatementLine{}
+	for rows.Next() {
+		var line domain.AccountStatementLine
+		err := rows.Scan(
+			&line.JournalEntryID,
+			&line.LineID,
+			&line.EntryNumber,
+			&line.JournalType,
+			&line.TransactionDate,
+			&line.Reference,
+			&line.Description,
+			&line.CounterAccounts,
+			&line.Debit,
+			&line.Credit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account statement line: %w", err)
+		}
+		stmt.Lines = append(stmt.Lines, line)
+	}
+
+	return stmt, rows.Err()
+}
@@ -0,0 +1,41 @@
+// backend/internal/gl-core/repository/report_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// ReportRepositoryInterface defines data access for ledger reports
+type ReportRepositoryInterface interface {
+	// GetAccountActivity returns opening and period debit/credit sums per account
+	GetAccountActivity(ctx context.Context, filter AccountActivityFilter) ([]domain.TrialBalanceLine, error)
+
+	// GetAccountStatement returns an account's opening balance and posted lines for a period
+	GetAccountStatement(ctx context.Context, filter AccountStatementFilter) (*domain.AccountStatement, error)
+}
+
+// AccountActivityFilter selects the posted entries summed by GetAccountActivity
+type AccountActivityFilter struct {
+	OrganizationID uuid.UUID
+	FromDate       time.Time // Lines before FromDate are summed into the opening columns
+	ToDate         time.Time // Inclusive
+	ExcludeClosing bool      // Leave out year-end closing entries (income statements)
+
+	// Only sum lines tagged with all of these dimension values (nil = all lines)
+	Dimensions domain.LineDimensions
+}
+
+// AccountStatementFilter selects the posted lines listed by GetAccountStatement
+type AccountStatementFilter struct {
+	OrganizationID uuid.UUID
+	AccountID      uuid.UUID
+	FromDate       time.Time // Lines before FromDate are summed into the opening balance
+	ToDate         time.Time // Inclusive
+
+	// Only include lines tagged with all of these dimension values (nil = all lines)
+	Dimensions domain.LineDimensions
+}
@@ -0,0 +1,295 @@
+// backend/internal/gl-core/repository/year_end_close_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type YearEndCloseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewYearEndCloseRepository creates a new year-end close repository
+func NewYearEndCloseRepository(pool *pgxpool.Pool) *YearEndCloseRepository {
+	return &YearEndCloseRepository{pool: pool}
+}
+
+// GetByFiscalYear retrieves the most recent close of a fiscal year, or nil if it was never closed
+func (r *YearEndCloseRepository) GetByFiscalYear(ctx context.Context, fiscalYearID uuid.UUID) (*domain.YearEndClose, error) {
+	query := `
+        SELECT id, organization_id, fiscal_year_id, next_fiscal_year_id,
+               retained_earnings_account_id, closing_entry_id, reversal_entry_id,
+               net_income, status, closed_by, closed_at,
+               reversed_by, reversed_at, COALESCE(reversal_reason, '')
+        FROM fiscal_year_closings
+        WHERE fiscal_year_id = $1
+        ORDER BY closed_at DESC
+        LIMIT 1
+    `
+
+	yc := &domain.YearEndClose{}
+	err := r.pool.QueryRow(ctx, query, fiscalYearID).Scan(
+		&yc.ID,
+		&yc.OrganizationID,
+		&yc.FiscalYearID,
+		&yc.NextFiscalYearID,
+		&yc.RetainedEarningsAccountID,
+		&yc.ClosingEntryID,
+		&yc.ReversalEntryID,
+		&yc.NetIncome,
+		&yc.Status,
+		&yc.ClosedBy,
+		&yc.ClosedAt,
+		&yc.ReversedBy,
+		&yc.ReversedAt,
+		&yc.ReversalReason,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get year-end close: %w", err)
+	}
+
+	if yc.Status == domain.YearEndCloseActive {
+		yc.OpeningBalances, err = r.ListOpeningBalances(ctx, yc.NextFiscalYearID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return yc, nil
+}
+
+// ListOpeningBalances lists the balances brought forward into a fiscal year
+func (r *YearEndCloseRepository) ListOpeningBalances(ctx context.Context, fiscalYearID uuid.UUID) ([]domain.OpeningBalance, error) {
+	query := `
+        SELECT ob.id, ob.fiscal_year_id, ob.organization_id, ob.account_id,
+               ob.year_end_close_id, ob.debit, ob.credit
+        FROM fiscal_year_opening_balances ob
+        INNER JOIN gl_accounts a ON a.id = ob.account_id
+        WHERE ob.fiscal_year_id = $1
+        ORDER BY a.code
+    `
+
+	rows, err := r.pool.Query(ctx, query, fiscalYearID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening balances: %w", err)
+	}
+	defer rows.Close()
+
+	var balances []domain.OpeningBalance
+	for rows.Next() {
+		var ob domain.OpeningBalance
+		err := rows.Scan(
+			&ob.ID,
+			&ob.FiscalYearID,
+			&ob.OrganizationID,
+			&ob.AccountID,
+			&ob.YearEndCloseID,
+			&ob.Debit,
+			&ob.Credit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan opening balance: %w", err)
+		}
+		balances = append(balances, ob)
+	}
+
+	return balances, rows.Err()
+}
+
+// Create saves a year-end close in one transaction: the posted closing entry,
+// the next fiscal year (when newYear is set), the opening balances, the closed
+// fiscal year status and the period close events
+func (r *YearEndCloseRepository) Create(
+	ctx context.Context,
+	yc *domain.YearEndClose,
+	fy *domain.FiscalYear,
+	closingEntry *domain.JournalEntry,
+	newYear *domain.FiscalYear,
+	events []domain.PeriodEvent,
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if closingEntry != nil {
+		if err := insertJournalEntry(ctx, tx, closingEntry); err != nil {
+			return err
+		}
+	}
+
+	if newYear != nil {
+		if err := insertFiscalYear(ctx, tx, newYear); err != nil {
+			return err
+		}
+	}
+
+	closeQuery := `
+        INSERT INTO fiscal_year_closings (
+            id, organization_id, fiscal_year_id, next_fiscal_year_id,
+            retained_earnings_account_id, closing_entry_id, net_income,
+            status, closed_by, closed_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+
+	_, err = tx.Exec(ctx, closeQuery,
+		yc.ID,
+		yc.OrganizationID,
+		yc.FiscalYearID,
+		yc.NextFiscalYearID,
+		yc.RetainedEarningsAccountID,
+		yc.ClosingEntryID,
+		yc.NetIncome,
+		yc.Status,
+		yc.ClosedBy,
+		yc.ClosedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert year-end close: %w", err)
+	}
+
+	balanceQuery := `
+        INSERT INTO fiscal_year_opening_balances (
+            id, fiscal_year_id, organization_id, account_id,
+            year_end_close_id, debit, credit
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+    `
+
+	for _, ob := range yc.OpeningBalances {
+		_, err = tx.Exec(ctx, balanceQuery,
+			ob.ID,
+			ob.FiscalYearID,
+			ob.OrganizationID,
+			ob.AccountID,
+			ob.YearEndCloseID,
+			ob.Debit,
+			ob.Credit,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert opening balance: %w", err)
+		}
+	}
+
+	if err := updateFiscalYearStatus(ctx, tx, fy); err != nil {
+		return err
+	}
+
+	if err := updatePeriodsForEvents(ctx, tx, fy, events); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Reverse undoes a year-end close in one transaction: the reversal entry, the
+// REVERSED original, the close status, the removed opening balances, the
+// reopened fiscal year and any period reopen events
+func (r *YearEndCloseRepository) Reverse(
+	ctx context.Context,
+	yc *domain.YearEndClose,
+	fy *domain.FiscalYear,
+	closingEntry *domain.JournalEntry,
+	reversalEntry *domain.JournalEntry,
+	events []domain.PeriodEvent,
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The final period is reopened first so the reversal can post into it
+	if err := updatePeriodsForEvents(ctx, tx, fy, events); err != nil {
+		return err
+	}
+
+	if reversalEntry != nil {
+		if err := insertJournalEntry(ctx, tx, reversalEntry); err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE journal_entries
+            SET status = $2, reversed_by = $3, updated_at = $4
+            WHERE id = $1
+        `, closingEntry.ID, closingEntry.Status, closingEntry.ReversedBy, closingEntry.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to update closing entry: %w", err)
+		}
+	}
+
+	closeQuery := `
+        UPDATE fiscal_year_closings
+        SET status = $2, reversal_entry_id = $3, reversed_by = $4,
+            reversed_at = $5, reversal_reason = $6
+        WHERE id = $1
+    `
+
+	_, err = tx.Exec(ctx, closeQuery,
+		yc.ID,
+		yc.Status,
+		yc.ReversalEntryID,
+		yc.ReversedBy,
+		yc.ReversedAt,
+		yc.ReversalReason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update year-end close: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM fiscal_year_opening_balances WHERE year_end_close_id = $1", yc.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete opening balances: %w", err)
+	}
+
+	if err := updateFiscalYearStatus(ctx, tx, fy); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// updateFiscalYearStatus saves a fiscal year's status within a transaction
+func updateFiscalYearStatus(ctx context.Context, tx pgx.Tx, fy *domain.FiscalYear) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE fiscal_years SET status = $2, updated_at = $3 WHERE id = $1",
+		fy.ID, fy.Status, fy.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update fiscal year status: %w", err)
+	}
+	return nil
+}
+
+// updatePeriodsForEvents saves the status of each fiscal year period that has an event
+func updatePeriodsForEvents(ctx context.Context, tx pgx.Tx, fy *domain.FiscalYear, events []domain.PeriodEvent) error {
+	for i := range events {
+		for j := range fy.Periods {
+			if fy.Periods[j].ID != events[i].PeriodID {
+				continue
+			}
+			if err := updatePeriodStatus(ctx, tx, &fy.Periods[j], &events[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
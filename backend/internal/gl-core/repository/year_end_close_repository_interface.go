@@ -0,0 +1,24 @@
+// backend/internal/gl-core/repository/year_end_close_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// YearEndCloseRepositoryInterface defines data access for year-end closes and opening balances
+type YearEndCloseRepositoryInterface interface {
+	// GetByFiscalYear retrieves the most recent close of a fiscal year (nil if never closed)
+	GetByFiscalYear(ctx context.Context, fiscalYearID uuid.UUID) (*domain.YearEndClose, error)
+
+	// ListOpeningBalances lists the balances brought forward into a fiscal year
+	ListOpeningBalances(ctx context.Context, fiscalYearID uuid.UUID) ([]domain.OpeningBalance, error)
+
+	// Create saves a year-end close with its closing entry, opening balances and period events
+	Create(ctx context.Context, yc *domain.YearEndClose, fy *domain.FiscalYear, closingEntry *domain.JournalEntry, newYear *domain.FiscalYear, events []domain.PeriodEvent) error
+
+	// Reverse undoes a year-end close with its reversal entry and period events
+	Reverse(ctx context.Context, yc *domain.YearEndClose, fy *domain.FiscalYear, closingEntry, reversalEntry *domain.JournalEntry, events []domain.PeriodEvent) error
+}
@@ -2,21 +2,29 @@
 package routes
 
 import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
 	handlers "github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterAccountRoutes(router *gin.RouterGroup, handler *handlers.AccountHandler) {
+// RegisterAccountRoutes registers chart of accounts routes. Authentication is
+// required so merges and moves are recorded against the user who made them.
+func RegisterAccountRoutes(router *gin.RouterGroup, handler *handlers.AccountHandler, authMiddleware *middleware.AuthMiddleware) {
 	accounts := router.Group("/accounts")
+	accounts.Use(authMiddleware.Authenticate())
 	{
-		accounts.POST("", handler.CreateAccount)
-		accounts.GET("", handler.ListAccounts)
-		accounts.GET("/search", handler.SearchAccounts)
-		accounts.GET("/code/:code", handler.GetAccountByCode)
-		accounts.GET("/:id", handler.GetAccount)
-		accounts.PUT("/:id", handler.UpdateAccount)
-		accounts.DELETE("/:id", handler.SoftDeleteAccount)
-		accounts.POST("/:id/activate", handler.ActivateAccount)
-		accounts.POST("/:id/deactivate", handler.DeactivateAccount)
+		accounts.POST("", authMiddleware.RequirePermission("accounts", "create"), handler.CreateAccount)
+		accounts.GET("", authMiddleware.RequirePermission("accounts", "view"), handler.ListAccounts)
+		accounts.GET("/search", authMiddleware.RequirePermission("accounts", "view"), handler.SearchAccounts)
+		accounts.GET("/tree", authMiddleware.RequirePermission("accounts", "view"), handler.GetAccountTree)
+		accounts.GET("/merges", authMiddleware.RequirePermission("accounts", "view"), handler.ListAccountMerges)
+		accounts.GET("/code/:code", authMiddleware.RequirePermission("accounts", "view"), handler.GetAccountByCode)
+		accounts.GET("/:id", authMiddleware.RequirePermission("accounts", "view"), handler.GetAccount)
+		accounts.PUT("/:id", authMiddleware.RequirePermission("accounts", "edit"), handler.UpdateAccount)
+		accounts.DELETE("/:id", authMiddleware.RequirePermission("accounts", "delete"), handler.SoftDeleteAccount)
+		accounts.POST("/:id/activate", authMiddleware.RequirePermission("accounts", "edit"), handler.ActivateAccount)
+		accounts.POST("/:id/deactivate", authMiddleware.RequirePermission("accounts", "edit"), handler.DeactivateAccount)
+		accounts.POST("/:id/move", authMiddleware.RequirePermission("accounts", "restructure"), handler.MoveAccount)   // Re-parent within the chart
+		accounts.POST("/:id/merge", authMiddleware.RequirePermission("accounts", "restructure"), handler.MergeAccount) // Move lines and balances into the target account
 	}
 }
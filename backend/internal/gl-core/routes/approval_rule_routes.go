@@ -0,0 +1,20 @@
+// backend/internal/gl-core/routes/approval_rule_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterApprovalRuleRoutes registers journal entry approval rule routes
+func RegisterApprovalRuleRoutes(r *gin.RouterGroup, h *handler.ApprovalRuleHandler, authMiddleware *middleware.AuthMiddleware) {
+	rules := r.Group("/approval-rules")
+	rules.Use(authMiddleware.Authenticate())
+	{
+		rules.GET("", authMiddleware.RequirePermission("approval_rules", "view"), h.ListRules)
+		rules.POST("", authMiddleware.RequirePermission("approval_rules", "manage"), h.CreateRule) // Amount threshold, accounts, segregation of duties
+		rules.GET("/:id", authMiddleware.RequirePermission("approval_rules", "view"), h.GetRule)
+		rules.PUT("/:id", authMiddleware.RequirePermission("approval_rules", "manage"), h.UpdateRule) // Change conditions or deactivate
+	}
+}
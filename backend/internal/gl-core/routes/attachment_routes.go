@@ -0,0 +1,32 @@
+// backend/internal/gl-core/routes/attachment_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterAttachmentRoutes registers supporting document routes for journal entries and imports
+func RegisterAttachmentRoutes(r *gin.RouterGroup, h *handler.AttachmentHandler, authMiddleware *middleware.AuthMiddleware) {
+	entries := r.Group("/journal-entries/:id/attachments")
+	entries.Use(authMiddleware.Authenticate())
+	{
+		entries.GET("", authMiddleware.RequirePermission("attachments", "view"), h.ListEntryAttachments)
+		entries.POST("", authMiddleware.RequirePermission("attachments", "upload"), h.UploadEntryAttachment) // Invoice PDFs, receipts
+	}
+
+	imports := r.Group("/import/logs/:id/attachments")
+	imports.Use(authMiddleware.Authenticate())
+	{
+		imports.GET("", authMiddleware.RequirePermission("attachments", "view"), h.ListImportAttachments) // Original workbooks
+	}
+
+	attachments := r.Group("/attachments")
+	attachments.Use(authMiddleware.Authenticate())
+	{
+		attachments.GET("/:id", authMiddleware.RequirePermission("attachments", "view"), h.GetAttachment)
+		attachments.GET("/:id/download", authMiddleware.RequirePermission("attachments", "view"), h.DownloadAttachment) // Checksum verified
+		attachments.DELETE("/:id", authMiddleware.RequirePermission("attachments", "delete"), h.DeleteAttachment)       // Draft entries only
+	}
+}
@@ -0,0 +1,20 @@
+// backend/internal/gl-core/routes/coa_template_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterCOATemplateRoutes registers chart of accounts template routes
+func RegisterCOATemplateRoutes(r *gin.RouterGroup, h *handler.COATemplateHandler, authMiddleware *middleware.AuthMiddleware) {
+	templates := r.Group("/coa-templates")
+	templates.Use(authMiddleware.Authenticate())
+	{
+		templates.GET("", authMiddleware.RequirePermission("coa_templates", "view"), h.ListTemplates)                 // Built-in templates
+		templates.GET("/applications", authMiddleware.RequirePermission("coa_templates", "view"), h.ListApplications) // Versions applied to an organization
+		templates.GET("/:code", authMiddleware.RequirePermission("coa_templates", "view"), h.GetTemplate)             // Accounts, tax accounts and payroll mappings
+		templates.POST("/:code/apply", authMiddleware.RequirePermission("coa_templates", "apply"), h.ApplyTemplate)   // Provision or upgrade
+	}
+}
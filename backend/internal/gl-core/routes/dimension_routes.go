@@ -0,0 +1,22 @@
+// backend/internal/gl-core/routes/dimension_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterDimensionRoutes registers analytic dimension routes
+func RegisterDimensionRoutes(r *gin.RouterGroup, h *handler.DimensionHandler, authMiddleware *middleware.AuthMiddleware) {
+	dimensions := r.Group("/dimensions")
+	dimensions.Use(authMiddleware.Authenticate())
+	{
+		dimensions.GET("", authMiddleware.RequirePermission("dimensions", "view"), h.ListDimensions)              // Dimensions with their values
+		dimensions.POST("", authMiddleware.RequirePermission("dimensions", "create"), h.CreateDimension)          // Cost center, department, project, ...
+		dimensions.GET("/:id", authMiddleware.RequirePermission("dimensions", "view"), h.GetDimension)            // Dimension with its values
+		dimensions.PUT("/:id", authMiddleware.RequirePermission("dimensions", "edit"), h.UpdateDimension)         // Name, required account types, active flag
+		dimensions.POST("/:id/values", authMiddleware.RequirePermission("dimensions", "create"), h.AddValue)      // Add a value
+		dimensions.PUT("/values/:valueId", authMiddleware.RequirePermission("dimensions", "edit"), h.UpdateValue) // Rename or deactivate a value
+	}
+}
@@ -0,0 +1,20 @@
+// backend/internal/gl-core/routes/exchange_rate_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterExchangeRateRoutes registers per-organization exchange rate routes
+func RegisterExchangeRateRoutes(r *gin.RouterGroup, h *handler.ExchangeRateHandler, authMiddleware *middleware.AuthMiddleware) {
+	rates := r.Group("/exchange-rates")
+	rates.Use(authMiddleware.Authenticate())
+	{
+		rates.POST("", authMiddleware.RequirePermission("exchange_rates", "create"), h.SetExchangeRate)          // Set rate for a pair and date
+		rates.GET("", authMiddleware.RequirePermission("exchange_rates", "view"), h.ListExchangeRates)           // List rates
+		rates.GET("/lookup", authMiddleware.RequirePermission("exchange_rates", "view"), h.GetEffectiveRate)     // Rate in effect on a date
+		rates.DELETE("/:id", authMiddleware.RequirePermission("exchange_rates", "delete"), h.DeleteExchangeRate) // Delete rate
+	}
+}
@@ -0,0 +1,33 @@
+// backend/internal/gl-core/routes/fiscal_period_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterFiscalPeriodRoutes registers fiscal year and period close routes.
+// Closing and reopening periods require the fiscal_periods close/reopen permissions.
+func RegisterFiscalPeriodRoutes(r *gin.RouterGroup, h *handler.FiscalPeriodHandler, authMiddleware *middleware.AuthMiddleware) {
+	fiscalYears := r.Group("/fiscal-years")
+	fiscalYears.Use(authMiddleware.Authenticate())
+	{
+		fiscalYears.POST("", authMiddleware.RequirePermission("fiscal_periods", "create"), h.CreateFiscalYear)                     // Create fiscal year with 12 periods
+		fiscalYears.GET("", authMiddleware.RequirePermission("fiscal_periods", "view"), h.ListFiscalYears)                         // List fiscal years
+		fiscalYears.GET("/:id", authMiddleware.RequirePermission("fiscal_periods", "view"), h.GetFiscalYear)                       // Get fiscal year with periods
+		fiscalYears.GET("/:id/closing", authMiddleware.RequirePermission("fiscal_periods", "view"), h.GetYearEndClose)             // Year-end close details
+		fiscalYears.GET("/:id/opening-balances", authMiddleware.RequirePermission("fiscal_periods", "view"), h.GetOpeningBalances) // Balances brought forward
+		fiscalYears.POST("/:id/close", authMiddleware.RequirePermission("fiscal_periods", "close"), h.CloseFiscalYear)             // Year-end close (idempotent)
+		fiscalYears.POST("/:id/reopen", authMiddleware.RequirePermission("fiscal_periods", "reopen"), h.ReopenFiscalYear)          // Reverse year-end close
+	}
+
+	periods := r.Group("/periods")
+	periods.Use(authMiddleware.Authenticate())
+	{
+		periods.GET("/:id/history", authMiddleware.RequirePermission("fiscal_periods", "view"), h.GetPeriodHistory)     // Close/reopen audit trail
+		periods.POST("/:id/soft-close", authMiddleware.RequirePermission("fiscal_periods", "close"), h.SoftClosePeriod) // Lock for review
+		periods.POST("/:id/close", authMiddleware.RequirePermission("fiscal_periods", "close"), h.ClosePeriod)          // Close period
+		periods.POST("/:id/reopen", authMiddleware.RequirePermission("fiscal_periods", "reopen"), h.ReopenPeriod)       // Reopen (reason required)
+	}
+}
@@ -0,0 +1,22 @@
+// backend/internal/gl-core/routes/fx_revaluation_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterFXRevaluationRoutes registers unrealized FX revaluation routes
+func RegisterFXRevaluationRoutes(r *gin.RouterGroup, h *handler.FXRevaluationHandler, authMiddleware *middleware.AuthMiddleware) {
+	revaluations := r.Group("/fx-revaluations")
+	revaluations.Use(authMiddleware.Authenticate())
+	{
+		revaluations.GET("/settings", authMiddleware.RequirePermission("fx_revaluations", "view"), h.GetSettings)    // Gain/loss accounts
+		revaluations.PUT("/settings", authMiddleware.RequirePermission("fx_revaluations", "edit"), h.UpdateSettings) // Set gain/loss accounts
+		revaluations.POST("/preview", authMiddleware.RequirePermission("fx_revaluations", "view"), h.Preview)        // Calculate without posting
+		revaluations.POST("", authMiddleware.RequirePermission("fx_revaluations", "run"), h.Run)                     // Post revaluation and reversal
+		revaluations.GET("", authMiddleware.RequirePermission("fx_revaluations", "view"), h.ListRevaluations)        // List runs
+		revaluations.GET("/:id", authMiddleware.RequirePermission("fx_revaluations", "view"), h.GetRevaluation)      // Run report (format=csv|xlsx to export)
+	}
+}
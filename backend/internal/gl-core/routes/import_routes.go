@@ -1,19 +1,31 @@
 package routes
 
 import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterImportRoutes registers import-related routes
-func RegisterImportRoutes(router *gin.RouterGroup, importHandler *handler.ImportHandler) {
+func RegisterImportRoutes(router *gin.RouterGroup, importHandler *handler.ImportHandler, authMiddleware *middleware.AuthMiddleware) {
 	imports := router.Group("/import")
+	imports.Use(authMiddleware.Authenticate())
 	{
 		// Import endpoints
-		imports.POST("/accounts", importHandler.ImportChartOfAccounts)
-		imports.POST("/journal-entries", importHandler.ImportJournalEntries)
+		imports.POST("/accounts", authMiddleware.RequirePermission("imports", "create"), importHandler.ImportChartOfAccounts)
+		imports.POST("/journal-entries", authMiddleware.RequirePermission("imports", "create"), importHandler.ImportJournalEntries)
+
+		// Background import jobs
+		imports.GET("/jobs/:id", authMiddleware.RequirePermission("imports", "view"), importHandler.GetImportJob)              // Status and progress
+		imports.POST("/jobs/:id/cancel", authMiddleware.RequirePermission("imports", "create"), importHandler.CancelImportJob) // Stop a queued or running import
+
+		// Import log
+		imports.GET("/batches", authMiddleware.RequirePermission("imports", "view"), importHandler.ListImports)                          // Filter by type
+		imports.GET("/batches/:id", authMiddleware.RequirePermission("imports", "view"), importHandler.GetImport)                        // Batch with its row errors
+		imports.GET("/batches/:id/error-report", authMiddleware.RequirePermission("imports", "view"), importHandler.DownloadErrorReport) // Workbook with failing rows annotated
+		imports.POST("/batches/:id/rollback", authMiddleware.RequirePermission("imports", "rollback"), importHandler.RollbackImport)     // Deactivate the accounts or void the entries it created
 
 		// Template download
-		imports.GET("/template/:type", importHandler.DownloadTemplate)
+		imports.GET("/template/:type", authMiddleware.RequirePermission("imports", "view"), importHandler.DownloadTemplate)
 	}
 }
@@ -2,21 +2,28 @@
 package routes
 
 import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
 	"github.com/gin-gonic/gin"
 )
 
-// RegisterJournalEntryRoutes registers all journal entry routes
-func RegisterJournalEntryRoutes(r *gin.RouterGroup, h *handler.JournalEntryHandler) {
+// RegisterJournalEntryRoutes registers all journal entry routes. Authentication
+// is required so the maker-checker workflow can tell creators and approvers apart.
+func RegisterJournalEntryRoutes(r *gin.RouterGroup, h *handler.JournalEntryHandler, authMiddleware *middleware.AuthMiddleware) {
 	journalEntries := r.Group("/journal-entries")
+	journalEntries.Use(authMiddleware.Authenticate())
 	{
-		journalEntries.POST("", h.CreateJournalEntry)              // Create entry
-		journalEntries.GET("", h.ListJournalEntries)               // List entries
-		journalEntries.GET("/:id", h.GetJournalEntry)              // Get entry by ID
-		journalEntries.PUT("/:id", h.UpdateJournalEntry)           // Update entry
-		journalEntries.DELETE("/:id", h.DeleteJournalEntry)        // Delete draft entry
-		journalEntries.POST("/:id/post", h.PostJournalEntry)       // Post entry
-		journalEntries.POST("/:id/void", h.VoidJournalEntry)       // Void entry
-		journalEntries.POST("/:id/reverse", h.ReverseJournalEntry) // Reverse entry
+		journalEntries.POST("", authMiddleware.RequirePermission("journal_entries", "create"), h.CreateJournalEntry)                   // Create entry
+		journalEntries.GET("", authMiddleware.RequirePermission("journal_entries", "view"), h.ListJournalEntries)                      // List entries
+		journalEntries.GET("/:id", authMiddleware.RequirePermission("journal_entries", "view"), h.GetJournalEntry)                     // Get entry by ID
+		journalEntries.PUT("/:id", authMiddleware.RequirePermission("journal_entries", "edit"), h.UpdateJournalEntry)                  // Update entry
+		journalEntries.DELETE("/:id", authMiddleware.RequirePermission("journal_entries", "delete"), h.DeleteJournalEntry)             // Delete draft entry
+		journalEntries.POST("/:id/post", authMiddleware.RequirePermission("journal_entries", "post"), h.PostJournalEntry)              // Post entry (no approval rule matches)
+		journalEntries.POST("/:id/submit", authMiddleware.RequirePermission("journal_entries", "create"), h.SubmitJournalEntry)        // Submit for approval
+		journalEntries.POST("/:id/approve", authMiddleware.RequirePermission("journal_entries", "approve"), h.ApproveJournalEntry)     // Approve and post
+		journalEntries.POST("/:id/reject", authMiddleware.RequirePermission("journal_entries", "approve"), h.RejectJournalEntry)       // Return to draft with a comment
+		journalEntries.GET("/:id/approvals", authMiddleware.RequirePermission("journal_entries", "view"), h.ListJournalEntryApprovals) // Approval history
+		journalEntries.POST("/:id/void", authMiddleware.RequirePermission("journal_entries", "post"), h.VoidJournalEntry)              // Void entry
+		journalEntries.POST("/:id/reverse", authMiddleware.RequirePermission("journal_entries", "post"), h.ReverseJournalEntry)        // Reverse entry
 	}
 }
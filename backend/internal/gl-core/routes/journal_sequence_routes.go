@@ -0,0 +1,19 @@
+// backend/internal/gl-core/routes/journal_sequence_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterJournalSequenceRoutes registers journal numbering sequence routes
+func RegisterJournalSequenceRoutes(r *gin.RouterGroup, h *handler.JournalSequenceHandler, authMiddleware *middleware.AuthMiddleware) {
+	sequences := r.Group("/journal-sequences")
+	sequences.Use(authMiddleware.Authenticate())
+	{
+		sequences.GET("", authMiddleware.RequirePermission("journal_sequences", "view"), h.ListSequences)                // Numbering for every journal type
+		sequences.PUT("/:type", authMiddleware.RequirePermission("journal_sequences", "manage"), h.SaveSequence)         // Prefix, reset policy, padding
+		sequences.GET("/:type/next", authMiddleware.RequirePermission("journal_sequences", "view"), h.PreviewNextNumber) // Next number, not reserved
+	}
+}
@@ -0,0 +1,22 @@
+// backend/internal/gl-core/routes/opening_balance_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterOpeningBalanceRoutes registers go-live opening balance routes. Reversing
+// opening balances has its own permission, held by administrators only.
+func RegisterOpeningBalanceRoutes(r *gin.RouterGroup, h *handler.OpeningBalanceHandler, authMiddleware *middleware.AuthMiddleware) {
+	openingBalances := r.Group("/opening-balances")
+	openingBalances.Use(authMiddleware.Authenticate())
+	{
+		openingBalances.POST("/preview", authMiddleware.RequirePermission("opening_balances", "view"), h.Preview)                       // Check balances and suspense line without posting
+		openingBalances.POST("", authMiddleware.RequirePermission("opening_balances", "post"), h.Post)                                  // Post the OPENING entry
+		openingBalances.GET("", authMiddleware.RequirePermission("opening_balances", "view"), h.ListOpeningBalances)                    // List batches
+		openingBalances.GET("/:id", authMiddleware.RequirePermission("opening_balances", "view"), h.GetOpeningBalances)                 // Batch with its lines
+		openingBalances.POST("/:id/reverse", authMiddleware.RequirePermission("opening_balances", "reverse"), h.ReverseOpeningBalances) // Undo so balances can be entered again
+	}
+}
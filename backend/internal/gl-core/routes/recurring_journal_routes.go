@@ -0,0 +1,26 @@
+// backend/internal/gl-core/routes/recurring_journal_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRecurringJournalRoutes registers recurring journal template routes
+func RegisterRecurringJournalRoutes(r *gin.RouterGroup, h *handler.RecurringJournalHandler, authMiddleware *middleware.AuthMiddleware) {
+	recurring := r.Group("/recurring-journals")
+	recurring.Use(authMiddleware.Authenticate())
+	{
+		recurring.GET("", authMiddleware.RequirePermission("recurring_journals", "view"), h.ListTemplates)
+		recurring.POST("", authMiddleware.RequirePermission("recurring_journals", "create"), h.CreateTemplate)
+		recurring.GET("/:id", authMiddleware.RequirePermission("recurring_journals", "view"), h.GetTemplate)
+		recurring.PUT("/:id", authMiddleware.RequirePermission("recurring_journals", "edit"), h.UpdateTemplate)
+		recurring.GET("/:id/occurrences", authMiddleware.RequirePermission("recurring_journals", "view"), h.PreviewOccurrences) // Upcoming dates
+		recurring.GET("/:id/runs", authMiddleware.RequirePermission("recurring_journals", "view"), h.ListRuns)                  // Generated and skipped occurrences
+		recurring.POST("/:id/skip", authMiddleware.RequirePermission("recurring_journals", "edit"), h.SkipOccurrence)           // Skip one occurrence
+		recurring.POST("/:id/pause", authMiddleware.RequirePermission("recurring_journals", "edit"), h.PauseTemplate)
+		recurring.POST("/:id/resume", authMiddleware.RequirePermission("recurring_journals", "edit"), h.ResumeTemplate)
+		recurring.POST("/:id/generate", authMiddleware.RequirePermission("recurring_journals", "generate"), h.Generate) // Generate due entries now
+	}
+}
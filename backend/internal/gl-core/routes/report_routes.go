@@ -0,0 +1,20 @@
+// backend/internal/gl-core/routes/report_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterReportRoutes registers all ledger report routes
+func RegisterReportRoutes(r *gin.RouterGroup, h *handler.ReportHandler, authMiddleware *middleware.AuthMiddleware) {
+	reports := r.Group("/reports")
+	reports.Use(authMiddleware.Authenticate())
+	{
+		reports.GET("/trial-balance", authMiddleware.RequirePermission("reports", "view"), h.GetTrialBalance)         // Trial balance (JSON, CSV, XLSX, PDF)
+		reports.GET("/income-statement", authMiddleware.RequirePermission("reports", "view"), h.GetIncomeStatement)   // Profit & loss for a period
+		reports.GET("/balance-sheet", authMiddleware.RequirePermission("reports", "view"), h.GetBalanceSheet)         // Balance sheet as of a date
+		reports.GET("/account-statement", authMiddleware.RequirePermission("reports", "view"), h.GetAccountStatement) // Account ledger with running balance
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
 	"github.com/google/uuid"
 )
 
@@ -14,32 +16,41 @@ const (
 	ErrAccountCodeRequired = "account code is required"
 	ErrAccountNameRequired = "account name is required"
 	ErrAccountTypeRequired = "account type is required"
+	ErrAccountOrgRequired  = "organization ID is required"
 )
 
 type AccountServiceInterface interface {
 	CreateAccount(ctx context.Context, account domain.GLAccount) (domain.GLAccount, error)
 	GetAccountByID(ctx context.Context, id uuid.UUID, includeIsActive bool) (domain.GLAccount, error)
-	GetAccountByCode(ctx context.Context, code string, includeIsActive bool) (domain.GLAccount, error)
-	ListAccounts(ctx context.Context, includeIsActive bool) ([]domain.GLAccount, error)
+	GetAccountByCode(ctx context.Context, orgID uuid.UUID, code string, includeIsActive bool) (domain.GLAccount, error)
+	ListAccounts(ctx context.Context, orgID uuid.UUID, includeIsActive bool) ([]domain.GLAccount, error)
 	DeactivateAccount(ctx context.Context, id uuid.UUID) error
 	ActivateAccount(ctx context.Context, id uuid.UUID) error
 	UpdateAccount(ctx context.Context, account domain.GLAccount) (domain.GLAccount, error)
 	SoftDeleteAccount(ctx context.Context, id uuid.UUID) error
 	SearchAccounts(ctx context.Context, params repository.GLAccountSearchParams) ([]domain.GLAccount, error)
+	GetAccountTree(ctx context.Context, orgID uuid.UUID, asOfDate time.Time, includeInactive bool) ([]*domain.AccountTreeNode, error)
+	MoveAccount(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (domain.GLAccount, error)
+	MergeAccount(ctx context.Context, sourceID, targetID uuid.UUID, reason string, mergedBy uuid.UUID) (*domain.AccountMerge, error)
+	ListAccountMerges(ctx context.Context, orgID uuid.UUID) ([]*domain.AccountMerge, error)
 
 	//helper methods can be added here
 
 }
 
 type AccountService struct {
-	repo repository.GLAccountRepositoryInterface
+	repo     repository.GLAccountRepositoryInterface
+	recorder audit.Recorder
 }
 
-func NewAccountService(repo repository.GLAccountRepositoryInterface) *AccountService {
-	return &AccountService{repo: repo}
+func NewAccountService(repo repository.GLAccountRepositoryInterface, recorder audit.Recorder) *AccountService {
+	return &AccountService{repo: repo, recorder: recorder}
 }
 
 func (s *AccountService) CreateAccount(ctx context.Context, account domain.GLAccount) (domain.GLAccount, error) {
+	if account.OrganizationID == uuid.Nil {
+		return domain.GLAccount{}, errors.New(ErrAccountOrgRequired)
+	}
 	if account.Code == "" {
 		return domain.GLAccount{}, errors.New(ErrAccountCodeRequired)
 	}
@@ -50,25 +61,36 @@ func (s *AccountService) CreateAccount(ctx context.Context, account domain.GLAcc
 		return domain.GLAccount{}, errors.New(ErrAccountTypeRequired)
 	}
 
-	// Check for duplicates (including inactive records)
-	existing, err := s.repo.GetGLAccountByCode(ctx, account.Code, false)
+	// Check for duplicates (including inactive records) within the organization
+	existing, err := s.repo.GetGLAccountByCode(ctx, account.OrganizationID, account.Code, false)
 	if err == nil && existing.ID != uuid.Nil {
 		return domain.GLAccount{}, fmt.Errorf("account with code %s already exists", account.Code)
 	}
 
-	return s.repo.CreateGLAccount(ctx, account)
+	if err := s.validateParent(ctx, account); err != nil {
+		return domain.GLAccount{}, err
+	}
+
+	created, err := s.repo.CreateGLAccount(ctx, account)
+	if err != nil {
+		return domain.GLAccount{}, err
+	}
+
+	s.recordChange(ctx, audit.ActionCreate, nil, &created)
+
+	return created, nil
 }
 
 func (s *AccountService) GetAccountByID(ctx context.Context, id uuid.UUID, includeIsActive bool) (domain.GLAccount, error) {
 	return s.repo.GetGLAccountByID(ctx, id, includeIsActive)
 }
 
-func (s *AccountService) GetAccountByCode(ctx context.Context, code string, includeIsActive bool) (domain.GLAccount, error) {
-	return s.repo.GetGLAccountByCode(ctx, code, includeIsActive)
+func (s *AccountService) GetAccountByCode(ctx context.Context, orgID uuid.UUID, code string, includeIsActive bool) (domain.GLAccount, error) {
+	return s.repo.GetGLAccountByCode(ctx, orgID, code, includeIsActive)
 }
 
-func (s *AccountService) ListAccounts(ctx context.Context, includeIsActive bool) ([]domain.GLAccount, error) {
-	return s.repo.ListGLAccounts(ctx, includeIsActive)
+func (s *AccountService) ListAccounts(ctx context.Context, orgID uuid.UUID, includeIsActive bool) ([]domain.GLAccount, error) {
+	return s.repo.ListGLAccounts(ctx, orgID, includeIsActive)
 }
 
 func (s *AccountService) DeactivateAccount(ctx context.Context, id uuid.UUID) error {
@@ -99,7 +121,14 @@ func (s *AccountService) DeactivateAccount(ctx context.Context, id uuid.UUID) er
 		return fmt.Errorf("cannot deactivate account with active child accounts")
 	}
 
-	return s.repo.DeactivateGLAccount(ctx, id)
+	if err := s.repo.DeactivateGLAccount(ctx, id); err != nil {
+		return err
+	}
+
+	after := account
+	after.IsActive = false
+	s.recordChange(ctx, "DEACTIVATE", &account, &after)
+	return nil
 }
 
 func (s *AccountService) ActivateAccount(ctx context.Context, id uuid.UUID) error {
@@ -113,7 +142,14 @@ func (s *AccountService) ActivateAccount(ctx context.Context, id uuid.UUID) erro
 		return fmt.Errorf("account is already active")
 	}
 
-	return s.repo.ActivateGLAccount(ctx, id)
+	if err := s.repo.ActivateGLAccount(ctx, id); err != nil {
+		return err
+	}
+
+	after := account
+	after.IsActive = true
+	s.recordChange(ctx, "ACTIVATE", &account, &after)
+	return nil
 }
 
 func (s *AccountService) UpdateAccount(ctx context.Context, account domain.GLAccount) (domain.GLAccount, error) {
@@ -134,15 +170,29 @@ func (s *AccountService) UpdateAccount(ctx context.Context, account domain.GLAcc
 		return domain.GLAccount{}, fmt.Errorf("account with id %s does not exist", account.ID)
 	}
 
+	// An account never moves between organizations
+	account.OrganizationID = existing.OrganizationID
+
 	// Check for duplicate code if code is being changed
 	if existing.Code != account.Code {
-		duplicate, err := s.repo.GetGLAccountByCode(ctx, account.Code, false)
+		duplicate, err := s.repo.GetGLAccountByCode(ctx, account.OrganizationID, account.Code, false)
 		if err == nil && duplicate.ID != uuid.Nil && duplicate.ID != account.ID {
 			return domain.GLAccount{}, fmt.Errorf("account with code %s already exists", account.Code)
 		}
 	}
 
-	return s.repo.UpdateGLAccount(ctx, account)
+	if err := s.validateParent(ctx, account); err != nil {
+		return domain.GLAccount{}, err
+	}
+
+	updated, err := s.repo.UpdateGLAccount(ctx, account)
+	if err != nil {
+		return domain.GLAccount{}, err
+	}
+
+	s.recordChange(ctx, audit.ActionUpdate, &existing, &updated)
+
+	return updated, nil
 }
 
 func (s *AccountService) SoftDeleteAccount(ctx context.Context, id uuid.UUID) error {
@@ -151,9 +201,181 @@ func (s *AccountService) SoftDeleteAccount(ctx context.Context, id uuid.UUID) er
 	if err != nil || existing.ID == uuid.Nil {
 		return fmt.Errorf("account with id %s does not exist", id)
 	}
-	return s.repo.SoftDeleteGLAccount(ctx, id)
+
+	if err := s.repo.SoftDeleteGLAccount(ctx, id); err != nil {
+		return err
+	}
+
+	s.recordChange(ctx, audit.ActionDelete, &existing, nil)
+	return nil
 }
 
 func (s *AccountService) SearchAccounts(ctx context.Context, params repository.GLAccountSearchParams) ([]domain.GLAccount, error) {
+	if params.OrganizationID == uuid.Nil {
+		return nil, errors.New(ErrAccountOrgRequired)
+	}
 	return s.repo.SearchGLAccounts(ctx, params)
 }
+
+// GetAccountTree returns the organization's chart of accounts as a tree with
+// posted balances up to a date, rolled up through each account's descendants
+func (s *AccountService) GetAccountTree(ctx context.Context, orgID uuid.UUID, asOfDate time.Time, includeInactive bool) ([]*domain.AccountTreeNode, error) {
+	accounts, err := s.repo.ListGLAccounts(ctx, orgID, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	balances, err := s.repo.GetAccountBalances(ctx, orgID, asOfDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.BuildAccountTree(accounts, balances), nil
+}
+
+// MoveAccount re-parents an account; a nil parent makes it a top-level account
+func (s *AccountService) MoveAccount(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (domain.GLAccount, error) {
+	account, err := s.repo.GetGLAccountByID(ctx, id, true)
+	if err != nil {
+		return domain.GLAccount{}, fmt.Errorf("account with id %s does not exist", id)
+	}
+
+	before := account
+	account.ParentCode = parentID
+	if err := s.validateParent(ctx, account); err != nil {
+		return domain.GLAccount{}, err
+	}
+
+	moved, err := s.repo.UpdateGLAccount(ctx, account)
+	if err != nil {
+		return domain.GLAccount{}, err
+	}
+
+	s.recordChange(ctx, "MOVE", &before, &moved)
+
+	return moved, nil
+}
+
+// MergeAccount merges the source account into the target: its journal lines
+// and opening balances move to the target, its children are re-parented to
+// the target, settings that post to it switch to the target and it is
+// deactivated. Accounts with lines in closed periods cannot be merged. The
+// merge is recorded for audit.
+func (s *AccountService) MergeAccount(ctx context.Context, sourceID, targetID uuid.UUID, reason string, mergedBy uuid.UUID) (*domain.AccountMerge, error) {
+	source, err := s.repo.GetGLAccountByID(ctx, sourceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("account with id %s does not exist", sourceID)
+	}
+
+	target, err := s.repo.GetGLAccountByID(ctx, targetID, true)
+	if err != nil {
+		return nil, fmt.Errorf("account with id %s does not exist", targetID)
+	}
+
+	merge, err := domain.NewAccountMerge(source, target, reason, mergedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// The target may not sit below the source, or re-parenting its children would create a cycle
+	if err := s.ensureNotDescendant(ctx, source.ID, target); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.MergeGLAccounts(ctx, merge); err != nil {
+		return nil, err
+	}
+
+	// Recorded against the source account, which the merge deactivates
+	audit.LogChange(ctx, s.recorder, source.OrganizationID, audit.EntityGLAccount, source.ID, "MERGE", source, merge)
+
+	return merge, nil
+}
+
+// ListAccountMerges lists an organization's account merges
+func (s *AccountService) ListAccountMerges(ctx context.Context, orgID uuid.UUID) ([]*domain.AccountMerge, error) {
+	return s.repo.ListAccountMerges(ctx, orgID)
+}
+
+// recordChange writes a GL account change to the audit trail
+func (s *AccountService) recordChange(ctx context.Context, action string, before, after *domain.GLAccount) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	audit.LogChange(ctx, s.recorder, subject.OrganizationID, audit.EntityGLAccount, subject.ID, action, before, after)
+}
+
+// validateParent checks that an account's parent exists in the same
+// organization's chart and that the move does not create a cycle
+func (s *AccountService) validateParent(ctx context.Context, account domain.GLAccount) error {
+	if account.ParentCode == nil {
+		return nil
+	}
+	if *account.ParentCode == account.ID {
+		return domain.NewGLError("an account cannot be its own parent", domain.ErrAccountMoveInvalid)
+	}
+
+	parent, err := s.repo.GetGLAccountByID(ctx, *account.ParentCode, true)
+	if err != nil {
+		return fmt.Errorf("parent account %s does not exist", *account.ParentCode)
+	}
+	if !parent.BelongsTo(account.OrganizationID) {
+		return domain.NewGLErrorf(domain.ErrAccountOrgMismatch,
+			"parent account %s belongs to another organization", parent.Code)
+	}
+
+	if account.ID == uuid.Nil {
+		return nil
+	}
+	return s.ensureNotDescendant(ctx, account.ID, parent)
+}
+
+// ensureNotDescendant walks up from an account and fails if it reaches ancestorID
+func (s *AccountService) ensureNotDescendant(ctx context.Context, ancestorID uuid.UUID, account domain.GLAccount) error {
+	seen := make(map[uuid.UUID]bool)
+	for !seen[account.ID] {
+		if account.ID == ancestorID {
+			return domain.NewGLErrorf(domain.ErrAccountMoveInvalid,
+				"account %s is a descendant of the account being moved", account.Code)
+		}
+		seen[account.ID] = true
+		if account.ParentCode == nil {
+			return nil
+		}
+
+		next, err := s.repo.GetGLAccountByID(ctx, *account.ParentCode, true)
+		if err != nil {
+			return nil
+		}
+		account = next
+	}
+	return nil
+}
+
+// ensureAccountsInOrganization checks that every line of an entry posts to an
+// account in the entry's own chart of accounts
+func ensureAccountsInOrganization(ctx context.Context, repo repository.GLAccountRepositoryInterface, entry *domain.JournalEntry) error {
+	if repo == nil {
+		return nil
+	}
+
+	checked := make(map[uuid.UUID]bool)
+	for i, line := range entry.Lines {
+		if line.AccountID == uuid.Nil || checked[line.AccountID] {
+			continue
+		}
+
+		account, err := repo.GetGLAccountByID(ctx, line.AccountID, true)
+		if err != nil {
+			return domain.NewGLErrorf(domain.ErrJournalLineInvalid, "line %d: account %s not found", i+1, line.AccountID)
+		}
+		if !account.BelongsTo(entry.OrganizationID) {
+			return domain.NewGLErrorf(domain.ErrAccountOrgMismatch,
+				"line %d: account %s belongs to another organization", i+1, account.Code)
+		}
+		checked[line.AccountID] = true
+	}
+
+	return nil
+}
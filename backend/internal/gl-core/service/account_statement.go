@@ -0,0 +1,57 @@
+// backend/internal/gl-core/service/account_statement.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+// AccountStatementParams contains parameters for generating an account statement
+type AccountStatementParams struct {
+	OrganizationID uuid.UUID
+	AccountID      uuid.UUID
+	FromDate       time.Time
+	ToDate         time.Time
+	Dimensions     domain.LineDimensions // Optional: only lines tagged with these values
+}
+
+// GetAccountStatement lists an account's posted lines for a period with a
+// running balance from the opening balance to the closing balance
+func (s *ReportService) GetAccountStatement(ctx context.Context, params AccountStatementParams) (*domain.AccountStatement, error) {
+	if params.OrganizationID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+
+	if params.AccountID == uuid.Nil {
+		return nil, fmt.Errorf("account ID is required")
+	}
+
+	if params.FromDate.IsZero() || params.ToDate.IsZero() {
+		return nil, fmt.Errorf("from date and to date are required")
+	}
+
+	if params.FromDate.After(params.ToDate) {
+		return nil, fmt.Errorf("from date cannot be after to date")
+	}
+
+	stmt, err := s.repo.GetAccountStatement(ctx, repository.AccountStatementFilter{
+		OrganizationID: params.OrganizationID,
+		AccountID:      params.AccountID,
+		FromDate:       params.FromDate,
+		ToDate:         params.ToDate,
+		Dimensions:     params.Dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account statement: %w", err)
+	}
+
+	stmt.CalculateBalances()
+	stmt.GeneratedAt = time.Now()
+
+	return stmt, nil
+}
@@ -0,0 +1,94 @@
+// backend/internal/gl-core/service/approval_rule_service.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+type ApprovalRuleService struct {
+	repo        repository.ApprovalRepositoryInterface
+	accountRepo repository.GLAccountRepositoryInterface
+}
+
+// NewApprovalRuleService creates a new approval rule service
+func NewApprovalRuleService(repo repository.ApprovalRepositoryInterface, accountRepo repository.GLAccountRepositoryInterface) *ApprovalRuleService {
+	return &ApprovalRuleService{repo: repo, accountRepo: accountRepo}
+}
+
+// CreateRule validates and saves a new active approval rule
+func (s *ApprovalRuleService) CreateRule(ctx context.Context, rule *domain.ApprovalRule) (*domain.ApprovalRule, error) {
+	rule.ID = uuid.New()
+	rule.IsActive = true
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = rule.CreatedAt
+
+	if err := s.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateRule replaces a rule's name, conditions and status
+func (s *ApprovalRuleService) UpdateRule(ctx context.Context, rule *domain.ApprovalRule) (*domain.ApprovalRule, error) {
+	existing, err := s.repo.GetRuleByID(ctx, rule.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.OrganizationID = existing.OrganizationID
+	rule.CreatedBy = existing.CreatedBy
+	rule.CreatedAt = existing.CreatedAt
+	rule.UpdatedAt = time.Now()
+
+	if err := s.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetRule retrieves an approval rule
+func (s *ApprovalRuleService) GetRule(ctx context.Context, id uuid.UUID) (*domain.ApprovalRule, error) {
+	return s.repo.GetRuleByID(ctx, id)
+}
+
+// ListRules lists an organization's approval rules
+func (s *ApprovalRuleService) ListRules(ctx context.Context, orgID uuid.UUID) ([]*domain.ApprovalRule, error) {
+	if orgID == uuid.Nil {
+		return nil, domain.NewGLError("organization ID is required", domain.ErrApprovalRuleInvalid)
+	}
+	return s.repo.ListRules(ctx, orgID)
+}
+
+// validate checks the rule and that its accounts belong to the organization
+func (s *ApprovalRuleService) validate(ctx context.Context, rule *domain.ApprovalRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	for _, id := range rule.AccountIDs {
+		account, err := s.accountRepo.GetGLAccountByID(ctx, id, false)
+		if err != nil {
+			return domain.NewGLErrorf(domain.ErrApprovalRuleInvalid, "account %s not found", id)
+		}
+		if !account.BelongsTo(rule.OrganizationID) {
+			return domain.NewGLErrorf(domain.ErrApprovalRuleInvalid, "account %s belongs to another organization", account.Code)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+// backend/internal/gl-core/service/approval_rule_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// ApprovalRuleServiceInterface defines business logic for journal entry approval rules
+type ApprovalRuleServiceInterface interface {
+	// CreateRule validates and saves a new active approval rule
+	CreateRule(ctx context.Context, rule *domain.ApprovalRule) (*domain.ApprovalRule, error)
+
+	// UpdateRule replaces a rule's name, conditions and status
+	UpdateRule(ctx context.Context, rule *domain.ApprovalRule) (*domain.ApprovalRule, error)
+
+	// GetRule retrieves an approval rule
+	GetRule(ctx context.Context, id uuid.UUID) (*domain.ApprovalRule, error)
+
+	// ListRules lists an organization's approval rules
+	ListRules(ctx context.Context, orgID uuid.UUID) ([]*domain.ApprovalRule, error)
+}
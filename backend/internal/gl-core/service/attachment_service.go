@@ -0,0 +1,185 @@
+// backend/internal/gl-core/service/attachment_service.go
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/storage"
+	"github.com/google/uuid"
+)
+
+type AttachmentService struct {
+	repo      repository.AttachmentRepositoryInterface
+	entryRepo repository.JournalEntryRepositoryInterface
+	store     storage.BlobStore
+}
+
+// NewAttachmentService creates a new attachment service
+func NewAttachmentService(
+	repo repository.AttachmentRepositoryInterface,
+	entryRepo repository.JournalEntryRepositoryInterface,
+	store storage.BlobStore,
+) *AttachmentService {
+	return &AttachmentService{
+		repo:      repo,
+		entryRepo: entryRepo,
+		store:     store,
+	}
+}
+
+// AttachToEntry stores a document against a journal entry. Documents can be
+// added in any status, since evidence often arrives after posting.
+func (s *AttachmentService) AttachToEntry(ctx context.Context, entryID uuid.UUID, fileName string, content io.Reader, uploadedBy uuid.UUID) (*domain.Attachment, error) {
+	entry, err := s.entryRepo.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("entry not found: %w", err)
+	}
+
+	return s.attach(ctx, entry.OrganizationID, domain.AttachmentEntityJournalEntry, entry.ID, fileName, content, uploadedBy)
+}
+
+// AttachToImport stores a document, usually the original workbook, against an import log
+func (s *AttachmentService) AttachToImport(ctx context.Context, orgID, importLogID uuid.UUID, fileName string, content io.Reader, uploadedBy uuid.UUID) (*domain.Attachment, error) {
+	return s.attach(ctx, orgID, domain.AttachmentEntityImport, importLogID, fileName, content, uploadedBy)
+}
+
+// GetAttachment retrieves an attachment's metadata
+func (s *AttachmentService) GetAttachment(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListAttachments lists the attachments of a journal entry or import
+func (s *AttachmentService) ListAttachments(ctx context.Context, entityType domain.AttachmentEntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+	if !entityType.IsValid() {
+		return nil, domain.NewGLErrorf(domain.ErrAttachmentInvalid, "invalid attachment target: %s", entityType)
+	}
+	return s.repo.ListByEntity(ctx, entityType, entityID)
+}
+
+// Download returns an attachment's content after checking it against the upload checksum
+func (s *AttachmentService) Download(ctx context.Context, id uuid.UUID) (*domain.Attachment, []byte, error) {
+	attachment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blob, err := s.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+	defer blob.Close()
+
+	data, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	if err := attachment.VerifyChecksum(checksum(data)); err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, data, nil
+}
+
+// DeleteAttachment removes an attachment from a draft journal entry. Once an
+// entry leaves DRAFT, and for imports always, attachments are kept as evidence.
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	attachment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if attachment.EntityType != domain.AttachmentEntityJournalEntry {
+		return domain.NewGLError("import attachments cannot be deleted", domain.ErrAttachmentCannotDelete)
+	}
+
+	entry, err := s.entryRepo.GetByID(ctx, attachment.EntityID)
+	if err != nil {
+		return fmt.Errorf("entry not found: %w", err)
+	}
+	if !entry.CanEdit() {
+		return domain.NewGLErrorf(domain.ErrAttachmentCannotDelete, "attachments of %s entries cannot be deleted", entry.Status)
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, attachment.StorageKey)
+}
+
+// attach reads the document within the size limit, checks its type, stores it
+// and saves its metadata. The blob is removed again if the metadata cannot be saved.
+func (s *AttachmentService) attach(ctx context.Context, orgID uuid.UUID, entityType domain.AttachmentEntityType, entityID uuid.UUID, fileName string, content io.Reader, uploadedBy uuid.UUID) (*domain.Attachment, error) {
+	data, err := io.ReadAll(io.LimitReader(content, domain.MaxAttachmentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if int64(len(data)) > domain.MaxAttachmentSize {
+		return nil, domain.NewGLErrorf(domain.ErrAttachmentTooLarge, "file exceeds the %d MB limit", domain.MaxAttachmentSize>>20)
+	}
+
+	attachment, err := domain.NewAttachment(
+		orgID,
+		entityType,
+		entityID,
+		fileName,
+		detectContentType(fileName, data),
+		int64(len(data)),
+		checksum(data),
+		uploadedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Put(ctx, attachment.StorageKey, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	if err := s.repo.Create(ctx, attachment); err != nil {
+		_ = s.store.Delete(ctx, attachment.StorageKey)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// detectContentType sniffs the MIME type from the content rather than trusting
+// the client. Office workbooks sniff as generic containers, so those are
+// narrowed by extension only when the container signature matches.
+func detectContentType(fileName string, data []byte) string {
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return "application/octet-stream"
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	switch {
+	case contentType == "application/zip" && ext == ".xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case contentType == "application/octet-stream" && ext == ".xls" && bytes.HasPrefix(data, []byte{0xD0, 0xCF, 0x11, 0xE0}):
+		return "application/vnd.ms-excel"
+	case contentType == "text/plain" && ext == ".csv":
+		return "text/csv"
+	}
+
+	return contentType
+}
+
+// checksum returns the hex SHA-256 digest of data
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
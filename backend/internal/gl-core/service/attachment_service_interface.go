@@ -0,0 +1,32 @@
+// backend/internal/gl-core/service/attachment_service_interface.go
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// AttachmentServiceInterface defines business logic for supporting documents
+// on journal entries and imports
+type AttachmentServiceInterface interface {
+	// AttachToEntry stores a document against a journal entry
+	AttachToEntry(ctx context.Context, entryID uuid.UUID, fileName string, content io.Reader, uploadedBy uuid.UUID) (*domain.Attachment, error)
+
+	// AttachToImport stores a document, usually the original workbook, against an import log
+	AttachToImport(ctx context.Context, orgID, importLogID uuid.UUID, fileName string, content io.Reader, uploadedBy uuid.UUID) (*domain.Attachment, error)
+
+	// GetAttachment retrieves an attachment's metadata
+	GetAttachment(ctx context.Context, id uuid.UUID) (*domain.Attachment, error)
+
+	// ListAttachments lists the attachments of a journal entry or import
+	ListAttachments(ctx context.Context, entityType domain.AttachmentEntityType, entityID uuid.UUID) ([]*domain.Attachment, error)
+
+	// Download returns an attachment's content after checking it against the upload checksum
+	Download(ctx context.Context, id uuid.UUID) (*domain.Attachment, []byte, error)
+
+	// DeleteAttachment removes an attachment from a draft journal entry
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+}
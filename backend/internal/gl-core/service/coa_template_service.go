@@ -0,0 +1,105 @@
+// backend/internal/gl-core/service/coa_template_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+type COATemplateService struct {
+	repo        repository.COATemplateRepositoryInterface
+	accountRepo repository.GLAccountRepositoryInterface
+}
+
+// NewCOATemplateService creates a new chart of accounts template service
+func NewCOATemplateService(
+	repo repository.COATemplateRepositoryInterface,
+	accountRepo repository.GLAccountRepositoryInterface,
+) *COATemplateService {
+	return &COATemplateService{
+		repo:        repo,
+		accountRepo: accountRepo,
+	}
+}
+
+// ListTemplates lists the built-in templates
+func (s *COATemplateService) ListTemplates() []domain.COATemplate {
+	return domain.COATemplates()
+}
+
+// GetTemplate retrieves a template by code
+func (s *COATemplateService) GetTemplate(code string) (*domain.COATemplate, error) {
+	return domain.FindCOATemplate(code)
+}
+
+// ListApplications lists the template versions applied to an organization
+func (s *COATemplateService) ListApplications(ctx context.Context, orgID uuid.UUID) ([]*domain.COATemplateApplication, error) {
+	return s.repo.ListApplications(ctx, orgID)
+}
+
+// ApplyTemplate provisions an organization's chart of accounts from a template.
+// Re-applying the same template at a newer version adds only the accounts and
+// payroll mappings the organization does not have yet; existing accounts are
+// never renamed, moved or deactivated.
+func (s *COATemplateService) ApplyTemplate(ctx context.Context, orgID uuid.UUID, code string, appliedBy uuid.UUID) (*domain.COATemplateApplication, error) {
+	if orgID == uuid.Nil {
+		return nil, domain.NewGLError("organization ID is required", domain.ErrAccountOrgRequired)
+	}
+
+	template, err := domain.FindCOATemplate(code)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.repo.GetLatestApplication(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		if err := latest.CanApply(template); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.accountRepo.ListGLAccounts(ctx, orgID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	codes := make(map[string]uuid.UUID, len(existing))
+	for _, a := range existing {
+		codes[a.Code] = a.ID
+	}
+
+	accounts := template.BuildAccounts(orgID, codes)
+	mappings := template.BuildPayrollMappings(orgID, codes)
+
+	app := domain.NewCOATemplateApplication(orgID, template, appliedBy)
+	if err := s.repo.Apply(ctx, app, accounts, mappings); err != nil {
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// ResolveCOATemplate returns the requested template code, or the default for
+// the organization type when none was requested
+func (s *COATemplateService) ResolveCOATemplate(orgType, code string) (string, error) {
+	if code == "" {
+		return domain.DefaultCOATemplate(orgType).Code, nil
+	}
+	template, err := domain.FindCOATemplate(code)
+	if err != nil {
+		return "", err
+	}
+	return template.Code, nil
+}
+
+// ProvisionChartOfAccounts applies a template to a newly created organization
+func (s *COATemplateService) ProvisionChartOfAccounts(ctx context.Context, orgID uuid.UUID, code string) error {
+	_, err := s.ApplyTemplate(ctx, orgID, code, uuid.Nil)
+	return err
+}
@@ -0,0 +1,31 @@
+// backend/internal/gl-core/service/coa_template_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// COATemplateServiceInterface defines business logic for industry chart of accounts templates
+type COATemplateServiceInterface interface {
+	// ListTemplates lists the built-in templates
+	ListTemplates() []domain.COATemplate
+
+	// GetTemplate retrieves a template by code
+	GetTemplate(code string) (*domain.COATemplate, error)
+
+	// ListApplications lists the template versions applied to an organization
+	ListApplications(ctx context.Context, orgID uuid.UUID) ([]*domain.COATemplateApplication, error)
+
+	// ApplyTemplate provisions or upgrades an organization's chart of accounts from a template
+	ApplyTemplate(ctx context.Context, orgID uuid.UUID, code string, appliedBy uuid.UUID) (*domain.COATemplateApplication, error)
+
+	// ResolveCOATemplate returns the template code to provision for an organization type:
+	// the requested code if given, otherwise the industry default
+	ResolveCOATemplate(orgType, code string) (string, error)
+
+	// ProvisionChartOfAccounts applies a template to a newly created organization
+	ProvisionChartOfAccounts(ctx context.Context, orgID uuid.UUID, code string) error
+}
@@ -0,0 +1,124 @@
+// backend/internal/gl-core/service/dimension_service.go
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+type DimensionService struct {
+	repo repository.DimensionRepositoryInterface
+}
+
+// NewDimensionService creates a new analytic dimension service
+func NewDimensionService(repo repository.DimensionRepositoryInterface) *DimensionService {
+	return &DimensionService{repo: repo}
+}
+
+// CreateDimension defines a new dimension for an organization. Codes are
+// unique per organization and cannot be changed once lines are tagged with them.
+func (s *DimensionService) CreateDimension(ctx context.Context, orgID uuid.UUID, code, name string, requiredFor []domain.AccountType) (*domain.Dimension, error) {
+	dimension, err := domain.NewDimension(orgID, code, name, requiredFor)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.ListDimensions(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range existing {
+		if d.Code == dimension.Code {
+			return nil, domain.NewGLErrorf(domain.ErrDimensionInvalid, "dimension %s already exists", dimension.Code)
+		}
+	}
+
+	if err := s.repo.CreateDimension(ctx, dimension); err != nil {
+		return nil, err
+	}
+
+	return dimension, nil
+}
+
+// UpdateDimension changes a dimension's name, required account types and status
+func (s *DimensionService) UpdateDimension(ctx context.Context, id uuid.UUID, name string, requiredFor []domain.AccountType, isActive bool) (*domain.Dimension, error) {
+	dimension, err := s.repo.GetDimensionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dimension.Name = strings.TrimSpace(name)
+	dimension.RequiredFor = requiredFor
+	dimension.IsActive = isActive
+	dimension.UpdatedAt = time.Now()
+
+	if err := dimension.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateDimension(ctx, dimension); err != nil {
+		return nil, err
+	}
+
+	return dimension, nil
+}
+
+// GetDimension retrieves a dimension with its values
+func (s *DimensionService) GetDimension(ctx context.Context, id uuid.UUID) (*domain.Dimension, error) {
+	return s.repo.GetDimensionByID(ctx, id)
+}
+
+// ListDimensions lists an organization's dimensions with their values
+func (s *DimensionService) ListDimensions(ctx context.Context, orgID uuid.UUID) ([]*domain.Dimension, error) {
+	return s.repo.ListDimensions(ctx, orgID)
+}
+
+// AddValue adds a value to a dimension
+func (s *DimensionService) AddValue(ctx context.Context, dimensionID uuid.UUID, code, name string) (*domain.DimensionValue, error) {
+	dimension, err := s.repo.GetDimensionByID(ctx, dimensionID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := domain.NewDimensionValue(dimension.ID, code, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if dimension.FindValue(value.Code) != nil {
+		return nil, domain.NewGLErrorf(domain.ErrDimensionValueInvalid, "%s value %s already exists", dimension.Name, value.Code)
+	}
+
+	if err := s.repo.CreateValue(ctx, value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// UpdateValue changes a value's name and status. Deactivated values stay on
+// posted lines but cannot be used on new postings.
+func (s *DimensionService) UpdateValue(ctx context.Context, valueID uuid.UUID, name string, isActive bool) (*domain.DimensionValue, error) {
+	value, err := s.repo.GetValueByID(ctx, valueID)
+	if err != nil {
+		return nil, err
+	}
+
+	value.Name = strings.TrimSpace(name)
+	if value.Name == "" {
+		return nil, domain.NewGLError("dimension value name is required", domain.ErrDimensionValueInvalid)
+	}
+	value.IsActive = isActive
+	value.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateValue(ctx, value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
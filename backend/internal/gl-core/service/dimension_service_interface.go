@@ -0,0 +1,30 @@
+// backend/internal/gl-core/service/dimension_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// DimensionServiceInterface defines business logic for analytic dimensions
+type DimensionServiceInterface interface {
+	// CreateDimension defines a new dimension for an organization
+	CreateDimension(ctx context.Context, orgID uuid.UUID, code, name string, requiredFor []domain.AccountType) (*domain.Dimension, error)
+
+	// UpdateDimension changes a dimension's name, required account types and status
+	UpdateDimension(ctx context.Context, id uuid.UUID, name string, requiredFor []domain.AccountType, isActive bool) (*domain.Dimension, error)
+
+	// GetDimension retrieves a dimension with its values
+	GetDimension(ctx context.Context, id uuid.UUID) (*domain.Dimension, error)
+
+	// ListDimensions lists an organization's dimensions with their values
+	ListDimensions(ctx context.Context, orgID uuid.UUID) ([]*domain.Dimension, error)
+
+	// AddValue adds a value to a dimension
+	AddValue(ctx context.Context, dimensionID uuid.UUID, code, name string) (*domain.DimensionValue, error)
+
+	// UpdateValue changes a value's name and status
+	UpdateValue(ctx context.Context, valueID uuid.UUID, name string, isActive bool) (*domain.DimensionValue, error)
+}
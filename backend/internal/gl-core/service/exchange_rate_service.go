@@ -0,0 +1,152 @@
+// backend/internal/gl-core/service/exchange_rate_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+type ExchangeRateService struct {
+	repo repository.ExchangeRateRepositoryInterface
+}
+
+// NewExchangeRateService creates a new exchange rate service
+func NewExchangeRateService(repo repository.ExchangeRateRepositoryInterface) *ExchangeRateService {
+	return &ExchangeRateService{repo: repo}
+}
+
+// SetRate records a rate effective from a date, replacing any rate for the same pair and date
+func (s *ExchangeRateService) SetRate(ctx context.Context, orgID uuid.UUID, from, to string, rate money.Rate, effectiveDate time.Time, createdBy uuid.UUID) (*domain.ExchangeRate, error) {
+	if strings.TrimSpace(to) == "" {
+		base, err := s.repo.GetBaseCurrency(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		to = base
+	}
+
+	er, err := domain.NewExchangeRate(orgID, from, to, rate, effectiveDate, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, er); err != nil {
+		return nil, err
+	}
+
+	return er, nil
+}
+
+// GetRate looks up the rate in effect on a date. When only the reverse pair
+// has been entered its inverse is returned.
+func (s *ExchangeRateService) GetRate(ctx context.Context, orgID uuid.UUID, from, to string, date time.Time) (*domain.ExchangeRate, error) {
+	if strings.TrimSpace(to) == "" {
+		base, err := s.repo.GetBaseCurrency(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		to = base
+	}
+
+	return lookupExchangeRate(ctx, s.repo, orgID, from, to, date)
+}
+
+// ListRates lists rates matching a filter
+func (s *ExchangeRateService) ListRates(ctx context.Context, filter repository.ExchangeRateFilter) ([]*domain.ExchangeRate, error) {
+	filter.FromCurrency = strings.ToUpper(strings.TrimSpace(filter.FromCurrency))
+	filter.ToCurrency = strings.ToUpper(strings.TrimSpace(filter.ToCurrency))
+	return s.repo.List(ctx, filter)
+}
+
+// DeleteRate removes an exchange rate
+func (s *ExchangeRateService) DeleteRate(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// lookupExchangeRate finds the rate for a pair on a date, falling back to the
+// inverse of the reverse pair; shared with the journal entry service
+func lookupExchangeRate(ctx context.Context, repo repository.ExchangeRateRepositoryInterface, orgID uuid.UUID, from, to string, date time.Time) (*domain.ExchangeRate, error) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+
+	rate, err := repo.GetEffectiveRate(ctx, orgID, from, to, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	if rate != nil {
+		return rate, nil
+	}
+
+	reverse, err := repo.GetEffectiveRate(ctx, orgID, to, from, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	if reverse != nil {
+		return reverse.Inverse(), nil
+	}
+
+	return nil, domain.NewGLErrorf(domain.ErrExchangeRateNotFound,
+		"no %s/%s exchange rate on or before %s", from, to, date.Format("2006-01-02"))
+}
+
+// applyExchangeRates derives base currency amounts for an entry's foreign
+// currency lines. Lines without a rate use the rate in effect on the
+// transaction date; lines entered in the base currency are stored as such.
+// Rounding differences between lines that balance in their own currency are
+// absorbed so the entry still balances in the base currency.
+func applyExchangeRates(ctx context.Context, repo repository.ExchangeRateRepositoryInterface, entry *domain.JournalEntry) error {
+	hasForeign := false
+	for _, line := range entry.Lines {
+		if line.IsForeignCurrency() {
+			hasForeign = true
+			break
+		}
+	}
+	if !hasForeign {
+		return nil
+	}
+	if repo == nil {
+		return domain.NewGLError("foreign currency lines are not supported", domain.ErrJournalLineCurrencyInvalid)
+	}
+
+	baseCode, err := repo.GetBaseCurrency(ctx, entry.OrganizationID)
+	if err != nil {
+		return err
+	}
+	base := money.CurrencyOrDefault(baseCode)
+
+	for i := range entry.Lines {
+		line := &entry.Lines[i]
+		if !line.IsForeignCurrency() {
+			continue
+		}
+
+		line.Currency = strings.ToUpper(strings.TrimSpace(line.Currency))
+		if line.Currency == base.Code {
+			line.Debit, line.Credit = line.ForeignDebit, line.ForeignCredit
+			line.Currency, line.ForeignDebit, line.ForeignCredit, line.ExchangeRate = "", 0, 0, 0
+			continue
+		}
+
+		rate := line.ExchangeRate
+		if rate == 0 {
+			er, err := lookupExchangeRate(ctx, repo, entry.OrganizationID, line.Currency, base.Code, entry.TransactionDate)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", i+1, err)
+			}
+			rate = er.Rate
+		}
+
+		line.ApplyExchangeRate(rate, base)
+	}
+
+	entry.BalanceConversionRounding()
+	return nil
+}
@@ -0,0 +1,28 @@
+// backend/internal/gl-core/service/exchange_rate_service_interface.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// ExchangeRateServiceInterface defines business logic for exchange rates
+type ExchangeRateServiceInterface interface {
+	// SetRate records a rate effective from a date, replacing any rate for the same pair and date.
+	// An empty target currency means the organization's base currency.
+	SetRate(ctx context.Context, orgID uuid.UUID, from, to string, rate money.Rate, effectiveDate time.Time, createdBy uuid.UUID) (*domain.ExchangeRate, error)
+
+	// GetRate looks up the rate in effect on a date
+	GetRate(ctx context.Context, orgID uuid.UUID, from, to string, date time.Time) (*domain.ExchangeRate, error)
+
+	// ListRates lists rates matching a filter
+	ListRates(ctx context.Context, filter repository.ExchangeRateFilter) ([]*domain.ExchangeRate, error)
+
+	// DeleteRate removes an exchange rate
+	DeleteRate(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,62 @@
+// backend/internal/gl-core/service/exchange_rate_service_test.go
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// stubRateRepo serves a single rate into an AED base currency
+type stubRateRepo struct {
+	repository.ExchangeRateRepositoryInterface
+	rate money.Rate
+}
+
+func (r *stubRateRepo) GetBaseCurrency(ctx context.Context, orgID uuid.UUID) (string, error) {
+	return "AED", nil
+}
+
+func (r *stubRateRepo) GetEffectiveRate(ctx context.Context, orgID uuid.UUID, from, to string, date time.Time) (*domain.ExchangeRate, error) {
+	if from != "USD" || to != "AED" {
+		return nil, nil
+	}
+	return &domain.ExchangeRate{FromCurrency: from, ToCurrency: to, Rate: r.rate, EffectiveDate: date}, nil
+}
+
+func TestApplyExchangeRatesBalancesRounding(t *testing.T) {
+	rate, _ := money.ParseRate("3.6725")
+	repo := &stubRateRepo{rate: rate}
+
+	entry := &domain.JournalEntry{
+		OrganizationID:  uuid.New(),
+		EntryNumber:     domain.NewDraftEntryNumber(),
+		TransactionDate: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		Description:     "USD invoice split three ways",
+	}
+	for _, s := range []string{"100.00", "-33.33", "-33.33", "-33.34"} {
+		amount, _ := money.Parse(s)
+		line := domain.JournalLine{AccountID: uuid.New(), Description: "Line", Currency: "USD"}
+		if amount.IsNegative() {
+			line.ForeignCredit = amount.Abs()
+		} else {
+			line.ForeignDebit = amount
+		}
+		entry.Lines = append(entry.Lines, line)
+	}
+
+	if err := applyExchangeRates(context.Background(), repo, entry); err != nil {
+		t.Fatalf("applyExchangeRates() = %v", err)
+	}
+	if err := entry.Validate(); err != nil {
+		t.Fatalf("Validate() = %v (debit %s, credit %s)", err, entry.TotalDebit, entry.TotalCredit)
+	}
+	if want, _ := money.Parse("367.24"); entry.TotalDebit != want {
+		t.Errorf("total debit = %s, want %s", entry.TotalDebit, want)
+	}
+}
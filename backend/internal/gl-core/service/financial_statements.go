@@ -0,0 +1,369 @@
+// backend/internal/gl-core/service/financial_statements.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// IncomeStatementParams contains parameters for generating an income statement
+type IncomeStatementParams struct {
+	OrganizationID uuid.UUID
+	FromDate       time.Time
+	ToDate         time.Time
+	Comparison     domain.ComparisonType
+	Dimensions     domain.LineDimensions // Optional: departmental or project P&L
+}
+
+// BalanceSheetParams contains parameters for generating a balance sheet
+type BalanceSheetParams struct {
+	OrganizationID       uuid.UUID
+	AsOf                 time.Time
+	Comparison           domain.ComparisonType
+	FiscalYearStartMonth time.Month // Defaults to January
+	Dimensions           domain.LineDimensions
+}
+
+// GetIncomeStatement generates a profit and loss statement for a period
+func (s *ReportService) GetIncomeStatement(ctx context.Context, params IncomeStatementParams) (*domain.FinancialStatement, error) {
+	if params.OrganizationID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+
+	if params.FromDate.IsZero() || params.ToDate.IsZero() {
+		return nil, fmt.Errorf("from date and to date are required")
+	}
+
+	if params.FromDate.After(params.ToDate) {
+		return nil, fmt.Errorf("from date cannot be after to date")
+	}
+
+	comparison, err := normalizeComparison(params.Comparison)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := newStatementBuilder(domain.StatementTypeIncome, params.OrganizationID,
+		incomeStatementColumns(params.FromDate, params.ToDate, comparison),
+		[]domain.AccountType{domain.AccountTypeRevenue, domain.AccountTypeExpense},
+	)
+
+	for i, col := range builder.stmt.Columns {
+		// Closing entries would zero out revenue and expense for a closed year
+		lines, err := s.repo.GetAccountActivity(ctx, repository.AccountActivityFilter{
+			OrganizationID: params.OrganizationID,
+			FromDate:       *col.FromDate,
+			ToDate:         col.ToDate,
+			ExcludeClosing: true,
+			Dimensions:     params.Dimensions,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account activity: %w", err)
+		}
+
+		builder.addColumn(i, lines, func(line domain.TrialBalanceLine) money.Amount {
+			return domain.NaturalBalance(line.Type, line.PeriodDebit, line.PeriodCredit)
+		})
+	}
+
+	stmt := builder.build()
+	stmt.Dimensions = params.Dimensions
+
+	revenue := stmt.Section(domain.AccountTypeRevenue)
+	expense := stmt.Section(domain.AccountTypeExpense)
+	netIncome := make([]money.Amount, len(stmt.Columns))
+	for i := range netIncome {
+		netIncome[i] = revenue.Totals[i] - expense.Totals[i]
+	}
+
+	stmt.Summary = append(stmt.Summary, domain.StatementLine{
+		Name:    "Net Income",
+		Amounts: netIncome,
+	})
+
+	return stmt, nil
+}
+
+// GetBalanceSheet generates a balance sheet as of a date. Revenue and expense
+// balances that have not been closed are added to equity as current-year and
+// prior-years earnings.
+func (s *ReportService) GetBalanceSheet(ctx context.Context, params BalanceSheetParams) (*domain.FinancialStatement, error) {
+	if params.OrganizationID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+
+	if params.AsOf.IsZero() {
+		return nil, fmt.Errorf("as of date is required")
+	}
+
+	comparison, err := normalizeComparison(params.Comparison)
+	if err != nil {
+		return nil, err
+	}
+
+	startMonth := params.FiscalYearStartMonth
+	if startMonth < time.January || startMonth > time.December {
+		startMonth = time.January
+	}
+
+	builder := newStatementBuilder(domain.StatementTypeBalanceSheet, params.OrganizationID,
+		balanceSheetColumns(params.AsOf, comparison),
+		[]domain.AccountType{domain.AccountTypeAsset, domain.AccountTypeLiability, domain.AccountTypeEquity},
+	)
+
+	columns := len(builder.stmt.Columns)
+	currentEarnings := make([]money.Amount, columns)
+	priorEarnings := make([]money.Amount, columns)
+
+	for i, col := range builder.stmt.Columns {
+		// Opening columns hold prior fiscal years, period columns the current one
+		yearStart := fiscalYearStart(col.ToDate, startMonth)
+		lines, err := s.repo.GetAccountActivity(ctx, repository.AccountActivityFilter{
+			OrganizationID: params.OrganizationID,
+			FromDate:       yearStart,
+			ToDate:         col.ToDate,
+			Dimensions:     params.Dimensions,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account activity: %w", err)
+		}
+
+		builder.addColumn(i, lines, func(line domain.TrialBalanceLine) money.Amount {
+			return domain.NaturalBalance(line.Type,
+				line.OpeningDebit+line.PeriodDebit,
+				line.OpeningCredit+line.PeriodCredit,
+			)
+		})
+
+		for _, line := range lines {
+			if line.Type != domain.AccountTypeRevenue && line.Type != domain.AccountTypeExpense {
+				continue
+			}
+			currentEarnings[i] += line.PeriodCredit - line.PeriodDebit
+			priorEarnings[i] += line.OpeningCredit - line.OpeningDebit
+		}
+	}
+
+	stmt := builder.build()
+	stmt.Dimensions = params.Dimensions
+
+	equity := stmt.Section(domain.AccountTypeEquity)
+	if !allZero(priorEarnings) {
+		equity.Lines = append(equity.Lines, domain.StatementLine{
+			Name:    "Prior Years' Earnings",
+			Amounts: priorEarnings,
+		})
+	}
+	equity.Lines = append(equity.Lines, domain.StatementLine{
+		Name:    "Current Year Earnings",
+		Amounts: currentEarnings,
+	})
+	for i := range equity.Totals {
+		equity.Totals[i] += currentEarnings[i] + priorEarnings[i]
+	}
+
+	assets := stmt.Section(domain.AccountTypeAsset)
+	liabilities := stmt.Section(domain.AccountTypeLiability)
+	liabilitiesAndEquity := make([]money.Amount, columns)
+	for i := range liabilitiesAndEquity {
+		liabilitiesAndEquity[i] = liabilities.Totals[i] + equity.Totals[i]
+	}
+
+	stmt.Summary = append(stmt.Summary,
+		domain.StatementLine{Name: "Total Assets", Amounts: assets.Totals},
+		domain.StatementLine{Name: "Total Liabilities and Equity", Amounts: liabilitiesAndEquity},
+	)
+
+	return stmt, nil
+}
+
+// statementBuilder collects per-column account amounts into statement sections
+type statementBuilder struct {
+	stmt   *domain.FinancialStatement
+	order  []uuid.UUID
+	lines  map[uuid.UUID]*domain.StatementLine
+	types  map[uuid.UUID]domain.AccountType
+	totals map[domain.AccountType][]money.Amount
+}
+
+func newStatementBuilder(stmtType domain.StatementType, orgID uuid.UUID, columns []domain.StatementColumn, sections []domain.AccountType) *statementBuilder {
+	b := &statementBuilder{
+		stmt: &domain.FinancialStatement{
+			Type:           stmtType,
+			OrganizationID: orgID,
+			Columns:        columns,
+			Sections:       make([]domain.StatementSection, len(sections)),
+			Summary:        []domain.StatementLine{},
+			GeneratedAt:    time.Now(),
+		},
+		lines:  make(map[uuid.UUID]*domain.StatementLine),
+		types:  make(map[uuid.UUID]domain.AccountType),
+		totals: make(map[domain.AccountType][]money.Amount),
+	}
+
+	for i, t := range sections {
+		b.stmt.Sections[i] = domain.StatementSection{Type: t, Title: sectionTitle(t)}
+		b.totals[t] = make([]money.Amount, len(columns))
+	}
+
+	return b
+}
+
+// addColumn records one column of amounts. Section totals come from the
+// accounts' own amounts; line amounts are rolled up through the account tree.
+func (b *statementBuilder) addColumn(col int, lines []domain.TrialBalanceLine, amount func(domain.TrialBalanceLine) money.Amount) {
+	for _, line := range lines {
+		if totals, ok := b.totals[line.Type]; ok {
+			totals[col] += amount(line)
+		}
+	}
+
+	for _, line := range rollUpAccountTree(lines) {
+		row, ok := b.lines[line.AccountID]
+		if !ok {
+			id := line.AccountID
+			row = &domain.StatementLine{
+				AccountID: &id,
+				Code:      line.Code,
+				Name:      line.Name,
+				Amounts:   make([]money.Amount, len(b.stmt.Columns)),
+			}
+			b.lines[id] = row
+			b.types[id] = line.Type
+			b.order = append(b.order, id)
+		}
+		row.Level = line.Level
+		row.IsSubtotal = row.IsSubtotal || line.HasChildren
+		row.Amounts[col] = amount(line)
+	}
+}
+
+// build assembles the sections, dropping accounts with no amount in any column
+func (b *statementBuilder) build() *domain.FinancialStatement {
+	for i := range b.stmt.Sections {
+		section := &b.stmt.Sections[i]
+		section.Lines = []domain.StatementLine{}
+		section.Totals = b.totals[section.Type]
+
+		for _, id := range b.order {
+			row := b.lines[id]
+			if b.types[id] != section.Type || allZero(row.Amounts) {
+				continue
+			}
+			section.Lines = append(section.Lines, *row)
+		}
+	}
+
+	return b.stmt
+}
+
+// incomeStatementColumns builds the current period column plus an optional comparative column
+func incomeStatementColumns(from, to time.Time, comparison domain.ComparisonType) []domain.StatementColumn {
+	columns := []domain.StatementColumn{periodColumn("Current Period", from, to)}
+
+	switch comparison {
+	case domain.ComparisonPriorPeriod:
+		prevFrom, prevTo := priorPeriod(from, to)
+		columns = append(columns, periodColumn("Prior Period", prevFrom, prevTo))
+	case domain.ComparisonPriorYear:
+		columns = append(columns, periodColumn("Prior Year", shiftYears(from, -1), shiftYears(to, -1)))
+	}
+
+	return columns
+}
+
+// balanceSheetColumns builds the as-of column plus an optional comparative column
+func balanceSheetColumns(asOf time.Time, comparison domain.ComparisonType) []domain.StatementColumn {
+	columns := []domain.StatementColumn{{Label: asOf.Format("2006-01-02"), ToDate: asOf}}
+
+	switch comparison {
+	case domain.ComparisonPriorPeriod:
+		// End of the previous month
+		prev := time.Date(asOf.Year(), asOf.Month(), 1, 0, 0, 0, 0, asOf.Location()).AddDate(0, 0, -1)
+		columns = append(columns, domain.StatementColumn{Label: prev.Format("2006-01-02"), ToDate: prev})
+	case domain.ComparisonPriorYear:
+		prev := shiftYears(asOf, -1)
+		columns = append(columns, domain.StatementColumn{Label: prev.Format("2006-01-02"), ToDate: prev})
+	}
+
+	return columns
+}
+
+func periodColumn(label string, from, to time.Time) domain.StatementColumn {
+	return domain.StatementColumn{Label: label, FromDate: &from, ToDate: to}
+}
+
+// priorPeriod returns the period of equal length immediately before from.
+// Whole-month periods shift by months so month lengths line up.
+func priorPeriod(from, to time.Time) (time.Time, time.Time) {
+	if from.Day() == 1 && isMonthEnd(to) {
+		months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+		return from.AddDate(0, -months, 0), from.AddDate(0, 0, -1)
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+	return from.AddDate(0, 0, -days), from.AddDate(0, 0, -1)
+}
+
+// shiftYears moves a date by whole years, keeping month ends on month ends
+func shiftYears(t time.Time, years int) time.Time {
+	if isMonthEnd(t) {
+		firstOfMonth := time.Date(t.Year()+years, t.Month(), 1, 0, 0, 0, 0, t.Location())
+		return firstOfMonth.AddDate(0, 1, -1)
+	}
+	return t.AddDate(years, 0, 0)
+}
+
+func isMonthEnd(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
+
+// fiscalYearStart returns the first day of the fiscal year containing date
+func fiscalYearStart(date time.Time, startMonth time.Month) time.Time {
+	year := date.Year()
+	if date.Month() < startMonth {
+		year--
+	}
+	return time.Date(year, startMonth, 1, 0, 0, 0, 0, date.Location())
+}
+
+func normalizeComparison(comparison domain.ComparisonType) (domain.ComparisonType, error) {
+	if comparison == "" {
+		return domain.ComparisonNone, nil
+	}
+	if !comparison.IsValid() {
+		return "", fmt.Errorf("invalid comparison: %s", comparison)
+	}
+	return comparison, nil
+}
+
+func sectionTitle(t domain.AccountType) string {
+	switch t {
+	case domain.AccountTypeAsset:
+		return "Assets"
+	case domain.AccountTypeLiability:
+		return "Liabilities"
+	case domain.AccountTypeEquity:
+		return "Equity"
+	case domain.AccountTypeRevenue:
+		return "Revenue"
+	case domain.AccountTypeExpense:
+		return "Expenses"
+	}
+	return string(t)
+}
+
+func allZero(amounts []money.Amount) bool {
+	for _, a := range amounts {
+		if a != 0 {
+			return false
+		}
+	}
+	return true
+}
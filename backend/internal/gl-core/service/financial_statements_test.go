@@ -0,0 +1,275 @@
+// backend/internal/gl-core/service/financial_statements_test.go
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPriorPeriod(t *testing.T) {
+	tests := []struct {
+		name             string
+		from, to         time.Time
+		wantFrom, wantTo time.Time
+	}{
+		{name: "month", from: date(2025, 3, 1), to: date(2025, 3, 31), wantFrom: date(2025, 2, 1), wantTo: date(2025, 2, 28)},
+		{name: "quarter", from: date(2025, 4, 1), to: date(2025, 6, 30), wantFrom: date(2025, 1, 1), wantTo: date(2025, 3, 31)},
+		{name: "across a year end", from: date(2025, 1, 1), to: date(2025, 2, 28), wantFrom: date(2024, 11, 1), wantTo: date(2024, 12, 31)},
+		{name: "days", from: date(2025, 3, 10), to: date(2025, 3, 19), wantFrom: date(2025, 2, 28), wantTo: date(2025, 3, 9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := priorPeriod(tt.from, tt.to)
+			if !from.Equal(tt.wantFrom) || !to.Equal(tt.wantTo) {
+				t.Errorf("priorPeriod() = %s to %s, want %s to %s",
+					from.Format("2006-01-02"), to.Format("2006-01-02"), tt.wantFrom.Format("2006-01-02"), tt.wantTo.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestShiftYears(t *testing.T) {
+	tests := []struct {
+		date  time.Time
+		years int
+		want  time.Time
+	}{
+		{date: date(2024, 2, 29), years: -1, want: date(2023, 2, 28)},
+		{date: date(2025, 2, 28), years: -1, want: date(2024, 2, 29)}, // Month end stays a month end
+		{date: date(2025, 3, 15), years: -1, want: date(2024, 3, 15)},
+		{date: date(2025, 6, 30), years: 1, want: date(2026, 6, 30)},
+	}
+
+	for _, tt := range tests {
+		if got := shiftYears(tt.date, tt.years); !got.Equal(tt.want) {
+			t.Errorf("shiftYears(%s, %d) = %s, want %s", tt.date.Format("2006-01-02"), tt.years, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestFiscalYearStart(t *testing.T) {
+	tests := []struct {
+		date       time.Time
+		startMonth time.Month
+		want       time.Time
+	}{
+		{date: date(2025, 6, 30), startMonth: time.January, want: date(2025, 1, 1)},
+		{date: date(2025, 6, 30), startMonth: time.April, want: date(2025, 4, 1)},
+		{date: date(2025, 2, 15), startMonth: time.April, want: date(2024, 4, 1)},
+		{date: date(2025, 4, 1), startMonth: time.April, want: date(2025, 4, 1)},
+	}
+
+	for _, tt := range tests {
+		if got := fiscalYearStart(tt.date, tt.startMonth); !got.Equal(tt.want) {
+			t.Errorf("fiscalYearStart(%s, %s) = %s, want %s", tt.date.Format("2006-01-02"), tt.startMonth, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestBalanceSheetColumns(t *testing.T) {
+	tests := []struct {
+		comparison domain.ComparisonType
+		want       []time.Time
+	}{
+		{comparison: domain.ComparisonNone, want: []time.Time{date(2025, 3, 15)}},
+		{comparison: domain.ComparisonPriorPeriod, want: []time.Time{date(2025, 3, 15), date(2025, 2, 28)}},
+		{comparison: domain.ComparisonPriorYear, want: []time.Time{date(2025, 3, 15), date(2024, 3, 15)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.comparison), func(t *testing.T) {
+			columns := balanceSheetColumns(date(2025, 3, 15), tt.comparison)
+			if len(columns) != len(tt.want) {
+				t.Fatalf("got %d columns, want %d", len(columns), len(tt.want))
+			}
+			for i, col := range columns {
+				if !col.ToDate.Equal(tt.want[i]) || col.FromDate != nil {
+					t.Errorf("column %d = %+v, want as of %s", i, col, tt.want[i].Format("2006-01-02"))
+				}
+			}
+		})
+	}
+}
+
+// amounts parses a column of expected amounts
+func amounts(values ...string) []money.Amount {
+	parsed := make([]money.Amount, len(values))
+	for i, v := range values {
+		parsed[i] = money.MustParse(v)
+	}
+	return parsed
+}
+
+func equalAmounts(a, b []money.Amount) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGetIncomeStatement(t *testing.T) {
+	accounts := chart{}
+	repo := &stubReportRepo{activity: func(filter repository.AccountActivityFilter) []domain.TrialBalanceLine {
+		revenue, expense := "700", "200"
+		if filter.FromDate.Year() == 2024 {
+			revenue, expense = "400", "450"
+		}
+		return []domain.TrialBalanceLine{
+			accounts.line("4000", "", domain.AccountTypeRevenue),
+			accounts.line("4100", "4000", domain.AccountTypeRevenue, "0", "0", "0", revenue),
+			accounts.line("5000", "", domain.AccountTypeExpense, "0", "0", expense, "0"),
+			accounts.line("1000", "", domain.AccountTypeAsset, "0", "0", "500", "0"), // Not on the statement
+		}
+	}}
+	svc := NewReportService(repo)
+
+	stmt, err := svc.GetIncomeStatement(context.Background(), IncomeStatementParams{
+		OrganizationID: uuid.New(),
+		FromDate:       date(2025, 4, 1),
+		ToDate:         date(2025, 6, 30),
+		Comparison:     domain.ComparisonPriorYear,
+	})
+	if err != nil {
+		t.Fatalf("GetIncomeStatement() error = %v", err)
+	}
+
+	if len(stmt.Columns) != 2 || !stmt.Columns[1].FromDate.Equal(date(2024, 4, 1)) || !stmt.Columns[1].ToDate.Equal(date(2024, 6, 30)) {
+		t.Fatalf("columns = %+v, want the period and the same period a year before", stmt.Columns)
+	}
+	for _, filter := range repo.filters {
+		if !filter.ExcludeClosing {
+			t.Errorf("activity for %s read with closing entries", filter.ToDate.Format("2006-01-02"))
+		}
+	}
+
+	tests := []struct {
+		name  string
+		lines func() []domain.StatementLine
+		want  map[string][]money.Amount
+	}{
+		{
+			name:  "revenue",
+			lines: func() []domain.StatementLine { return stmt.Section(domain.AccountTypeRevenue).Lines },
+			want:  map[string][]money.Amount{"4000": amounts("700", "400"), "4100": amounts("700", "400")},
+		},
+		{
+			name:  "expenses",
+			lines: func() []domain.StatementLine { return stmt.Section(domain.AccountTypeExpense).Lines },
+			want:  map[string][]money.Amount{"5000": amounts("200", "450")},
+		},
+		{
+			name:  "net income",
+			lines: func() []domain.StatementLine { return stmt.Summary },
+			want:  map[string][]money.Amount{"Net Income": amounts("500", "-50")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := tt.lines()
+			if len(lines) != len(tt.want) {
+				t.Fatalf("got %d lines, want %d", len(lines), len(tt.want))
+			}
+			for _, line := range lines {
+				key := line.Code
+				if key == "" {
+					key = line.Name
+				}
+				if want, ok := tt.want[key]; !ok || !equalAmounts(line.Amounts, want) {
+					t.Errorf("%s = %v, want %v", key, line.Amounts, want)
+				}
+				if line.Code == "4000" && !line.IsSubtotal {
+					t.Error("4000 is not marked as a subtotal of 4100")
+				}
+			}
+		})
+	}
+
+	if revenue := stmt.Section(domain.AccountTypeRevenue); !equalAmounts(revenue.Totals, amounts("700", "400")) {
+		t.Errorf("revenue totals = %v, want the child amounts counted once", revenue.Totals)
+	}
+}
+
+func TestGetBalanceSheet(t *testing.T) {
+	accounts := chart{}
+	repo := &stubReportRepo{activity: func(filter repository.AccountActivityFilter) []domain.TrialBalanceLine {
+		return []domain.TrialBalanceLine{
+			accounts.line("1000", "", domain.AccountTypeAsset, "1000", "0", "500", "0"),
+			accounts.line("3000", "", domain.AccountTypeEquity, "0", "700", "0", "0"),
+			accounts.line("4000", "", domain.AccountTypeRevenue, "0", "300", "0", "700"), // Last year not closed
+			accounts.line("5000", "", domain.AccountTypeExpense, "0", "0", "200", "0"),
+		}
+	}}
+	svc := NewReportService(repo)
+
+	stmt, err := svc.GetBalanceSheet(context.Background(), BalanceSheetParams{
+		OrganizationID:       uuid.New(),
+		AsOf:                 date(2025, 6, 30),
+		FiscalYearStartMonth: time.April,
+	})
+	if err != nil {
+		t.Fatalf("GetBalanceSheet() error = %v", err)
+	}
+
+	if len(repo.filters) != 1 || !repo.filters[0].FromDate.Equal(date(2025, 4, 1)) {
+		t.Errorf("activity filters = %+v, want one from the fiscal year start", repo.filters)
+	}
+	if stmt.Section(domain.AccountTypeRevenue) != nil {
+		t.Error("balance sheet has a revenue section")
+	}
+
+	tests := []struct {
+		section domain.AccountType
+		lines   map[string]string
+		total   string
+	}{
+		{section: domain.AccountTypeAsset, lines: map[string]string{"1000": "1500"}, total: "1500"},
+		{section: domain.AccountTypeLiability, lines: map[string]string{}, total: "0"},
+		{
+			section: domain.AccountTypeEquity,
+			lines:   map[string]string{"3000": "700", "Prior Years' Earnings": "300", "Current Year Earnings": "500"},
+			total:   "1500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.section), func(t *testing.T) {
+			section := stmt.Section(tt.section)
+			if len(section.Lines) != len(tt.lines) {
+				t.Fatalf("got %d lines, want %d", len(section.Lines), len(tt.lines))
+			}
+			for _, line := range section.Lines {
+				key := line.Code
+				if key == "" {
+					key = line.Name
+				}
+				if want, ok := tt.lines[key]; !ok || !equalAmounts(line.Amounts, amounts(want)) {
+					t.Errorf("%s = %v, want %s", key, line.Amounts, want)
+				}
+			}
+			if !equalAmounts(section.Totals, amounts(tt.total)) {
+				t.Errorf("total = %v, want %s", section.Totals, tt.total)
+			}
+		})
+	}
+
+	if len(stmt.Summary) != 2 || !equalAmounts(stmt.Summary[0].Amounts, stmt.Summary[1].Amounts) {
+		t.Errorf("summary = %+v, want total assets equal to total liabilities and equity", stmt.Summary)
+	}
+}
@@ -0,0 +1,119 @@
+// backend/internal/gl-core/service/fiscal_period_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+type FiscalPeriodService struct {
+	repo repository.FiscalPeriodRepositoryInterface
+}
+
+// NewFiscalPeriodService creates a new fiscal period service
+func NewFiscalPeriodService(repo repository.FiscalPeriodRepositoryInterface) *FiscalPeriodService {
+	return &FiscalPeriodService{repo: repo}
+}
+
+// CreateFiscalYear creates a fiscal year with twelve monthly periods
+func (s *FiscalPeriodService) CreateFiscalYear(ctx context.Context, orgID uuid.UUID, name string, startDate time.Time, createdBy uuid.UUID) (*domain.FiscalYear, error) {
+	fy, err := domain.NewFiscalYear(orgID, name, startDate, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps, err := s.repo.HasOverlappingFiscalYear(ctx, orgID, fy.StartDate, fy.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if overlaps {
+		return nil, domain.NewGLErrorf(domain.ErrFiscalYearOverlap,
+			"fiscal year %s overlaps an existing fiscal year", fy.Name)
+	}
+
+	if err := s.repo.CreateFiscalYear(ctx, fy); err != nil {
+		return nil, fmt.Errorf("failed to create fiscal year: %w", err)
+	}
+
+	return fy, nil
+}
+
+// GetFiscalYear retrieves a fiscal year with its periods
+func (s *FiscalPeriodService) GetFiscalYear(ctx context.Context, id uuid.UUID) (*domain.FiscalYear, error) {
+	return s.repo.GetFiscalYearByID(ctx, id)
+}
+
+// ListFiscalYears lists fiscal years for an organization
+func (s *FiscalPeriodService) ListFiscalYears(ctx context.Context, orgID uuid.UUID) ([]*domain.FiscalYear, error) {
+	return s.repo.ListFiscalYears(ctx, orgID)
+}
+
+// SoftClosePeriod locks a period for review
+func (s *FiscalPeriodService) SoftClosePeriod(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error) {
+	return s.changePeriodStatus(ctx, periodID, domain.PeriodActionSoftClose, userID, reason)
+}
+
+// ClosePeriod closes a period to postings
+func (s *FiscalPeriodService) ClosePeriod(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error) {
+	return s.changePeriodStatus(ctx, periodID, domain.PeriodActionClose, userID, reason)
+}
+
+// ReopenPeriod reopens a soft-closed or closed period; a reason is required
+func (s *FiscalPeriodService) ReopenPeriod(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error) {
+	return s.changePeriodStatus(ctx, periodID, domain.PeriodActionReopen, userID, reason)
+}
+
+// GetPeriodHistory lists who closed and reopened a period
+func (s *FiscalPeriodService) GetPeriodHistory(ctx context.Context, periodID uuid.UUID) ([]domain.PeriodEvent, error) {
+	if _, err := s.repo.GetPeriodByID(ctx, periodID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListPeriodEvents(ctx, periodID)
+}
+
+// EnsurePeriodOpen returns an error if the date falls in a period that is not open.
+// Dates outside any configured fiscal year are allowed so organizations without
+// a fiscal calendar keep working.
+func (s *FiscalPeriodService) EnsurePeriodOpen(ctx context.Context, orgID uuid.UUID, date time.Time) error {
+	return ensurePeriodOpen(ctx, s.repo, orgID, date)
+}
+
+func (s *FiscalPeriodService) changePeriodStatus(ctx context.Context, periodID uuid.UUID, action domain.PeriodAction, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error) {
+	period, err := s.repo.GetPeriodByID(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := period.ChangeStatus(action, userID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdatePeriodStatus(ctx, period, event); err != nil {
+		return nil, fmt.Errorf("failed to change period status: %w", err)
+	}
+
+	return period, nil
+}
+
+// ensurePeriodOpen checks the period containing date; shared with the journal entry service
+func ensurePeriodOpen(ctx context.Context, repo repository.FiscalPeriodRepositoryInterface, orgID uuid.UUID, date time.Time) error {
+	if repo == nil {
+		return nil
+	}
+
+	period, err := repo.GetPeriodByDate(ctx, orgID, date)
+	if err != nil {
+		return fmt.Errorf("failed to check accounting period: %w", err)
+	}
+	if period == nil {
+		return nil
+	}
+
+	return period.EnsurePostingAllowed()
+}
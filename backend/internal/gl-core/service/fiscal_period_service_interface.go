@@ -0,0 +1,37 @@
+// backend/internal/gl-core/service/fiscal_period_service_interface.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// FiscalPeriodServiceInterface defines business logic for the fiscal calendar
+type FiscalPeriodServiceInterface interface {
+	// CreateFiscalYear creates a fiscal year with twelve monthly periods
+	CreateFiscalYear(ctx context.Context, orgID uuid.UUID, name string, startDate time.Time, createdBy uuid.UUID) (*domain.FiscalYear, error)
+
+	// GetFiscalYear retrieves a fiscal year with its periods
+	GetFiscalYear(ctx context.Context, id uuid.UUID) (*domain.FiscalYear, error)
+
+	// ListFiscalYears lists fiscal years for an organization
+	ListFiscalYears(ctx context.Context, orgID uuid.UUID) ([]*domain.FiscalYear, error)
+
+	// SoftClosePeriod locks a period for review
+	SoftClosePeriod(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error)
+
+	// ClosePeriod closes a period to postings
+	ClosePeriod(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error)
+
+	// ReopenPeriod reopens a soft-closed or closed period
+	ReopenPeriod(ctx context.Context, periodID, userID uuid.UUID, reason string) (*domain.AccountingPeriod, error)
+
+	// GetPeriodHistory lists who closed and reopened a period
+	GetPeriodHistory(ctx context.Context, periodID uuid.UUID) ([]domain.PeriodEvent, error)
+
+	// EnsurePeriodOpen returns an error if the date falls in a period that is not open
+	EnsurePeriodOpen(ctx context.Context, orgID uuid.UUID, date time.Time) error
+}
@@ -0,0 +1,188 @@
+// backend/internal/gl-core/service/fx_revaluation_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+type FXRevaluationService struct {
+	repo        repository.FXRevaluationRepositoryInterface
+	accountRepo repository.GLAccountRepositoryInterface
+	periodRepo  repository.FiscalPeriodRepositoryInterface
+	rateRepo    repository.ExchangeRateRepositoryInterface
+}
+
+// NewFXRevaluationService creates a new FX revaluation service
+func NewFXRevaluationService(
+	repo repository.FXRevaluationRepositoryInterface,
+	accountRepo repository.GLAccountRepositoryInterface,
+	periodRepo repository.FiscalPeriodRepositoryInterface,
+	rateRepo repository.ExchangeRateRepositoryInterface,
+) *FXRevaluationService {
+	return &FXRevaluationService{
+		repo:        repo,
+		accountRepo: accountRepo,
+		periodRepo:  periodRepo,
+		rateRepo:    rateRepo,
+	}
+}
+
+// GetSettings retrieves the organization's gain and loss accounts
+func (s *FXRevaluationService) GetSettings(ctx context.Context, orgID uuid.UUID) (*domain.FXRevaluationSettings, error) {
+	settings, err := s.repo.GetSettings(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, domain.NewGLError("FX revaluation accounts have not been configured", domain.ErrFXRevaluationNotConfigured)
+	}
+	return settings, nil
+}
+
+// UpdateSettings sets the organization's gain and loss accounts. Both must be
+// active REVENUE or EXPENSE accounts; they may be the same account.
+func (s *FXRevaluationService) UpdateSettings(ctx context.Context, orgID, gainAccountID, lossAccountID, updatedBy uuid.UUID) (*domain.FXRevaluationSettings, error) {
+	settings := &domain.FXRevaluationSettings{
+		OrganizationID: orgID,
+		GainAccountID:  gainAccountID,
+		LossAccountID:  lossAccountID,
+		UpdatedBy:      updatedBy,
+		UpdatedAt:      time.Now(),
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range []uuid.UUID{gainAccountID, lossAccountID} {
+		account, err := s.accountRepo.GetGLAccountByID(ctx, id, false)
+		if err != nil {
+			return nil, domain.NewGLErrorf(domain.ErrFXRevaluationAccountInvalid, "account %s not found", id)
+		}
+		if !account.BelongsTo(orgID) {
+			return nil, domain.NewGLErrorf(domain.ErrFXRevaluationAccountInvalid,
+				"account %s belongs to another organization", account.Code)
+		}
+		if account.Type != domain.AccountTypeRevenue && account.Type != domain.AccountTypeExpense {
+			return nil, domain.NewGLErrorf(domain.ErrFXRevaluationAccountInvalid,
+				"account %s must be a REVENUE or EXPENSE account (got %s)", account.Code, account.Type)
+		}
+		if !account.IsActive {
+			return nil, domain.NewGLErrorf(domain.ErrFXRevaluationAccountInvalid, "account %s is inactive", account.Code)
+		}
+		if !account.IsPostable {
+			return nil, domain.NewGLErrorf(domain.ErrFXRevaluationAccountInvalid, "account %s is a control account", account.Code)
+		}
+	}
+
+	if err := s.repo.SaveSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Preview calculates a revaluation as of a date without posting anything
+func (s *FXRevaluationService) Preview(ctx context.Context, orgID uuid.UUID, asOfDate time.Time) (*domain.FXRevaluation, error) {
+	settings, err := s.GetSettings(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return s.calculate(ctx, settings, asOfDate, uuid.Nil)
+}
+
+// Run revalues open foreign currency balances on monetary accounts at the
+// closing rate as of a date, posting the unrealized gain/loss entry on that
+// date and its reversal on the following day. Runs must move forward in time.
+func (s *FXRevaluationService) Run(ctx context.Context, orgID uuid.UUID, asOfDate time.Time, createdBy uuid.UUID) (*domain.FXRevaluation, error) {
+	settings, err := s.GetSettings(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.repo.GetLatest(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil && !latest.AsOfDate.Before(asOfDate) {
+		return nil, domain.NewGLErrorf(domain.ErrFXRevaluationAlreadyRun,
+			"balances have already been revalued as of %s", latest.AsOfDate.Format("2006-01-02"))
+	}
+
+	rev, err := s.calculate(ctx, settings, asOfDate, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensurePeriodOpen(ctx, s.periodRepo, orgID, rev.AsOfDate); err != nil {
+		return nil, err
+	}
+	if err := ensurePeriodOpen(ctx, s.periodRepo, orgID, rev.ReversalDate); err != nil {
+		return nil, err
+	}
+
+	// Both entries are posted, so they are numbered when saved
+	entry, reversal, err := rev.BuildEntries(domain.NewDraftEntryNumber(), domain.NewDraftEntryNumber())
+	if err != nil {
+		return nil, fmt.Errorf("failed to build revaluation entry: %w", err)
+	}
+
+	if err := s.repo.Create(ctx, rev, entry, reversal); err != nil {
+		return nil, fmt.Errorf("failed to save revaluation: %w", err)
+	}
+
+	return rev, nil
+}
+
+// GetRevaluation retrieves a run with its before and after values
+func (s *FXRevaluationService) GetRevaluation(ctx context.Context, id uuid.UUID) (*domain.FXRevaluation, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListRevaluations lists an organization's runs
+func (s *FXRevaluationService) ListRevaluations(ctx context.Context, orgID uuid.UUID) ([]*domain.FXRevaluation, error) {
+	return s.repo.List(ctx, orgID)
+}
+
+// calculate revalues each open balance at the closing rate for its currency
+func (s *FXRevaluationService) calculate(ctx context.Context, settings *domain.FXRevaluationSettings, asOfDate time.Time, createdBy uuid.UUID) (*domain.FXRevaluation, error) {
+	baseCode, err := s.rateRepo.GetBaseCurrency(ctx, settings.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	base := money.CurrencyOrDefault(baseCode)
+
+	rev := domain.NewFXRevaluation(settings, asOfDate, base, createdBy)
+
+	balances, err := s.repo.GetForeignBalances(ctx, settings.OrganizationID, rev.AsOfDate)
+	if err != nil {
+		return nil, err
+	}
+
+	closingRates := make(map[string]money.Rate)
+	for _, balance := range balances {
+		if !balance.Type.IsMonetary() {
+			continue
+		}
+
+		rate, ok := closingRates[balance.Currency]
+		if !ok {
+			er, err := lookupExchangeRate(ctx, s.rateRepo, settings.OrganizationID, balance.Currency, base.Code, rev.AsOfDate)
+			if err != nil {
+				return nil, err
+			}
+			rate = er.Rate
+			closingRates[balance.Currency] = rate
+		}
+
+		rev.AddBalance(balance, rate, base)
+	}
+
+	return rev, nil
+}
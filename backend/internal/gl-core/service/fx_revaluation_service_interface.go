@@ -0,0 +1,31 @@
+// backend/internal/gl-core/service/fx_revaluation_service_interface.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// FXRevaluationServiceInterface defines business logic for unrealized FX revaluation
+type FXRevaluationServiceInterface interface {
+	// GetSettings retrieves the organization's gain and loss accounts
+	GetSettings(ctx context.Context, orgID uuid.UUID) (*domain.FXRevaluationSettings, error)
+
+	// UpdateSettings sets the organization's gain and loss accounts
+	UpdateSettings(ctx context.Context, orgID, gainAccountID, lossAccountID, updatedBy uuid.UUID) (*domain.FXRevaluationSettings, error)
+
+	// Preview calculates a revaluation as of a date without posting anything
+	Preview(ctx context.Context, orgID uuid.UUID, asOfDate time.Time) (*domain.FXRevaluation, error)
+
+	// Run revalues balances as of a date and posts the entry and its next-day reversal
+	Run(ctx context.Context, orgID uuid.UUID, asOfDate time.Time, createdBy uuid.UUID) (*domain.FXRevaluation, error)
+
+	// GetRevaluation retrieves a run with its before and after values
+	GetRevaluation(ctx context.Context, id uuid.UUID) (*domain.FXRevaluation, error)
+
+	// ListRevaluations lists an organization's runs
+	ListRevaluations(ctx context.Context, orgID uuid.UUID) ([]*domain.FXRevaluation, error)
+}
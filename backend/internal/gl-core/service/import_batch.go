@@ -0,0 +1,349 @@
+// backend/internal/gl-core/service/import_batch.go
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+)
+
+// recordBatch stores an import run in the import log. Rows may already have
+// been saved, so a failure to record the run is reported as a warning rather
+// than failing the import.
+func (s *ImportService) recordBatch(
+	ctx context.Context,
+	importType domain.ImportType,
+	fileName string,
+	fileSize int64,
+	userID uuid.UUID,
+	options ImportOptions,
+	result *ImportResult,
+	importErr error,
+) {
+	if result == nil || options.OrganizationID == uuid.Nil {
+		return
+	}
+
+	now := time.Now()
+	batch := &domain.ImportBatch{
+		ID:             result.ImportLogID,
+		OrganizationID: options.OrganizationID,
+		ImportType:     importType,
+		FileName:       fileName,
+		FileSize:       fileSize,
+		LegacySystem:   result.LegacySystem,
+		Status:         result.Status,
+		ValidateOnly:   options.ValidateOnly,
+		AutoPost:       options.AutoPost,
+		TotalRows:      result.TotalRows,
+		SuccessCount:   result.SuccessCount,
+		ErrorCount:     result.ErrorCount,
+		WarningCount:   result.WarningCount,
+		ImportedIDs:    result.ImportedIDs,
+		MigrationNotes: options.MigrationNotes,
+		ImportedBy:     userID,
+		CreatedAt:      now.Add(-time.Duration(result.ProcessingTimeMillis) * time.Millisecond),
+		CompletedAt:    &now,
+		Errors:         make([]domain.ImportBatchError, len(result.Errors)),
+	}
+	if importErr != nil {
+		batch.Status = domain.ImportStatusFailed
+		batch.FailureReason = importErr.Error()
+	}
+	for i, e := range result.Errors {
+		batch.Errors[i] = domain.ImportBatchError{
+			Row:        e.Row,
+			Column:     e.Column,
+			Field:      e.Field,
+			Value:      e.Value,
+			Code:       e.Code,
+			Message:    e.Message,
+			LegacyCode: e.LegacyCode,
+		}
+	}
+
+	if err := s.batchRepo.Create(ctx, batch); err != nil {
+		result.Warnings = append(result.Warnings, ImportWarning{
+			Message: fmt.Sprintf("Import could not be recorded in the import log: %v", err),
+		})
+		result.WarningCount++
+	}
+}
+
+// ListImports lists an organization's import runs, newest first
+func (s *ImportService) ListImports(ctx context.Context, orgID uuid.UUID, importType domain.ImportType, limit, offset int) ([]*domain.ImportBatch, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.batchRepo.List(ctx, orgID, importType, limit, offset)
+}
+
+// GetImport retrieves an import run with its row errors
+func (s *ImportService) GetImport(ctx context.Context, orgID, batchID uuid.UUID) (*domain.ImportBatch, error) {
+	batch, err := s.batchRepo.GetByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.OrganizationID != orgID {
+		return nil, domain.NewGLError("import not found", domain.ErrImportNotFound)
+	}
+	return batch, nil
+}
+
+// RollbackImport undoes an import run: accounts it created are deactivated
+// and journal entries it created are voided. The records are undone and the
+// run marked rolled back in one transaction, so nothing is changed unless
+// every record can safely be undone.
+func (s *ImportService) RollbackImport(ctx context.Context, orgID, batchID, userID uuid.UUID) (*domain.ImportBatch, error) {
+	batch, err := s.GetImport(ctx, orgID, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := batch.RolledBack(userID); err != nil {
+		return nil, err
+	}
+
+	switch batch.ImportType {
+	case domain.ImportTypeAccounts:
+		err = s.rollbackAccounts(ctx, batch)
+	case domain.ImportTypeJournalEntries:
+		err = s.rollbackJournalEntries(ctx, batch)
+	default:
+		err = fmt.Errorf("unknown import type: %s", batch.ImportType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// rollbackAccounts deactivates the accounts an import created. An account
+// that has been posted to, or that has since gained a child account outside
+// the import, blocks the rollback.
+func (s *ImportService) rollbackAccounts(ctx context.Context, batch *domain.ImportBatch) error {
+	inBatch := make(map[uuid.UUID]bool, len(batch.ImportedIDs))
+	for _, id := range batch.ImportedIDs {
+		inBatch[id] = true
+	}
+
+	chart, err := s.accountRepo.ListGLAccounts(ctx, batch.OrganizationID, false)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+	for _, account := range chart {
+		if account.ParentCode != nil && inBatch[*account.ParentCode] && !inBatch[account.ID] {
+			return domain.NewGLErrorf(domain.ErrImportCannotRollback,
+				"account %s has been added under an imported account; move it before rolling back", account.Code)
+		}
+	}
+
+	accounts := make([]*domain.GLAccount, 0, len(batch.ImportedIDs))
+	for _, id := range batch.ImportedIDs {
+		account, err := s.accountRepo.GetGLAccountByID(ctx, id, true)
+		if err != nil {
+			return fmt.Errorf("failed to get imported account %s: %w", id, err)
+		}
+		accounts = append(accounts, &account)
+	}
+
+	// Accounts posted to are refused within the transaction
+	if err := s.batchRepo.RollbackAccounts(ctx, batch); err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		if !account.IsActive {
+			continue
+		}
+		after := *account
+		after.IsActive = false
+		audit.LogChange(ctx, s.recorder, batch.OrganizationID, audit.EntityGLAccount, account.ID, audit.ActionDelete, account, &after)
+	}
+
+	return nil
+}
+
+// rollbackJournalEntries voids the journal entries an import created. Posted
+// entries must still be in open periods, and an import with a reversed entry
+// cannot be rolled back.
+func (s *ImportService) rollbackJournalEntries(ctx context.Context, batch *domain.ImportBatch) error {
+	entries, err := s.journalEntryRepo.ListByImportLog(ctx, batch.OrganizationID, batch.ID)
+	if err != nil {
+		return err
+	}
+
+	// Periods are checked under lock within the transaction
+	if err := s.batchRepo.RollbackJournalEntries(ctx, batch); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Status == domain.EntryStatusVoid {
+			continue
+		}
+		after := *entry
+		after.Status = domain.EntryStatusVoid
+		audit.LogChange(ctx, s.recorder, batch.OrganizationID, audit.EntityJournalEntry, entry.ID, "VOID", entry, &after)
+	}
+
+	return nil
+}
+
+// ErrorReport builds a workbook of an import run's failing rows and returns it
+// with a file name. When the uploaded workbook was kept, it comes back with an
+// "Import Errors" column and the failing rows highlighted; otherwise the errors
+// are listed on a sheet of their own.
+func (s *ImportService) ErrorReport(ctx context.Context, orgID, batchID uuid.UUID) ([]byte, string, error) {
+	batch, err := s.GetImport(ctx, orgID, batchID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	baseName := strings.TrimSuffix(batch.FileName, filepath.Ext(batch.FileName))
+	fileName := baseName + "_errors.xlsx"
+
+	original, err := s.originalWorkbook(ctx, batchID)
+	if err != nil {
+		return nil, "", err
+	}
+	if original == nil {
+		data, err := listImportErrors(batch)
+		return data, fileName, err
+	}
+	defer original.Close()
+
+	data, err := annotateImportErrors(original, batch)
+	return data, fileName, err
+}
+
+// originalWorkbook opens the workbook kept against an import, or returns nil
+// when none was kept
+func (s *ImportService) originalWorkbook(ctx context.Context, batchID uuid.UUID) (*excelize.File, error) {
+	if s.attachments == nil {
+		return nil, nil
+	}
+
+	attachments, err := s.attachments.ListAttachments(ctx, domain.AttachmentEntityImport, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import attachments: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		if !strings.EqualFold(filepath.Ext(attachment.FileName), ".xlsx") {
+			continue
+		}
+		_, content, err := s.attachments.Download(ctx, attachment.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download original workbook: %w", err)
+		}
+		f, err := excelize.OpenReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open original workbook: %w", err)
+		}
+		return f, nil
+	}
+
+	return nil, nil
+}
+
+// annotateImportErrors adds an "Import Errors" column to the first sheet of
+// the original workbook and highlights the rows that failed
+func annotateImportErrors(f *excelize.File, batch *domain.ImportBatch) ([]byte, error) {
+	sheet := f.GetSheetName(0)
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	errorCol := width + 1
+
+	messages := make(map[int][]string)
+	for _, e := range batch.Errors {
+		if e.Row < 2 {
+			continue
+		}
+		msg := e.Message
+		if e.Column != "" {
+			msg = e.Column + ": " + msg
+		}
+		messages[e.Row] = append(messages[e.Row], fmt.Sprintf("%s (%s)", msg, e.Code))
+	}
+
+	highlight, err := f.NewStyle(&excelize.Style{
+		Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFC7CE"}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create style: %w", err)
+	}
+
+	header, err := excelize.CoordinatesToCellName(errorCol, 1)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetCellValue(sheet, header, "Import Errors"); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+
+	rowNumbers := make([]int, 0, len(messages))
+	for row := range messages {
+		rowNumbers = append(rowNumbers, row)
+	}
+	sort.Ints(rowNumbers)
+
+	for _, row := range rowNumbers {
+		first, err := excelize.CoordinatesToCellName(1, row)
+		if err != nil {
+			return nil, err
+		}
+		cell, err := excelize.CoordinatesToCellName(errorCol, row)
+		if err != nil {
+			return nil, err
+		}
+		if err := f.SetCellValue(sheet, cell, strings.Join(messages[row], "; ")); err != nil {
+			return nil, fmt.Errorf("failed to write row %d: %w", row, err)
+		}
+		if err := f.SetCellStyle(sheet, first, cell, highlight); err != nil {
+			return nil, fmt.Errorf("failed to highlight row %d: %w", row, err)
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// listImportErrors writes an import run's row errors as a workbook
+func listImportErrors(batch *domain.ImportBatch) ([]byte, error) {
+	header := []string{"Row", "Column", "Field", "Value", "Code", "Message", "Legacy Code"}
+
+	rows := make([][]interface{}, len(batch.Errors))
+	for i, e := range batch.Errors {
+		rows[i] = []interface{}{e.Row, e.Column, e.Field, e.Value, e.Code, e.Message, e.LegacyCode}
+	}
+
+	return exportTable("Import Errors", "", header, rows, ExportFormatXLSX)
+}
@@ -0,0 +1,290 @@
+// backend/internal/gl-core/service/import_job_service.go
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+)
+
+const (
+	// importProgressInterval is how often a running job saves its progress
+	// and checks for cancellation
+	importProgressInterval = time.Second
+
+	// importJobStaleAfter is how long a running job may go without saving
+	// progress before it is taken to have died with its process
+	importJobStaleAfter = 15 * time.Minute
+)
+
+// errImportCancelled stops a run whose job has been cancelled
+var errImportCancelled = errors.New("import cancelled")
+
+// ImportJobService queues imports and runs them on a pool of background
+// workers, so large uploads do not have to finish within an HTTP request.
+// Jobs are claimed from the job table, so queued work survives restarts and
+// several processes can share the queue.
+type ImportJobService struct {
+	jobRepo      repository.ImportJobRepositoryInterface
+	imports      *ImportService
+	attachments  AttachmentServiceInterface
+	workers      int
+	pollInterval time.Duration
+	wake         chan struct{}
+}
+
+// NewImportJobService creates a job service with the given number of workers,
+// which check for queued jobs every pollInterval and whenever one is submitted
+func NewImportJobService(
+	jobRepo repository.ImportJobRepositoryInterface,
+	imports *ImportService,
+	attachments AttachmentServiceInterface,
+	workers int,
+	pollInterval time.Duration,
+) *ImportJobService {
+	if workers <= 0 {
+		workers = 2
+	}
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+	return &ImportJobService{
+		jobRepo:      jobRepo,
+		imports:      imports,
+		attachments:  attachments,
+		workers:      workers,
+		pollInterval: pollInterval,
+		wake:         make(chan struct{}, workers),
+	}
+}
+
+// Submit keeps the upload against a new import log and queues a job to
+// import it
+func (s *ImportJobService) Submit(
+	ctx context.Context,
+	importType domain.ImportType,
+	fileName string,
+	fileSize int64,
+	content io.Reader,
+	userID uuid.UUID,
+	options ImportOptions,
+) (*domain.ImportJob, error) {
+	if options.OrganizationID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+
+	encoded, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode import options: %w", err)
+	}
+	job := domain.NewImportJob(options.OrganizationID, importType, fileName, fileSize, encoded, userID)
+
+	// The worker reads the upload back from the attachment, which also
+	// serves as evidence and for the error report
+	attachment, err := s.attachments.AttachToImport(ctx, job.OrganizationID, job.ImportLogID, fileName, content, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store upload: %w", err)
+	}
+	job.AttachmentID = &attachment.ID
+
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+
+	return job, nil
+}
+
+// GetJob retrieves a job for polling
+func (s *ImportJobService) GetJob(ctx context.Context, orgID, jobID uuid.UUID) (*domain.ImportJob, error) {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.OrganizationID != orgID {
+		return nil, domain.NewGLError("import job not found", domain.ErrImportJobNotFound)
+	}
+	return job, nil
+}
+
+// CancelJob cancels a queued job, or asks a running one to stop. A running
+// journal entry import saves nothing; accounts a running chart import has
+// already created are kept and can be rolled back.
+func (s *ImportJobService) CancelJob(ctx context.Context, orgID, jobID uuid.UUID) (*domain.ImportJob, error) {
+	job, err := s.GetJob(ctx, orgID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := job.RequestCancel(); err != nil {
+		return nil, err
+	}
+	if err := s.jobRepo.RequestCancel(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// Start runs the workers in the background until ctx is cancelled
+func (s *ImportJobService) Start(ctx context.Context) {
+	for i := 0; i < s.workers; i++ {
+		go s.work(ctx)
+	}
+}
+
+// work claims and runs queued jobs one at a time
+func (s *ImportJobService) work(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for s.RunNext(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// RunNext claims the oldest queued job and runs it. It reports whether a
+// job was run, so the caller knows to look for another.
+func (s *ImportJobService) RunNext(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if n, err := s.jobRepo.FailStale(ctx, time.Now().Add(-importJobStaleAfter)); err != nil {
+		log.Printf("⚠️  Import jobs: %v", err)
+	} else if n > 0 {
+		log.Printf("Import jobs: failed %d job(s) that stopped responding", n)
+	}
+
+	job, err := s.jobRepo.ClaimNext(ctx)
+	if err != nil {
+		log.Printf("⚠️  Import jobs: %v", err)
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	s.run(ctx, job)
+	return true
+}
+
+// run imports a claimed job's upload and records the outcome
+func (s *ImportJobService) run(ctx context.Context, job *domain.ImportJob) {
+	result, err := s.runImport(ctx, job)
+
+	status := domain.ImportJobCompleted
+	errorMessage := ""
+	switch {
+	case err != nil:
+		status = domain.ImportJobFailed
+		errorMessage = err.Error()
+	case result.Status == domain.ImportStatusCancelled:
+		status = domain.ImportJobCancelled
+	}
+
+	var encoded []byte
+	if result != nil {
+		result.AttachmentID = job.AttachmentID
+		job.ProcessedRows = result.TotalRows
+		job.TotalRows = result.TotalRows
+		if encoded, err = json.Marshal(result); err != nil {
+			log.Printf("⚠️  Import job %s: failed to encode result: %v", job.ID, err)
+		}
+	}
+	job.Finish(status, encoded, errorMessage)
+
+	// Record the outcome even if the workers are shutting down
+	if err := s.jobRepo.Finish(context.WithoutCancel(ctx), job); err != nil {
+		log.Printf("⚠️  Import job %s: %v", job.ID, err)
+	}
+}
+
+// runImport copies the upload to a temporary file and imports it, passing
+// progress back to the job
+func (s *ImportJobService) runImport(ctx context.Context, job *domain.ImportJob) (*ImportResult, error) {
+	var options ImportOptions
+	if err := json.Unmarshal(job.Options, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode import options: %w", err)
+	}
+	if job.AttachmentID == nil {
+		return nil, fmt.Errorf("import job has no upload")
+	}
+
+	_, data, err := s.attachments.Download(ctx, *job.AttachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load upload: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "import_*"+filepath.Ext(job.FileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	options.OrganizationID = job.OrganizationID
+	options.importLogID = job.ImportLogID
+	options.progress = s.progressTracker(ctx, job)
+
+	if job.ImportType == domain.ImportTypeAccounts {
+		return s.imports.ImportChartOfAccounts(ctx, file.Name(), job.FileName, job.FileSize, job.CreatedBy, options)
+	}
+	return s.imports.ImportJournalEntries(ctx, file.Name(), job.FileName, job.FileSize, job.CreatedBy, options)
+}
+
+// progressTracker saves a job's progress at most once per
+// importProgressInterval and stops the run once cancellation is requested.
+// A failure to save progress is logged; the import carries on.
+func (s *ImportJobService) progressTracker(ctx context.Context, job *domain.ImportJob) func(processed, total int) error {
+	var last time.Time
+	return func(processed, total int) error {
+		if time.Since(last) < importProgressInterval {
+			return nil
+		}
+		last = time.Now()
+
+		job.ProcessedRows = processed
+		job.TotalRows = total
+		job.Progress = domain.ProgressPercent(processed, total)
+
+		cancelRequested, err := s.jobRepo.UpdateProgress(ctx, job.ID, processed, total, job.Progress)
+		if err != nil {
+			log.Printf("⚠️  Import job %s: %v", job.ID, err)
+			return nil
+		}
+		if cancelRequested {
+			return errImportCancelled
+		}
+		return nil
+	}
+}
@@ -6,24 +6,31 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/xuri/excelize/v2"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 )
 
-// ImportService handles Excel imports for GL data
+// ImportService handles Excel imports for GL data, and native Tally XML and
+// QuickBooks IIF/CSV exports
 type ImportService struct {
 	pool             *pgxpool.Pool
 	accountRepo      repository.GLAccountRepositoryInterface
 	journalEntryRepo repository.JournalEntryRepositoryInterface
 	journalLineRepo  repository.JournalLineRepositoryInterface
+	periodRepo       repository.FiscalPeriodRepositoryInterface
+	dimRepo          repository.DimensionRepositoryInterface
+	approvalRepo     repository.ApprovalRepositoryInterface
+	batchRepo        repository.ImportBatchRepositoryInterface
+	attachments      AttachmentServiceInterface
+	recorder         audit.Recorder
 }
 
 // ImportResult contains results of an import operation
@@ -39,6 +46,7 @@ type ImportResult struct {
 	ProcessingTimeMillis int64           `json:"processing_time_millis" example:"1250"`
 	Status               string          `json:"status"`
 	LegacySystem         string          `json:"legacy_system,omitempty"`
+	AttachmentID         *uuid.UUID      `json:"attachment_id,omitempty"` // Original workbook kept as evidence
 }
 
 // ImportError represents a single import error
@@ -61,12 +69,40 @@ type ImportWarning struct {
 
 // ImportOptions contains options for import operations
 type ImportOptions struct {
-	LegacySystem   string `json:"legacy_system"`   // 'tally', 'quickbooks', 'excel'
-	LegacyVersion  string `json:"legacy_version"`  // Version info
-	SkipDuplicates bool   `json:"skip_duplicates"` // Skip existing accounts
-	UpdateExisting bool   `json:"update_existing"` // Update if exists
-	ValidateOnly   bool   `json:"validate_only"`   // Only validate, don't import
-	MigrationNotes string `json:"migration_notes"` // Additional notes
+	OrganizationID uuid.UUID `json:"organization_id"` // Chart of accounts the rows belong to
+	LegacySystem   string    `json:"legacy_system"`   // 'tally', 'quickbooks', 'excel'
+	LegacyVersion  string    `json:"legacy_version"`  // Version info
+	SkipDuplicates bool      `json:"skip_duplicates"` // Skip existing accounts
+	UpdateExisting bool      `json:"update_existing"` // Update if exists
+	ValidateOnly   bool      `json:"validate_only"`   // Only validate, don't import
+	AutoPost       bool      `json:"auto_post"`       // Post imported journal entries instead of leaving drafts
+	MigrationNotes string    `json:"migration_notes"` // Additional notes
+
+	// TypeMappings maps legacy ledger groups or account types to account
+	// types, over the built-in Tally and QuickBooks rules
+	TypeMappings map[string]string `json:"type_mappings,omitempty"`
+
+	// Set by background jobs, which attach the upload to the import log
+	// before the run starts and track its progress
+	importLogID uuid.UUID
+	progress    func(processed, total int) error
+}
+
+// logID returns the import log ID the run is recorded under
+func (o ImportOptions) logID() uuid.UUID {
+	if o.importLogID != uuid.Nil {
+		return o.importLogID
+	}
+	return uuid.New()
+}
+
+// reportProgress passes row progress to a background job. An error means
+// the job has been cancelled and the run should stop.
+func (o ImportOptions) reportProgress(processed, total int) error {
+	if o.progress == nil {
+		return nil
+	}
+	return o.progress(processed, total)
 }
 
 // JournalEntryLine represents a single journal entry line
@@ -77,13 +113,25 @@ type JournalEntryLine struct {
 	Description  string
 	AccountCode  string
 	AccountID    uuid.UUID
-	DebitAmount  float64
-	CreditAmount float64
+	DebitAmount  money.Amount
+	CreditAmount money.Amount
 	CostCenter   string
 	Department   string
+	Project      string
+	ServiceLine  string
 	Notes        string
 }
 
+// Dimensions returns the line's analytic tags keyed by dimension code
+func (l *JournalEntryLine) Dimensions() domain.LineDimensions {
+	return domain.LineDimensions{
+		domain.DimensionCostCenter:  l.CostCenter,
+		domain.DimensionDepartment:  l.Department,
+		domain.DimensionProject:     l.Project,
+		domain.DimensionServiceLine: l.ServiceLine,
+	}.Normalize()
+}
+
 // LegacyAccountInfo holds legacy system specific account information
 type LegacyAccountInfo struct {
 	Code    string                 `json:"code"`
@@ -99,16 +147,28 @@ func NewImportService(
 	accountRepo repository.GLAccountRepositoryInterface,
 	journalEntryRepo repository.JournalEntryRepositoryInterface,
 	journalLineRepo repository.JournalLineRepositoryInterface,
+	periodRepo repository.FiscalPeriodRepositoryInterface,
+	dimRepo repository.DimensionRepositoryInterface,
+	approvalRepo repository.ApprovalRepositoryInterface,
+	batchRepo repository.ImportBatchRepositoryInterface,
+	attachments AttachmentServiceInterface,
+	recorder audit.Recorder,
 ) *ImportService {
 	return &ImportService{
 		pool:             pool,
 		accountRepo:      accountRepo,
 		journalEntryRepo: journalEntryRepo,
 		journalLineRepo:  journalLineRepo,
+		periodRepo:       periodRepo,
+		dimRepo:          dimRepo,
+		approvalRepo:     approvalRepo,
+		batchRepo:        batchRepo,
+		attachments:      attachments,
+		recorder:         recorder,
 	}
 }
 
-// ImportChartOfAccounts imports chart of accounts from Excel
+// ImportChartOfAccounts imports chart of accounts from Excel and records the run in the import log
 func (s *ImportService) ImportChartOfAccounts(
 	ctx context.Context,
 	filePath string,
@@ -116,48 +176,70 @@ func (s *ImportService) ImportChartOfAccounts(
 	fileSize int64,
 	userID uuid.UUID,
 	options ImportOptions,
+) (*ImportResult, error) {
+	result, err := s.importChartOfAccounts(ctx, filePath, fileName, userID, options)
+	s.recordBatch(ctx, domain.ImportTypeAccounts, fileName, fileSize, userID, options, result, err)
+	return result, err
+}
+
+// importChartOfAccounts reads accounts from the upload and creates or updates them
+func (s *ImportService) importChartOfAccounts(
+	ctx context.Context,
+	filePath string,
+	fileName string,
+	userID uuid.UUID,
+	options ImportOptions,
 ) (*ImportResult, error) {
 	startTime := time.Now()
 
 	result := &ImportResult{
-		ImportLogID:  uuid.New(),
+		ImportLogID:  options.logID(),
 		Errors:       make([]ImportError, 0),
 		Warnings:     make([]ImportWarning, 0),
 		ImportedIDs:  make([]uuid.UUID, 0),
 		LegacySystem: options.LegacySystem,
 	}
 
-	// Open Excel file
-	f, err := excelize.OpenFile(filePath)
-	if err != nil {
+	if options.OrganizationID == uuid.Nil {
 		result.Status = "failed"
-		return result, fmt.Errorf("failed to open file: %w", err)
+		return result, fmt.Errorf("organization ID is required")
 	}
-	defer f.Close()
 
-	// Get active sheet
-	sheetName := f.GetSheetName(0)
-	rows, err := f.GetRows(sheetName)
+	// Read the workbook, or a native export converted to the workbook's columns
+	sheet, err := s.readAccountSheet(filePath, fileName, options)
 	if err != nil {
 		result.Status = "failed"
-		return result, fmt.Errorf("failed to read rows: %w", err)
+		return result, err
 	}
+	defer sheet.close()
+	result.Warnings = append(result.Warnings, sheet.warnings...)
+	result.WarningCount += len(sheet.warnings)
 
-	if len(rows) < 2 {
+	if !sheet.next() {
 		result.Status = "failed"
+		if err := sheet.err(); err != nil {
+			return result, err
+		}
 		return result, fmt.Errorf("file must contain header and at least one data row")
 	}
 
 	// Parse header and detect legacy system format
-	header := rows[0]
-	colMap, detectedSystem := s.buildColumnMapWithLegacyDetection(header, options.LegacySystem)
-
-	if detectedSystem != "" && options.LegacySystem == "" {
-		result.LegacySystem = detectedSystem
-		result.Warnings = append(result.Warnings, ImportWarning{
-			Row:     1,
-			Message: fmt.Sprintf("Auto-detected legacy system: %s", detectedSystem),
-		})
+	header := sheet.current()
+	var colMap map[string]int
+	if sheet.system != "" {
+		colMap = s.buildColumnMap(header)
+		result.LegacySystem = sheet.system
+	} else {
+		var detectedSystem string
+		colMap, detectedSystem = s.buildColumnMapWithLegacyDetection(header, options.LegacySystem)
+
+		if detectedSystem != "" && options.LegacySystem == "" {
+			result.LegacySystem = detectedSystem
+			result.Warnings = append(result.Warnings, ImportWarning{
+				Row:     1,
+				Message: fmt.Sprintf("Auto-detected legacy system: %s", detectedSystem),
+			})
+		}
 	}
 
 	// Validate required columns
@@ -179,11 +261,17 @@ func (s *ImportService) ImportChartOfAccounts(
 		tx = txn
 	}
 
-	result.TotalRows = len(rows) - 1
+	// Process each row as it is read
+	cancelled := false
+	for sheet.next() {
+		row := sheet.current()
+		rowNum := sheet.rowNumber()
+		result.TotalRows++
 
-	// Process each row
-	for rowIdx := 1; rowIdx < len(rows); rowIdx++ {
-		row := rows[rowIdx]
+		if err := options.reportProgress(result.TotalRows, sheet.dataRows()); err != nil {
+			cancelled = true
+			break
+		}
 
 		// Skip empty rows
 		if s.isEmptyRow(row) {
@@ -192,7 +280,7 @@ func (s *ImportService) ImportChartOfAccounts(
 
 		// Validate and convert row to account
 		account, legacyInfo, validationErrs := s.validateAndConvertAccountRow(
-			row, colMap, rowIdx+1, result.LegacySystem,
+			row, colMap, rowNum, result.LegacySystem,
 		)
 
 		if len(validationErrs) > 0 {
@@ -201,11 +289,13 @@ func (s *ImportService) ImportChartOfAccounts(
 			continue
 		}
 
-		// Check for existing account
-		existingAccount, err := s.accountRepo.GetGLAccountByCode(ctx, account.Code, true)
+		account.OrganizationID = options.OrganizationID
+
+		// Check for existing account in this organization's chart
+		existingAccount, err := s.accountRepo.GetGLAccountByCode(ctx, options.OrganizationID, account.Code, true)
 		if err != nil && err.Error() != "no rows in result set" {
 			result.Errors = append(result.Errors, ImportError{
-				Row:        rowIdx + 1,
+				Row:        rowNum,
 				Field:      "code",
 				Value:      account.Code,
 				Message:    fmt.Sprintf("Database error: %v", err),
@@ -220,7 +310,7 @@ func (s *ImportService) ImportChartOfAccounts(
 		if existingAccount.ID != uuid.Nil {
 			if options.SkipDuplicates {
 				result.Warnings = append(result.Warnings, ImportWarning{
-					Row:        rowIdx + 1,
+					Row:        rowNum,
 					Message:    fmt.Sprintf("Account '%s' already exists, skipping", account.Code),
 					LegacyCode: legacyInfo.Code,
 				})
@@ -229,11 +319,12 @@ func (s *ImportService) ImportChartOfAccounts(
 			} else if options.UpdateExisting {
 				// Update existing account
 				account.ID = existingAccount.ID
+				account.IsPostable = existingAccount.IsPostable
 				if !options.ValidateOnly {
 					_, err := s.accountRepo.UpdateGLAccount(ctx, *account)
 					if err != nil {
 						result.Errors = append(result.Errors, ImportError{
-							Row:        rowIdx + 1,
+							Row:        rowNum,
 							Field:      "code",
 							Value:      account.Code,
 							Message:    fmt.Sprintf("Failed to update: %v", err),
@@ -245,14 +336,14 @@ func (s *ImportService) ImportChartOfAccounts(
 					}
 				}
 				result.Warnings = append(result.Warnings, ImportWarning{
-					Row:        rowIdx + 1,
+					Row:        rowNum,
 					Message:    fmt.Sprintf("Account '%s' updated", account.Code),
 					LegacyCode: legacyInfo.Code,
 				})
 				result.WarningCount++
 			} else {
 				result.Errors = append(result.Errors, ImportError{
-					Row:        rowIdx + 1,
+					Row:        rowNum,
 					Field:      "code",
 					Value:      account.Code,
 					Message:    "Account already exists",
@@ -268,9 +359,10 @@ func (s *ImportService) ImportChartOfAccounts(
 				account.ID = uuid.New()
 				account.CreateAt = time.Now()
 				account.UpdateAt = time.Now()
-				if _, err := s.accountRepo.CreateGLAccount(ctx, *account); err != nil {
+				created, err := s.accountRepo.CreateGLAccount(ctx, *account)
+				if err != nil {
 					result.Errors = append(result.Errors, ImportError{
-						Row:        rowIdx + 1,
+						Row:        rowNum,
 						Field:      "code",
 						Value:      account.Code,
 						Message:    fmt.Sprintf("Failed to create: %v", err),
@@ -280,12 +372,21 @@ func (s *ImportService) ImportChartOfAccounts(
 					result.ErrorCount++
 					continue
 				}
-				result.ImportedIDs = append(result.ImportedIDs, account.ID)
+				// The database assigns the ID rollback needs
+				result.ImportedIDs = append(result.ImportedIDs, created.ID)
 			}
 		}
 
 		result.SuccessCount++
 	}
+	if err := sheet.err(); err != nil {
+		result.Status = "failed"
+		return result, err
+	}
+	if result.TotalRows == 0 {
+		result.Status = "failed"
+		return result, fmt.Errorf("file must contain header and at least one data row")
+	}
 
 	// Determine final status
 	if result.ErrorCount == 0 {
@@ -308,12 +409,17 @@ func (s *ImportService) ImportChartOfAccounts(
 		result.Status = "validated"
 	}
 
+	// Accounts created before the job was cancelled are kept; the run can be rolled back
+	if cancelled {
+		result.Status = domain.ImportStatusCancelled
+	}
+
 	result.ProcessingTimeMillis = time.Since(startTime).Milliseconds()
 
 	return result, nil
 }
 
-// ImportJournalEntries imports journal entries from Excel
+// ImportJournalEntries imports journal entries from Excel and records the run in the import log
 func (s *ImportService) ImportJournalEntries(
 	ctx context.Context,
 	filePath string,
@@ -321,36 +427,61 @@ func (s *ImportService) ImportJournalEntries(
 	fileSize int64,
 	userID uuid.UUID,
 	options ImportOptions,
+) (*ImportResult, error) {
+	result, err := s.importJournalEntries(ctx, filePath, fileName, userID, options)
+	s.recordBatch(ctx, domain.ImportTypeJournalEntries, fileName, fileSize, userID, options, result, err)
+	return result, err
+}
+
+// importJournalEntries imports journal entries from Excel, or from a native
+// Tally or QuickBooks export. Rows are grouped into one entry per reference
+// number. Entries are saved together, linked to
+// the import log so the run can be rolled back, as drafts or, with AutoPost,
+// posted. An entry that fails validation is reported against its rows and
+// left out; the rest are still imported.
+func (s *ImportService) importJournalEntries(
+	ctx context.Context,
+	filePath string,
+	fileName string,
+	userID uuid.UUID,
+	options ImportOptions,
 ) (*ImportResult, error) {
 	startTime := time.Now()
 
 	result := &ImportResult{
-		ImportLogID: uuid.New(),
-		Errors:      make([]ImportError, 0),
-		Warnings:    make([]ImportWarning, 0),
-		ImportedIDs: make([]uuid.UUID, 0),
+		ImportLogID:  options.logID(),
+		Errors:       make([]ImportError, 0),
+		Warnings:     make([]ImportWarning, 0),
+		ImportedIDs:  make([]uuid.UUID, 0),
+		LegacySystem: options.LegacySystem,
 	}
 
-	f, err := excelize.OpenFile(filePath)
-	if err != nil {
+	if options.OrganizationID == uuid.Nil {
 		result.Status = "failed"
-		return result, fmt.Errorf("failed to open file: %w", err)
+		return result, fmt.Errorf("organization ID is required")
 	}
-	defer f.Close()
 
-	sheetName := f.GetSheetName(0)
-	rows, err := f.GetRows(sheetName)
+	sheet, err := s.readJournalSheet(ctx, filePath, fileName, options.OrganizationID)
 	if err != nil {
 		result.Status = "failed"
-		return result, fmt.Errorf("failed to read rows: %w", err)
+		return result, err
+	}
+	defer sheet.close()
+	if sheet.system != "" {
+		result.LegacySystem = sheet.system
 	}
+	result.Warnings = append(result.Warnings, sheet.warnings...)
+	result.WarningCount += len(sheet.warnings)
 
-	if len(rows) < 2 {
+	if !sheet.next() {
 		result.Status = "failed"
+		if err := sheet.err(); err != nil {
+			return result, err
+		}
 		return result, fmt.Errorf("file must contain header and at least one data row")
 	}
 
-	header := rows[0]
+	header := sheet.current()
 	colMap := s.buildColumnMap(header)
 
 	// Validate required columns
@@ -362,66 +493,143 @@ func (s *ImportService) ImportJournalEntries(
 		}
 	}
 
-	var tx interface{}
-	if !options.ValidateOnly {
-		var err error
-		txn, err := s.pool.Begin(ctx)
-		if err != nil {
-			result.Status = "failed"
-			return result, fmt.Errorf("failed to begin transaction: %w", err)
-		}
-		defer txn.Rollback(ctx)
-		tx = txn
-	}
-
-	result.TotalRows = len(rows) - 1
-
-	// Group entries by reference number
+	// Group entries by reference number, keeping the workbook's order. Each
+	// account is looked up once however many rows use it.
 	entriesByRef := make(map[string][]*JournalEntryLine)
-
-	for rowIdx := 1; rowIdx < len(rows); rowIdx++ {
-		row := rows[rowIdx]
+	var refs []string
+	accounts := make(map[uuid.UUID]*domain.GLAccount)
+	accountsByCode := make(map[string]*domain.GLAccount)
+	cancelled := false
+
+	for sheet.next() {
+		row := sheet.current()
+		rowNum := sheet.rowNumber()
+		result.TotalRows++
+
+		if err := options.reportProgress(result.TotalRows, sheet.dataRows()); err != nil {
+			cancelled = true
+			break
+		}
 
 		if s.isEmptyRow(row) {
 			continue
 		}
 
-		line, _, validationErrs := s.validateAndConvertJournalEntryRow(row, colMap, rowIdx+1)
+		line, _, validationErrs := s.validateAndConvertJournalEntryRow(row, colMap, rowNum)
 		if len(validationErrs) > 0 {
 			result.Errors = append(result.Errors, validationErrs...)
 			result.ErrorCount++
 			continue
 		}
 
+		// Account codes resolve against the organization's own chart only
+		account, looked := accountsByCode[line.AccountCode]
+		if !looked {
+			if found, err := s.accountRepo.GetGLAccountByCode(ctx, options.OrganizationID, line.AccountCode, false); err == nil {
+				account = &found
+			}
+			accountsByCode[line.AccountCode] = account
+		}
+		if account == nil {
+			result.Errors = append(result.Errors, ImportError{
+				Row:     rowNum,
+				Column:  "Account Code",
+				Field:   "account_code",
+				Value:   line.AccountCode,
+				Message: "Account not found in this organization's chart of accounts",
+				Code:    "ACCOUNT_NOT_FOUND",
+			})
+			result.ErrorCount++
+			continue
+		}
+		line.AccountID = account.ID
+		accounts[account.ID] = account
+
+		if _, seen := entriesByRef[line.ReferenceNo]; !seen {
+			refs = append(refs, line.ReferenceNo)
+		}
 		entriesByRef[line.ReferenceNo] = append(entriesByRef[line.ReferenceNo], line)
 	}
+	if err := sheet.err(); err != nil {
+		result.Status = "failed"
+		return result, err
+	}
+	if result.TotalRows == 0 && !cancelled {
+		result.Status = "failed"
+		return result, fmt.Errorf("file must contain header and at least one data row")
+	}
+
+	// Posting checks the organization's dimensions and approval rules
+	var dimensions []*domain.Dimension
+	var rules []*domain.ApprovalRule
+	if options.AutoPost {
+		if dimensions, err = s.dimRepo.ListDimensions(ctx, options.OrganizationID); err != nil {
+			result.Status = "failed"
+			return result, fmt.Errorf("failed to list dimensions: %w", err)
+		}
+		if rules, err = s.approvalRepo.ListRules(ctx, options.OrganizationID); err != nil {
+			result.Status = "failed"
+			return result, fmt.Errorf("failed to load approval rules: %w", err)
+		}
+	}
+
+	// Validate and build each journal entry
+	entries := make([]*domain.JournalEntry, 0, len(refs))
+	for _, ref := range refs {
+		if cancelled || options.reportProgress(result.TotalRows, sheet.dataRows()) != nil {
+			cancelled = true
+			break
+		}
+		lines := entriesByRef[ref]
 
-	// Validate and process each journal entry
-	for _, lines := range entriesByRef {
 		// Validate balancing
-		var totalDebit, totalCredit float64
+		var totalDebit, totalCredit money.Amount
 		for _, line := range lines {
 			totalDebit += line.DebitAmount
 			totalCredit += line.CreditAmount
 		}
 
 		// Check balance
-		if fmt.Sprintf("%.2f", totalDebit) != fmt.Sprintf("%.2f", totalCredit) {
-			for _, line := range lines {
-				result.Errors = append(result.Errors, ImportError{
-					Row:     line.RowNumber,
-					Field:   "reference_no",
-					Value:   line.ReferenceNo,
-					Message: fmt.Sprintf("Entry not balanced. Debits: %.2f, Credits: %.2f", totalDebit, totalCredit),
-					Code:    "UNBALANCED_ENTRY",
+		if totalDebit != totalCredit {
+			s.rejectEntryLines(result, lines, "UNBALANCED_ENTRY",
+				fmt.Sprintf("Entry not balanced. Debits: %s, Credits: %s", totalDebit, totalCredit))
+			continue
+		}
+
+		entry, err := s.buildImportedEntry(ref, lines, options.OrganizationID, userID, result.ImportLogID)
+		if err != nil {
+			s.rejectEntryLines(result, lines, "INVALID_ENTRY", err.Error())
+			continue
+		}
+
+		if options.AutoPost {
+			if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, entry.TransactionDate); err != nil {
+				s.rejectEntryLines(result, lines, "PERIOD_CLOSED", err.Error())
+				continue
+			}
+
+			validation := domain.ValidateForPosting(entry, accounts)
+			domain.ValidateDimensions(entry, accounts, dimensions, validation)
+			if !validation.IsValid {
+				s.rejectEntryLines(result, lines, "POSTING_VALIDATION_FAILED", strings.Join(validation.Errors, "; "))
+				continue
+			}
+
+			// Entries matching an approval rule still go through submit/approve
+			if matched := domain.MatchingApprovalRules(entry, rules); len(matched) > 0 {
+				result.Warnings = append(result.Warnings, ImportWarning{
+					Row:     lines[0].RowNumber,
+					Message: fmt.Sprintf("Entry '%s' requires approval (rule: %s); imported as draft", ref, matched[0].Name),
 				})
+				result.WarningCount++
+			} else if err := entry.Post(userID); err != nil {
+				s.rejectEntryLines(result, lines, "POSTING_FAILED", err.Error())
+				continue
 			}
-			result.ErrorCount += len(lines)
-			continue
 		}
 
+		entries = append(entries, entry)
 		result.SuccessCount += len(lines)
-		result.ImportedIDs = append(result.ImportedIDs, uuid.New()) // Representative ID
 	}
 
 	// Determine final status
@@ -433,16 +641,22 @@ func (s *ImportService) ImportJournalEntries(
 		result.Status = "failed"
 	}
 
-	// Commit if successful
-	if !options.ValidateOnly && tx != nil && result.Status != "failed" {
-		if txn, ok := tx.(interface{ Commit(context.Context) error }); ok {
-			if err := txn.Commit(ctx); err != nil {
-				result.Status = "failed"
-				return result, fmt.Errorf("failed to commit transaction: %w", err)
-			}
-		}
+	// Entries are only saved once every row has been read, so a cancelled job saves nothing
+	if cancelled {
+		result.Status = domain.ImportStatusCancelled
+		result.SuccessCount = 0
 	} else if options.ValidateOnly {
 		result.Status = "validated"
+	} else if len(entries) > 0 {
+		// Posted entries are numbered as they are saved
+		if err := s.journalEntryRepo.CreateBatch(ctx, entries); err != nil {
+			result.Status = "failed"
+			return result, fmt.Errorf("failed to save journal entries: %w", err)
+		}
+		for _, entry := range entries {
+			result.ImportedIDs = append(result.ImportedIDs, entry.ID)
+			audit.LogChange(ctx, s.recorder, entry.OrganizationID, audit.EntityJournalEntry, entry.ID, "IMPORT", nil, entry)
+		}
 	}
 
 	result.ProcessingTimeMillis = time.Since(startTime).Milliseconds()
@@ -450,6 +664,62 @@ func (s *ImportService) ImportJournalEntries(
 	return result, nil
 }
 
+// buildImportedEntry builds a draft journal entry from the rows sharing a
+// reference number. The first row's description describes the entry.
+func (s *ImportService) buildImportedEntry(ref string, lines []*JournalEntryLine, orgID, userID, importLogID uuid.UUID) (*domain.JournalEntry, error) {
+	now := time.Now()
+	entry := &domain.JournalEntry{
+		ID:              uuid.New(),
+		OrganizationID:  orgID,
+		EntryNumber:     domain.NewDraftEntryNumber(),
+		JournalType:     domain.JournalTypeGeneral,
+		TransactionDate: lines[0].EntryDate,
+		Reference:       ref,
+		Description:     lines[0].Description,
+		Status:          domain.EntryStatusDraft,
+		CreatedBy:       userID,
+		ImportLogID:     &importLogID,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	for i, line := range lines {
+		if !line.EntryDate.Equal(entry.TransactionDate) {
+			return nil, fmt.Errorf("all rows of an entry must have the same entry date (row %d differs)", line.RowNumber)
+		}
+		entry.Lines = append(entry.Lines, domain.JournalLine{
+			ID:          uuid.New(),
+			AccountID:   line.AccountID,
+			Description: line.Description,
+			Debit:       line.DebitAmount,
+			Credit:      line.CreditAmount,
+			LineNumber:  i + 1,
+			Dimensions:  line.Dimensions(),
+		})
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	entry.CalculateTotals()
+
+	return entry, nil
+}
+
+// rejectEntryLines reports an error against every row of a rejected entry
+func (s *ImportService) rejectEntryLines(result *ImportResult, lines []*JournalEntryLine, code, message string) {
+	for _, line := range lines {
+		result.Errors = append(result.Errors, ImportError{
+			Row:     line.RowNumber,
+			Field:   "reference_no",
+			Value:   line.ReferenceNo,
+			Message: message,
+			Code:    code,
+		})
+	}
+	result.ErrorCount += len(lines)
+}
+
 // ============================================================================
 // VALIDATION METHODS (from import_validation.go)
 // ============================================================================
@@ -468,8 +738,9 @@ func (s *ImportService) validateAndConvertAccountRow(
 	}
 
 	account := &domain.GLAccount{
-		ID:       uuid.New(),
-		IsActive: true, // Default
+		ID:         uuid.New(),
+		IsActive:   true, // Default
+		IsPostable: true,
 	}
 
 	// Account Code validation
@@ -540,6 +811,15 @@ func (s *ImportService) validateAndConvertAccountRow(
 			Message: "Account Type is required",
 			Code:    "REQUIRED_FIELD",
 		})
+	} else if _, err := domain.PrefixForType(domain.AccountType(strings.ToUpper(accountType))); err != nil {
+		errors = append(errors, ImportError{
+			Row:     rowNum,
+			Column:  "Account Type",
+			Field:   "type",
+			Value:   accountType,
+			Message: "Account Type must be ASSET, LIABILITY, EQUITY, REVENUE or EXPENSE; legacy groups and types need a type mapping",
+			Code:    "INVALID_VALUE",
+		})
 	} else {
 		// Convert to AccountType from domain
 		account.Type = domain.AccountType(strings.ToUpper(accountType))
@@ -676,7 +956,7 @@ func (s *ImportService) validateAndConvertJournalEntryRow(
 	// Debit Amount validation
 	debitAmountStr := strings.TrimSpace(s.getCellValue(row, colMap, "debit_amount"))
 	if debitAmountStr != "" {
-		debit, err := strconv.ParseFloat(debitAmountStr, 64)
+		debit, err := money.Parse(debitAmountStr)
 		if err != nil {
 			errors = append(errors, ImportError{
 				Row:     rowNum,
@@ -695,6 +975,15 @@ func (s *ImportService) validateAndConvertJournalEntryRow(
 				Message: "Debit Amount must be positive",
 				Code:    "INVALID_VALUE",
 			})
+		} else if debit.RoundTo(domain.LedgerDecimalPlaces) != debit {
+			errors = append(errors, ImportError{
+				Row:     rowNum,
+				Column:  "Debit Amount",
+				Field:   "debit_amount",
+				Value:   debitAmountStr,
+				Message: fmt.Sprintf("Debit Amount cannot have more than %d decimal places", domain.LedgerDecimalPlaces),
+				Code:    "INVALID_VALUE",
+			})
 		} else {
 			line.DebitAmount = debit
 		}
@@ -703,7 +992,7 @@ func (s *ImportService) validateAndConvertJournalEntryRow(
 	// Credit Amount validation
 	creditAmountStr := strings.TrimSpace(s.getCellValue(row, colMap, "credit_amount"))
 	if creditAmountStr != "" {
-		credit, err := strconv.ParseFloat(creditAmountStr, 64)
+		credit, err := money.Parse(creditAmountStr)
 		if err != nil {
 			errors = append(errors, ImportError{
 				Row:     rowNum,
@@ -722,6 +1011,15 @@ func (s *ImportService) validateAndConvertJournalEntryRow(
 				Message: "Credit Amount must be positive",
 				Code:    "INVALID_VALUE",
 			})
+		} else if credit.RoundTo(domain.LedgerDecimalPlaces) != credit {
+			errors = append(errors, ImportError{
+				Row:     rowNum,
+				Column:  "Credit Amount",
+				Field:   "credit_amount",
+				Value:   creditAmountStr,
+				Message: fmt.Sprintf("Credit Amount cannot have more than %d decimal places", domain.LedgerDecimalPlaces),
+				Code:    "INVALID_VALUE",
+			})
 		} else {
 			line.CreditAmount = credit
 		}
@@ -747,6 +1045,8 @@ func (s *ImportService) validateAndConvertJournalEntryRow(
 	// Optional fields
 	line.CostCenter = strings.TrimSpace(s.getCellValue(row, colMap, "cost_center"))
 	line.Department = strings.TrimSpace(s.getCellValue(row, colMap, "department"))
+	line.Project = strings.TrimSpace(s.getCellValue(row, colMap, "project"))
+	line.ServiceLine = strings.TrimSpace(s.getCellValue(row, colMap, "service_line"))
 	line.Notes = strings.TrimSpace(s.getCellValue(row, colMap, "notes"))
 
 	// Store raw data
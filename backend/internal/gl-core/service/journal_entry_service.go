@@ -3,66 +3,111 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
 	"github.com/google/uuid"
 )
 
 type JournalEntryService struct {
-	repo        repository.JournalEntryRepositoryInterface
-	accountRepo repository.GLAccountRepositoryInterface
+	repo         repository.JournalEntryRepositoryInterface
+	accountRepo  repository.GLAccountRepositoryInterface
+	periodRepo   repository.FiscalPeriodRepositoryInterface
+	rateRepo     repository.ExchangeRateRepositoryInterface
+	dimRepo      repository.DimensionRepositoryInterface
+	approvalRepo repository.ApprovalRepositoryInterface
+	recorder     audit.Recorder
 }
 
 // NewJournalEntryService creates a new journal entry service
 func NewJournalEntryService(
 	repo repository.JournalEntryRepositoryInterface,
 	accountRepo repository.GLAccountRepositoryInterface,
+	periodRepo repository.FiscalPeriodRepositoryInterface,
+	rateRepo repository.ExchangeRateRepositoryInterface,
+	dimRepo repository.DimensionRepositoryInterface,
+	approvalRepo repository.ApprovalRepositoryInterface,
+	recorder audit.Recorder,
 ) *JournalEntryService {
 	return &JournalEntryService{
-		repo:        repo,
-		accountRepo: accountRepo,
+		repo:         repo,
+		accountRepo:  accountRepo,
+		periodRepo:   periodRepo,
+		rateRepo:     rateRepo,
+		dimRepo:      dimRepo,
+		approvalRepo: approvalRepo,
+		recorder:     recorder,
 	}
 }
 
-// CreateEntry creates a new journal entry in DRAFT status
-func (s *JournalEntryService) CreateEntry(ctx context.Context, entry *domain.JournalEntry) (*domain.JournalEntry, error) {
+// PrepareEntry defaults, validates and totals a new DRAFT entry without saving it,
+// for callers that insert the entry in their own transaction
+func (s *JournalEntryService) PrepareEntry(ctx context.Context, entry *domain.JournalEntry) error {
 	// Set defaults
 	entry.ID = uuid.New()
 	entry.Status = domain.EntryStatusDraft
+	if entry.JournalType == "" {
+		entry.JournalType = domain.JournalTypeGeneral
+	}
 	entry.CreatedAt = time.Now()
 	entry.UpdatedAt = time.Now()
 
-	// Generate entry number if not provided
+	if !entry.JournalType.IsValid() {
+		return domain.NewGLErrorf(domain.ErrJournalTypeInvalid, "invalid journal type: %s", entry.JournalType)
+	}
+
+	// Drafts carry a placeholder; the number is allocated from the journal
+	// type's sequence when the entry is posted
 	if entry.EntryNumber == "" {
-		entryNumber, err := s.GenerateEntryNumber(ctx, entry.OrganizationID, entry.TransactionDate.Format("20060102"))
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate entry number: %w", err)
-		}
-		entry.EntryNumber = entryNumber
+		entry.EntryNumber = domain.NewDraftEntryNumber()
 	}
 
 	// Generate line IDs
 	for i := range entry.Lines {
 		entry.Lines[i].ID = uuid.New()
 		entry.Lines[i].LineNumber = i + 1
+		entry.Lines[i].Dimensions = entry.Lines[i].Dimensions.Normalize()
+	}
+
+	// Lines may only post to the organization's own accounts
+	if err := ensureAccountsInOrganization(ctx, s.accountRepo, entry); err != nil {
+		return err
+	}
+
+	// Derive base currency amounts for foreign currency lines
+	if err := applyExchangeRates(ctx, s.rateRepo, entry); err != nil {
+		return err
 	}
 
 	// Domain validation
 	if err := entry.Validate(); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+		return fmt.Errorf("validation failed: %w", err)
 	}
 
 	// Calculate totals
 	entry.CalculateTotals()
 
+	return nil
+}
+
+// CreateEntry creates a new journal entry in DRAFT status
+func (s *JournalEntryService) CreateEntry(ctx context.Context, entry *domain.JournalEntry) (*domain.JournalEntry, error) {
+	if err := s.PrepareEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
 	// Save to repository
 	if err := s.repo.Create(ctx, entry); err != nil {
 		return nil, fmt.Errorf("failed to create journal entry: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionCreate, nil, entry)
+
 	return entry, nil
 }
 
@@ -79,11 +124,33 @@ func (s *JournalEntryService) UpdateEntry(ctx context.Context, entry *domain.Jou
 		return nil, fmt.Errorf("entry cannot be edited (status: %s)", existing.Status)
 	}
 
+	// Neither the old nor the new date may fall in a closed period
+	if err := ensurePeriodOpen(ctx, s.periodRepo, existing.OrganizationID, existing.TransactionDate); err != nil {
+		return nil, err
+	}
+	if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, entry.TransactionDate); err != nil {
+		return nil, err
+	}
+
 	// Update timestamps
 	entry.UpdatedAt = time.Now()
 	entry.CreatedAt = existing.CreatedAt
 	entry.CreatedBy = existing.CreatedBy
 
+	for i := range entry.Lines {
+		entry.Lines[i].Dimensions = entry.Lines[i].Dimensions.Normalize()
+	}
+
+	// Lines may only post to the organization's own accounts
+	if err := ensureAccountsInOrganization(ctx, s.accountRepo, entry); err != nil {
+		return nil, err
+	}
+
+	// Derive base currency amounts for foreign currency lines
+	if err := applyExchangeRates(ctx, s.rateRepo, entry); err != nil {
+		return nil, err
+	}
+
 	// Domain validation
 	if err := entry.Validate(); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
@@ -97,6 +164,8 @@ func (s *JournalEntryService) UpdateEntry(ctx context.Context, entry *domain.Jou
 		return nil, fmt.Errorf("failed to update journal entry: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionUpdate, existing, entry)
+
 	return entry, nil
 }
 
@@ -155,6 +224,12 @@ func (s *JournalEntryService) PostEntry(ctx context.Context, entryID uuid.UUID,
 		return fmt.Errorf("entry cannot be posted (status: %s)", entry.Status)
 	}
 
+	// Validate the accounting period is open. The repository checks it again
+	// under a row lock when saving, in case the period closes meanwhile.
+	if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, entry.TransactionDate); err != nil {
+		return err
+	}
+
 	// Validate entry for posting
 	validationResult, err := s.ValidateEntry(ctx, entryID)
 	if err != nil {
@@ -165,7 +240,24 @@ func (s *JournalEntryService) PostEntry(ctx context.Context, entryID uuid.UUID,
 		return fmt.Errorf("entry validation failed: %v", validationResult.Errors)
 	}
 
+	// Entries matching an approval rule must go through submit/approve
+	rules, err := s.matchingApprovalRules(ctx, entry)
+	if err != nil {
+		return err
+	}
+	if len(rules) > 0 {
+		return domain.NewGLErrorf(domain.ErrJournalApprovalRequired,
+			"entry requires approval (rule: %s); submit it for approval instead of posting", rules[0].Name)
+	}
+
+	// A reversal marks its original REVERSED as it posts
+	original, err := s.reversedEntry(ctx, entry)
+	if err != nil {
+		return err
+	}
+
 	// Post the entry (domain logic)
+	before := *entry
 	if err := entry.Post(postedBy); err != nil {
 		return fmt.Errorf("failed to post entry: %w", err)
 	}
@@ -175,6 +267,9 @@ func (s *JournalEntryService) PostEntry(ctx context.Context, entryID uuid.UUID,
 		return fmt.Errorf("failed to save posted entry: %w", err)
 	}
 
+	s.recordChange(ctx, "POST", &before, entry)
+	s.recordReversed(ctx, original, entry)
+
 	// TODO: Update account balances here
 	// This could be done via a separate AccountBalanceService
 	// For each line:
@@ -184,6 +279,136 @@ func (s *JournalEntryService) PostEntry(ctx context.Context, entryID uuid.UUID,
 	return nil
 }
 
+// SubmitEntry sends a draft entry for approval
+func (s *JournalEntryService) SubmitEntry(ctx context.Context, entryID, submittedBy uuid.UUID, comment string) (*domain.JournalEntry, error) {
+	entry, err := s.repo.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("entry not found: %w", err)
+	}
+
+	if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, entry.TransactionDate); err != nil {
+		return nil, err
+	}
+
+	// Catch posting errors before the checker sees the entry
+	validationResult, err := s.ValidateEntry(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if !validationResult.IsValid {
+		return nil, fmt.Errorf("entry validation failed: %v", validationResult.Errors)
+	}
+
+	before := *entry
+	if err := entry.Submit(); err != nil {
+		return nil, err
+	}
+
+	approval := domain.NewJournalEntryApproval(entry.ID, domain.ApprovalActionSubmitted, submittedBy, comment)
+	if err := s.repo.UpdateWithApproval(ctx, entry, approval); err != nil {
+		return nil, fmt.Errorf("failed to save submitted entry: %w", err)
+	}
+
+	s.recordChange(ctx, "SUBMIT", &before, entry)
+
+	return entry, nil
+}
+
+// ApproveEntry posts a pending entry on behalf of the approver, enforcing
+// segregation of duties for the approval rules the entry matches
+func (s *JournalEntryService) ApproveEntry(ctx context.Context, entryID, approvedBy uuid.UUID, comment string) (*domain.JournalEntry, error) {
+	entry, err := s.repo.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("entry not found: %w", err)
+	}
+
+	if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, entry.TransactionDate); err != nil {
+		return nil, err
+	}
+
+	validationResult, err := s.ValidateEntry(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if !validationResult.IsValid {
+		return nil, fmt.Errorf("entry validation failed: %v", validationResult.Errors)
+	}
+
+	rules, err := s.matchingApprovalRules(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := s.reversedEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *entry
+	if err := entry.Approve(approvedBy, rules); err != nil {
+		return nil, err
+	}
+
+	approval := domain.NewJournalEntryApproval(entry.ID, domain.ApprovalActionApproved, approvedBy, comment)
+	if err := s.repo.UpdateWithApproval(ctx, entry, approval); err != nil {
+		return nil, fmt.Errorf("failed to save approved entry: %w", err)
+	}
+
+	s.recordChange(ctx, "APPROVE", &before, entry)
+	s.recordReversed(ctx, original, entry)
+
+	return entry, nil
+}
+
+// RejectEntry returns a pending entry to DRAFT. A comment explaining the rejection is required.
+func (s *JournalEntryService) RejectEntry(ctx context.Context, entryID, rejectedBy uuid.UUID, comment string) (*domain.JournalEntry, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, domain.NewGLError("a comment is required when rejecting an entry", domain.ErrJournalCannotApprove)
+	}
+
+	entry, err := s.repo.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("entry not found: %w", err)
+	}
+
+	before := *entry
+	if err := entry.Reject(); err != nil {
+		return nil, err
+	}
+
+	approval := domain.NewJournalEntryApproval(entry.ID, domain.ApprovalActionRejected, rejectedBy, comment)
+	if err := s.repo.UpdateWithApproval(ctx, entry, approval); err != nil {
+		return nil, fmt.Errorf("failed to save rejected entry: %w", err)
+	}
+
+	s.recordChange(ctx, "REJECT", &before, entry)
+
+	return entry, nil
+}
+
+// ListApprovals lists an entry's submit, approve and reject history
+func (s *JournalEntryService) ListApprovals(ctx context.Context, entryID uuid.UUID) ([]*domain.JournalEntryApproval, error) {
+	return s.approvalRepo.ListActions(ctx, entryID)
+}
+
+// recordChange writes a journal entry change to the audit trail
+func (s *JournalEntryService) recordChange(ctx context.Context, action string, before, after *domain.JournalEntry) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	audit.LogChange(ctx, s.recorder, subject.OrganizationID, audit.EntityJournalEntry, subject.ID, action, before, after)
+}
+
+// matchingApprovalRules returns the organization's active approval rules the entry matches
+func (s *JournalEntryService) matchingApprovalRules(ctx context.Context, entry *domain.JournalEntry) ([]*domain.ApprovalRule, error) {
+	rules, err := s.approvalRepo.ListRules(ctx, entry.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load approval rules: %w", err)
+	}
+	return domain.MatchingApprovalRules(entry, rules), nil
+}
+
 // VoidEntry voids a posted entry
 func (s *JournalEntryService) VoidEntry(ctx context.Context, entryID uuid.UUID) error {
 	// Get entry
@@ -197,7 +422,23 @@ func (s *JournalEntryService) VoidEntry(ctx context.Context, entryID uuid.UUID)
 		return fmt.Errorf("entry cannot be voided (status: %s)", entry.Status)
 	}
 
+	// Closing entries are undone by reopening the fiscal year
+	if entry.JournalType == domain.JournalTypeClosing {
+		return fmt.Errorf("closing entries cannot be voided; reopen the fiscal year instead")
+	}
+
+	// Opening balances are only undone through the opening balances wizard
+	if entry.JournalType == domain.JournalTypeOpening {
+		return domain.NewGLError("opening balance entries cannot be voided; reverse the opening balances instead", domain.ErrOpeningEntryProtected)
+	}
+
+	// Voiding changes the ledger for the original date
+	if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, entry.TransactionDate); err != nil {
+		return err
+	}
+
 	// Void the entry (domain logic)
+	before := *entry
 	if err := entry.Void(); err != nil {
 		return fmt.Errorf("failed to void entry: %w", err)
 	}
@@ -207,10 +448,13 @@ func (s *JournalEntryService) VoidEntry(ctx context.Context, entryID uuid.UUID)
 		return fmt.Errorf("failed to save voided entry: %w", err)
 	}
 
+	s.recordChange(ctx, "VOID", &before, entry)
 	return nil
 }
 
-// ReverseEntry creates a reversal entry for a posted entry
+// ReverseEntry creates a draft reversal of a posted entry. The original stays
+// POSTED until the reversal is posted, directly or on approval, and is marked
+// REVERSED in the same transaction.
 func (s *JournalEntryService) ReverseEntry(ctx context.Context, entryID uuid.UUID, reversedBy uuid.UUID) (*domain.JournalEntry, error) {
 	// Get original entry
 	originalEntry, err := s.repo.GetByID(ctx, entryID)
@@ -223,15 +467,24 @@ func (s *JournalEntryService) ReverseEntry(ctx context.Context, entryID uuid.UUI
 		return nil, fmt.Errorf("entry cannot be reversed (status: %s)", originalEntry.Status)
 	}
 
-	// Generate new entry number for reversal
-	reversalDate := time.Now().Format("20060102")
-	newEntryNumber, err := s.GenerateEntryNumber(ctx, originalEntry.OrganizationID, reversalDate)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate reversal entry number: %w", err)
+	// Closing entries are undone by reopening the fiscal year
+	if originalEntry.JournalType == domain.JournalTypeClosing {
+		return nil, fmt.Errorf("closing entries cannot be reversed; reopen the fiscal year instead")
 	}
 
-	// Create reversal entry (domain logic)
-	reversalEntry, err := originalEntry.CreateReversal(reversedBy, newEntryNumber)
+	// Opening balances are only undone through the opening balances wizard
+	if originalEntry.JournalType == domain.JournalTypeOpening {
+		return nil, domain.NewGLError("opening balance entries cannot be reversed; reverse the opening balances instead", domain.ErrOpeningEntryProtected)
+	}
+
+	// The reversal is dated today, so today's period must be open
+	now := time.Now()
+	if err := ensurePeriodOpen(ctx, s.periodRepo, originalEntry.OrganizationID, now); err != nil {
+		return nil, err
+	}
+
+	// Create reversal entry (domain logic); it is numbered when posted
+	reversalEntry, err := originalEntry.CreateReversal(reversedBy, domain.NewDraftEntryNumber(), now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create reversal: %w", err)
 	}
@@ -241,16 +494,105 @@ func (s *JournalEntryService) ReverseEntry(ctx context.Context, entryID uuid.UUI
 		return nil, fmt.Errorf("failed to save reversal entry: %w", err)
 	}
 
-	// Mark original entry as REVERSED
-	originalEntry.Status = domain.EntryStatusReversed
-	originalEntry.ReversedBy = &reversedBy
-	originalEntry.UpdatedAt = time.Now()
+	s.recordChange(ctx, audit.ActionCreate, nil, reversalEntry)
 
-	if err := s.repo.Update(ctx, originalEntry); err != nil {
-		return nil, fmt.Errorf("failed to update original entry: %w", err)
+	return reversalEntry, nil
+}
+
+// reversedEntry returns the posted entry a reversal reverses, or nil if the
+// entry is not a reversal
+func (s *JournalEntryService) reversedEntry(ctx context.Context, entry *domain.JournalEntry) (*domain.JournalEntry, error) {
+	if !entry.IsReversal() {
+		return nil, nil
 	}
 
-	return reversalEntry, nil
+	original, err := s.repo.GetByID(ctx, *entry.ReversalOf)
+	if err != nil {
+		return nil, fmt.Errorf("reversed entry not found: %w", err)
+	}
+	if !original.CanReverse() {
+		return nil, domain.NewGLErrorf(domain.ErrJournalCannotReverse,
+			"entry %s cannot be reversed (status: %s)", original.EntryNumber, original.Status)
+	}
+
+	return original, nil
+}
+
+// recordReversed audits the original of a reversal that has just posted,
+// which the repository marked REVERSED in the same transaction
+func (s *JournalEntryService) recordReversed(ctx context.Context, original, reversal *domain.JournalEntry) {
+	if original == nil {
+		return
+	}
+
+	after := *original
+	after.Status = domain.EntryStatusReversed
+	after.ReversedBy = &reversal.CreatedBy
+	s.recordChange(ctx, "REVERSE", original, &after)
+}
+
+// ProcessAutoReversals reverses every posted entry whose auto-reverse date is on
+// or before asOf. Each reversal is dated on the entry's auto-reverse date and
+// posted immediately. A failing entry does not stop the others; their errors are
+// returned together and the entry is retried on the next run.
+func (s *JournalEntryService) ProcessAutoReversals(ctx context.Context, asOf time.Time) ([]*domain.JournalEntry, error) {
+	entries, err := s.repo.ListDueAutoReversals(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var reversals []*domain.JournalEntry
+	var errs []error
+	for _, entry := range entries {
+		reversal, err := s.autoReverse(ctx, entry, asOf)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %s: %w", entry.EntryNumber, err))
+			continue
+		}
+		reversals = append(reversals, reversal)
+	}
+
+	return reversals, errors.Join(errs...)
+}
+
+// autoReverse posts the reversal of an accrual dated on its auto-reverse date
+func (s *JournalEntryService) autoReverse(ctx context.Context, entry *domain.JournalEntry, asOf time.Time) (*domain.JournalEntry, error) {
+	if !entry.IsAutoReversalDue(asOf) {
+		return nil, fmt.Errorf("entry is not due for auto-reversal (status: %s)", entry.Status)
+	}
+
+	reversalDate := *entry.AutoReverseDate
+	if err := ensurePeriodOpen(ctx, s.periodRepo, entry.OrganizationID, reversalDate); err != nil {
+		return nil, err
+	}
+
+	// Reversed on behalf of whoever posted the accrual
+	reversedBy := entry.CreatedBy
+	if entry.PostedBy != nil {
+		reversedBy = *entry.PostedBy
+	}
+
+	reversal, err := entry.CreateReversal(reversedBy, domain.NewDraftEntryNumber(), reversalDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reversal: %w", err)
+	}
+	if err := reversal.Post(reversedBy); err != nil {
+		return nil, fmt.Errorf("failed to post reversal: %w", err)
+	}
+
+	before := *entry
+	entry.Status = domain.EntryStatusReversed
+	entry.ReversedBy = &reversedBy
+	entry.UpdatedAt = time.Now()
+
+	if err := s.repo.SaveReversal(ctx, entry, reversal); err != nil {
+		return nil, fmt.Errorf("failed to save reversal: %w", err)
+	}
+
+	s.recordChange(ctx, audit.ActionCreate, nil, reversal)
+	s.recordChange(ctx, "AUTO_REVERSE", &before, entry)
+
+	return reversal, nil
 }
 
 // DeleteEntry soft deletes a draft entry
@@ -271,6 +613,7 @@ func (s *JournalEntryService) DeleteEntry(ctx context.Context, entryID uuid.UUID
 		return fmt.Errorf("failed to delete entry: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionDelete, entry, nil)
 	return nil
 }
 
@@ -297,15 +640,12 @@ func (s *JournalEntryService) ValidateEntry(ctx context.Context, entryID uuid.UU
 	// Perform validation
 	validationResult := domain.ValidateForPosting(entry, accounts)
 
-	return validationResult, nil
-}
-
-// GenerateEntryNumber generates the next entry number
-func (s *JournalEntryService) GenerateEntryNumber(ctx context.Context, orgID uuid.UUID, date string) (string, error) {
-	sequence, err := s.repo.GetNextEntryNumber(ctx, orgID, date)
+	// Analytic tags must use the organization's active dimensions and values
+	dimensions, err := s.dimRepo.ListDimensions(ctx, entry.OrganizationID)
 	if err != nil {
-		return "", fmt.Errorf("failed to get next sequence: %w", err)
+		return nil, fmt.Errorf("failed to list dimensions: %w", err)
 	}
+	domain.ValidateDimensions(entry, accounts, dimensions, validationResult)
 
-	return domain.GenerateEntryNumber(time.Now(), sequence), nil
+	return validationResult, nil
 }
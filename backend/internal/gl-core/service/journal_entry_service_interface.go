@@ -3,6 +3,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/google/uuid"
@@ -13,6 +14,9 @@ type JournalEntryServiceInterface interface {
 	// CreateEntry creates a new journal entry in DRAFT status
 	CreateEntry(ctx context.Context, entry *domain.JournalEntry) (*domain.JournalEntry, error)
 
+	// PrepareEntry defaults, validates and totals a new DRAFT entry without saving it
+	PrepareEntry(ctx context.Context, entry *domain.JournalEntry) error
+
 	// UpdateEntry updates an existing draft entry
 	UpdateEntry(ctx context.Context, entry *domain.JournalEntry) (*domain.JournalEntry, error)
 
@@ -28,18 +32,30 @@ type JournalEntryServiceInterface interface {
 	// PostEntry posts a draft entry to the ledger
 	PostEntry(ctx context.Context, entryID uuid.UUID, postedBy uuid.UUID) error
 
+	// SubmitEntry sends a draft entry for approval
+	SubmitEntry(ctx context.Context, entryID, submittedBy uuid.UUID, comment string) (*domain.JournalEntry, error)
+
+	// ApproveEntry posts a pending entry, enforcing segregation of duties
+	ApproveEntry(ctx context.Context, entryID, approvedBy uuid.UUID, comment string) (*domain.JournalEntry, error)
+
+	// RejectEntry returns a pending entry to DRAFT with a comment
+	RejectEntry(ctx context.Context, entryID, rejectedBy uuid.UUID, comment string) (*domain.JournalEntry, error)
+
+	// ListApprovals lists an entry's submit, approve and reject history
+	ListApprovals(ctx context.Context, entryID uuid.UUID) ([]*domain.JournalEntryApproval, error)
+
 	// VoidEntry voids a posted entry
 	VoidEntry(ctx context.Context, entryID uuid.UUID) error
 
-	// ReverseEntry creates a reversal entry for a posted entry
+	// ReverseEntry creates a draft reversal of a posted entry; the original is marked REVERSED when the reversal posts
 	ReverseEntry(ctx context.Context, entryID uuid.UUID, reversedBy uuid.UUID) (*domain.JournalEntry, error)
 
+	// ProcessAutoReversals posts the reversal of every entry whose auto-reverse date has arrived
+	ProcessAutoReversals(ctx context.Context, asOf time.Time) ([]*domain.JournalEntry, error)
+
 	// DeleteEntry soft deletes a draft entry
 	DeleteEntry(ctx context.Context, entryID uuid.UUID) error
 
 	// ValidateEntry validates an entry before posting
 	ValidateEntry(ctx context.Context, entryID uuid.UUID) (*domain.PostingValidationResult, error)
-
-	// GenerateEntryNumber generates the next entry number
-	GenerateEntryNumber(ctx context.Context, orgID uuid.UUID, date string) (string, error)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -71,7 +72,7 @@ func (s *JournalLineService) GetLinesByReference(ctx context.Context, orgID uuid
 }
 
 // GetAccountBalance calculates running balance for an account
-func (s *JournalLineService) GetAccountBalance(ctx context.Context, accountID uuid.UUID) (float64, error) {
+func (s *JournalLineService) GetAccountBalance(ctx context.Context, accountID uuid.UUID) (money.Amount, error) {
 	// Get account to determine type
 	account, err := s.accountRepo.GetGLAccountByID(ctx, accountID, false)
 	if err != nil {
@@ -85,18 +86,10 @@ func (s *JournalLineService) GetAccountBalance(ctx context.Context, accountID uu
 	}
 
 	// Calculate balance based on account type
-	balance := 0.0
+	var balance money.Amount
 
 	for _, line := range lines {
-		switch account.Type {
-		case domain.AccountTypeAsset, domain.AccountTypeExpense:
-			// Debit increases, credit decreases
-			balance += line.Debit - line.Credit
-
-		case domain.AccountTypeLiability, domain.AccountTypeEquity, domain.AccountTypeRevenue:
-			// Credit increases, debit decreases
-			balance += line.Credit - line.Debit
-		}
+		balance += domain.NaturalBalance(account.Type, line.Debit, line.Credit)
 	}
 
 	return balance, nil
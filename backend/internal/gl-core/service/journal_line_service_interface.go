@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -20,5 +21,5 @@ type JournalLineServiceInterface interface {
 	GetLinesByReference(ctx context.Context, orgID uuid.UUID, reference string) ([]domain.JournalLine, error)
 
 	// GetAccountBalance calculates running balance for an account
-	GetAccountBalance(ctx context.Context, accountID uuid.UUID) (float64, error)
+	GetAccountBalance(ctx context.Context, accountID uuid.UUID) (money.Amount, error)
 }
@@ -0,0 +1,64 @@
+// backend/internal/gl-core/service/journal_scheduler.go
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// JournalScheduler periodically generates due recurring journal entries and
+// posts the reversals of accruals whose auto-reverse date has arrived
+type JournalScheduler struct {
+	recurring RecurringJournalServiceInterface
+	entries   JournalEntryServiceInterface
+	interval  time.Duration
+}
+
+// NewJournalScheduler creates a scheduler that checks for due work every interval
+func NewJournalScheduler(recurring RecurringJournalServiceInterface, entries JournalEntryServiceInterface, interval time.Duration) *JournalScheduler {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &JournalScheduler{recurring: recurring, entries: entries, interval: interval}
+}
+
+// Start runs the scheduler in the background until ctx is cancelled. It runs
+// once immediately so work missed while the service was down is caught up.
+func (s *JournalScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.RunOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunOnce generates recurring entries due today, then reverses accruals due today
+func (s *JournalScheduler) RunOnce(ctx context.Context) {
+	now := time.Now()
+
+	runs, err := s.recurring.GenerateDue(ctx, now)
+	if len(runs) > 0 {
+		log.Printf("Recurring journals: processed %d occurrence(s)", len(runs))
+	}
+	if err != nil {
+		log.Printf("⚠️  Recurring journals: %v", err)
+	}
+
+	reversals, err := s.entries.ProcessAutoReversals(ctx, now)
+	if len(reversals) > 0 {
+		log.Printf("Auto-reversals: posted %d reversal(s)", len(reversals))
+	}
+	if err != nil {
+		log.Printf("⚠️  Auto-reversals: %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+// backend/internal/gl-core/service/journal_sequence_service.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+type JournalSequenceService struct {
+	repo repository.JournalSequenceRepositoryInterface
+}
+
+// NewJournalSequenceService creates a new journal sequence service
+func NewJournalSequenceService(repo repository.JournalSequenceRepositoryInterface) *JournalSequenceService {
+	return &JournalSequenceService{repo: repo}
+}
+
+// ListSequences lists the numbering for every journal type. Types the
+// organization has not configured are returned with the built-in defaults.
+func (s *JournalSequenceService) ListSequences(ctx context.Context, orgID uuid.UUID) ([]*domain.JournalSequence, error) {
+	configured, err := s.repo.ListSequences(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[domain.JournalType]*domain.JournalSequence, len(configured))
+	for _, seq := range configured {
+		byType[seq.JournalType] = seq
+	}
+
+	sequences := make([]*domain.JournalSequence, 0, len(domain.JournalTypes))
+	for _, t := range domain.JournalTypes {
+		seq, ok := byType[t]
+		if !ok {
+			seq = domain.DefaultJournalSequence(orgID, t)
+		}
+		sequences = append(sequences, seq)
+	}
+
+	return sequences, nil
+}
+
+// SaveSequence configures the numbering for a journal type. Changing the reset
+// policy starts new counters; numbers already posted are not renumbered.
+func (s *JournalSequenceService) SaveSequence(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType, prefix string, policy domain.SequenceResetPolicy, padding int) (*domain.JournalSequence, error) {
+	seq, err := domain.NewJournalSequence(orgID, journalType, prefix, policy, padding)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SaveSequence(ctx, seq); err != nil {
+		return nil, err
+	}
+
+	return seq, nil
+}
+
+// PreviewNextNumber returns the number the next posted entry of a type dated on
+// date would take. Nothing is reserved, so a concurrent posting may take it first.
+func (s *JournalSequenceService) PreviewNextNumber(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType, date time.Time) (string, error) {
+	if !journalType.IsValid() {
+		return "", domain.NewGLErrorf(domain.ErrJournalTypeInvalid, "invalid journal type: %s", journalType)
+	}
+
+	seq, err := s.repo.GetSequence(ctx, orgID, journalType)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := s.repo.PeekNextNumber(ctx, orgID, journalType, seq.PeriodKey(date))
+	if err != nil {
+		return "", err
+	}
+
+	return seq.Format(date, next), nil
+}
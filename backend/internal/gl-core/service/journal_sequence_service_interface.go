@@ -0,0 +1,22 @@
+// backend/internal/gl-core/service/journal_sequence_service_interface.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// JournalSequenceServiceInterface defines business logic for journal numbering sequences
+type JournalSequenceServiceInterface interface {
+	// ListSequences lists the numbering for every journal type, configured or default
+	ListSequences(ctx context.Context, orgID uuid.UUID) ([]*domain.JournalSequence, error)
+
+	// SaveSequence configures the prefix, reset policy and padding for a journal type
+	SaveSequence(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType, prefix string, policy domain.SequenceResetPolicy, padding int) (*domain.JournalSequence, error)
+
+	// PreviewNextNumber returns the number the next posted entry of a type dated on date would take
+	PreviewNextNumber(ctx context.Context, orgID uuid.UUID, journalType domain.JournalType, date time.Time) (string, error)
+}
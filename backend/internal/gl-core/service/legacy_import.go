@@ -0,0 +1,393 @@
+// backend/internal/gl-core/service/legacy_import.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+)
+
+// Upload formats, chosen by file extension
+const (
+	importFormatExcel         = "excel"
+	importFormatTallyXML      = "tally_xml"
+	importFormatQuickBooksIIF = "quickbooks_iif"
+	importFormatQuickBooksCSV = "quickbooks_csv"
+)
+
+// maxAccountCodeLength is the longest code the import accepts, prefix included
+const maxAccountCodeLength = 20
+
+// Native exports are converted to the columns of the Excel templates so they
+// go through the same row validation
+var (
+	accountSheetHeader = []string{"Account Code", "Account Name", "Account Type", "Parent Code", "Is Active"}
+	journalSheetHeader = []string{"Entry Date", "Reference No", "Description", "Account Code", "Debit Amount", "Credit Amount"}
+)
+
+// journalAccountColumn is where journalSheetHeader holds the account
+const journalAccountColumn = 3
+
+var nonCodeChars = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// importSheet streams an upload's rows, header first. Workbooks are read
+// row by row rather than loaded whole; native exports are converted up front.
+type importSheet struct {
+	rows     [][]string      // Converted rows of a native export
+	lines    []int           // Source line of each converted row
+	system   string          // Legacy system of a native export; empty for workbooks
+	warnings []ImportWarning // Records skipped while reading
+
+	file   *excelize.File // Streamed workbook
+	stream *excelize.Rows
+	total  int // Rows in the upload, header included; estimated for workbooks
+
+	pos     int // Rows read so far
+	row     []string
+	readErr error
+}
+
+// next advances to the next row
+func (sh *importSheet) next() bool {
+	if sh.stream == nil {
+		if sh.pos >= len(sh.rows) {
+			return false
+		}
+		sh.row = sh.rows[sh.pos]
+		sh.pos++
+		return true
+	}
+
+	if !sh.stream.Next() {
+		sh.readErr = sh.stream.Error()
+		return false
+	}
+	sh.row, sh.readErr = sh.stream.Columns()
+	if sh.readErr != nil {
+		return false
+	}
+	sh.pos++
+	return true
+}
+
+// current returns the row next moved to
+func (sh *importSheet) current() []string {
+	return sh.row
+}
+
+// rowNumber returns the row or source line to report for the current row
+func (sh *importSheet) rowNumber() int {
+	if sh.stream == nil && sh.pos-1 < len(sh.lines) {
+		return sh.lines[sh.pos-1]
+	}
+	return sh.pos
+}
+
+// dataRows returns how many rows follow the header, or 0 when unknown
+func (sh *importSheet) dataRows() int {
+	if sh.total <= 1 {
+		return 0
+	}
+	return sh.total - 1
+}
+
+// err returns the error that stopped next, if any
+func (sh *importSheet) err() error {
+	if sh.readErr != nil {
+		return fmt.Errorf("failed to read rows: %w", sh.readErr)
+	}
+	return nil
+}
+
+// close releases a streamed workbook
+func (sh *importSheet) close() {
+	if sh.stream != nil {
+		sh.stream.Close()
+	}
+	if sh.file != nil {
+		sh.file.Close()
+	}
+}
+
+// add appends a converted row read from a source line
+func (sh *importSheet) add(line int, row ...string) {
+	sh.rows = append(sh.rows, row)
+	sh.lines = append(sh.lines, line)
+	sh.total = len(sh.rows)
+}
+
+// warn records a source record that was left out
+func (sh *importSheet) warn(line int, format string, args ...interface{}) {
+	sh.warnings = append(sh.warnings, ImportWarning{Row: line, Message: fmt.Sprintf(format, args...)})
+}
+
+// importFileFormat picks the upload format from the file name
+func importFileFormat(fileName string) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".xml":
+		return importFormatTallyXML
+	case ".iif":
+		return importFormatQuickBooksIIF
+	case ".csv":
+		return importFormatQuickBooksCSV
+	default:
+		return importFormatExcel
+	}
+}
+
+// readWorkbookSheet opens the first sheet of an Excel workbook for
+// streaming. The row count comes from the sheet's dimension, which is only
+// an estimate of progress.
+func readWorkbookSheet(filePath string) (*importSheet, error) {
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	sheetName := f.GetSheetName(0)
+	stream, err := f.Rows(sheetName)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	sheet := &importSheet{file: f, stream: stream}
+	if dimension, err := f.GetSheetDimension(sheetName); err == nil {
+		if i := strings.LastIndex(dimension, ":"); i >= 0 {
+			if _, lastRow, err := excelize.CellNameToCoordinates(dimension[i+1:]); err == nil {
+				sheet.total = lastRow
+			}
+		}
+	}
+
+	return sheet, nil
+}
+
+// readAccountSheet reads a chart of accounts upload in any supported format
+func (s *ImportService) readAccountSheet(filePath, fileName string, options ImportOptions) (*importSheet, error) {
+	format := importFileFormat(fileName)
+	if format == importFormatExcel {
+		return readWorkbookSheet(filePath)
+	}
+
+	rules, err := newLegacyTypeRules(options.TypeMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case importFormatTallyXML:
+		export, err := readTallyXMLFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return tallyAccountSheet(export, rules), nil
+	case importFormatQuickBooksIIF:
+		records, err := readIIFFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return iifAccountSheet(records, rules), nil
+	default:
+		records, err := readCSVFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return quickBooksCSVAccountSheet(records, rules)
+	}
+}
+
+// readJournalSheet reads a journal entry upload in any supported format.
+// Native exports name their accounts, so names are resolved to the codes of
+// the organization's chart.
+func (s *ImportService) readJournalSheet(ctx context.Context, filePath, fileName string, orgID uuid.UUID) (*importSheet, error) {
+	var sheet *importSheet
+	switch importFileFormat(fileName) {
+	case importFormatTallyXML:
+		export, err := readTallyXMLFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		sheet = tallyJournalSheet(export)
+	case importFormatQuickBooksIIF:
+		records, err := readIIFFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		sheet = iifJournalSheet(records)
+	case importFormatQuickBooksCSV:
+		records, err := readCSVFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if sheet, err = quickBooksCSVJournalSheet(records); err != nil {
+			return nil, err
+		}
+	default:
+		return readWorkbookSheet(filePath)
+	}
+
+	if err := s.resolveAccountNames(ctx, orgID, sheet); err != nil {
+		return nil, err
+	}
+	return sheet, nil
+}
+
+// resolveAccountNames replaces account names in a converted journal sheet
+// with the codes of the organization's accounts. Names that match no account,
+// or several, are left for the row checks to report.
+func (s *ImportService) resolveAccountNames(ctx context.Context, orgID uuid.UUID, sheet *importSheet) error {
+	accounts, err := s.accountRepo.ListGLAccounts(ctx, orgID, false)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	codes := make(map[string]string, len(accounts))
+	ambiguous := make(map[string]bool)
+	for _, account := range accounts {
+		key := normalizeLegacyName(account.Name)
+		if _, exists := codes[key]; exists {
+			ambiguous[key] = true
+		}
+		codes[key] = account.Code
+	}
+
+	warned := make(map[string]bool)
+	for i := 1; i < len(sheet.rows); i++ {
+		name := sheet.rows[i][journalAccountColumn]
+		key := normalizeLegacyName(name)
+		if ambiguous[key] {
+			if !warned[key] {
+				sheet.warn(sheet.lines[i], "Account name '%s' matches more than one account", name)
+				warned[key] = true
+			}
+			continue
+		}
+		if code, ok := codes[key]; ok {
+			sheet.rows[i][journalAccountColumn] = code
+		}
+	}
+
+	return nil
+}
+
+// legacyAccountCode derives an account code for a legacy account from its
+// number or, when it has none, its name. The code carries the type prefix
+// CreateGLAccount would add, so re-imports find the account by code. Codes
+// that have to be cut short end in a hash of the name to keep them distinct.
+func legacyAccountCode(accountType domain.AccountType, number, name string) string {
+	prefix := domain.TypeToPrefix[accountType]
+
+	base := number
+	if base == "" {
+		base = name
+	}
+	code := strings.Trim(nonCodeChars.ReplaceAllString(strings.ToUpper(base), "-"), "-")
+	code = strings.TrimPrefix(code, strings.TrimSuffix(prefix, "-")+"-")
+
+	room := maxAccountCodeLength - len(prefix)
+	if code == "" || len(code) > room {
+		h := fnv.New32a()
+		h.Write([]byte(name))
+		hash := fmt.Sprintf("%04X", h.Sum32()&0xFFFF)
+		if code == "" {
+			code = hash
+		} else {
+			code = strings.TrimRight(code[:room-len(hash)-1], "-") + "-" + hash
+		}
+	}
+
+	return prefix + code
+}
+
+// normalizeLegacyName lower-cases a name and collapses its whitespace so
+// group, type and account names match however they were keyed
+func normalizeLegacyName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// cleanLegacyAmount reduces an exported amount to a plain decimal: currency
+// symbols and thousands separators are dropped and (1.00) reads as -1.00
+func cleanLegacyAmount(value string) string {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")")
+
+	var b strings.Builder
+	for _, r := range value {
+		if (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			b.WriteRune(r)
+		}
+	}
+
+	cleaned := b.String()
+	if negative && !strings.HasPrefix(cleaned, "-") {
+		cleaned = "-" + cleaned
+	}
+	return cleaned
+}
+
+// splitSignedAmount puts a signed amount in the debit or credit column.
+// debitPositive says which sign the export uses for debits.
+func splitSignedAmount(amount string, debitPositive bool) (debit, credit string) {
+	negative := strings.HasPrefix(amount, "-")
+	unsigned := strings.TrimPrefix(amount, "-")
+	if negative != debitPositive {
+		return unsigned, ""
+	}
+	return "", unsigned
+}
+
+// referenceSet hands out entry references that are unique within an upload.
+// Rows are grouped into entries by reference, so two legacy transactions that
+// share a number must not end up in one entry.
+type referenceSet map[string]int
+
+// unique returns ref, suffixed if an earlier transaction already used it
+func (refs referenceSet) unique(ref string) string {
+	refs[ref]++
+	if n := refs[ref]; n > 1 {
+		return fmt.Sprintf("%s/%d", ref, n)
+	}
+	return ref
+}
+
+// legacyTypeRules map legacy ledger groups and account types to account
+// types. Mappings from the import options take precedence over the built-in
+// rules.
+type legacyTypeRules map[string]domain.AccountType
+
+// newLegacyTypeRules builds the rules from the built-in Tally and QuickBooks
+// mappings plus the caller's own
+func newLegacyTypeRules(custom map[string]string) (legacyTypeRules, error) {
+	rules := make(legacyTypeRules, len(tallyGroupTypes)+len(quickBooksAccountTypes)+len(custom))
+	for name, t := range tallyGroupTypes {
+		rules[name] = t
+	}
+	for name, t := range quickBooksAccountTypes {
+		rules[name] = t
+	}
+
+	for name, value := range custom {
+		t := domain.AccountType(strings.ToUpper(strings.TrimSpace(value)))
+		if _, err := domain.PrefixForType(t); err != nil {
+			return nil, fmt.Errorf("invalid type mapping for '%s': %w", name, err)
+		}
+		rules[normalizeLegacyName(name)] = t
+	}
+
+	return rules, nil
+}
+
+// lookup finds the account type a legacy group or type maps to
+func (r legacyTypeRules) lookup(name string) (domain.AccountType, bool) {
+	t, ok := r[normalizeLegacyName(name)]
+	return t, ok
+}
@@ -0,0 +1,332 @@
+// backend/internal/gl-core/service/legacy_import_test.go
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+)
+
+// sheetRows returns a converted sheet's rows after the header
+func sheetRows(sh *importSheet) [][]string {
+	if len(sh.rows) <= 1 {
+		return nil
+	}
+	return sh.rows[1:]
+}
+
+func TestCleanLegacyAmount(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "1234.50", want: "1234.50"},
+		{input: " 1,234.50 ", want: "1234.50"},
+		{input: "$1,234.50", want: "1234.50"},
+		{input: "(250.00)", want: "-250.00"},
+		{input: "-250.00", want: "-250.00"},
+		{input: "₹ 10,00,000.00", want: "1000000.00"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := cleanLegacyAmount(tt.input); got != tt.want {
+				t.Errorf("cleanLegacyAmount(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitSignedAmount(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        string
+		debitPositive bool
+		wantDebit     string
+		wantCredit    string
+	}{
+		{name: "QuickBooks debit", amount: "500.00", debitPositive: true, wantDebit: "500.00"},
+		{name: "QuickBooks credit", amount: "-500.00", debitPositive: true, wantCredit: "500.00"},
+		{name: "Tally debit", amount: "-500.00", debitPositive: false, wantDebit: "500.00"},
+		{name: "Tally credit", amount: "500.00", debitPositive: false, wantCredit: "500.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			debit, credit := splitSignedAmount(tt.amount, tt.debitPositive)
+			if debit != tt.wantDebit || credit != tt.wantCredit {
+				t.Errorf("splitSignedAmount(%q, %v) = (%q, %q), want (%q, %q)",
+					tt.amount, tt.debitPositive, debit, credit, tt.wantDebit, tt.wantCredit)
+			}
+		})
+	}
+}
+
+// tallyEnvelope wraps masters and vouchers in a Tally export envelope
+func tallyEnvelope(messages ...string) []byte {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><ENVELOPE><BODY><IMPORTDATA><REQUESTDATA>`)
+	for _, m := range messages {
+		b.WriteString("<TALLYMESSAGE>" + m + "</TALLYMESSAGE>")
+	}
+	b.WriteString(`</REQUESTDATA></IMPORTDATA></BODY></ENVELOPE>`)
+	return []byte(b.String())
+}
+
+func TestTallyJournalSheet(t *testing.T) {
+	tests := []struct {
+		name         string
+		vouchers     []string
+		want         [][]string
+		wantWarnings int
+	}{
+		{
+			name: "debits are signed negative",
+			vouchers: []string{`<VOUCHER VCHTYPE="Payment"><DATE>20250115</DATE><VOUCHERTYPENAME>Payment</VOUCHERTYPENAME><VOUCHERNUMBER>1</VOUCHERNUMBER><NARRATION>January rent</NARRATION>
+				<ALLLEDGERENTRIES.LIST><LEDGERNAME>Rent</LEDGERNAME><AMOUNT>-5000.00</AMOUNT></ALLLEDGERENTRIES.LIST>
+				<ALLLEDGERENTRIES.LIST><LEDGERNAME>HDFC Bank</LEDGERNAME><AMOUNT>5000.00</AMOUNT></ALLLEDGERENTRIES.LIST></VOUCHER>`},
+			want: [][]string{
+				{"2025-01-15", "Payment 1", "January rent", "Rent", "5000.00", ""},
+				{"2025-01-15", "Payment 1", "January rent", "HDFC Bank", "", "5000.00"},
+			},
+		},
+		{
+			name: "foreign amounts use the converted value",
+			vouchers: []string{`<VOUCHER VCHTYPE="Journal"><DATE>20250131</DATE><VOUCHERTYPENAME>Journal</VOUCHERTYPENAME><VOUCHERNUMBER>7</VOUCHERNUMBER>
+				<LEDGERENTRIES.LIST><LEDGERNAME>Consulting</LEDGERNAME><AMOUNT>-100.00 USD @ 83.00/USD = -8300.00</AMOUNT></LEDGERENTRIES.LIST>
+				<LEDGERENTRIES.LIST><LEDGERNAME>Creditors</LEDGERNAME><AMOUNT>8,300.00</AMOUNT></LEDGERENTRIES.LIST></VOUCHER>`},
+			want: [][]string{
+				{"2025-01-31", "Journal 7", "Journal 7", "Consulting", "8300.00", ""},
+				{"2025-01-31", "Journal 7", "Journal 7", "Creditors", "", "8300.00"},
+			},
+		},
+		{
+			name: "inventory allocations and zero lines",
+			vouchers: []string{`<VOUCHER VCHTYPE="Sales"><DATE>20250201</DATE><VOUCHERTYPENAME>Sales</VOUCHERTYPENAME><VOUCHERNUMBER>S-1</VOUCHERNUMBER>
+				<ALLLEDGERENTRIES.LIST><LEDGERNAME>Acme Ltd</LEDGERNAME><AMOUNT>-1180.00</AMOUNT></ALLLEDGERENTRIES.LIST>
+				<ALLLEDGERENTRIES.LIST><LEDGERNAME>Round Off</LEDGERNAME><AMOUNT>0.00</AMOUNT></ALLLEDGERENTRIES.LIST>
+				<ALLLEDGERENTRIES.LIST><LEDGERNAME>Output VAT</LEDGERNAME><AMOUNT>180.00</AMOUNT></ALLLEDGERENTRIES.LIST>
+				<ALLINVENTORYENTRIES.LIST><ACCOUNTINGALLOCATIONS.LIST><LEDGERNAME>Sales</LEDGERNAME><AMOUNT>1000.00</AMOUNT></ACCOUNTINGALLOCATIONS.LIST></ALLINVENTORYENTRIES.LIST></VOUCHER>`},
+			want: [][]string{
+				{"2025-02-01", "Sales S-1", "Sales S-1", "Acme Ltd", "1180.00", ""},
+				{"2025-02-01", "Sales S-1", "Sales S-1", "Output VAT", "", "180.00"},
+				{"2025-02-01", "Sales S-1", "Sales S-1", "Sales", "", "1000.00"},
+			},
+		},
+		{
+			name: "repeated voucher numbers get distinct references",
+			vouchers: []string{
+				`<VOUCHER VCHTYPE="Receipt"><DATE>20250301</DATE><VOUCHERNUMBER>1</VOUCHERNUMBER>
+					<ALLLEDGERENTRIES.LIST><LEDGERNAME>Cash</LEDGERNAME><AMOUNT>-10.00</AMOUNT></ALLLEDGERENTRIES.LIST></VOUCHER>`,
+				`<VOUCHER VCHTYPE="Receipt"><DATE>20250302</DATE><VOUCHERNUMBER>1</VOUCHERNUMBER>
+					<ALLLEDGERENTRIES.LIST><LEDGERNAME>Cash</LEDGERNAME><AMOUNT>-20.00</AMOUNT></ALLLEDGERENTRIES.LIST></VOUCHER>`,
+			},
+			want: [][]string{
+				{"2025-03-01", "Receipt 1", "Receipt 1", "Cash", "10.00", ""},
+				{"2025-03-02", "Receipt 1/2", "Receipt 1", "Cash", "20.00", ""},
+			},
+		},
+		{
+			name: "cancelled and optional vouchers are skipped",
+			vouchers: []string{
+				`<VOUCHER VCHTYPE="Payment"><DATE>20250401</DATE><VOUCHERNUMBER>9</VOUCHERNUMBER><ISCANCELLED>Yes</ISCANCELLED>
+					<ALLLEDGERENTRIES.LIST><LEDGERNAME>Rent</LEDGERNAME><AMOUNT>-1.00</AMOUNT></ALLLEDGERENTRIES.LIST></VOUCHER>`,
+				`<VOUCHER VCHTYPE="Payment"><DATE>20250401</DATE><VOUCHERNUMBER>10</VOUCHERNUMBER><ISOPTIONAL>Yes</ISOPTIONAL>
+					<ALLLEDGERENTRIES.LIST><LEDGERNAME>Rent</LEDGERNAME><AMOUNT>-1.00</AMOUNT></ALLLEDGERENTRIES.LIST></VOUCHER>`,
+			},
+			wantWarnings: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := parseTallyXML(tallyEnvelope(tt.vouchers...))
+			if err != nil {
+				t.Fatalf("parseTallyXML() error = %v", err)
+			}
+
+			sheet := tallyJournalSheet(export)
+			if got := sheetRows(sheet); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %q, want %q", got, tt.want)
+			}
+			if len(sheet.warnings) != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", len(sheet.warnings), tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestTallyAccountSheet(t *testing.T) {
+	export, err := parseTallyXML(tallyEnvelope(
+		`<GROUP NAME="Office Expenses"><PARENT>Indirect Expenses</PARENT></GROUP>`,
+		`<GROUP NAME="Head Office"><PARENT>Office Expenses</PARENT></GROUP>`,
+		`<LEDGER NAME="Rent"><PARENT>Head Office</PARENT></LEDGER>`,
+		`<LEDGER><NAME.LIST><NAME>HDFC Bank</NAME></NAME.LIST><PARENT>Bank Accounts</PARENT></LEDGER>`,
+		`<LEDGER NAME="Profit &amp; Loss A/c"><PARENT>&#4; Primary</PARENT></LEDGER>`,
+		`<LEDGER NAME="Mystery"><PARENT>Unknown Group</PARENT></LEDGER>`,
+	))
+	if err != nil {
+		t.Fatalf("parseTallyXML() error = %v", err)
+	}
+	rules, err := newLegacyTypeRules(nil)
+	if err != nil {
+		t.Fatalf("newLegacyTypeRules() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		wantType string // The parent group when it does not map
+	}{
+		{name: "Rent", wantType: string(domain.AccountTypeExpense)},
+		{name: "HDFC Bank", wantType: string(domain.AccountTypeAsset)},
+		{name: "Profit & Loss A/c", wantType: string(domain.AccountTypeEquity)},
+		{name: "Mystery", wantType: "Unknown Group"},
+	}
+
+	rows := sheetRows(tallyAccountSheet(export, rules))
+	if len(rows) != len(tests) {
+		t.Fatalf("got %d account rows, want %d", len(rows), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := rows[i]
+			if row[1] != tt.name || row[2] != tt.wantType {
+				t.Errorf("row = %q, want name %q of type %q", row, tt.name, tt.wantType)
+			}
+			if prefix := domain.TypeToPrefix[domain.AccountType(tt.wantType)]; !strings.HasPrefix(row[0], prefix) {
+				t.Errorf("code %q does not start with %q", row[0], prefix)
+			}
+		})
+	}
+}
+
+// iifHeader names the TRNS and SPL columns of an IIF export
+const iifHeader = "!TRNS\tTRNSID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tDOCNUM\tMEMO\n" +
+	"!SPL\tSPLID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tDOCNUM\tMEMO\n" +
+	"!ENDTRNS\n"
+
+func TestIIFJournalSheet(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		want         [][]string
+		wantWarnings int
+	}{
+		{
+			name: "debits are signed positive",
+			body: "TRNS\t1\tGENERAL JOURNAL\t1/15/2025\tChecking\t\t-500.00\tJE1\tOffice supplies\n" +
+				"SPL\t2\tGENERAL JOURNAL\t1/15/2025\tOffice Supplies\t\t500.00\tJE1\t\n" +
+				"ENDTRNS\n",
+			want: [][]string{
+				{"2025-01-15", "JE1", "Office supplies", "Checking", "", "500.00"},
+				{"2025-01-15", "JE1", "Office supplies", "Office Supplies", "500.00", ""},
+			},
+		},
+		{
+			name: "reference falls back to type and ID",
+			body: "TRNS\t42\tDEPOSIT\t02/03/25\tChecking\tAcme\t1,000.00\t\t\n" +
+				"SPL\t43\tDEPOSIT\t02/03/25\tSales\t\t-1,000.00\t\tMarch sales\n" +
+				"ENDTRNS\n",
+			want: [][]string{
+				{"2025-02-03", "DEPOSIT 42", "Acme", "Checking", "1000.00", ""},
+				{"2025-02-03", "DEPOSIT 42", "March sales", "Sales", "", "1000.00"},
+			},
+		},
+		{
+			name: "non-posting transactions and stray splits are skipped",
+			body: "TRNS\t5\tESTIMATE\t1/20/2025\tAccounts Receivable\t\t300.00\tE1\t\n" +
+				"SPL\t6\tESTIMATE\t1/20/2025\tSales\t\t-300.00\tE1\t\n" +
+				"ENDTRNS\n" +
+				"SPL\t7\tGENERAL JOURNAL\t1/21/2025\tSales\t\t-1.00\t\t\n",
+			wantWarnings: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "export.iif")
+			if err := os.WriteFile(path, []byte(iifHeader+tt.body), 0o600); err != nil {
+				t.Fatalf("write IIF file: %v", err)
+			}
+			records, err := readIIFFile(path)
+			if err != nil {
+				t.Fatalf("readIIFFile() error = %v", err)
+			}
+
+			sheet := iifJournalSheet(records)
+			if got := sheetRows(sheet); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %q, want %q", got, tt.want)
+			}
+			if len(sheet.warnings) != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", len(sheet.warnings), tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestQuickBooksCSVJournalSheet(t *testing.T) {
+	tests := []struct {
+		name    string
+		records [][]string
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name: "debit and credit columns after report titles",
+			records: [][]string{
+				{"Acme Trading"},
+				{"Journal"},
+				{"Date", "Transaction Type", "Num", "Name", "Memo/Description", "Account", "Debit", "Credit"},
+				{"01/15/2025", "Journal Entry", "101", "", "Accrual", "Rent Expense", "1,200.00", ""},
+				{"", "", "", "", "", "Accrued Liabilities", "", "1,200.00"},
+				{"Total for 101", "", "", "", "", "", "1,200.00", "1,200.00"},
+				{"01/16/2025", "Journal Entry", "101", "", "Second", "Cash", "5.00", ""},
+			},
+			want: [][]string{
+				{"2025-01-15", "101", "Accrual", "Rent Expense", "1200.00", ""},
+				{"2025-01-15", "101", "Accrual", "Accrued Liabilities", "", "1200.00"},
+				{"2025-01-16", "101/2", "Second", "Cash", "5.00", ""},
+			},
+		},
+		{
+			name: "signed amount column",
+			records: [][]string{
+				{"Date", "Trans #", "Account", "Amount"},
+				{"2025-02-01", "7", "Bank", "(250.00)"},
+				{"", "7", "Utilities", "250.00"},
+			},
+			want: [][]string{
+				{"2025-02-01", "7", "7", "Bank", "", "250.00"},
+				{"2025-02-01", "7", "7", "Utilities", "250.00", ""},
+			},
+		},
+		{
+			name:    "no amount columns",
+			records: [][]string{{"Date", "Account"}, {"1/1/2025", "Cash"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sheet, err := quickBooksCSVJournalSheet(tt.records)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("quickBooksCSVJournalSheet() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("quickBooksCSVJournalSheet() error = %v", err)
+			}
+			if got := sheetRows(sheet); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
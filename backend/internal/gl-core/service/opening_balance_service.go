@@ -0,0 +1,216 @@
+// backend/internal/gl-core/service/opening_balance_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type OpeningBalanceService struct {
+	repo        repository.OpeningBalanceRepositoryInterface
+	entryRepo   repository.JournalEntryRepositoryInterface
+	accountRepo repository.GLAccountRepositoryInterface
+	periodRepo  repository.FiscalPeriodRepositoryInterface
+	recorder    audit.Recorder
+}
+
+// NewOpeningBalanceService creates a new opening balance service
+func NewOpeningBalanceService(
+	repo repository.OpeningBalanceRepositoryInterface,
+	entryRepo repository.JournalEntryRepositoryInterface,
+	accountRepo repository.GLAccountRepositoryInterface,
+	periodRepo repository.FiscalPeriodRepositoryInterface,
+	recorder audit.Recorder,
+) *OpeningBalanceService {
+	return &OpeningBalanceService{
+		repo:        repo,
+		entryRepo:   entryRepo,
+		accountRepo: accountRepo,
+		periodRepo:  periodRepo,
+		recorder:    recorder,
+	}
+}
+
+// Preview checks a batch and works out any suspense line without posting
+// anything, returning the warnings posting would give
+func (s *OpeningBalanceService) Preview(ctx context.Context, batch *domain.OpeningBalanceBatch, suspenseAccountID *uuid.UUID) (*domain.OpeningBalanceBatch, error) {
+	if err := s.prepare(ctx, batch, suspenseAccountID); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// Post brings a batch's balances into the ledger as a single OPENING entry
+// dated on the go-live date. Debits must equal credits unless a suspense
+// account is given to take the difference.
+func (s *OpeningBalanceService) Post(ctx context.Context, batch *domain.OpeningBalanceBatch, suspenseAccountID *uuid.UUID) (*domain.OpeningBalanceBatch, error) {
+	existing, err := s.repo.GetPosted(ctx, batch.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.NewGLErrorf(domain.ErrOpeningBalanceAlreadyPosted,
+			"opening balances as of %s have already been posted; reverse them first", existing.GoLiveDate.Format("2006-01-02"))
+	}
+
+	if err := s.prepare(ctx, batch, suspenseAccountID); err != nil {
+		return nil, err
+	}
+
+	if err := ensurePeriodOpen(ctx, s.periodRepo, batch.OrganizationID, batch.GoLiveDate); err != nil {
+		return nil, err
+	}
+
+	// The entry is posted, so it is numbered when saved
+	entry, err := batch.BuildEntry(domain.NewDraftEntryNumber())
+	if err != nil {
+		return nil, fmt.Errorf("failed to build opening entry: %w", err)
+	}
+
+	if err := s.repo.Create(ctx, batch, entry); err != nil {
+		return nil, fmt.Errorf("failed to save opening balances: %w", err)
+	}
+
+	audit.LogChange(ctx, s.recorder, entry.OrganizationID, audit.EntityJournalEntry, entry.ID, audit.ActionCreate, nil, entry)
+
+	return batch, nil
+}
+
+// Reverse undoes posted opening balances with a reversal dated on the go-live
+// date, so they can be entered again. Opening entries cannot be reversed
+// through the journal entry endpoints; this is the only way to undo them.
+func (s *OpeningBalanceService) Reverse(ctx context.Context, orgID, id, reversedBy uuid.UUID, reason string) (*domain.OpeningBalanceBatch, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, domain.NewGLError("a reason is required to reverse opening balances", domain.ErrOpeningBalanceInvalid)
+	}
+
+	batch, err := s.GetOpeningBalances(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Status != domain.OpeningBalancePosted {
+		return nil, domain.NewGLError("opening balances have already been reversed", domain.ErrOpeningBalanceAlreadyReversed)
+	}
+
+	if err := ensurePeriodOpen(ctx, s.periodRepo, orgID, batch.GoLiveDate); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.entryRepo.GetByID(ctx, batch.EntryID)
+	if err != nil {
+		return nil, fmt.Errorf("opening entry not found: %w", err)
+	}
+
+	reversal, err := entry.CreateReversal(reversedBy, domain.NewDraftEntryNumber(), batch.GoLiveDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reversal: %w", err)
+	}
+	if err := reversal.Post(reversedBy); err != nil {
+		return nil, fmt.Errorf("failed to post reversal: %w", err)
+	}
+
+	before := *entry
+	entry.Status = domain.EntryStatusReversed
+	entry.ReversedBy = &reversedBy
+	entry.UpdatedAt = time.Now()
+
+	if err := batch.Reverse(reversedBy, reason, reversal.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Reverse(ctx, batch, entry, reversal); err != nil {
+		return nil, fmt.Errorf("failed to reverse opening balances: %w", err)
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityJournalEntry, reversal.ID, audit.ActionCreate, nil, reversal)
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityJournalEntry, entry.ID, "REVERSE", &before, entry)
+
+	return batch, nil
+}
+
+// GetOpeningBalances retrieves one of an organization's batches with its lines
+func (s *OpeningBalanceService) GetOpeningBalances(ctx context.Context, orgID, id uuid.UUID) (*domain.OpeningBalanceBatch, error) {
+	batch, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if batch.OrganizationID != orgID {
+		return nil, domain.NewGLError("opening balances not found", domain.ErrOpeningBalanceNotFound)
+	}
+	return batch, nil
+}
+
+// ListOpeningBalances lists an organization's batches, most recent first
+func (s *OpeningBalanceService) ListOpeningBalances(ctx context.Context, orgID uuid.UUID) ([]*domain.OpeningBalanceBatch, error) {
+	return s.repo.List(ctx, orgID)
+}
+
+// prepare resolves and checks a batch's accounts, validates it and balances
+// it against the suspense account when debits and credits differ
+func (s *OpeningBalanceService) prepare(ctx context.Context, batch *domain.OpeningBalanceBatch, suspenseAccountID *uuid.UUID) error {
+	for i := range batch.Lines {
+		line := &batch.Lines[i]
+		account, err := s.postableAccount(ctx, batch.OrganizationID, line.AccountID, line.AccountCode)
+		if err != nil {
+			return domain.NewGLErrorf(domain.ErrOpeningBalanceInvalid, "line %d: %v", line.LineNumber, err)
+		}
+		line.AccountID = account.ID
+		line.AccountCode = account.Code
+		line.AccountName = account.Name
+	}
+
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	var suspenseCode string
+	if suspenseAccountID != nil && batch.Difference() != 0 {
+		account, err := s.postableAccount(ctx, batch.OrganizationID, *suspenseAccountID, "")
+		if err != nil {
+			return domain.NewGLErrorf(domain.ErrOpeningBalanceInvalid, "suspense account: %v", err)
+		}
+		suspenseCode = account.Code
+	}
+
+	return batch.Balance(suspenseAccountID, suspenseCode)
+}
+
+// postableAccount finds an organization's account by ID or, failing that,
+// by code, and checks balances can be posted to it
+func (s *OpeningBalanceService) postableAccount(ctx context.Context, orgID, id uuid.UUID, code string) (domain.GLAccount, error) {
+	var account domain.GLAccount
+	var err error
+	switch {
+	case id != uuid.Nil:
+		account, err = s.accountRepo.GetGLAccountByID(ctx, id, true)
+		if err != nil {
+			return account, fmt.Errorf("account %s not found", id)
+		}
+	case code != "":
+		account, err = s.accountRepo.GetGLAccountByCode(ctx, orgID, code, true)
+		if err != nil {
+			return account, fmt.Errorf("account %s not found", code)
+		}
+	default:
+		return account, fmt.Errorf("account ID or code is required")
+	}
+
+	if !account.BelongsTo(orgID) {
+		return account, fmt.Errorf("account %s belongs to another organization", account.Code)
+	}
+	if !account.IsActive {
+		return account, fmt.Errorf("account %s is inactive", account.Code)
+	}
+	if !account.IsPostable {
+		return account, fmt.Errorf("account %s is a control account", account.Code)
+	}
+
+	return account, nil
+}
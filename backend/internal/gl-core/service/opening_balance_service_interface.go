@@ -0,0 +1,27 @@
+// backend/internal/gl-core/service/opening_balance_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// OpeningBalanceServiceInterface defines business logic for go-live opening balances
+type OpeningBalanceServiceInterface interface {
+	// Preview checks a batch and works out any suspense line without posting anything
+	Preview(ctx context.Context, batch *domain.OpeningBalanceBatch, suspenseAccountID *uuid.UUID) (*domain.OpeningBalanceBatch, error)
+
+	// Post brings a batch's balances into the ledger as a single OPENING entry
+	Post(ctx context.Context, batch *domain.OpeningBalanceBatch, suspenseAccountID *uuid.UUID) (*domain.OpeningBalanceBatch, error)
+
+	// Reverse undoes posted opening balances so they can be entered again
+	Reverse(ctx context.Context, orgID, id, reversedBy uuid.UUID, reason string) (*domain.OpeningBalanceBatch, error)
+
+	// GetOpeningBalances retrieves one of an organization's batches with its lines
+	GetOpeningBalances(ctx context.Context, orgID, id uuid.UUID) (*domain.OpeningBalanceBatch, error)
+
+	// ListOpeningBalances lists an organization's batches, most recent first
+	ListOpeningBalances(ctx context.Context, orgID uuid.UUID) ([]*domain.OpeningBalanceBatch, error)
+}
@@ -0,0 +1,433 @@
+// backend/internal/gl-core/service/quickbooks_import.go
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+)
+
+// quickBooksNonPosting is the type of estimates, purchase orders and other
+// accounts that never carry ledger balances
+const quickBooksNonPosting = "nonposting"
+
+// quickBooksAccountTypes maps QuickBooks account types to account types:
+// the IIF codes of QuickBooks Desktop and the names QuickBooks Online exports
+var quickBooksAccountTypes = map[string]domain.AccountType{
+	"bank":                      domain.AccountTypeAsset,
+	"ar":                        domain.AccountTypeAsset,
+	"ocasset":                   domain.AccountTypeAsset,
+	"fixasset":                  domain.AccountTypeAsset,
+	"oasset":                    domain.AccountTypeAsset,
+	"accounts receivable":       domain.AccountTypeAsset,
+	"accounts receivable (a/r)": domain.AccountTypeAsset,
+	"other current assets":      domain.AccountTypeAsset,
+	"other current asset":       domain.AccountTypeAsset,
+	"fixed asset":               domain.AccountTypeAsset,
+	"other assets":              domain.AccountTypeAsset,
+	"other asset":               domain.AccountTypeAsset,
+	"ap":                        domain.AccountTypeLiability,
+	"ccard":                     domain.AccountTypeLiability,
+	"ocliab":                    domain.AccountTypeLiability,
+	"ltliab":                    domain.AccountTypeLiability,
+	"accounts payable":          domain.AccountTypeLiability,
+	"accounts payable (a/p)":    domain.AccountTypeLiability,
+	"credit card":               domain.AccountTypeLiability,
+	"other current liabilities": domain.AccountTypeLiability,
+	"other current liability":   domain.AccountTypeLiability,
+	"long term liabilities":     domain.AccountTypeLiability,
+	"long term liability":       domain.AccountTypeLiability,
+	"equity":                    domain.AccountTypeEquity,
+	"inc":                       domain.AccountTypeRevenue,
+	"exinc":                     domain.AccountTypeRevenue,
+	"income":                    domain.AccountTypeRevenue,
+	"other income":              domain.AccountTypeRevenue,
+	"cogs":                      domain.AccountTypeExpense,
+	"exp":                       domain.AccountTypeExpense,
+	"exexp":                     domain.AccountTypeExpense,
+	"cost of goods sold":        domain.AccountTypeExpense,
+	"expenses":                  domain.AccountTypeExpense,
+	"expense":                   domain.AccountTypeExpense,
+	"other expense":             domain.AccountTypeExpense,
+	"other expenses":            domain.AccountTypeExpense,
+}
+
+// quickBooksNonPostingTransactions are IIF transaction types that do not
+// touch the ledger
+var quickBooksNonPostingTransactions = map[string]bool{
+	"ESTIMATE":   true,
+	"PURCHORDER": true,
+	"SALESORDER": true,
+}
+
+// quickBooksDateLayouts are the date formats QuickBooks exports use
+var quickBooksDateLayouts = []string{"1/2/2006", "1/2/06", "2006-01-02"}
+
+// iifRecord is one data line of an IIF file, keyed by the column names of
+// the header line for its record type
+type iifRecord struct {
+	kind   string
+	fields map[string]string
+	line   int
+}
+
+// get returns a field of the record
+func (r iifRecord) get(name string) string {
+	return strings.TrimSpace(r.fields[name])
+}
+
+// readIIFFile reads a QuickBooks IIF file. Lines are tab separated; a line
+// starting with '!' names the columns of the record type that follows it.
+func readIIFFile(filePath string) ([]iifRecord, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	headers := make(map[string][]string)
+	var records []iifRecord
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		cells := strings.Split(line, "\t")
+		for i, cell := range cells {
+			cells[i] = strings.Trim(strings.TrimSpace(cell), `"`)
+		}
+		kind := strings.ToUpper(cells[0])
+
+		if strings.HasPrefix(kind, "!") {
+			columns := make([]string, len(cells))
+			for i, cell := range cells {
+				columns[i] = strings.ToUpper(cell)
+			}
+			headers[strings.TrimPrefix(kind, "!")] = columns
+			continue
+		}
+
+		columns, ok := headers[kind]
+		if !ok && kind != "ENDTRNS" {
+			return nil, fmt.Errorf("line %d: %s record has no !%s header line", lineNum, kind, kind)
+		}
+		record := iifRecord{kind: kind, fields: make(map[string]string), line: lineNum}
+		for i := 1; i < len(cells) && i < len(columns); i++ {
+			record.fields[columns[i]] = cells[i]
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read IIF file: %w", err)
+	}
+
+	return records, nil
+}
+
+// iifAccountSheet converts the ACCNT records of an IIF file to account rows
+func iifAccountSheet(records []iifRecord, rules legacyTypeRules) *importSheet {
+	sheet := &importSheet{system: "quickbooks"}
+	sheet.add(0, accountSheetHeader...)
+
+	for _, record := range records {
+		if record.kind != "ACCNT" {
+			continue
+		}
+		addQuickBooksAccount(sheet, rules, record.line, record.get("NAME"), record.get("ACCNTTYPE"), record.get("ACCNUM"), !strings.EqualFold(record.get("HIDDEN"), "Y"))
+	}
+
+	return sheet
+}
+
+// addQuickBooksAccount adds an account row, leaving out non-posting accounts.
+// An unmapped type is kept as the row's type so the row check reports it.
+func addQuickBooksAccount(sheet *importSheet, rules legacyTypeRules, line int, name, qbType, number string, active bool) {
+	if normalizeLegacyName(strings.ReplaceAll(qbType, "-", "")) == quickBooksNonPosting {
+		sheet.warn(line, "Account '%s' is non-posting, skipping", name)
+		return
+	}
+
+	accountType, ok := rules.lookup(qbType)
+	typeValue := string(accountType)
+	if !ok {
+		typeValue = qbType
+	}
+
+	// Subaccounts are named Parent:Child
+	parent := ""
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		parent = name[:i]
+	}
+
+	isActive := "Yes"
+	if !active {
+		isActive = "No"
+	}
+
+	sheet.add(line, legacyAccountCode(accountType, number, name), name, typeValue, parent, isActive)
+}
+
+// iifJournalSheet converts the TRNS/SPL transactions of an IIF file to
+// journal rows. QuickBooks signs debits positive.
+func iifJournalSheet(records []iifRecord) *importSheet {
+	sheet := &importSheet{system: "quickbooks"}
+	sheet.add(0, journalSheetHeader...)
+	refs := make(referenceSet)
+
+	var transaction []iifRecord
+	flush := func() {
+		if len(transaction) == 0 {
+			return
+		}
+		addIIFTransaction(sheet, refs, transaction)
+		transaction = nil
+	}
+
+	for _, record := range records {
+		switch record.kind {
+		case "TRNS":
+			flush()
+			transaction = []iifRecord{record}
+		case "SPL":
+			if len(transaction) == 0 {
+				sheet.warn(record.line, "Split line outside a transaction, skipping")
+				continue
+			}
+			transaction = append(transaction, record)
+		case "ENDTRNS":
+			flush()
+		}
+	}
+	flush()
+
+	return sheet
+}
+
+// addIIFTransaction adds one row per line of a TRNS record and its splits
+func addIIFTransaction(sheet *importSheet, refs referenceSet, transaction []iifRecord) {
+	head := transaction[0]
+	trnsType := strings.ToUpper(head.get("TRNSTYPE"))
+	if quickBooksNonPostingTransactions[trnsType] {
+		sheet.warn(head.line, "%s transaction does not post to the ledger, skipping", trnsType)
+		return
+	}
+
+	ref := head.get("DOCNUM")
+	if ref == "" {
+		ref = strings.TrimSpace(fmt.Sprintf("%s %s", head.get("TRNSTYPE"), head.get("TRNSID")))
+	}
+	if ref == "" {
+		ref = fmt.Sprintf("IIF %d", head.line)
+	}
+	ref = refs.unique(ref)
+
+	date := quickBooksDate(head.get("DATE"))
+	for _, record := range transaction {
+		description := firstNonEmpty(record.get("MEMO"), head.get("MEMO"), head.get("NAME"), ref)
+		debit, credit := splitSignedAmount(cleanLegacyAmount(record.get("AMOUNT")), true)
+		sheet.add(record.line, date, ref, description, record.get("ACCNT"), debit, credit)
+	}
+}
+
+// readCSVFile reads every record of a CSV file
+func readCSVFile(filePath string) ([][]string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(bufio.NewReader(file))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV file: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// csvColumns finds the header row of a QuickBooks report export, which may
+// follow title lines, and maps each wanted column to its index. A column is
+// found under any of its names.
+func csvColumns(records [][]string, required []string, names map[string][]string) (int, map[string]int, error) {
+	for rowIdx, record := range records {
+		index := make(map[string]int)
+		for i, cell := range record {
+			cell = normalizeLegacyName(strings.TrimPrefix(cell, "\ufeff"))
+			for column, aliases := range names {
+				for _, alias := range aliases {
+					if _, found := index[column]; !found && cell == alias {
+						index[column] = i
+					}
+				}
+			}
+		}
+
+		complete := true
+		for _, column := range required {
+			if _, ok := index[column]; !ok {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			return rowIdx, index, nil
+		}
+	}
+
+	return 0, nil, fmt.Errorf("no header row with columns: %s", strings.Join(required, ", "))
+}
+
+// csvCell returns a cell of a CSV record by column
+func csvCell(record []string, index map[string]int, column string) string {
+	if i, ok := index[column]; ok && i < len(record) {
+		return strings.TrimSpace(record[i])
+	}
+	return ""
+}
+
+// quickBooksCSVAccountSheet converts a QuickBooks account list export to
+// account rows
+func quickBooksCSVAccountSheet(records [][]string, rules legacyTypeRules) (*importSheet, error) {
+	headerIdx, index, err := csvColumns(records, []string{"account", "type"}, map[string][]string{
+		"account": {"account", "full name", "account name", "name"},
+		"type":    {"type", "account type"},
+		"number":  {"account #", "acct #", "number", "account number"},
+		"active":  {"active status", "status"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := &importSheet{system: "quickbooks"}
+	sheet.add(0, accountSheetHeader...)
+
+	for i := headerIdx + 1; i < len(records); i++ {
+		record := records[i]
+		name := csvCell(record, index, "account")
+		if name == "" {
+			continue
+		}
+		active := !strings.EqualFold(csvCell(record, index, "active"), "inactive")
+		addQuickBooksAccount(sheet, rules, i+1, name, csvCell(record, index, "type"), csvCell(record, index, "number"), active)
+	}
+
+	return sheet, nil
+}
+
+// quickBooksCSVJournalSheet converts a QuickBooks journal or transaction
+// detail export to journal rows. Only a transaction's first line carries its
+// date, type and number, so a line starts a new transaction when it has a
+// date (or a new transaction ID, when the export has one). Total and
+// subtotal lines have no account and are dropped.
+func quickBooksCSVJournalSheet(records [][]string) (*importSheet, error) {
+	headerIdx, index, err := csvColumns(records, []string{"date", "account"}, map[string][]string{
+		"date":    {"date", "transaction date"},
+		"id":      {"trans #", "transaction id", "trans no"},
+		"type":    {"transaction type", "type"},
+		"number":  {"num", "no.", "ref no.", "doc num"},
+		"name":    {"name"},
+		"memo":    {"memo/description", "memo", "description"},
+		"account": {"account", "account name", "account full name"},
+		"debit":   {"debit"},
+		"credit":  {"credit"},
+		"amount":  {"amount"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := index["debit"]; !ok {
+		if _, ok := index["amount"]; !ok {
+			return nil, fmt.Errorf("no Debit/Credit or Amount columns")
+		}
+	}
+
+	sheet := &importSheet{system: "quickbooks"}
+	sheet.add(0, journalSheetHeader...)
+	refs := make(referenceSet)
+
+	var date, id, ref, heading string
+	for i := headerIdx + 1; i < len(records); i++ {
+		record := records[i]
+		rowDate := csvCell(record, index, "date")
+		rowID := csvCell(record, index, "id")
+
+		if (rowDate != "" && !strings.HasPrefix(strings.ToLower(rowDate), "total")) || (rowID != "" && rowID != id) {
+			if rowDate != "" {
+				date = quickBooksDate(rowDate)
+			}
+			id = rowID
+			heading = firstNonEmpty(csvCell(record, index, "memo"), csvCell(record, index, "name"))
+			ref = csvCell(record, index, "number")
+			if ref == "" {
+				ref = strings.TrimSpace(fmt.Sprintf("%s %s", csvCell(record, index, "type"), id))
+			}
+			if ref == "" {
+				ref = fmt.Sprintf("CSV %d", i+1)
+			}
+			ref = refs.unique(ref)
+		}
+
+		account := csvCell(record, index, "account")
+		if account == "" || ref == "" {
+			continue
+		}
+
+		var debit, credit string
+		if _, ok := index["debit"]; ok {
+			debit = cleanLegacyAmount(csvCell(record, index, "debit"))
+			credit = cleanLegacyAmount(csvCell(record, index, "credit"))
+		} else {
+			debit, credit = splitSignedAmount(cleanLegacyAmount(csvCell(record, index, "amount")), true)
+		}
+		if debit == "" && credit == "" {
+			continue
+		}
+
+		description := firstNonEmpty(csvCell(record, index, "memo"), heading, ref)
+		sheet.add(i+1, date, ref, description, account, debit, credit)
+	}
+
+	return sheet, nil
+}
+
+// quickBooksDate converts a QuickBooks date to YYYY-MM-DD, or returns it
+// unchanged for the row check to report
+func quickBooksDate(value string) string {
+	for _, layout := range quickBooksDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed.Format("2006-01-02")
+		}
+	}
+	return value
+}
+
+// firstNonEmpty returns the first value that is not blank
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
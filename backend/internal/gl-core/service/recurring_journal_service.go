@@ -0,0 +1,333 @@
+// backend/internal/gl-core/service/recurring_journal_service.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type RecurringJournalService struct {
+	repo         repository.RecurringJournalRepositoryInterface
+	entryService JournalEntryServiceInterface
+	accountRepo  repository.GLAccountRepositoryInterface
+	recorder     audit.Recorder
+}
+
+// NewRecurringJournalService creates a new recurring journal service
+func NewRecurringJournalService(
+	repo repository.RecurringJournalRepositoryInterface,
+	entryService JournalEntryServiceInterface,
+	accountRepo repository.GLAccountRepositoryInterface,
+	recorder audit.Recorder,
+) *RecurringJournalService {
+	return &RecurringJournalService{
+		repo:         repo,
+		entryService: entryService,
+		accountRepo:  accountRepo,
+		recorder:     recorder,
+	}
+}
+
+// CreateTemplate validates and saves a new ACTIVE template and schedules its first occurrence
+func (s *RecurringJournalService) CreateTemplate(ctx context.Context, t *domain.RecurringJournalTemplate) (*domain.RecurringJournalTemplate, error) {
+	t.ID = uuid.New()
+	t.Status = domain.RecurringTemplateActive
+	t.LastRunDate = nil
+	t.CreatedAt = time.Now()
+	t.UpdatedAt = t.CreatedAt
+
+	if err := s.prepare(ctx, t); err != nil {
+		return nil, err
+	}
+	t.Schedule()
+
+	if err := s.repo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// UpdateTemplate replaces a template's header, schedule and lines. The schedule
+// restarts after the last generated occurrence; a paused template stays paused.
+func (s *RecurringJournalService) UpdateTemplate(ctx context.Context, t *domain.RecurringJournalTemplate) (*domain.RecurringJournalTemplate, error) {
+	existing, err := s.repo.GetByID(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Status == domain.RecurringTemplateCompleted {
+		return nil, domain.NewGLError("completed templates cannot be edited", domain.ErrRecurringTemplateInvalidState)
+	}
+
+	t.OrganizationID = existing.OrganizationID
+	t.Status = existing.Status
+	t.LastRunDate = existing.LastRunDate
+	t.CreatedBy = existing.CreatedBy
+	t.CreatedAt = existing.CreatedAt
+	t.UpdatedAt = time.Now()
+
+	if err := s.prepare(ctx, t); err != nil {
+		return nil, err
+	}
+
+	from := t.StartDate
+	if t.LastRunDate != nil && !t.LastRunDate.Before(from) {
+		from = t.LastRunDate.AddDate(0, 0, 1)
+	}
+	t.NextRunDate = t.NextOccurrence(from)
+	if t.NextRunDate == nil {
+		t.Status = domain.RecurringTemplateCompleted
+	}
+
+	if err := s.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// GetTemplate retrieves a template with its lines
+func (s *RecurringJournalService) GetTemplate(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListTemplates lists an organization's templates
+func (s *RecurringJournalService) ListTemplates(ctx context.Context, orgID uuid.UUID) ([]*domain.RecurringJournalTemplate, error) {
+	if orgID == uuid.Nil {
+		return nil, domain.NewGLError("organization ID is required", domain.ErrJournalOrgRequired)
+	}
+	return s.repo.List(ctx, orgID)
+}
+
+// PreviewOccurrences lists the next count occurrences, marking skipped ones
+func (s *RecurringJournalService) PreviewOccurrences(ctx context.Context, id uuid.UUID, count int) ([]domain.RecurringOccurrence, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.NextRunDate == nil {
+		return []domain.RecurringOccurrence{}, nil
+	}
+
+	dates, err := s.repo.ListSkipped(ctx, id, *t.NextRunDate)
+	if err != nil {
+		return nil, err
+	}
+	skipped := make(map[time.Time]bool, len(dates))
+	for _, date := range dates {
+		skipped[time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)] = true
+	}
+
+	return t.Upcoming(count, skipped), nil
+}
+
+// SkipOccurrence marks an upcoming occurrence so no entry is generated for it
+func (s *RecurringJournalService) SkipOccurrence(ctx context.Context, id uuid.UUID, occurrence time.Time, skippedBy uuid.UUID) (*domain.RecurringJournalRun, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.NextRunDate == nil {
+		return nil, domain.NewGLError("template has no upcoming occurrences", domain.ErrRecurringTemplateInvalidState)
+	}
+	if !t.IsOccurrence(occurrence) {
+		return nil, domain.NewGLErrorf(domain.ErrRecurringOccurrenceInvalid,
+			"%s is not a scheduled occurrence", occurrence.Format("2006-01-02"))
+	}
+	if occurrence.Before(*t.NextRunDate) {
+		return nil, domain.NewGLErrorf(domain.ErrRecurringOccurrenceInvalid,
+			"%s has already been processed", occurrence.Format("2006-01-02"))
+	}
+
+	existing, err := s.repo.GetRun(ctx, id, occurrence)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.NewGLErrorf(domain.ErrRecurringOccurrenceInvalid,
+			"%s is already %s", occurrence.Format("2006-01-02"), existing.Status)
+	}
+
+	run := domain.NewRecurringJournalRun(id, occurrence, nil, skippedBy)
+	if err := s.repo.CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// PauseTemplate stops a template generating entries
+func (s *RecurringJournalService) PauseTemplate(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Pause(); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateSchedule(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ResumeTemplate restarts a paused template from the next occurrence on or after today
+func (s *RecurringJournalService) ResumeTemplate(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Resume(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateSchedule(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListRuns lists a template's generated and skipped occurrences
+func (s *RecurringJournalService) ListRuns(ctx context.Context, id uuid.UUID) ([]*domain.RecurringJournalRun, error) {
+	return s.repo.ListRuns(ctx, id)
+}
+
+// GenerateTemplate generates a template's occurrences due on or before a date
+func (s *RecurringJournalService) GenerateTemplate(ctx context.Context, id uuid.UUID, asOf time.Time) ([]*domain.RecurringJournalRun, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != domain.RecurringTemplateActive {
+		return nil, domain.NewGLErrorf(domain.ErrRecurringTemplateInvalidState,
+			"only active templates can generate entries (status: %s)", t.Status)
+	}
+	return s.generate(ctx, t, asOf)
+}
+
+// GenerateDue generates every organization's occurrences due on or before a date.
+// A failing template does not stop the others; their errors are returned together.
+func (s *RecurringJournalService) GenerateDue(ctx context.Context, asOf time.Time) ([]*domain.RecurringJournalRun, error) {
+	templates, err := s.repo.ListDue(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*domain.RecurringJournalRun
+	var errs []error
+	for _, t := range templates {
+		generated, err := s.generate(ctx, t, asOf)
+		runs = append(runs, generated...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return runs, errors.Join(errs...)
+}
+
+// generate creates an entry for each due occurrence, posting it when the
+// template auto-posts. Each occurrence's run is claimed in the transaction
+// that saves its entry and advances the schedule, so a concurrent generator
+// cannot create the entry twice; an occurrence claimed elsewhere is stepped
+// over, as are skipped ones.
+func (s *RecurringJournalService) generate(ctx context.Context, t *domain.RecurringJournalTemplate, asOf time.Time) ([]*domain.RecurringJournalRun, error) {
+	var runs []*domain.RecurringJournalRun
+
+	for t.IsDue(asOf) {
+		occurrence := *t.NextRunDate
+
+		existing, err := s.repo.GetRun(ctx, t.ID, occurrence)
+		if err != nil {
+			return runs, err
+		}
+		if existing != nil {
+			t.Advance(occurrence)
+			if err := s.repo.UpdateSchedule(ctx, t); err != nil {
+				return runs, err
+			}
+			continue
+		}
+
+		entry := t.BuildEntry(occurrence, "")
+		if err := s.entryService.PrepareEntry(ctx, entry); err != nil {
+			return runs, fmt.Errorf("recurring journal %s: failed to generate %s: %w",
+				t.Name, occurrence.Format("2006-01-02"), err)
+		}
+
+		run := domain.NewRecurringJournalRun(t.ID, occurrence, &entry.ID, t.CreatedBy)
+		t.Advance(occurrence)
+		claimed, err := s.repo.GenerateRun(ctx, t, run, entry)
+		if err != nil {
+			return runs, fmt.Errorf("recurring journal %s: failed to generate %s: %w",
+				t.Name, occurrence.Format("2006-01-02"), err)
+		}
+		if !claimed {
+			// Another generator got there first; carry on from its schedule
+			if t, err = s.repo.GetByID(ctx, t.ID); err != nil {
+				return runs, err
+			}
+			continue
+		}
+		runs = append(runs, run)
+
+		audit.LogChange(ctx, s.recorder, entry.OrganizationID, audit.EntityJournalEntry, entry.ID, audit.ActionCreate, nil, entry)
+
+		if t.AutoPost {
+			if err := s.autoPost(ctx, t, entry); err != nil {
+				return runs, err
+			}
+		}
+	}
+
+	return runs, nil
+}
+
+// autoPost posts a generated entry as the template's creator. An entry that
+// matches an approval rule is submitted for approval instead; any other
+// failure leaves it in draft and is reported.
+func (s *RecurringJournalService) autoPost(ctx context.Context, t *domain.RecurringJournalTemplate, entry *domain.JournalEntry) error {
+	err := s.entryService.PostEntry(ctx, entry.ID, t.CreatedBy)
+	if err == nil {
+		return nil
+	}
+
+	var glErr *domain.GLError
+	if !errors.As(err, &glErr) || glErr.Code != domain.ErrJournalApprovalRequired {
+		return fmt.Errorf("recurring journal %s: entry %s was left in draft: %w", t.Name, entry.EntryNumber, err)
+	}
+
+	comment := fmt.Sprintf("Generated by recurring journal %s", t.Name)
+	if _, err := s.entryService.SubmitEntry(ctx, entry.ID, t.CreatedBy, comment); err != nil {
+		return fmt.Errorf("recurring journal %s: entry %s requires approval and could not be submitted: %w", t.Name, entry.EntryNumber, err)
+	}
+
+	return nil
+}
+
+// prepare numbers and normalizes a template's lines and validates it against the organization's accounts
+func (s *RecurringJournalService) prepare(ctx context.Context, t *domain.RecurringJournalTemplate) error {
+	t.StartDate = time.Date(t.StartDate.Year(), t.StartDate.Month(), t.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	if t.EndDate != nil {
+		end := time.Date(t.EndDate.Year(), t.EndDate.Month(), t.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+		t.EndDate = &end
+	}
+
+	for i := range t.Lines {
+		t.Lines[i].ID = uuid.New()
+		t.Lines[i].LineNumber = i + 1
+		t.Lines[i].Dimensions = t.Lines[i].Dimensions.Normalize()
+	}
+
+	if err := t.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	return ensureAccountsInOrganization(ctx, s.accountRepo, t.BuildEntry(t.StartDate, ""))
+}
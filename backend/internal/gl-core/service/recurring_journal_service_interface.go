@@ -0,0 +1,46 @@
+// backend/internal/gl-core/service/recurring_journal_service_interface.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// RecurringJournalServiceInterface defines business logic for recurring journal templates
+type RecurringJournalServiceInterface interface {
+	// CreateTemplate validates and saves a new ACTIVE template and schedules its first occurrence
+	CreateTemplate(ctx context.Context, template *domain.RecurringJournalTemplate) (*domain.RecurringJournalTemplate, error)
+
+	// UpdateTemplate replaces a template's header, schedule and lines
+	UpdateTemplate(ctx context.Context, template *domain.RecurringJournalTemplate) (*domain.RecurringJournalTemplate, error)
+
+	// GetTemplate retrieves a template with its lines
+	GetTemplate(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error)
+
+	// ListTemplates lists an organization's templates
+	ListTemplates(ctx context.Context, orgID uuid.UUID) ([]*domain.RecurringJournalTemplate, error)
+
+	// PreviewOccurrences lists the next count occurrences, marking skipped ones
+	PreviewOccurrences(ctx context.Context, id uuid.UUID, count int) ([]domain.RecurringOccurrence, error)
+
+	// SkipOccurrence marks an upcoming occurrence so no entry is generated for it
+	SkipOccurrence(ctx context.Context, id uuid.UUID, occurrence time.Time, skippedBy uuid.UUID) (*domain.RecurringJournalRun, error)
+
+	// PauseTemplate stops a template generating entries
+	PauseTemplate(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error)
+
+	// ResumeTemplate restarts a paused template from the next occurrence on or after today
+	ResumeTemplate(ctx context.Context, id uuid.UUID) (*domain.RecurringJournalTemplate, error)
+
+	// ListRuns lists a template's generated and skipped occurrences
+	ListRuns(ctx context.Context, id uuid.UUID) ([]*domain.RecurringJournalRun, error)
+
+	// GenerateTemplate generates a template's occurrences due on or before a date
+	GenerateTemplate(ctx context.Context, id uuid.UUID, asOf time.Time) ([]*domain.RecurringJournalRun, error)
+
+	// GenerateDue generates every organization's occurrences due on or before a date
+	GenerateDue(ctx context.Context, asOf time.Time) ([]*domain.RecurringJournalRun, error)
+}
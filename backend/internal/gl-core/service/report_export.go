@@ -0,0 +1,282 @@
+// backend/internal/gl-core/service/report_export.go
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/chaitu35/costeasy/backend/pkg/pdf"
+)
+
+// ExportFormat defines supported report export formats
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+	ExportFormatPDF  ExportFormat = "pdf"
+)
+
+// ContentType returns the MIME type for the export format
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ExportFormatPDF:
+		return "application/pdf"
+	default:
+		return "text/csv"
+	}
+}
+
+// ParseExportFormat parses an export format string
+func ParseExportFormat(format string) (ExportFormat, error) {
+	switch ExportFormat(strings.ToLower(format)) {
+	case ExportFormatCSV:
+		return ExportFormatCSV, nil
+	case ExportFormatXLSX:
+		return ExportFormatXLSX, nil
+	case ExportFormatPDF:
+		return ExportFormatPDF, nil
+	default:
+		return "", fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ExportTrialBalance renders a trial balance in the requested format
+func ExportTrialBalance(tb *domain.TrialBalance, format ExportFormat) ([]byte, error) {
+	header := []string{
+		"Account Code", "Account Name", "Type",
+		"Opening Debit", "Opening Credit",
+		"Period Debit", "Period Credit",
+		"Closing Debit", "Closing Credit",
+	}
+
+	rows := make([][]interface{}, 0, len(tb.Lines)+1)
+	for _, line := range tb.Lines {
+		rows = append(rows, []interface{}{
+			strings.Repeat("  ", line.Level) + line.Code,
+			line.Name,
+			string(line.Type),
+			line.OpeningDebit, line.OpeningCredit,
+			line.PeriodDebit, line.PeriodCredit,
+			line.ClosingDebit, line.ClosingCredit,
+		})
+	}
+	rows = append(rows, []interface{}{
+		"", "Total", "",
+		tb.TotalOpeningDebit, tb.TotalOpeningCredit,
+		tb.TotalPeriodDebit, tb.TotalPeriodCredit,
+		tb.TotalClosingDebit, tb.TotalClosingCredit,
+	})
+
+	return exportTable("Trial Balance", "", header, rows, format)
+}
+
+// ExportFinancialStatement renders an income statement or balance sheet in the requested format
+func ExportFinancialStatement(stmt *domain.FinancialStatement, format ExportFormat) ([]byte, error) {
+	header := []string{"Account Code", "Account Name"}
+	for _, col := range stmt.Columns {
+		header = append(header, col.Label)
+	}
+
+	amountRow := func(code, name string, amounts []money.Amount) []interface{} {
+		row := []interface{}{code, name}
+		for _, amount := range amounts {
+			row = append(row, amount)
+		}
+		return row
+	}
+
+	var rows [][]interface{}
+	for _, section := range stmt.Sections {
+		rows = append(rows, []interface{}{"", section.Title})
+		for _, line := range section.Lines {
+			rows = append(rows, amountRow(line.Code, strings.Repeat("  ", line.Level+1)+line.Name, line.Amounts))
+		}
+		rows = append(rows, amountRow("", "Total "+section.Title, section.Totals))
+	}
+	for _, line := range stmt.Summary {
+		rows = append(rows, amountRow("", line.Name, line.Amounts))
+	}
+
+	sheet := "Income Statement"
+	if stmt.Type == domain.StatementTypeBalanceSheet {
+		sheet = "Balance Sheet"
+	}
+
+	return exportTable(sheet, "", header, rows, format)
+}
+
+// ExportFXRevaluation renders a revaluation run's before and after values in the requested format
+func ExportFXRevaluation(rev *domain.FXRevaluation, format ExportFormat) ([]byte, error) {
+	header := []string{
+		"Account Code", "Account Name", "Type", "Currency",
+		"Foreign Balance", "Closing Rate",
+		"Book Balance", "Revalued Balance", "Gain/(Loss)",
+	}
+
+	rows := make([][]interface{}, 0, len(rev.Lines)+3)
+	for _, line := range rev.Lines {
+		rows = append(rows, []interface{}{
+			line.AccountCode,
+			line.AccountName,
+			string(line.AccountType),
+			line.Currency,
+			line.ForeignBalance.String(), // keeps three-decimal currencies exact
+			line.ClosingRate.String(),
+			line.BookBalance, line.RevaluedBalance, line.Adjustment,
+		})
+	}
+	rows = append(rows,
+		[]interface{}{"", "Total Gain", "", "", "", "", "", "", rev.TotalGain},
+		[]interface{}{"", "Total Loss", "", "", "", "", "", "", -rev.TotalLoss},
+		[]interface{}{"", "Net Gain/(Loss)", "", "", "", "", "", "", rev.NetGain()},
+	)
+
+	return exportTable("FX Revaluation", "", header, rows, format)
+}
+
+// ExportAccountStatement renders an account statement with its running balance in the requested format
+func ExportAccountStatement(stmt *domain.AccountStatement, format ExportFormat) ([]byte, error) {
+	header := []string{
+		"Date", "Entry Number", "Type", "Reference", "Description",
+		"Counter Accounts", "Debit", "Credit", "Balance",
+	}
+
+	from := stmt.FromDate.Format("2006-01-02")
+	to := stmt.ToDate.Format("2006-01-02")
+
+	rows := make([][]interface{}, 0, len(stmt.Lines)+2)
+	rows = append(rows, []interface{}{from, "", "", "", "Opening Balance", "", "", "", stmt.OpeningBalance})
+	for _, line := range stmt.Lines {
+		counters := make([]string, len(line.CounterAccounts))
+		for i, counter := range line.CounterAccounts {
+			counters[i] = counter.Code + " " + counter.Name
+		}
+		rows = append(rows, []interface{}{
+			line.TransactionDate.Format("2006-01-02"),
+			line.EntryNumber,
+			string(line.JournalType),
+			line.Reference,
+			line.Description,
+			strings.Join(counters, "; "),
+			line.Debit, line.Credit, line.Balance,
+		})
+	}
+	rows = append(rows, []interface{}{
+		to, "", "", "", "Closing Balance", "",
+		stmt.TotalDebit, stmt.TotalCredit, stmt.ClosingBalance,
+	})
+
+	subtitle := fmt.Sprintf("%s %s (%s), %s to %s", stmt.AccountCode, stmt.AccountName, stmt.AccountType, from, to)
+	return exportTable("Account Statement", subtitle, header, rows, format)
+}
+
+// exportTable writes a header and rows as CSV, a single-sheet workbook or a PDF
+// table. The subtitle is only printed in PDFs.
+func exportTable(sheet string, subtitle string, header []string, rows [][]interface{}, format ExportFormat) ([]byte, error) {
+	switch format {
+	case ExportFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(header); err != nil {
+			return nil, fmt.Errorf("failed to write header: %w", err)
+		}
+		for _, row := range rows {
+			record := make([]string, len(row))
+			for i, cell := range row {
+				record[i] = formatCell(cell)
+			}
+			if err := w.Write(record); err != nil {
+				return nil, fmt.Errorf("failed to write row: %w", err)
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, fmt.Errorf("failed to write csv: %w", err)
+		}
+		return buf.Bytes(), nil
+
+	case ExportFormatXLSX:
+		f := excelize.NewFile()
+		defer f.Close()
+
+		if err := f.SetSheetName(f.GetSheetName(0), sheet); err != nil {
+			return nil, fmt.Errorf("failed to name sheet: %w", err)
+		}
+
+		headerRow := make([]interface{}, len(header))
+		for i, h := range header {
+			headerRow[i] = h
+		}
+		if err := f.SetSheetRow(sheet, "A1", &headerRow); err != nil {
+			return nil, fmt.Errorf("failed to write header: %w", err)
+		}
+
+		for i, row := range rows {
+			cell, err := excelize.CoordinatesToCellName(1, i+2)
+			if err != nil {
+				return nil, err
+			}
+			values := make([]interface{}, len(row))
+			for j, v := range row {
+				values[j] = xlsxCell(v)
+			}
+			if err := f.SetSheetRow(sheet, cell, &values); err != nil {
+				return nil, fmt.Errorf("failed to write row %d: %w", i+2, err)
+			}
+		}
+
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to write workbook: %w", err)
+		}
+		return buf.Bytes(), nil
+
+	case ExportFormatPDF:
+		records := make([][]string, len(rows))
+		for i, row := range rows {
+			records[i] = make([]string, len(row))
+			for j, cell := range row {
+				records[i][j] = formatCell(cell)
+			}
+		}
+		data, err := pdf.RenderTable(sheet, subtitle, header, records)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write pdf: %w", err)
+		}
+		return data, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// formatCell renders a cell value for CSV and PDF output
+func formatCell(v interface{}) string {
+	switch val := v.(type) {
+	case money.Amount:
+		return val.StringFixed(domain.LedgerDecimalPlaces)
+	case float64:
+		return fmt.Sprintf("%.2f", val)
+	case string:
+		return val
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// xlsxCell converts amounts to numbers so spreadsheet cells stay numeric
+func xlsxCell(v interface{}) interface{} {
+	if amount, ok := v.(money.Amount); ok {
+		return amount.Float64()
+	}
+	return v
+}
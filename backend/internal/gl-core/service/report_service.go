@@ -0,0 +1,160 @@
+// backend/internal/gl-core/service/report_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+// TrialBalanceParams contains parameters for generating a trial balance
+type TrialBalanceParams struct {
+	OrganizationID uuid.UUID
+	FromDate       *time.Time            // Optional: start of period (nil = as of ToDate)
+	ToDate         time.Time             // Required: end of period / as-of date
+	RollUp         bool                  // Roll child account balances up to parents
+	IncludeZero    bool                  // Include accounts with no balance and no activity
+	Dimensions     domain.LineDimensions // Optional: only lines tagged with these values
+}
+
+type ReportService struct {
+	repo repository.ReportRepositoryInterface
+}
+
+// NewReportService creates a new report service
+func NewReportService(repo repository.ReportRepositoryInterface) *ReportService {
+	return &ReportService{repo: repo}
+}
+
+// GetTrialBalance generates a trial balance for an organization
+func (s *ReportService) GetTrialBalance(ctx context.Context, params TrialBalanceParams) (*domain.TrialBalance, error) {
+	if params.OrganizationID == uuid.Nil {
+		return nil, fmt.Errorf("organization ID is required")
+	}
+
+	if params.ToDate.IsZero() {
+		return nil, fmt.Errorf("to date is required")
+	}
+
+	// As-of reports have no opening column: everything up to ToDate is period activity
+	fromDate := time.Time{}
+	if params.FromDate != nil {
+		if params.FromDate.After(params.ToDate) {
+			return nil, fmt.Errorf("from date cannot be after to date")
+		}
+		fromDate = *params.FromDate
+	}
+
+	lines, err := s.repo.GetAccountActivity(ctx, repository.AccountActivityFilter{
+		OrganizationID: params.OrganizationID,
+		FromDate:       fromDate,
+		ToDate:         params.ToDate,
+		Dimensions:     params.Dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account activity: %w", err)
+	}
+
+	if params.RollUp {
+		lines = rollUpAccountTree(lines)
+	}
+
+	result := &domain.TrialBalance{
+		OrganizationID: params.OrganizationID,
+		FromDate:       params.FromDate,
+		ToDate:         params.ToDate,
+		Lines:          make([]domain.TrialBalanceLine, 0, len(lines)),
+		Dimensions:     params.Dimensions,
+		GeneratedAt:    time.Now(),
+	}
+
+	for _, line := range lines {
+		if !params.IncludeZero && line.IsZero() {
+			continue
+		}
+		line.Finalize()
+		result.Lines = append(result.Lines, line)
+	}
+
+	result.CalculateTotals()
+
+	return result, nil
+}
+
+// rollUpAccountTree orders lines depth-first by the account tree and adds
+// each account's descendants' amounts to its own.
+func rollUpAccountTree(lines []domain.TrialBalanceLine) []domain.TrialBalanceLine {
+	byID := make(map[uuid.UUID]*domain.TrialBalanceLine, len(lines))
+	for i := range lines {
+		byID[lines[i].AccountID] = &lines[i]
+	}
+
+	children := make(map[uuid.UUID][]*domain.TrialBalanceLine)
+	var roots []*domain.TrialBalanceLine
+	for i := range lines {
+		line := &lines[i]
+		if line.ParentID != nil {
+			if _, ok := byID[*line.ParentID]; ok && *line.ParentID != line.AccountID {
+				children[*line.ParentID] = append(children[*line.ParentID], line)
+				continue
+			}
+		}
+		roots = append(roots, line)
+	}
+
+	byCode := func(nodes []*domain.TrialBalanceLine) {
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Code < nodes[j].Code })
+	}
+	byCode(roots)
+
+	ordered := make([]domain.TrialBalanceLine, 0, len(lines))
+	visited := make(map[uuid.UUID]bool, len(lines))
+
+	// walk appends the node and its subtree, returning the subtree totals
+	var walk func(node *domain.TrialBalanceLine, level int) domain.TrialBalanceLine
+	walk = func(node *domain.TrialBalanceLine, level int) domain.TrialBalanceLine {
+		visited[node.AccountID] = true
+		idx := len(ordered)
+		ordered = append(ordered, *node)
+		ordered[idx].Level = level
+
+		total := *node
+		kids := children[node.AccountID]
+		byCode(kids)
+		for _, child := range kids {
+			if visited[child.AccountID] {
+				continue
+			}
+			sub := walk(child, level+1)
+			total.OpeningDebit += sub.OpeningDebit
+			total.OpeningCredit += sub.OpeningCredit
+			total.PeriodDebit += sub.PeriodDebit
+			total.PeriodCredit += sub.PeriodCredit
+			ordered[idx].HasChildren = true
+		}
+
+		ordered[idx].OpeningDebit = total.OpeningDebit
+		ordered[idx].OpeningCredit = total.OpeningCredit
+		ordered[idx].PeriodDebit = total.PeriodDebit
+		ordered[idx].PeriodCredit = total.PeriodCredit
+		return total
+	}
+
+	for _, root := range roots {
+		walk(root, 0)
+	}
+
+	// Accounts caught in a parent cycle are never reached from a root
+	for i := range lines {
+		if !visited[lines[i].AccountID] {
+			walk(&lines[i], 0)
+		}
+	}
+
+	return ordered
+}
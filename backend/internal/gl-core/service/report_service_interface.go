@@ -0,0 +1,23 @@
+// backend/internal/gl-core/service/report_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+)
+
+// ReportServiceInterface defines business logic for ledger reports
+type ReportServiceInterface interface {
+	// GetTrialBalance generates a trial balance as of a date or for a date range
+	GetTrialBalance(ctx context.Context, params TrialBalanceParams) (*domain.TrialBalance, error)
+
+	// GetIncomeStatement generates a profit and loss statement for a period
+	GetIncomeStatement(ctx context.Context, params IncomeStatementParams) (*domain.FinancialStatement, error)
+
+	// GetBalanceSheet generates a balance sheet as of a date
+	GetBalanceSheet(ctx context.Context, params BalanceSheetParams) (*domain.FinancialStatement, error)
+
+	// GetAccountStatement lists an account's posted lines for a period with a running balance
+	GetAccountStatement(ctx context.Context, params AccountStatementParams) (*domain.AccountStatement, error)
+}
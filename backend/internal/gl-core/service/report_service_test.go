@@ -0,0 +1,260 @@
+// backend/internal/gl-core/service/report_service_test.go
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// stubReportRepo serves account activity computed from each filter
+type stubReportRepo struct {
+	repository.ReportRepositoryInterface
+	activity func(filter repository.AccountActivityFilter) []domain.TrialBalanceLine
+	filters  []repository.AccountActivityFilter
+}
+
+func (r *stubReportRepo) GetAccountActivity(ctx context.Context, filter repository.AccountActivityFilter) ([]domain.TrialBalanceLine, error) {
+	r.filters = append(r.filters, filter)
+	return r.activity(filter), nil
+}
+
+// chart is a small account tree with ids per code
+type chart map[string]uuid.UUID
+
+func (c chart) id(code string) uuid.UUID {
+	if _, ok := c[code]; !ok {
+		c[code] = uuid.New()
+	}
+	return c[code]
+}
+
+// line builds an account's activity. Amounts are opening debit, opening
+// credit, period debit and period credit.
+func (c chart) line(code, parent string, typ domain.AccountType, amounts ...string) domain.TrialBalanceLine {
+	l := domain.TrialBalanceLine{AccountID: c.id(code), Code: code, Name: "Account " + code, Type: typ}
+	if parent != "" {
+		id := c.id(parent)
+		l.ParentID = &id
+	}
+	fields := []*money.Amount{&l.OpeningDebit, &l.OpeningCredit, &l.PeriodDebit, &l.PeriodCredit}
+	for i, a := range amounts {
+		*fields[i] = money.MustParse(a)
+	}
+	return l
+}
+
+func TestGetTrialBalance(t *testing.T) {
+	accounts := chart{}
+	activity := []domain.TrialBalanceLine{
+		accounts.line("2000", "", domain.AccountTypeLiability, "0", "450", "0", "200"),
+		accounts.line("1200", "1000", domain.AccountTypeAsset, "0", "50", "0", "0"), // Overdrawn
+		accounts.line("1100", "1000", domain.AccountTypeAsset, "500", "0", "300", "100"),
+		accounts.line("1000", "", domain.AccountTypeAsset),
+		accounts.line("5000", "", domain.AccountTypeExpense),
+	}
+
+	type wantLine struct {
+		code    string
+		level   int
+		closing string // Positive is a debit balance
+	}
+
+	tests := []struct {
+		name        string
+		rollUp      bool
+		includeZero bool
+		want        []wantLine
+		wantTotal   string // Closing debits, equal to closing credits
+	}{
+		{
+			name:   "rolled up",
+			rollUp: true,
+			want: []wantLine{
+				{code: "1000", level: 0, closing: "650"},
+				{code: "1100", level: 1, closing: "700"},
+				{code: "1200", level: 1, closing: "-50"},
+				{code: "2000", level: 0, closing: "-650"},
+			},
+			wantTotal: "650",
+		},
+		{
+			name:        "rolled up with zero accounts",
+			rollUp:      true,
+			includeZero: true,
+			want: []wantLine{
+				{code: "1000", level: 0, closing: "650"},
+				{code: "1100", level: 1, closing: "700"},
+				{code: "1200", level: 1, closing: "-50"},
+				{code: "2000", level: 0, closing: "-650"},
+				{code: "5000", level: 0, closing: "0"},
+			},
+			wantTotal: "650",
+		},
+		{
+			name: "flat",
+			want: []wantLine{
+				{code: "2000", level: 0, closing: "-650"},
+				{code: "1200", level: 0, closing: "-50"},
+				{code: "1100", level: 0, closing: "700"},
+			},
+			wantTotal: "700",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubReportRepo{activity: func(repository.AccountActivityFilter) []domain.TrialBalanceLine {
+				return append([]domain.TrialBalanceLine(nil), activity...)
+			}}
+			svc := NewReportService(repo)
+
+			tb, err := svc.GetTrialBalance(context.Background(), TrialBalanceParams{
+				OrganizationID: uuid.New(),
+				ToDate:         time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+				RollUp:         tt.rollUp,
+				IncludeZero:    tt.includeZero,
+			})
+			if err != nil {
+				t.Fatalf("GetTrialBalance() error = %v", err)
+			}
+
+			if len(tb.Lines) != len(tt.want) {
+				t.Fatalf("got %d lines, want %d", len(tb.Lines), len(tt.want))
+			}
+			for i, want := range tt.want {
+				line := tb.Lines[i]
+				if line.Code != want.code || line.Level != want.level {
+					t.Errorf("line %d is %s at level %d, want %s at level %d", i, line.Code, line.Level, want.code, want.level)
+					continue
+				}
+				if got := line.ClosingDebit - line.ClosingCredit; got != money.MustParse(want.closing) {
+					t.Errorf("%s closing balance = %s, want %s", line.Code, got, want.closing)
+				}
+				if line.ClosingDebit != 0 && line.ClosingCredit != 0 {
+					t.Errorf("%s closes on both sides: debit %s, credit %s", line.Code, line.ClosingDebit, line.ClosingCredit)
+				}
+			}
+
+			if tb.TotalClosingDebit != money.MustParse(tt.wantTotal) || !tb.IsBalanced() {
+				t.Errorf("closing totals = debit %s, credit %s, want %s each", tb.TotalClosingDebit, tb.TotalClosingCredit, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestGetTrialBalancePeriod(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		from     *time.Time
+		wantFrom time.Time
+		wantErr  bool
+	}{
+		{name: "as of a date", from: nil, wantFrom: time.Time{}},
+		{name: "for a period", from: &from, wantFrom: from},
+		{name: "from after to", from: ptrTime(to.AddDate(0, 0, 1)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubReportRepo{activity: func(repository.AccountActivityFilter) []domain.TrialBalanceLine { return nil }}
+			svc := NewReportService(repo)
+
+			_, err := svc.GetTrialBalance(context.Background(), TrialBalanceParams{
+				OrganizationID: uuid.New(),
+				FromDate:       tt.from,
+				ToDate:         to,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("GetTrialBalance() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetTrialBalance() error = %v", err)
+			}
+			if len(repo.filters) != 1 || !repo.filters[0].FromDate.Equal(tt.wantFrom) || !repo.filters[0].ToDate.Equal(to) {
+				t.Errorf("activity filter = %+v, want from %s to %s", repo.filters, tt.wantFrom, to)
+			}
+		})
+	}
+}
+
+func TestRollUpAccountTree(t *testing.T) {
+	tests := []struct {
+		name       string
+		lines      func(c chart) []domain.TrialBalanceLine
+		wantCodes  []string
+		wantLevels []int
+		wantPeriod []string // Period debits after the roll-up
+	}{
+		{
+			name: "children follow their parent in code order",
+			lines: func(c chart) []domain.TrialBalanceLine {
+				return []domain.TrialBalanceLine{
+					c.line("1120", "1100", domain.AccountTypeAsset, "0", "0", "5", "0"),
+					c.line("1100", "1000", domain.AccountTypeAsset, "0", "0", "10", "0"),
+					c.line("1110", "1100", domain.AccountTypeAsset, "0", "0", "20", "0"),
+					c.line("1000", "", domain.AccountTypeAsset),
+					c.line("1200", "1000", domain.AccountTypeAsset, "0", "0", "1", "0"),
+				}
+			},
+			wantCodes:  []string{"1000", "1100", "1110", "1120", "1200"},
+			wantLevels: []int{0, 1, 2, 2, 1},
+			wantPeriod: []string{"36", "35", "20", "5", "1"},
+		},
+		{
+			name: "missing parent is a root",
+			lines: func(c chart) []domain.TrialBalanceLine {
+				return []domain.TrialBalanceLine{
+					c.line("1100", "9999", domain.AccountTypeAsset, "0", "0", "10", "0"),
+				}
+			},
+			wantCodes:  []string{"1100"},
+			wantLevels: []int{0},
+			wantPeriod: []string{"10"},
+		},
+		{
+			name: "parent cycle",
+			lines: func(c chart) []domain.TrialBalanceLine {
+				return []domain.TrialBalanceLine{
+					c.line("1100", "1200", domain.AccountTypeAsset, "0", "0", "10", "0"),
+					c.line("1200", "1100", domain.AccountTypeAsset, "0", "0", "20", "0"),
+				}
+			},
+			wantCodes:  []string{"1100", "1200"},
+			wantLevels: []int{0, 1},
+			wantPeriod: []string{"30", "20"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rollUpAccountTree(tt.lines(chart{}))
+			if len(got) != len(tt.wantCodes) {
+				t.Fatalf("got %d lines, want %d", len(got), len(tt.wantCodes))
+			}
+			for i, line := range got {
+				if line.Code != tt.wantCodes[i] || line.Level != tt.wantLevels[i] {
+					t.Errorf("line %d is %s at level %d, want %s at level %d", i, line.Code, line.Level, tt.wantCodes[i], tt.wantLevels[i])
+				}
+				if line.PeriodDebit != money.MustParse(tt.wantPeriod[i]) {
+					t.Errorf("%s period debit = %s, want %s", line.Code, line.PeriodDebit, tt.wantPeriod[i])
+				}
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,340 @@
+// backend/internal/gl-core/service/tally_import.go
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+)
+
+// tallyPrimary is the parent Tally gives top-level groups and ledgers
+const tallyPrimary = "primary"
+
+// tallyGroupTypes maps Tally's predefined groups to account types. User
+// groups resolve through their parent chain to one of these.
+var tallyGroupTypes = map[string]domain.AccountType{
+	"capital account":          domain.AccountTypeEquity,
+	"reserves & surplus":       domain.AccountTypeEquity,
+	"profit & loss a/c":        domain.AccountTypeEquity,
+	"current assets":           domain.AccountTypeAsset,
+	"fixed assets":             domain.AccountTypeAsset,
+	"investments":              domain.AccountTypeAsset,
+	"bank accounts":            domain.AccountTypeAsset,
+	"cash-in-hand":             domain.AccountTypeAsset,
+	"deposits (asset)":         domain.AccountTypeAsset,
+	"loans & advances (asset)": domain.AccountTypeAsset,
+	"stock-in-hand":            domain.AccountTypeAsset,
+	"sundry debtors":           domain.AccountTypeAsset,
+	"misc. expenses (asset)":   domain.AccountTypeAsset,
+	"current liabilities":      domain.AccountTypeLiability,
+	"loans (liability)":        domain.AccountTypeLiability,
+	"bank od a/c":              domain.AccountTypeLiability,
+	"bank occ a/c":             domain.AccountTypeLiability,
+	"secured loans":            domain.AccountTypeLiability,
+	"unsecured loans":          domain.AccountTypeLiability,
+	"duties & taxes":           domain.AccountTypeLiability,
+	"provisions":               domain.AccountTypeLiability,
+	"sundry creditors":         domain.AccountTypeLiability,
+	"suspense a/c":             domain.AccountTypeLiability,
+	"branch / divisions":       domain.AccountTypeLiability,
+	"sales accounts":           domain.AccountTypeRevenue,
+	"direct incomes":           domain.AccountTypeRevenue,
+	"indirect incomes":         domain.AccountTypeRevenue,
+	"income (direct)":          domain.AccountTypeRevenue,
+	"income (indirect)":        domain.AccountTypeRevenue,
+	"purchase accounts":        domain.AccountTypeExpense,
+	"direct expenses":          domain.AccountTypeExpense,
+	"indirect expenses":        domain.AccountTypeExpense,
+	"expenses (direct)":        domain.AccountTypeExpense,
+	"expenses (indirect)":      domain.AccountTypeExpense,
+}
+
+// tallyControlRef matches numeric character references. Tally writes control
+// characters such as &#4; (its "Primary" marker) that XML does not allow.
+var tallyControlRef = regexp.MustCompile(`&#(x[0-9a-fA-F]+|[0-9]+);`)
+
+// tallyExport holds the masters and vouchers of a Tally XML export
+type tallyExport struct {
+	groups   map[string]tallyGroup // By normalized name
+	ledgers  []tallyLedger
+	vouchers []tallyVoucher
+}
+
+// tallyGroup is a GROUP master
+type tallyGroup struct {
+	NameAttr string   `xml:"NAME,attr"`
+	Names    []string `xml:"NAME.LIST>NAME"`
+	Parent   string   `xml:"PARENT"`
+}
+
+// tallyLedger is a LEDGER master
+type tallyLedger struct {
+	NameAttr string   `xml:"NAME,attr"`
+	Names    []string `xml:"NAME.LIST>NAME"`
+	Parent   string   `xml:"PARENT"`
+	line     int
+}
+
+// tallyVoucher is a VOUCHER with its ledger lines
+type tallyVoucher struct {
+	VchType      string                `xml:"VCHTYPE,attr"`
+	Date         string                `xml:"DATE"`
+	TypeName     string                `xml:"VOUCHERTYPENAME"`
+	Number       string                `xml:"VOUCHERNUMBER"`
+	Narration    string                `xml:"NARRATION"`
+	IsCancelled  string                `xml:"ISCANCELLED"`
+	IsOptional   string                `xml:"ISOPTIONAL"`
+	Entries      []tallyLedgerEntry    `xml:"ALLLEDGERENTRIES.LIST"`
+	OldEntries   []tallyLedgerEntry    `xml:"LEDGERENTRIES.LIST"`
+	Inventory    []tallyInventoryEntry `xml:"ALLINVENTORYENTRIES.LIST"`
+	OldInventory []tallyInventoryEntry `xml:"INVENTORYENTRIES.LIST"`
+	line         int
+}
+
+// tallyLedgerEntry is one ledger line of a voucher
+type tallyLedgerEntry struct {
+	LedgerName string `xml:"LEDGERNAME"`
+	Amount     string `xml:"AMOUNT"`
+}
+
+// tallyInventoryEntry is a stock line of an item invoice. Its ledger side is
+// in the accounting allocations.
+type tallyInventoryEntry struct {
+	Allocations []tallyLedgerEntry `xml:"ACCOUNTINGALLOCATIONS.LIST"`
+}
+
+// name returns the master's name
+func (g tallyGroup) name() string {
+	return tallyName(g.NameAttr, g.Names)
+}
+
+// name returns the master's name
+func (l tallyLedger) name() string {
+	return tallyName(l.NameAttr, l.Names)
+}
+
+// tallyName prefers the NAME attribute over the first of the name list
+func tallyName(attr string, names []string) string {
+	if name := strings.TrimSpace(attr); name != "" {
+		return name
+	}
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// ledgerLines returns every ledger line of the voucher, whichever list
+// Tally wrote it in
+func (v tallyVoucher) ledgerLines() []tallyLedgerEntry {
+	lines := append(append([]tallyLedgerEntry{}, v.Entries...), v.OldEntries...)
+	for _, item := range append(append([]tallyInventoryEntry{}, v.Inventory...), v.OldInventory...) {
+		lines = append(lines, item.Allocations...)
+	}
+	return lines
+}
+
+// readTallyXMLFile reads a Tally XML export
+func readTallyXMLFile(filePath string) (*tallyExport, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	return parseTallyXML(data)
+}
+
+// parseTallyXML collects the GROUP, LEDGER and VOUCHER elements of an export,
+// wherever they sit in its envelope. Tally exports UTF-16 by default.
+func parseTallyXML(data []byte) (*tallyExport, error) {
+	data = tallyControlRef.ReplaceAllFunc(decodeUTF16(data), func(ref []byte) []byte {
+		digits := string(ref[2 : len(ref)-1])
+		base := 10
+		if strings.HasPrefix(digits, "x") {
+			digits, base = digits[1:], 16
+		}
+		if n, err := strconv.ParseInt(digits, base, 32); err == nil && n < 0x20 && n != '\t' && n != '\n' && n != '\r' {
+			return nil
+		}
+		return ref
+	})
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// The content is UTF-8 by now, whatever the declaration says
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	export := &tallyExport{groups: make(map[string]tallyGroup)}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Tally XML: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		line, _ := decoder.InputPos()
+
+		switch start.Name.Local {
+		case "GROUP":
+			var group tallyGroup
+			if err := decoder.DecodeElement(&group, &start); err != nil {
+				return nil, fmt.Errorf("failed to read group at line %d: %w", line, err)
+			}
+			export.groups[normalizeLegacyName(group.name())] = group
+		case "LEDGER":
+			var ledger tallyLedger
+			if err := decoder.DecodeElement(&ledger, &start); err != nil {
+				return nil, fmt.Errorf("failed to read ledger at line %d: %w", line, err)
+			}
+			ledger.line = line
+			export.ledgers = append(export.ledgers, ledger)
+		case "VOUCHER":
+			var voucher tallyVoucher
+			if err := decoder.DecodeElement(&voucher, &start); err != nil {
+				return nil, fmt.Errorf("failed to read voucher at line %d: %w", line, err)
+			}
+			voucher.line = line
+			export.vouchers = append(export.vouchers, voucher)
+		}
+	}
+
+	return export, nil
+}
+
+// decodeUTF16 converts UTF-16 content with a byte order mark to UTF-8
+func decodeUTF16(data []byte) []byte {
+	var order binary.ByteOrder
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		order = binary.LittleEndian
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		order = binary.BigEndian
+	default:
+		return bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+	}
+
+	units := make([]uint16, (len(data)-2)/2)
+	for i := range units {
+		units[i] = order.Uint16(data[2+2*i:])
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+// resolveLedgerType walks a ledger's group chain up to a mapped group
+func (e *tallyExport) resolveLedgerType(ledger tallyLedger, rules legacyTypeRules) (domain.AccountType, bool) {
+	// Top-level ledgers such as Profit & Loss A/c map by their own name
+	if strings.Contains(normalizeLegacyName(ledger.Parent), tallyPrimary) {
+		return rules.lookup(ledger.name())
+	}
+
+	group := ledger.Parent
+	for depth := 0; depth <= len(e.groups); depth++ {
+		if t, ok := rules.lookup(group); ok {
+			return t, true
+		}
+		parent, ok := e.groups[normalizeLegacyName(group)]
+		if !ok || strings.Contains(normalizeLegacyName(parent.Parent), tallyPrimary) {
+			return "", false
+		}
+		group = parent.Parent
+	}
+	return "", false
+}
+
+// tallyAccountSheet converts the export's ledgers to account rows. A ledger
+// whose group does not map keeps the group as its type so the row check
+// reports it.
+func tallyAccountSheet(export *tallyExport, rules legacyTypeRules) *importSheet {
+	sheet := &importSheet{system: "tally"}
+	sheet.add(0, accountSheetHeader...)
+
+	for _, ledger := range export.ledgers {
+		name := ledger.name()
+		parent := strings.TrimSpace(ledger.Parent)
+		accountType, ok := export.resolveLedgerType(ledger, rules)
+		typeValue := string(accountType)
+		if !ok {
+			typeValue = parent
+		}
+		sheet.add(ledger.line, legacyAccountCode(accountType, "", name), name, typeValue, parent, "")
+	}
+
+	return sheet
+}
+
+// tallyJournalSheet converts the export's vouchers to journal rows, one row
+// per ledger line. Tally signs debits negative. Cancelled and optional
+// vouchers are left out.
+func tallyJournalSheet(export *tallyExport) *importSheet {
+	sheet := &importSheet{system: "tally"}
+	sheet.add(0, journalSheetHeader...)
+	refs := make(referenceSet)
+
+	for _, voucher := range export.vouchers {
+		voucherType := strings.TrimSpace(voucher.TypeName)
+		if voucherType == "" {
+			voucherType = strings.TrimSpace(voucher.VchType)
+		}
+		label := strings.TrimSpace(voucherType + " " + strings.TrimSpace(voucher.Number))
+
+		if tallyYes(voucher.IsCancelled) || tallyYes(voucher.IsOptional) {
+			sheet.warn(voucher.line, "Voucher '%s' is cancelled or optional, skipping", label)
+			continue
+		}
+
+		ref := label
+		if strings.TrimSpace(voucher.Number) == "" {
+			ref = fmt.Sprintf("%s %d", voucherType, voucher.line)
+		}
+		ref = refs.unique(strings.TrimSpace(ref))
+
+		date := strings.TrimSpace(voucher.Date)
+		if parsed, err := time.Parse("20060102", date); err == nil {
+			date = parsed.Format("2006-01-02")
+		}
+
+		description := strings.TrimSpace(voucher.Narration)
+		if description == "" {
+			description = label
+		}
+
+		for _, entry := range voucher.ledgerLines() {
+			amount := strings.TrimSpace(entry.Amount)
+			// Foreign currency amounts read "-100.00 USD @ 83.00/USD = -8300.00"
+			if i := strings.LastIndex(amount, "="); i >= 0 {
+				amount = amount[i+1:]
+			}
+			amount = cleanLegacyAmount(amount)
+			if strings.Trim(amount, "-0.") == "" {
+				continue
+			}
+			debit, credit := splitSignedAmount(amount, false)
+			sheet.add(voucher.line, date, ref, description, strings.TrimSpace(entry.LedgerName), debit, credit)
+		}
+	}
+
+	return sheet
+}
+
+// tallyYes reads a Tally Yes/No flag
+func tallyYes(value string) bool {
+	return strings.EqualFold(strings.TrimSpace(value), "yes")
+}
@@ -0,0 +1,218 @@
+// backend/internal/gl-core/service/year_end_close_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/google/uuid"
+)
+
+type YearEndCloseService struct {
+	repo        repository.YearEndCloseRepositoryInterface
+	periodRepo  repository.FiscalPeriodRepositoryInterface
+	entryRepo   repository.JournalEntryRepositoryInterface
+	accountRepo repository.GLAccountRepositoryInterface
+	reportRepo  repository.ReportRepositoryInterface
+}
+
+// NewYearEndCloseService creates a new year-end close service
+func NewYearEndCloseService(
+	repo repository.YearEndCloseRepositoryInterface,
+	periodRepo repository.FiscalPeriodRepositoryInterface,
+	entryRepo repository.JournalEntryRepositoryInterface,
+	accountRepo repository.GLAccountRepositoryInterface,
+	reportRepo repository.ReportRepositoryInterface,
+) *YearEndCloseService {
+	return &YearEndCloseService{
+		repo:        repo,
+		periodRepo:  periodRepo,
+		entryRepo:   entryRepo,
+		accountRepo: accountRepo,
+		reportRepo:  reportRepo,
+	}
+}
+
+// CloseFiscalYear posts the closing entry that moves revenue and expense balances
+// into the retained earnings account, brings balance sheet balances forward into
+// the next fiscal year (creating it if needed) and closes every period.
+// Closing an already closed year returns the existing close.
+func (s *YearEndCloseService) CloseFiscalYear(ctx context.Context, fiscalYearID, retainedEarningsID, closedBy uuid.UUID) (*domain.YearEndClose, error) {
+	fy, err := s.periodRepo.GetFiscalYearByID(ctx, fiscalYearID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetByFiscalYear(ctx, fy.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Status == domain.YearEndCloseActive {
+		return existing, nil
+	}
+
+	account, err := s.accountRepo.GetGLAccountByID(ctx, retainedEarningsID, false)
+	if err != nil {
+		return nil, domain.NewGLErrorf(domain.ErrYearEndRetainedEarningsInvalid,
+			"retained earnings account %s not found", retainedEarningsID)
+	}
+	if !account.BelongsTo(fy.OrganizationID) {
+		return nil, domain.NewGLErrorf(domain.ErrYearEndRetainedEarningsInvalid,
+			"retained earnings account %s belongs to another organization", account.Code)
+	}
+	if account.Type != domain.AccountTypeEquity {
+		return nil, domain.NewGLErrorf(domain.ErrYearEndRetainedEarningsInvalid,
+			"retained earnings account %s must be an EQUITY account (got %s)", account.Code, account.Type)
+	}
+	if !account.IsActive {
+		return nil, domain.NewGLErrorf(domain.ErrYearEndRetainedEarningsInvalid,
+			"retained earnings account %s is inactive", account.Code)
+	}
+	if !account.IsPostable {
+		return nil, domain.NewGLErrorf(domain.ErrYearEndRetainedEarningsInvalid,
+			"retained earnings account %s is a control account", account.Code)
+	}
+
+	balances, err := s.reportRepo.GetAccountActivity(ctx, repository.AccountActivityFilter{
+		OrganizationID: fy.OrganizationID,
+		FromDate:       fy.StartDate,
+		ToDate:         fy.EndDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account balances: %w", err)
+	}
+
+	// The closing entry is posted, so it is numbered when saved
+	closingEntry, netIncome, err := domain.BuildClosingEntry(fy, balances, account.ID, domain.NewDraftEntryNumber(), closedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build closing entry: %w", err)
+	}
+
+	// Opening balances go to the following fiscal year, created here if missing
+	nextYear, err := s.periodRepo.GetFiscalYearByDate(ctx, fy.OrganizationID, fy.EndDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	var newYear *domain.FiscalYear
+	if nextYear == nil {
+		newYear, err = domain.NewFiscalYear(fy.OrganizationID, "", fy.EndDate.AddDate(0, 0, 1), closedBy)
+		if err != nil {
+			return nil, err
+		}
+		nextYear = newYear
+	}
+
+	yc := &domain.YearEndClose{
+		ID:                        uuid.New(),
+		OrganizationID:            fy.OrganizationID,
+		FiscalYearID:              fy.ID,
+		NextFiscalYearID:          nextYear.ID,
+		RetainedEarningsAccountID: account.ID,
+		NetIncome:                 netIncome,
+		Status:                    domain.YearEndCloseActive,
+		ClosedBy:                  closedBy,
+		ClosedAt:                  time.Now(),
+	}
+	if closingEntry != nil {
+		yc.ClosingEntryID = &closingEntry.ID
+	}
+	yc.OpeningBalances = domain.BuildOpeningBalances(yc, balances)
+
+	events, err := fy.Close(closedBy, "Year-end close")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, yc, fy, closingEntry, newYear, events); err != nil {
+		return nil, fmt.Errorf("failed to save year-end close: %w", err)
+	}
+
+	return yc, nil
+}
+
+// ReopenFiscalYear reverses the closing entry as of the fiscal year end, removes
+// the opening balances it created and reopens the year and its final period
+func (s *YearEndCloseService) ReopenFiscalYear(ctx context.Context, fiscalYearID, reopenedBy uuid.UUID, reason string) (*domain.YearEndClose, error) {
+	fy, err := s.periodRepo.GetFiscalYearByID(ctx, fiscalYearID)
+	if err != nil {
+		return nil, err
+	}
+
+	yc, err := s.repo.GetByFiscalYear(ctx, fy.ID)
+	if err != nil {
+		return nil, err
+	}
+	if yc == nil || yc.Status != domain.YearEndCloseActive {
+		return nil, domain.NewGLErrorf(domain.ErrYearEndNotClosed, "fiscal year %s has not been closed", fy.Name)
+	}
+
+	// The next year's opening balances depend on this close
+	nextClose, err := s.repo.GetByFiscalYear(ctx, yc.NextFiscalYearID)
+	if err != nil {
+		return nil, err
+	}
+	if nextClose != nil && nextClose.Status == domain.YearEndCloseActive {
+		return nil, domain.NewGLError("the following fiscal year is closed; reopen it first", domain.ErrPeriodInvalidTransition)
+	}
+
+	events, err := fy.Reopen(reopenedBy, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	var closingEntry, reversalEntry *domain.JournalEntry
+	if yc.ClosingEntryID != nil {
+		closingEntry, err = s.entryRepo.GetByID(ctx, *yc.ClosingEntryID)
+		if err != nil {
+			return nil, fmt.Errorf("closing entry not found: %w", err)
+		}
+
+		// Date the reversal at year end so the closed year's balances are restored
+		reversalEntry, err = closingEntry.CreateReversal(reopenedBy, domain.NewDraftEntryNumber(), fy.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create reversal: %w", err)
+		}
+
+		if err := reversalEntry.Post(reopenedBy); err != nil {
+			return nil, fmt.Errorf("failed to post reversal: %w", err)
+		}
+
+		closingEntry.Status = domain.EntryStatusReversed
+		closingEntry.ReversedBy = &reopenedBy
+		closingEntry.UpdatedAt = time.Now()
+	}
+
+	var reversalID *uuid.UUID
+	if reversalEntry != nil {
+		reversalID = &reversalEntry.ID
+	}
+	if err := yc.Reverse(reopenedBy, reason, reversalID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Reverse(ctx, yc, fy, closingEntry, reversalEntry, events); err != nil {
+		return nil, fmt.Errorf("failed to reverse year-end close: %w", err)
+	}
+
+	return yc, nil
+}
+
+// GetYearEndClose retrieves the most recent close of a fiscal year
+func (s *YearEndCloseService) GetYearEndClose(ctx context.Context, fiscalYearID uuid.UUID) (*domain.YearEndClose, error) {
+	yc, err := s.repo.GetByFiscalYear(ctx, fiscalYearID)
+	if err != nil {
+		return nil, err
+	}
+	if yc == nil {
+		return nil, domain.NewGLError("fiscal year has not been closed", domain.ErrYearEndNotClosed)
+	}
+	return yc, nil
+}
+
+// GetOpeningBalances lists the balances brought forward into a fiscal year
+func (s *YearEndCloseService) GetOpeningBalances(ctx context.Context, fiscalYearID uuid.UUID) ([]domain.OpeningBalance, error) {
+	return s.repo.ListOpeningBalances(ctx, fiscalYearID)
+}
@@ -0,0 +1,24 @@
+// backend/internal/gl-core/service/year_end_close_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// YearEndCloseServiceInterface defines business logic for closing and reopening fiscal years
+type YearEndCloseServiceInterface interface {
+	// CloseFiscalYear closes revenue and expense into retained earnings and closes the year
+	CloseFiscalYear(ctx context.Context, fiscalYearID, retainedEarningsID, closedBy uuid.UUID) (*domain.YearEndClose, error)
+
+	// ReopenFiscalYear reverses the year-end close and reopens the year
+	ReopenFiscalYear(ctx context.Context, fiscalYearID, reopenedBy uuid.UUID, reason string) (*domain.YearEndClose, error)
+
+	// GetYearEndClose retrieves the most recent close of a fiscal year
+	GetYearEndClose(ctx context.Context, fiscalYearID uuid.UUID) (*domain.YearEndClose, error)
+
+	// GetOpeningBalances lists the balances brought forward into a fiscal year
+	GetOpeningBalances(ctx context.Context, fiscalYearID uuid.UUID) ([]domain.OpeningBalance, error)
+}
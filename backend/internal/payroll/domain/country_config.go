@@ -3,6 +3,7 @@ package domain
 import (
 	"time"
 
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -23,21 +24,21 @@ type Country struct {
 
 // CountryPayrollConfig stores payroll rules per country
 type CountryPayrollConfig struct {
-	ID                  uuid.UUID `json:"id"`
-	CountryID           uuid.UUID `json:"country_id"`
-	HasIncomeTax        bool      `json:"has_income_tax"`
-	HasSocialSecurity   bool      `json:"has_social_security"`
-	HasProfessionalTax  bool      `json:"has_professional_tax"`
-	HasGratuity         bool      `json:"has_gratuity"`
-	MinimumWage         float64   `json:"minimum_wage"`
-	OvertimeMultiplier  float64   `json:"overtime_multiplier"`
-	ProbationPeriodDays int       `json:"probation_period_days"`
-	NoticePeriodDays    int       `json:"notice_period_days"`
-	AnnualLeaveDays     int       `json:"annual_leave_days"`
-	SickLeaveDays       int       `json:"sick_leave_days"`
-	MaternityLeaveDays  int       `json:"maternity_leave_days"`
-	PaternityLeaveDays  int       `json:"paternity_leave_days"`
-	ConfigJSON          []byte    `json:"config_json,omitempty"`
-	CreatedAt           time.Time `json:"created_at"`
-	UpdatedAt           time.Time `json:"updated_at"`
+	ID                  uuid.UUID    `json:"id"`
+	CountryID           uuid.UUID    `json:"country_id"`
+	HasIncomeTax        bool         `json:"has_income_tax"`
+	HasSocialSecurity   bool         `json:"has_social_security"`
+	HasProfessionalTax  bool         `json:"has_professional_tax"`
+	HasGratuity         bool         `json:"has_gratuity"`
+	MinimumWage         money.Amount `json:"minimum_wage"`
+	OvertimeMultiplier  float64      `json:"overtime_multiplier"`
+	ProbationPeriodDays int          `json:"probation_period_days"`
+	NoticePeriodDays    int          `json:"notice_period_days"`
+	AnnualLeaveDays     int          `json:"annual_leave_days"`
+	SickLeaveDays       int          `json:"sick_leave_days"`
+	MaternityLeaveDays  int          `json:"maternity_leave_days"`
+	PaternityLeaveDays  int          `json:"paternity_leave_days"`
+	ConfigJSON          []byte       `json:"config_json,omitempty"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
 }
@@ -3,6 +3,7 @@ package domain
 import (
 	"time"
 
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -46,19 +47,19 @@ type Employee struct {
 	RelievedAt    *time.Time `json:"relieved_at,omitempty"`
 
 	// Employment Status
-	EmploymentStatus EmploymentStatus `json:"employment_status"`
-	TerminationReason *string         `json:"termination_reason,omitempty"`
+	EmploymentStatus  EmploymentStatus `json:"employment_status"`
+	TerminationReason *string          `json:"termination_reason,omitempty"`
 
 	// Payroll Info
-	ContractType    string     `json:"contract_type"`
-	SalaryCurrency  string     `json:"salary_currency"`
-	BaseSalary      float64    `json:"base_salary"`
-	LeavePolicyID   *uuid.UUID `json:"leave_policy_id,omitempty"`
+	ContractType   string       `json:"contract_type"`
+	SalaryCurrency string       `json:"salary_currency"`
+	BaseSalary     money.Amount `json:"base_salary"`
+	LeavePolicyID  *uuid.UUID   `json:"leave_policy_id,omitempty"`
 
 	// Flags / Controls
-	IsActive                bool  `json:"is_active"`
-	IsSalaryStopped         bool  `json:"is_salary_stopped"`
-	FinalSettlementGenerated bool `json:"final_settlement_generated"`
+	IsActive                 bool       `json:"is_active"`
+	IsSalaryStopped          bool       `json:"is_salary_stopped"`
+	FinalSettlementGenerated bool       `json:"final_settlement_generated"`
 	FinalSettlementDate      *time.Time `json:"final_settlement_date,omitempty"`
 
 	// Timestamps
@@ -3,20 +3,24 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/chaitu35/costeasy/backend/internal/payroll/domain"
 	"github.com/chaitu35/costeasy/backend/internal/payroll/imports/types"
 	"github.com/chaitu35/costeasy/backend/internal/payroll/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
 	"github.com/google/uuid"
 )
 
 type employeeService struct {
-	repo repository.EmployeeRepository
+	repo     repository.EmployeeRepository
+	recorder audit.Recorder
 }
 
-func NewEmployeeService(repo repository.EmployeeRepository) EmployeeService {
-	return &employeeService{repo: repo}
+func NewEmployeeService(repo repository.EmployeeRepository, recorder audit.Recorder) EmployeeService {
+	return &employeeService{repo: repo, recorder: recorder}
 }
 
 // ========================================================
@@ -46,17 +50,36 @@ func (s *employeeService) CreateEmployee(ctx context.Context, emp *domain.Employ
 		emp.JoinedAt = emp.DateOfJoining
 	}
 
+	if !emp.BaseSalary.IsRounded(money.CurrencyOrDefault(emp.SalaryCurrency)) {
+		return nil, fmt.Errorf("base salary %s has more decimal places than %s allows", emp.BaseSalary, emp.SalaryCurrency)
+	}
+
 	err := s.repo.Create(ctx, emp)
 	if err != nil {
 		return nil, fmt.Errorf("create employee failed: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionCreate, nil, emp)
+
 	return emp, nil
 }
 
 func (s *employeeService) UpdateEmployee(ctx context.Context, emp *domain.Employee) error {
+	if !emp.BaseSalary.IsRounded(money.CurrencyOrDefault(emp.SalaryCurrency)) {
+		return fmt.Errorf("base salary %s has more decimal places than %s allows", emp.BaseSalary, emp.SalaryCurrency)
+	}
+	before, err := s.repo.GetByID(ctx, emp.ID)
+	if err != nil {
+		return err
+	}
+
 	emp.UpdatedAt = time.Now()
-	return s.repo.Update(ctx, emp)
+	if err := s.repo.Update(ctx, emp); err != nil {
+		return err
+	}
+
+	s.recordChange(ctx, audit.ActionUpdate, before, emp)
+	return nil
 }
 
 func (s *employeeService) GetEmployeeByID(ctx context.Context, id uuid.UUID) (*domain.Employee, error) {
@@ -80,7 +103,18 @@ func (s *employeeService) CreateFromImport(
 	orgID uuid.UUID,
 	row types.RowValidated,
 ) error {
-	return s.repo.CreateFromImport(ctx, orgID, row)
+	if err := s.repo.CreateFromImport(ctx, orgID, row); err != nil {
+		return err
+	}
+
+	// The ID is assigned by the database, so read the row back for the snapshot
+	emp, err := s.repo.GetByCode(ctx, orgID, row.EmployeeCode)
+	if err != nil {
+		return fmt.Errorf("failed to reload imported employee (%s): %w", row.EmployeeCode, err)
+	}
+
+	s.recordChange(ctx, "IMPORT", nil, emp)
+	return nil
 }
 
 // ========================================================
@@ -97,8 +131,14 @@ func (s *employeeService) TerminateEmployee(ctx context.Context, id uuid.UUID, r
 		return fmt.Errorf("employee cannot be terminated in status: %s", emp.EmploymentStatus)
 	}
 
+	before := *emp
 	emp.Terminate(reason, date)
-	return s.repo.Terminate(ctx, id, reason, date.Format("2006-01-02"))
+	if err := s.repo.Terminate(ctx, id, reason, date.Format("2006-01-02")); err != nil {
+		return err
+	}
+
+	s.recordChange(ctx, "TERMINATE", &before, emp)
+	return nil
 }
 
 func (s *employeeService) RelieveEmployee(ctx context.Context, id uuid.UUID, date time.Time) error {
@@ -111,16 +151,30 @@ func (s *employeeService) RelieveEmployee(ctx context.Context, id uuid.UUID, dat
 		return fmt.Errorf("employee cannot be relieved in status: %s", emp.EmploymentStatus)
 	}
 
+	before := *emp
 	emp.Relieve(date)
-	return s.repo.Relieve(ctx, id, date.Format("2006-01-02"))
+	if err := s.repo.Relieve(ctx, id, date.Format("2006-01-02")); err != nil {
+		return err
+	}
+
+	s.recordChange(ctx, "RELIEVE", &before, emp)
+	return nil
 }
 
 func (s *employeeService) StopSalary(ctx context.Context, id uuid.UUID) error {
-	return s.repo.StopSalary(ctx, id)
+	if err := s.repo.StopSalary(ctx, id); err != nil {
+		return err
+	}
+	s.recordStatusChange(ctx, "STOP_SALARY", id)
+	return nil
 }
 
 func (s *employeeService) ResumeSalary(ctx context.Context, id uuid.UUID) error {
-	return s.repo.ResumeSalary(ctx, id)
+	if err := s.repo.ResumeSalary(ctx, id); err != nil {
+		return err
+	}
+	s.recordStatusChange(ctx, "RESUME_SALARY", id)
+	return nil
 }
 
 func (s *employeeService) GenerateFinalSettlement(ctx context.Context, id uuid.UUID) error {
@@ -134,6 +188,43 @@ func (s *employeeService) GenerateFinalSettlement(ctx context.Context, id uuid.U
 		return fmt.Errorf("final settlement allowed only for relieved or terminated employees")
 	}
 
+	before := *emp
 	emp.MarkFinalSettlement()
-	return s.repo.MarkFinalSettlement(ctx, id)
+	if err := s.repo.MarkFinalSettlement(ctx, id); err != nil {
+		return err
+	}
+
+	s.recordChange(ctx, "FINAL_SETTLEMENT", &before, emp)
+	return nil
+}
+
+// ========================================================
+// AUDIT TRAIL
+// ========================================================
+
+// recordStatusChange records a change made directly in the repository, using
+// the stored employee as the after snapshot
+func (s *employeeService) recordStatusChange(ctx context.Context, action string, id uuid.UUID) {
+	emp, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		log.Printf("⚠️  Audit log: failed to reload employee %s for %s: %v", id, action, err)
+		return
+	}
+	s.recordChange(ctx, action, nil, emp)
+}
+
+func (s *employeeService) recordChange(ctx context.Context, action string, before, after *domain.Employee) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	change := audit.Change{
+		OrganizationID: &subject.OrganizationID,
+		EntityType:     audit.EntityEmployee,
+		EntityID:       subject.ID,
+		Action:         action,
+		Before:         before,
+		After:          after,
+	}
+	audit.Log(ctx, s.recorder, change)
 }
@@ -0,0 +1,216 @@
+// backend/internal/receivables/domain/aging.go
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// AgingBasis is the date an invoice's age is counted from
+type AgingBasis string
+
+const (
+	AgingByInvoiceDate AgingBasis = "INVOICE_DATE"
+	AgingByDueDate     AgingBasis = "DUE_DATE" // Invoices not yet due are 0 days old
+)
+
+// ParseAgingBasis reads an aging basis, defaulting to the invoice date
+func ParseAgingBasis(s string) (AgingBasis, error) {
+	switch basis := AgingBasis(strings.ToUpper(strings.TrimSpace(s))); basis {
+	case "":
+		return AgingByInvoiceDate, nil
+	case AgingByInvoiceDate, AgingByDueDate:
+		return basis, nil
+	default:
+		return "", NewReceivablesErrorf(ErrAgingBasisInvalid, "invalid aging basis: %s", s)
+	}
+}
+
+// Aging bucket labels
+const (
+	AgingBucket0To30  = "0-30"
+	AgingBucket31To60 = "31-60"
+	AgingBucket61To90 = "61-90"
+	AgingBucketOver90 = "90+"
+)
+
+// AgingBuckets splits an outstanding balance by age
+type AgingBuckets struct {
+	Days0To30  money.Amount `json:"days_0_30"`
+	Days31To60 money.Amount `json:"days_31_60"`
+	Days61To90 money.Amount `json:"days_61_90"`
+	Over90     money.Amount `json:"over_90"`
+	Total      money.Amount `json:"total"`
+}
+
+// agingBucket is the label of the bucket an age in days falls in
+func agingBucket(days int) string {
+	switch {
+	case days <= 30:
+		return AgingBucket0To30
+	case days <= 60:
+		return AgingBucket31To60
+	case days <= 90:
+		return AgingBucket61To90
+	default:
+		return AgingBucketOver90
+	}
+}
+
+// add puts an amount in its bucket
+func (b *AgingBuckets) add(bucket string, amount money.Amount) {
+	switch bucket {
+	case AgingBucket0To30:
+		b.Days0To30 += amount
+	case AgingBucket31To60:
+		b.Days31To60 += amount
+	case AgingBucket61To90:
+		b.Days61To90 += amount
+	default:
+		b.Over90 += amount
+	}
+	b.Total += amount
+}
+
+// AgedItem is an invoice with a balance outstanding, or a receipt or credit
+// note with an amount unallocated, as of the report date
+type AgedItem struct {
+	CustomerID   uuid.UUID
+	CustomerCode string
+	CustomerName string
+	CustomerType CustomerType
+	Currency     string
+	DocumentID   uuid.UUID
+	DocumentType DocumentType
+	Number       string
+	Date         time.Time
+	DueDate      time.Time // Invoices only
+	Outstanding  money.Amount
+}
+
+// AgedReceivables is what each customer owes as of a date, by age
+type AgedReceivables struct {
+	AsOf      time.Time      `json:"as_of"`
+	Basis     AgingBasis     `json:"basis"`
+	Customers []AgedCustomer `json:"customers"`
+	Totals    []AgedTotal    `json:"totals"` // One per currency
+}
+
+// AgedCustomer is one customer's line on the aged receivables report
+type AgedCustomer struct {
+	CustomerID       uuid.UUID     `json:"customer_id"`
+	CustomerCode     string        `json:"customer_code"`
+	CustomerName     string        `json:"customer_name"`
+	CustomerType     CustomerType  `json:"customer_type"`
+	Currency         string        `json:"currency"`
+	Buckets          AgingBuckets  `json:"buckets"`
+	UnappliedCredits money.Amount  `json:"unapplied_credits"` // Receipts and credit notes not yet allocated
+	NetBalance       money.Amount  `json:"net_balance"`       // Outstanding invoices less unapplied credits
+	Invoices         []AgedInvoice `json:"invoices"`
+}
+
+// AgedInvoice is an outstanding invoice on the aged receivables report
+type AgedInvoice struct {
+	InvoiceID       uuid.UUID    `json:"invoice_id"`
+	InvoiceNumber   string       `json:"invoice_number"`
+	InvoiceDate     time.Time    `json:"invoice_date"`
+	DueDate         time.Time    `json:"due_date"`
+	DaysOutstanding int          `json:"days_outstanding"`
+	Bucket          string       `json:"bucket"`
+	Outstanding     money.Amount `json:"outstanding"`
+}
+
+// AgedTotal totals the report for one currency
+type AgedTotal struct {
+	Currency         string       `json:"currency"`
+	Buckets          AgingBuckets `json:"buckets"`
+	UnappliedCredits money.Amount `json:"unapplied_credits"`
+	NetBalance       money.Amount `json:"net_balance"`
+}
+
+// BuildAgedReceivables ages the outstanding items as of a date, by customer
+// in the order the items are given. Totals are kept per currency since
+// customers may be invoiced in different ones.
+func BuildAgedReceivables(asOf time.Time, basis AgingBasis, items []AgedItem) *AgedReceivables {
+	report := &AgedReceivables{
+		AsOf:      asOf,
+		Basis:     basis,
+		Customers: []AgedCustomer{},
+		Totals:    []AgedTotal{},
+	}
+
+	index := make(map[uuid.UUID]int)
+	for _, item := range items {
+		i, ok := index[item.CustomerID]
+		if !ok {
+			i = len(report.Customers)
+			index[item.CustomerID] = i
+			report.Customers = append(report.Customers, AgedCustomer{
+				CustomerID:   item.CustomerID,
+				CustomerCode: item.CustomerCode,
+				CustomerName: item.CustomerName,
+				CustomerType: item.CustomerType,
+				Currency:     item.Currency,
+				Invoices:     []AgedInvoice{},
+			})
+		}
+		customer := &report.Customers[i]
+
+		if item.DocumentType != DocumentTypeInvoice {
+			customer.UnappliedCredits += item.Outstanding
+			continue
+		}
+
+		from := item.Date
+		if basis == AgingByDueDate {
+			from = item.DueDate
+		}
+		days := int(asOf.Sub(from).Hours() / 24)
+		if days < 0 {
+			days = 0
+		}
+
+		bucket := agingBucket(days)
+		customer.Buckets.add(bucket, item.Outstanding)
+		customer.Invoices = append(customer.Invoices, AgedInvoice{
+			InvoiceID:       item.DocumentID,
+			InvoiceNumber:   item.Number,
+			InvoiceDate:     item.Date,
+			DueDate:         item.DueDate,
+			DaysOutstanding: days,
+			Bucket:          bucket,
+			Outstanding:     item.Outstanding,
+		})
+	}
+
+	totals := make(map[string]*AgedTotal)
+	for i := range report.Customers {
+		customer := &report.Customers[i]
+		customer.NetBalance = customer.Buckets.Total - customer.UnappliedCredits
+
+		total, ok := totals[customer.Currency]
+		if !ok {
+			total = &AgedTotal{Currency: customer.Currency}
+			totals[customer.Currency] = total
+		}
+		total.Buckets.Days0To30 += customer.Buckets.Days0To30
+		total.Buckets.Days31To60 += customer.Buckets.Days31To60
+		total.Buckets.Days61To90 += customer.Buckets.Days61To90
+		total.Buckets.Over90 += customer.Buckets.Over90
+		total.Buckets.Total += customer.Buckets.Total
+		total.UnappliedCredits += customer.UnappliedCredits
+		total.NetBalance += customer.NetBalance
+	}
+	for _, total := range totals {
+		report.Totals = append(report.Totals, *total)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		return report.Totals[i].Currency < report.Totals[j].Currency
+	})
+
+	return report
+}
@@ -0,0 +1,239 @@
+// backend/internal/receivables/domain/aging_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+func TestAgingBucket(t *testing.T) {
+	tests := []struct {
+		days int
+		want string
+	}{
+		{days: 0, want: AgingBucket0To30},
+		{days: 30, want: AgingBucket0To30},
+		{days: 31, want: AgingBucket31To60},
+		{days: 60, want: AgingBucket31To60},
+		{days: 61, want: AgingBucket61To90},
+		{days: 90, want: AgingBucket61To90},
+		{days: 91, want: AgingBucketOver90},
+		{days: 400, want: AgingBucketOver90},
+	}
+
+	for _, tt := range tests {
+		if got := agingBucket(tt.days); got != tt.want {
+			t.Errorf("agingBucket(%d) = %s, want %s", tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestParseAgingBasis(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    AgingBasis
+		wantErr bool
+	}{
+		{input: "", want: AgingByInvoiceDate},
+		{input: "invoice_date", want: AgingByInvoiceDate},
+		{input: " DUE_DATE ", want: AgingByDueDate},
+		{input: "posting_date", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseAgingBasis(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAgingBasis(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAgingBasis(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildAgedReceivables(t *testing.T) {
+	asOf := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) time.Time { return asOf.AddDate(0, 0, -n) }
+
+	acme, globex := uuid.New(), uuid.New()
+	invoice := func(customer uuid.UUID, currency string, dated, due int, outstanding string) AgedItem {
+		return AgedItem{
+			CustomerID:   customer,
+			Currency:     currency,
+			DocumentID:   uuid.New(),
+			DocumentType: DocumentTypeInvoice,
+			Date:         daysAgo(dated),
+			DueDate:      daysAgo(due),
+			Outstanding:  money.MustParse(outstanding),
+		}
+	}
+	credit := func(customer uuid.UUID, currency string, docType DocumentType, unallocated string) AgedItem {
+		return AgedItem{
+			CustomerID:   customer,
+			Currency:     currency,
+			DocumentID:   uuid.New(),
+			DocumentType: docType,
+			Date:         daysAgo(5),
+			Outstanding:  money.MustParse(unallocated),
+		}
+	}
+
+	items := []AgedItem{
+		invoice(acme, "AED", 10, -20, "100.00"), // Not yet due
+		invoice(acme, "AED", 45, 15, "200.00"),
+		invoice(acme, "AED", 75, 45, "300.00"),
+		invoice(acme, "AED", 120, 90, "400.00"),
+		credit(acme, "AED", DocumentTypeReceipt, "150.00"),
+		invoice(globex, "USD", 31, 1, "50.00"),
+		credit(globex, "USD", DocumentTypeCreditNote, "80.00"),
+	}
+
+	type wantCustomer struct {
+		buckets   [4]string // 0-30, 31-60, 61-90, 90+
+		unapplied string
+		net       string
+	}
+
+	tests := []struct {
+		name      string
+		basis     AgingBasis
+		customers map[uuid.UUID]wantCustomer
+		totals    map[string][4]string
+	}{
+		{
+			name:  "by invoice date",
+			basis: AgingByInvoiceDate,
+			customers: map[uuid.UUID]wantCustomer{
+				acme:   {buckets: [4]string{"100.00", "200.00", "300.00", "400.00"}, unapplied: "150.00", net: "850.00"},
+				globex: {buckets: [4]string{"0", "50.00", "0", "0"}, unapplied: "80.00", net: "-30.00"},
+			},
+			totals: map[string][4]string{
+				"AED": {"100.00", "200.00", "300.00", "400.00"},
+				"USD": {"0", "50.00", "0", "0"},
+			},
+		},
+		{
+			name:  "by due date",
+			basis: AgingByDueDate,
+			customers: map[uuid.UUID]wantCustomer{
+				acme:   {buckets: [4]string{"300.00", "300.00", "400.00", "0"}, unapplied: "150.00", net: "850.00"},
+				globex: {buckets: [4]string{"50.00", "0", "0", "0"}, unapplied: "80.00", net: "-30.00"},
+			},
+			totals: map[string][4]string{
+				"AED": {"300.00", "300.00", "400.00", "0"},
+				"USD": {"50.00", "0", "0", "0"},
+			},
+		},
+	}
+
+	bucketAmounts := func(b AgingBuckets) [4]money.Amount {
+		return [4]money.Amount{b.Days0To30, b.Days31To60, b.Days61To90, b.Over90}
+	}
+	parseBuckets := func(s [4]string) [4]money.Amount {
+		var a [4]money.Amount
+		for i := range s {
+			a[i] = money.MustParse(s[i])
+		}
+		return a
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := BuildAgedReceivables(asOf, tt.basis, items)
+
+			if len(report.Customers) != 2 || report.Customers[0].CustomerID != acme {
+				t.Fatalf("got %d customers, want acme then globex", len(report.Customers))
+			}
+			for _, c := range report.Customers {
+				want := tt.customers[c.CustomerID]
+				if got := bucketAmounts(c.Buckets); got != parseBuckets(want.buckets) {
+					t.Errorf("%s buckets = %v, want %v", c.Currency, got, want.buckets)
+				}
+				if c.Buckets.Total != c.Buckets.Days0To30+c.Buckets.Days31To60+c.Buckets.Days61To90+c.Buckets.Over90 {
+					t.Errorf("%s bucket total %s does not add up", c.Currency, c.Buckets.Total)
+				}
+				if c.UnappliedCredits != money.MustParse(want.unapplied) {
+					t.Errorf("%s unapplied = %s, want %s", c.Currency, c.UnappliedCredits, want.unapplied)
+				}
+				if c.NetBalance != money.MustParse(want.net) {
+					t.Errorf("%s net balance = %s, want %s", c.Currency, c.NetBalance, want.net)
+				}
+			}
+
+			if len(report.Totals) != len(tt.totals) {
+				t.Fatalf("got %d currency totals, want %d", len(report.Totals), len(tt.totals))
+			}
+			if report.Totals[0].Currency != "AED" || report.Totals[1].Currency != "USD" {
+				t.Errorf("totals not in currency order: %s, %s", report.Totals[0].Currency, report.Totals[1].Currency)
+			}
+			for _, total := range report.Totals {
+				if got := bucketAmounts(total.Buckets); got != parseBuckets(tt.totals[total.Currency]) {
+					t.Errorf("%s totals = %v, want %v", total.Currency, got, tt.totals[total.Currency])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildAgedReceivablesInvoiceAge(t *testing.T) {
+	asOf := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	item := AgedItem{
+		CustomerID:   uuid.New(),
+		DocumentType: DocumentTypeInvoice,
+		Date:         time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:      time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC),
+		Outstanding:  money.MustParse("10.00"),
+	}
+
+	tests := []struct {
+		basis      AgingBasis
+		wantDays   int
+		wantBucket string
+	}{
+		{basis: AgingByInvoiceDate, wantDays: 60, wantBucket: AgingBucket31To60},
+		{basis: AgingByDueDate, wantDays: 0, wantBucket: AgingBucket0To30}, // Not yet due
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.basis), func(t *testing.T) {
+			invoices := BuildAgedReceivables(asOf, tt.basis, []AgedItem{item}).Customers[0].Invoices
+			if len(invoices) != 1 {
+				t.Fatalf("got %d invoices, want 1", len(invoices))
+			}
+			if invoices[0].DaysOutstanding != tt.wantDays || invoices[0].Bucket != tt.wantBucket {
+				t.Errorf("aged %d days in %s, want %d days in %s",
+					invoices[0].DaysOutstanding, invoices[0].Bucket, tt.wantDays, tt.wantBucket)
+			}
+		})
+	}
+}
+
+func TestDocumentPaymentStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    DocumentStatus
+		allocated string
+		want      PaymentStatus
+	}{
+		{name: "draft", status: DocumentStatusDraft, allocated: "0", want: ""},
+		{name: "pending approval", status: DocumentStatusPendingApproval, allocated: "0", want: ""},
+		{name: "unpaid", status: DocumentStatusPosted, allocated: "0", want: PaymentStatusUnpaid},
+		{name: "partly paid", status: DocumentStatusPosted, allocated: "40.00", want: PaymentStatusPartial},
+		{name: "paid", status: DocumentStatusPosted, allocated: "100.00", want: PaymentStatusPaid},
+		{name: "void", status: DocumentStatusVoid, allocated: "0", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Document{Status: tt.status, TotalAmount: money.MustParse("100.00"), AmountAllocated: money.MustParse(tt.allocated)}
+			if got := d.PaymentStatus(); got != tt.want {
+				t.Errorf("PaymentStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
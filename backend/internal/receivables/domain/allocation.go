@@ -0,0 +1,87 @@
+// backend/internal/receivables/domain/allocation.go
+package domain
+
+import (
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Allocation settles part of an invoice with a receipt or credit note
+type Allocation struct {
+	ID             uuid.UUID    `json:"id"`
+	OrganizationID uuid.UUID    `json:"organization_id"`
+	CustomerID     uuid.UUID    `json:"customer_id"`
+	SourceType     DocumentType `json:"source_type"` // RECEIPT or CREDIT_NOTE
+	SourceID       uuid.UUID    `json:"source_id"`
+	SourceNumber   string       `json:"source_number,omitempty"` // Read only
+	InvoiceID      uuid.UUID    `json:"invoice_id"`
+	InvoiceNumber  string       `json:"invoice_number,omitempty"` // Read only
+	Amount         money.Amount `json:"amount"`
+	AllocationDate time.Time    `json:"allocation_date"` // The later of the two documents' dates
+	CreatedBy      uuid.UUID    `json:"created_by"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+// Allocate settles amount of an invoice with a receipt or credit note,
+// updating the balances of both documents
+func Allocate(source *Document, inv *Invoice, amount money.Amount, createdBy uuid.UUID) (*Allocation, error) {
+	if source.Type != DocumentTypeReceipt && source.Type != DocumentTypeCreditNote {
+		return nil, NewReceivablesErrorf(ErrAllocationInvalid, "%s documents cannot settle invoices", source.Type)
+	}
+	if !source.IsPosted() {
+		return nil, NewReceivablesErrorf(ErrDocumentInvalidState, "the %s is not posted (status: %s)", source.Type.Label(), source.Status)
+	}
+	if !inv.IsPosted() {
+		return nil, NewReceivablesErrorf(ErrDocumentInvalidState, "invoice %s is not posted (status: %s)", inv.Number, inv.Status)
+	}
+	if source.IsVoiding() {
+		return nil, NewReceivablesErrorf(ErrDocumentInvalidState, "the %s is being voided", source.Type.Label())
+	}
+	if inv.IsVoiding() {
+		return nil, NewReceivablesErrorf(ErrDocumentInvalidState, "invoice %s is being voided", inv.Number)
+	}
+	if source.CustomerID != inv.CustomerID {
+		return nil, NewReceivablesErrorf(ErrAllocationInvalid, "invoice %s belongs to another customer", inv.Number)
+	}
+	if !amount.IsPositive() {
+		return nil, NewReceivablesError("allocation amount must be positive", ErrAllocationInvalid)
+	}
+	if amount > source.Balance() {
+		return nil, NewReceivablesErrorf(ErrAllocationExceeds,
+			"the %s has only %s left to allocate", source.Type.Label(), source.Balance())
+	}
+	if amount > inv.Balance() {
+		return nil, NewReceivablesErrorf(ErrAllocationExceeds,
+			"invoice %s has only %s outstanding", inv.Number, inv.Balance())
+	}
+
+	date := source.Date
+	if inv.Date.After(date) {
+		date = inv.Date
+	}
+
+	source.AmountAllocated += amount
+	inv.AmountAllocated += amount
+
+	return &Allocation{
+		ID:             uuid.New(),
+		OrganizationID: source.OrganizationID,
+		CustomerID:     source.CustomerID,
+		SourceType:     source.Type,
+		SourceID:       source.ID,
+		SourceNumber:   source.Number,
+		InvoiceID:      inv.ID,
+		InvoiceNumber:  inv.Number,
+		Amount:         amount,
+		AllocationDate: date,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// BelongsTo checks if the allocation belongs to the organization
+func (a *Allocation) BelongsTo(orgID uuid.UUID) bool {
+	return a.OrganizationID == orgID
+}
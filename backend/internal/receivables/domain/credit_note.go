@@ -0,0 +1,71 @@
+// backend/internal/receivables/domain/credit_note.go
+package domain
+
+import (
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// CreditNote reduces what a customer owes, such as a refund of an overcharge
+// or an insurer's rejected claim lines. It is settled against invoices like a
+// receipt.
+type CreditNote struct {
+	Document
+	InvoiceID *uuid.UUID     `json:"invoice_id,omitempty"` // Invoice credited; it is allocated there on posting
+	Lines     []DocumentLine `json:"lines,omitempty"`
+}
+
+// Prepare readies a new credit note for saving as a draft
+func (cn *CreditNote) Prepare(customer *Customer, createdBy uuid.UUID) error {
+	if err := cn.prepare(DocumentTypeCreditNote, customer, createdBy); err != nil {
+		return err
+	}
+
+	total, err := prepareLines(cn.Lines, cn.Currency)
+	if err != nil {
+		return err
+	}
+	cn.TotalAmount = total
+	return nil
+}
+
+// CheckInvoice checks the invoice a credit note is raised against can be
+// credited by it
+func (cn *CreditNote) CheckInvoice(inv *Invoice) error {
+	if inv.CustomerID != cn.CustomerID {
+		return NewReceivablesErrorf(ErrDocumentInvalid, "invoice %s belongs to another customer", inv.Number)
+	}
+	if inv.Status == DocumentStatusVoid {
+		return NewReceivablesErrorf(ErrDocumentInvalidState, "invoice %s is void", inv.Number)
+	}
+	if cn.Date.Before(inv.Date) {
+		return NewReceivablesErrorf(ErrDocumentInvalid, "credit note cannot be dated before invoice %s", inv.Number)
+	}
+	return nil
+}
+
+// BuildEntry builds the SALES entry posting the credit note, the reverse of
+// an invoice: each line's revenue account is debited and the customer's
+// receivable account credited
+func (cn *CreditNote) BuildEntry(customer *Customer) *gldomain.JournalEntry {
+	entry := cn.newEntry(gldomain.JournalTypeSales, cn.entryDescription("Credit note"))
+
+	for _, line := range cn.Lines {
+		debit, credit := splitAmount(line.Amount, false)
+		entry.Lines = append(entry.Lines,
+			cn.entryLine(line.AccountID, line.Description, debit, credit),
+			cn.entryLine(customer.ReceivableAccountID, line.Description, credit, debit),
+		)
+	}
+
+	return entry
+}
+
+// Void cancels the credit note, releasing the invoices it was allocated to
+func (cn *CreditNote) Void(voidedBy uuid.UUID, reason string) error {
+	if err := cn.void(voidedBy, reason); err != nil {
+		return err
+	}
+	cn.AmountAllocated = 0
+	return nil
+}
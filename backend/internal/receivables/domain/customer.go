@@ -0,0 +1,115 @@
+// backend/internal/receivables/domain/customer.go
+package domain
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// CustomerType is who a customer is to the organization
+type CustomerType string
+
+const (
+	CustomerTypePatient   CustomerType = "PATIENT"   // Self-paying individual
+	CustomerTypeInsurer   CustomerType = "INSURER"   // Insurance company or TPA billed for claims
+	CustomerTypeCorporate CustomerType = "CORPORATE" // Employer or other company paying on account
+)
+
+// IsValid checks if the customer type is known
+func (t CustomerType) IsValid() bool {
+	switch t {
+	case CustomerTypePatient, CustomerTypeInsurer, CustomerTypeCorporate:
+		return true
+	}
+	return false
+}
+
+// maxPaymentTermsDays caps payment terms at a year
+const maxPaymentTermsDays = 365
+
+// Customer is someone the organization invoices. Their invoices, credit notes
+// and receipts post to a single receivable control account.
+type Customer struct {
+	ID                  uuid.UUID    `json:"id"`
+	OrganizationID      uuid.UUID    `json:"organization_id"`
+	Code                string       `json:"code"`
+	Name                string       `json:"name"`
+	CustomerType        CustomerType `json:"customer_type"`
+	Email               string       `json:"email,omitempty"`
+	Phone               string       `json:"phone,omitempty"`
+	Address             string       `json:"address,omitempty"`
+	TaxNumber           string       `json:"tax_number,omitempty"`
+	Currency            string       `json:"currency"`           // Currency the customer is invoiced in
+	PaymentTermsDays    int          `json:"payment_terms_days"` // Days from invoice to due date
+	ReceivableAccountID uuid.UUID    `json:"receivable_account_id"`
+	IsActive            bool         `json:"is_active"`
+	CreatedBy           uuid.UUID    `json:"created_by"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+}
+
+// Normalize tidies the customer's fields before validation
+func (c *Customer) Normalize() {
+	c.Code = strings.ToUpper(strings.TrimSpace(c.Code))
+	c.Name = strings.TrimSpace(c.Name)
+	c.CustomerType = CustomerType(strings.ToUpper(strings.TrimSpace(string(c.CustomerType))))
+	c.Email = strings.TrimSpace(c.Email)
+	c.Phone = strings.TrimSpace(c.Phone)
+	c.Address = strings.TrimSpace(c.Address)
+	c.TaxNumber = strings.TrimSpace(c.TaxNumber)
+	c.Currency = strings.ToUpper(strings.TrimSpace(c.Currency))
+}
+
+// Validate performs domain validation on Customer
+func (c *Customer) Validate() error {
+	if c.OrganizationID == uuid.Nil {
+		return NewReceivablesError("organization ID is required", ErrCustomerInvalid)
+	}
+	if c.Code == "" {
+		return NewReceivablesError("code is required", ErrCustomerInvalid)
+	}
+	if len(c.Code) > 50 {
+		return NewReceivablesError("code cannot exceed 50 characters", ErrCustomerInvalid)
+	}
+	if c.Name == "" {
+		return NewReceivablesError("name is required", ErrCustomerInvalid)
+	}
+	if len(c.Name) > 255 {
+		return NewReceivablesError("name cannot exceed 255 characters", ErrCustomerInvalid)
+	}
+	if !c.CustomerType.IsValid() {
+		return NewReceivablesErrorf(ErrCustomerInvalid, "invalid customer type: %s", c.CustomerType)
+	}
+	if c.Email != "" {
+		if _, err := mail.ParseAddress(c.Email); err != nil || len(c.Email) > 255 {
+			return NewReceivablesErrorf(ErrCustomerInvalid, "invalid email: %s", c.Email)
+		}
+	}
+	if len(c.Phone) > 50 || len(c.TaxNumber) > 50 {
+		return NewReceivablesError("phone and tax number cannot exceed 50 characters", ErrCustomerInvalid)
+	}
+	if _, err := money.LookupCurrency(c.Currency); err != nil {
+		return NewReceivablesErrorf(ErrCustomerInvalid, "invalid currency: %s", c.Currency)
+	}
+	if c.PaymentTermsDays < 0 || c.PaymentTermsDays > maxPaymentTermsDays {
+		return NewReceivablesErrorf(ErrCustomerInvalid, "payment terms must be between 0 and %d days", maxPaymentTermsDays)
+	}
+	if c.ReceivableAccountID == uuid.Nil {
+		return NewReceivablesError("receivable account is required", ErrCustomerAccountInvalid)
+	}
+	return nil
+}
+
+// BelongsTo checks if the customer belongs to the organization
+func (c *Customer) BelongsTo(orgID uuid.UUID) bool {
+	return c.OrganizationID == orgID
+}
+
+// DueDate is when an invoice dated invoiceDate falls due under the customer's terms
+func (c *Customer) DueDate(invoiceDate time.Time) time.Time {
+	return invoiceDate.AddDate(0, 0, c.PaymentTermsDays)
+}
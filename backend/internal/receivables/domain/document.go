@@ -0,0 +1,328 @@
+// backend/internal/receivables/domain/document.go
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// DocumentType identifies the kind of receivables document
+type DocumentType string
+
+const (
+	DocumentTypeInvoice    DocumentType = "INVOICE"
+	DocumentTypeCreditNote DocumentType = "CREDIT_NOTE"
+	DocumentTypeReceipt    DocumentType = "RECEIPT"
+)
+
+// documentPrefixes number each document type's own sequence, e.g. INV-000001
+var documentPrefixes = map[DocumentType]string{
+	DocumentTypeInvoice:    "INV",
+	DocumentTypeCreditNote: "CN",
+	DocumentTypeReceipt:    "RCT",
+}
+
+// NumberPrefix is the prefix of the document type's numbers
+func (t DocumentType) NumberPrefix() string {
+	return documentPrefixes[t]
+}
+
+// Label names the document type in messages, e.g. "credit note"
+func (t DocumentType) Label() string {
+	return strings.ToLower(strings.ReplaceAll(string(t), "_", " "))
+}
+
+// DocumentStatus is where a document is in its life
+type DocumentStatus string
+
+const (
+	DocumentStatusDraft           DocumentStatus = "DRAFT"            // Saved but not yet in the ledger
+	DocumentStatusPendingApproval DocumentStatus = "PENDING_APPROVAL" // Journal entry awaiting approval
+	DocumentStatusPosted          DocumentStatus = "POSTED"           // Journal entry posted
+	DocumentStatusVoid            DocumentStatus = "VOID"             // Cancelled; any entry was reversed
+)
+
+// PaymentStatus is how much of a posted document has been settled
+type PaymentStatus string
+
+const (
+	PaymentStatusUnpaid  PaymentStatus = "UNPAID"
+	PaymentStatusPartial PaymentStatus = "PARTIALLY_PAID"
+	PaymentStatusPaid    PaymentStatus = "PAID"
+)
+
+// Document holds what invoices, credit notes and receipts have in common
+type Document struct {
+	ID              uuid.UUID      `json:"id"`
+	OrganizationID  uuid.UUID      `json:"organization_id"`
+	CustomerID      uuid.UUID      `json:"customer_id"`
+	CustomerName    string         `json:"customer_name,omitempty"` // Read only
+	Type            DocumentType   `json:"document_type"`
+	Number          string         `json:"number"` // Allocated when saved, e.g. INV-000001
+	Date            time.Time      `json:"date"`
+	Currency        string         `json:"currency"` // The customer's currency
+	Reference       string         `json:"reference,omitempty"`
+	Description     string         `json:"description"`
+	Status          DocumentStatus `json:"status"`
+	TotalAmount     money.Amount   `json:"total_amount"`
+	AmountAllocated money.Amount   `json:"amount_allocated"` // Settled against other documents
+	JournalEntryID  *uuid.UUID     `json:"journal_entry_id,omitempty"`
+	ReversalEntryID *uuid.UUID     `json:"reversal_entry_id,omitempty"`
+	CreatedBy       uuid.UUID      `json:"created_by"`
+	CreatedAt       time.Time      `json:"created_at"`
+	PostedAt        *time.Time     `json:"posted_at,omitempty"`
+	VoidedBy        *uuid.UUID     `json:"voided_by,omitempty"`
+	VoidedAt        *time.Time     `json:"voided_at,omitempty"`
+	VoidReason      string         `json:"void_reason,omitempty"`
+}
+
+// DocumentLine is a priced line on an invoice or credit note
+type DocumentLine struct {
+	ID          uuid.UUID    `json:"id"`
+	LineNumber  int          `json:"line_number"`
+	AccountID   uuid.UUID    `json:"account_id"` // Revenue account credited by an invoice
+	Description string       `json:"description"`
+	Quantity    money.Amount `json:"quantity"`
+	UnitPrice   money.Amount `json:"unit_price"`
+	Amount      money.Amount `json:"amount"` // Quantity times unit price, rounded to the currency
+}
+
+// prepare checks the customer can be billed and readies the document for
+// saving as a draft
+func (d *Document) prepare(docType DocumentType, customer *Customer, createdBy uuid.UUID) error {
+	if !customer.BelongsTo(d.OrganizationID) {
+		return NewReceivablesError("customer not found", ErrCustomerNotFound)
+	}
+	if !customer.IsActive {
+		return NewReceivablesErrorf(ErrCustomerInactive, "customer %s is inactive", customer.Code)
+	}
+	if d.Date.IsZero() {
+		return NewReceivablesError("date is required", ErrDocumentInvalid)
+	}
+
+	d.Currency = strings.ToUpper(strings.TrimSpace(d.Currency))
+	if d.Currency == "" {
+		d.Currency = customer.Currency
+	}
+	if d.Currency != customer.Currency {
+		return NewReceivablesErrorf(ErrDocumentInvalid,
+			"customer %s is invoiced in %s, not %s", customer.Code, customer.Currency, d.Currency)
+	}
+
+	d.Reference = strings.TrimSpace(d.Reference)
+	if len(d.Reference) > 100 {
+		return NewReceivablesError("reference cannot exceed 100 characters", ErrDocumentInvalid)
+	}
+	d.Description = strings.TrimSpace(d.Description)
+	if len(d.Description) > 255 {
+		return NewReceivablesError("description cannot exceed 255 characters", ErrDocumentInvalid)
+	}
+
+	d.ID = uuid.New()
+	d.CustomerID = customer.ID
+	d.CustomerName = customer.Name
+	d.Type = docType
+	d.Status = DocumentStatusDraft
+	d.AmountAllocated = 0
+	d.CreatedBy = createdBy
+	d.CreatedAt = time.Now()
+	return nil
+}
+
+// prepareLines numbers and prices a document's lines, returning their total
+func prepareLines(lines []DocumentLine, currency string) (money.Amount, error) {
+	if len(lines) == 0 {
+		return 0, NewReceivablesError("at least one line is required", ErrDocumentInvalid)
+	}
+
+	cur := money.CurrencyOrDefault(currency)
+	var total money.Amount
+	for i := range lines {
+		line := &lines[i]
+		line.ID = uuid.New()
+		line.LineNumber = i + 1
+		line.Description = strings.TrimSpace(line.Description)
+
+		if line.AccountID == uuid.Nil {
+			return 0, NewReceivablesErrorf(ErrDocumentInvalid, "line %d: account is required", i+1)
+		}
+		if line.Description == "" {
+			return 0, NewReceivablesErrorf(ErrDocumentInvalid, "line %d: description is required", i+1)
+		}
+		if !line.Quantity.IsPositive() {
+			return 0, NewReceivablesErrorf(ErrDocumentInvalid, "line %d: quantity must be positive", i+1)
+		}
+
+		// Quantity carries Scale decimal places, so divide it back out
+		line.Amount = line.UnitPrice.MulRatio(int64(line.Quantity), int64(money.New(1))).Round(cur)
+		if line.Amount.IsZero() {
+			return 0, NewReceivablesErrorf(ErrDocumentInvalid, "line %d: amount cannot be zero", i+1)
+		}
+		total += line.Amount
+	}
+
+	if !total.IsPositive() {
+		return 0, NewReceivablesError("document total must be positive", ErrDocumentInvalid)
+	}
+	return total, nil
+}
+
+// IsPosted checks if the document is in the ledger
+func (d *Document) IsPosted() bool {
+	return d.Status == DocumentStatusPosted
+}
+
+// CanPost checks if the document is a draft, or awaits approval of its
+// entry, and so can be posted
+func (d *Document) CanPost() bool {
+	return d.Status == DocumentStatusDraft || d.Status == DocumentStatusPendingApproval
+}
+
+// IsVoiding checks if a posted document's reversal has been raised but not
+// yet posted, such as while it awaits approval. The document is voided once
+// its reversal posts, and can't be settled meanwhile.
+func (d *Document) IsVoiding() bool {
+	return d.IsPosted() && d.ReversalEntryID != nil
+}
+
+// Balance is the amount not yet settled against other documents
+func (d *Document) Balance() money.Amount {
+	return d.TotalAmount - d.AmountAllocated
+}
+
+// PaymentStatus reports how much of a posted document has been settled; it
+// is empty for drafts and void documents
+func (d *Document) PaymentStatus() PaymentStatus {
+	switch {
+	case !d.IsPosted():
+		return ""
+	case d.AmountAllocated.IsZero():
+		return PaymentStatusUnpaid
+	case d.Balance().IsZero():
+		return PaymentStatusPaid
+	default:
+		return PaymentStatusPartial
+	}
+}
+
+// BelongsTo checks if the document belongs to the organization
+func (d *Document) BelongsTo(orgID uuid.UUID) bool {
+	return d.OrganizationID == orgID
+}
+
+// MarkPendingApproval records the journal entry submitted for approval; the
+// document is posted once the entry is approved
+func (d *Document) MarkPendingApproval(entryID uuid.UUID) error {
+	if !d.CanPost() {
+		return NewReceivablesErrorf(ErrDocumentInvalidState, "only draft documents can be submitted (status: %s)", d.Status)
+	}
+
+	d.Status = DocumentStatusPendingApproval
+	d.JournalEntryID = &entryID
+	return nil
+}
+
+// MarkPosted records the posted journal entry
+func (d *Document) MarkPosted(entryID uuid.UUID) error {
+	if !d.CanPost() {
+		return NewReceivablesErrorf(ErrDocumentInvalidState, "only draft documents can be posted (status: %s)", d.Status)
+	}
+
+	now := time.Now()
+	d.Status = DocumentStatusPosted
+	d.JournalEntryID = &entryID
+	d.PostedAt = &now
+	return nil
+}
+
+// void cancels the document. The caller records the entry reversing it, if
+// it was posted, and saves the document only once that entry has posted.
+func (d *Document) void(voidedBy uuid.UUID, reason string) error {
+	if d.Status == DocumentStatusVoid {
+		return NewReceivablesErrorf(ErrDocumentInvalidState, "%s is already void", d.Number)
+	}
+	if d.Status == DocumentStatusPendingApproval {
+		return NewReceivablesErrorf(ErrDocumentInvalidState, "%s cannot be voided while its entry awaits approval", d.Number)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return NewReceivablesError("a reason is required to void a document", ErrDocumentInvalid)
+	}
+
+	now := time.Now()
+	d.Status = DocumentStatusVoid
+	d.VoidedBy = &voidedBy
+	d.VoidedAt = &now
+	d.VoidReason = reason
+	return nil
+}
+
+// newEntry starts the journal entry posting a document; lines are added by
+// the caller. Lines carry the document currency, and the journal entry
+// service converts them to the base currency.
+func (d *Document) newEntry(journalType gldomain.JournalType, description string) *gldomain.JournalEntry {
+	reference := d.Number
+	if d.Reference != "" {
+		reference = d.Reference
+	}
+
+	return &gldomain.JournalEntry{
+		OrganizationID:  d.OrganizationID,
+		JournalType:     journalType,
+		TransactionDate: d.Date,
+		Reference:       reference,
+		Description:     description,
+		CreatedBy:       d.CreatedBy,
+	}
+}
+
+// entryLine builds a journal line in the document currency
+func (d *Document) entryLine(accountID uuid.UUID, description string, debit, credit money.Amount) gldomain.JournalLine {
+	return gldomain.JournalLine{
+		AccountID:     accountID,
+		Reference:     d.Number,
+		Description:   description,
+		Currency:      d.Currency,
+		ForeignDebit:  debit,
+		ForeignCredit: credit,
+	}
+}
+
+// entryDescription describes a document's journal entry
+func (d *Document) entryDescription(label string) string {
+	description := fmt.Sprintf("%s %s - %s", label, d.Number, d.CustomerName)
+	if d.Description != "" {
+		description += ": " + d.Description
+	}
+	return truncate(description, 500)
+}
+
+// splitAmount returns a line amount as a debit or credit, crediting positive
+// amounts when credit is true
+func splitAmount(amount money.Amount, credit bool) (money.Amount, money.Amount) {
+	if amount.IsNegative() {
+		credit = !credit
+		amount = amount.Abs()
+	}
+	if credit {
+		return 0, amount
+	}
+	return amount, 0
+}
+
+// truncate cuts s to at most n bytes without splitting a character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
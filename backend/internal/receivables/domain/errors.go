@@ -0,0 +1,57 @@
+// backend/internal/receivables/domain/errors.go
+package domain
+
+import "fmt"
+
+// ReceivablesError represents an accounts receivable domain error
+type ReceivablesError struct {
+	Message string
+	Code    string
+}
+
+// Error implements the error interface
+func (e *ReceivablesError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+}
+
+// NewReceivablesError creates a new receivables error
+func NewReceivablesError(message, code string) *ReceivablesError {
+	return &ReceivablesError{
+		Message: message,
+		Code:    code,
+	}
+}
+
+// NewReceivablesErrorf creates a new receivables error with formatted message
+func NewReceivablesErrorf(code, format string, args ...interface{}) *ReceivablesError {
+	return &ReceivablesError{
+		Message: fmt.Sprintf(format, args...),
+		Code:    code,
+	}
+}
+
+// Receivables error codes
+const (
+	// Customer errors
+	ErrCustomerInvalid        = "AR_CUSTOMER_INVALID"
+	ErrCustomerNotFound       = "AR_CUSTOMER_NOT_FOUND"
+	ErrCustomerCodeExists     = "AR_CUSTOMER_CODE_EXISTS"
+	ErrCustomerInactive       = "AR_CUSTOMER_INACTIVE"
+	ErrCustomerAccountInvalid = "AR_CUSTOMER_ACCOUNT_INVALID"
+
+	// Document errors
+	ErrDocumentInvalid      = "AR_DOCUMENT_INVALID"
+	ErrDocumentAccount      = "AR_DOCUMENT_ACCOUNT_INVALID"
+	ErrDocumentInvalidState = "AR_DOCUMENT_INVALID_STATE"
+	ErrInvoiceNotFound      = "AR_INVOICE_NOT_FOUND"
+	ErrCreditNoteNotFound   = "AR_CREDIT_NOTE_NOT_FOUND"
+	ErrReceiptNotFound      = "AR_RECEIPT_NOT_FOUND"
+
+	// Allocation errors
+	ErrAllocationInvalid  = "AR_ALLOCATION_INVALID"
+	ErrAllocationExceeds  = "AR_ALLOCATION_EXCEEDS_BALANCE"
+	ErrAllocationNotFound = "AR_ALLOCATION_NOT_FOUND"
+
+	// Report errors
+	ErrAgingBasisInvalid = "AR_AGING_BASIS_INVALID"
+)
@@ -0,0 +1,72 @@
+// backend/internal/receivables/domain/invoice.go
+package domain
+
+import (
+	"time"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/google/uuid"
+)
+
+// Invoice is a sales invoice billed to a customer, such as a patient bill or
+// an insurance claim
+type Invoice struct {
+	Document
+	DueDate time.Time      `json:"due_date"`
+	Lines   []DocumentLine `json:"lines,omitempty"`
+}
+
+// Prepare readies a new invoice for saving as a draft. The due date defaults
+// to the customer's payment terms.
+func (inv *Invoice) Prepare(customer *Customer, createdBy uuid.UUID) error {
+	if err := inv.prepare(DocumentTypeInvoice, customer, createdBy); err != nil {
+		return err
+	}
+
+	if inv.DueDate.IsZero() {
+		inv.DueDate = customer.DueDate(inv.Date)
+	}
+	if inv.DueDate.Before(inv.Date) {
+		return NewReceivablesError("due date cannot be before the invoice date", ErrDocumentInvalid)
+	}
+
+	total, err := prepareLines(inv.Lines, inv.Currency)
+	if err != nil {
+		return err
+	}
+	inv.TotalAmount = total
+	return nil
+}
+
+// IsOverdue checks if the invoice is unpaid past its due date
+func (inv *Invoice) IsOverdue(asOf time.Time) bool {
+	return inv.IsPosted() && inv.Balance().IsPositive() && asOf.After(inv.DueDate)
+}
+
+// BuildEntry builds the SALES entry posting the invoice: the customer's
+// receivable account is debited and each line's revenue account credited.
+// Each line gets its own receivable line so a foreign currency invoice still
+// balances once every line is converted.
+func (inv *Invoice) BuildEntry(customer *Customer) *gldomain.JournalEntry {
+	entry := inv.newEntry(gldomain.JournalTypeSales, inv.entryDescription("Invoice"))
+
+	for _, line := range inv.Lines {
+		debit, credit := splitAmount(line.Amount, false)
+		entry.Lines = append(entry.Lines,
+			inv.entryLine(customer.ReceivableAccountID, line.Description, debit, credit),
+			inv.entryLine(line.AccountID, line.Description, credit, debit),
+		)
+	}
+
+	return entry
+}
+
+// Void cancels the invoice. Receipts and credit notes allocated to it must be
+// removed first.
+func (inv *Invoice) Void(voidedBy uuid.UUID, reason string) error {
+	if !inv.AmountAllocated.IsZero() {
+		return NewReceivablesErrorf(ErrDocumentInvalidState,
+			"invoice %s has %s settled against it; remove its allocations first", inv.Number, inv.AmountAllocated)
+	}
+	return inv.void(voidedBy, reason)
+}
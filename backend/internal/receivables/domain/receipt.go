@@ -0,0 +1,84 @@
+// backend/internal/receivables/domain/receipt.go
+package domain
+
+import (
+	"strings"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// PaymentMethod is how a customer paid
+type PaymentMethod string
+
+const (
+	PaymentMethodCash         PaymentMethod = "CASH"
+	PaymentMethodCard         PaymentMethod = "CARD"
+	PaymentMethodBankTransfer PaymentMethod = "BANK_TRANSFER" // Including insurer remittances
+	PaymentMethodCheque       PaymentMethod = "CHEQUE"
+)
+
+// IsValid checks if the payment method is known
+func (m PaymentMethod) IsValid() bool {
+	switch m {
+	case PaymentMethodCash, PaymentMethodCard, PaymentMethodBankTransfer, PaymentMethodCheque:
+		return true
+	}
+	return false
+}
+
+// Receipt is money received from a customer. Whatever isn't allocated to
+// invoices stays on the customer's account as a credit.
+type Receipt struct {
+	Document
+	DepositAccountID uuid.UUID     `json:"deposit_account_id"` // Bank or cash account debited
+	PaymentMethod    PaymentMethod `json:"payment_method"`
+}
+
+// Prepare readies a new receipt for saving as a draft
+func (r *Receipt) Prepare(customer *Customer, createdBy uuid.UUID) error {
+	if err := r.prepare(DocumentTypeReceipt, customer, createdBy); err != nil {
+		return err
+	}
+
+	r.PaymentMethod = PaymentMethod(strings.ToUpper(strings.TrimSpace(string(r.PaymentMethod))))
+	if !r.PaymentMethod.IsValid() {
+		return NewReceivablesErrorf(ErrDocumentInvalid, "invalid payment method: %s", r.PaymentMethod)
+	}
+	if r.DepositAccountID == uuid.Nil {
+		return NewReceivablesError("deposit account is required", ErrDocumentAccount)
+	}
+	if r.DepositAccountID == customer.ReceivableAccountID {
+		return NewReceivablesError("deposit account cannot be the customer's receivable account", ErrDocumentAccount)
+	}
+
+	r.TotalAmount = r.TotalAmount.Round(money.CurrencyOrDefault(r.Currency))
+	if !r.TotalAmount.IsPositive() {
+		return NewReceivablesError("amount must be positive", ErrDocumentInvalid)
+	}
+	return nil
+}
+
+// BuildEntry builds the BANK entry posting the receipt: the deposit account
+// is debited and the customer's receivable account credited. The payer's
+// reference is kept on the entry so bank reconciliation can match it.
+func (r *Receipt) BuildEntry(customer *Customer) *gldomain.JournalEntry {
+	description := r.entryDescription("Receipt")
+	entry := r.newEntry(gldomain.JournalTypeBank, description)
+	entry.Lines = []gldomain.JournalLine{
+		r.entryLine(r.DepositAccountID, description, r.TotalAmount, 0),
+		r.entryLine(customer.ReceivableAccountID, description, 0, r.TotalAmount),
+	}
+	return entry
+}
+
+// Void cancels the receipt, such as a bounced cheque, releasing the invoices
+// it was allocated to
+func (r *Receipt) Void(voidedBy uuid.UUID, reason string) error {
+	if err := r.void(voidedBy, reason); err != nil {
+		return err
+	}
+	r.AmountAllocated = 0
+	return nil
+}
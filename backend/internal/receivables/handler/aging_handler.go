@@ -0,0 +1,138 @@
+// backend/internal/receivables/handler/aging_handler.go
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AgingHandler struct {
+	service service.AgingServiceInterface
+}
+
+// NewAgingHandler creates a new aging handler
+func NewAgingHandler(service service.AgingServiceInterface) *AgingHandler {
+	return &AgingHandler{service: service}
+}
+
+// AgedReceivables handles GET /ar-reports/aged-receivables?organization_id=&as_of=&basis=&customer_type=&customer_id=
+// as_of defaults to today and basis to INVOICE_DATE; use DUE_DATE to age by days overdue
+func (h *AgingHandler) AgedReceivables(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	filter := repository.AgingFilter{
+		OrganizationID: orgID,
+		CustomerType:   domain.CustomerType(c.Query("customer_type")),
+	}
+
+	asOf, ok := parseDateQuery(c, "as_of")
+	if !ok {
+		return
+	}
+	if asOf == nil {
+		today := time.Now().Truncate(24 * time.Hour)
+		asOf = &today
+	}
+	filter.AsOf = *asOf
+
+	if value := c.Query("customer_id"); value != "" {
+		customerID, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid customer ID",
+				Message: err.Error(),
+			})
+			return
+		}
+		filter.CustomerID = &customerID
+	}
+
+	basis, err := domain.ParseAgingBasis(c.Query("basis"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid aging basis",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.service.AgedReceivables(c.Request.Context(), filter, basis)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to build aged receivables",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toAgedReceivablesResponse(report))
+}
+
+// toAgedReceivablesResponse converts domain.AgedReceivables to AgedReceivablesResponse
+func toAgedReceivablesResponse(r *domain.AgedReceivables) dto.AgedReceivablesResponse {
+	response := dto.AgedReceivablesResponse{
+		AsOf:      r.AsOf.Format("2006-01-02"),
+		Basis:     string(r.Basis),
+		Customers: make([]dto.AgedCustomerResponse, len(r.Customers)),
+		Totals:    make([]dto.AgedTotalResponse, len(r.Totals)),
+	}
+
+	for i, cu := range r.Customers {
+		invoices := make([]dto.AgedInvoiceResponse, len(cu.Invoices))
+		for j, inv := range cu.Invoices {
+			invoices[j] = dto.AgedInvoiceResponse{
+				InvoiceID:       inv.InvoiceID.String(),
+				InvoiceNumber:   inv.InvoiceNumber,
+				InvoiceDate:     inv.InvoiceDate.Format("2006-01-02"),
+				DueDate:         inv.DueDate.Format("2006-01-02"),
+				DaysOutstanding: inv.DaysOutstanding,
+				Bucket:          inv.Bucket,
+				Outstanding:     inv.Outstanding,
+			}
+		}
+
+		response.Customers[i] = dto.AgedCustomerResponse{
+			CustomerID:       cu.CustomerID.String(),
+			CustomerCode:     cu.CustomerCode,
+			CustomerName:     cu.CustomerName,
+			CustomerType:     string(cu.CustomerType),
+			Currency:         cu.Currency,
+			Buckets:          toAgingBucketsResponse(cu.Buckets),
+			UnappliedCredits: cu.UnappliedCredits,
+			NetBalance:       cu.NetBalance,
+			Invoices:         invoices,
+		}
+	}
+
+	for i, t := range r.Totals {
+		response.Totals[i] = dto.AgedTotalResponse{
+			Currency:         t.Currency,
+			Buckets:          toAgingBucketsResponse(t.Buckets),
+			UnappliedCredits: t.UnappliedCredits,
+			NetBalance:       t.NetBalance,
+		}
+	}
+
+	return response
+}
+
+// toAgingBucketsResponse converts domain.AgingBuckets to AgingBucketsResponse
+func toAgingBucketsResponse(b domain.AgingBuckets) dto.AgingBucketsResponse {
+	return dto.AgingBucketsResponse{
+		Days0To30:  b.Days0To30,
+		Days31To60: b.Days31To60,
+		Days61To90: b.Days61To90,
+		Over90:     b.Over90,
+		Total:      b.Total,
+	}
+}
@@ -0,0 +1,128 @@
+// backend/internal/receivables/handler/allocation_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AllocationHandler struct {
+	service service.AllocationServiceInterface
+}
+
+// NewAllocationHandler creates a new allocation handler
+func NewAllocationHandler(service service.AllocationServiceInterface) *AllocationHandler {
+	return &AllocationHandler{service: service}
+}
+
+// AllocateReceipt handles POST /ar-receipts/:id/allocations?organization_id=
+func (h *AllocationHandler) AllocateReceipt(c *gin.Context) {
+	h.allocate(c, domain.DocumentTypeReceipt)
+}
+
+// AllocateCreditNote handles POST /ar-credit-notes/:id/allocations?organization_id=
+func (h *AllocationHandler) AllocateCreditNote(c *gin.Context) {
+	h.allocate(c, domain.DocumentTypeCreditNote)
+}
+
+// ListReceiptAllocations handles GET /ar-receipts/:id/allocations?organization_id=
+func (h *AllocationHandler) ListReceiptAllocations(c *gin.Context) {
+	h.listSource(c, domain.DocumentTypeReceipt)
+}
+
+// ListCreditNoteAllocations handles GET /ar-credit-notes/:id/allocations?organization_id=
+func (h *AllocationHandler) ListCreditNoteAllocations(c *gin.Context) {
+	h.listSource(c, domain.DocumentTypeCreditNote)
+}
+
+// ListInvoiceAllocations handles GET /ar-invoices/:id/allocations?organization_id=
+func (h *AllocationHandler) ListInvoiceAllocations(c *gin.Context) {
+	orgID, invoiceID, ok := parseOrgAndID(c, "invoice")
+	if !ok {
+		return
+	}
+
+	allocations, err := h.service.ListInvoiceAllocations(c.Request.Context(), orgID, invoiceID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list allocations",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toAllocationResponses(allocations))
+}
+
+// Unallocate handles DELETE /ar-allocations/:id?organization_id=
+func (h *AllocationHandler) Unallocate(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "allocation")
+	if !ok {
+		return
+	}
+
+	if err := h.service.Unallocate(c.Request.Context(), orgID, id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to remove allocation",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// allocate settles invoices with the receipt or credit note in the path
+func (h *AllocationHandler) allocate(c *gin.Context, sourceType domain.DocumentType) {
+	orgID, sourceID, ok := parseOrgAndID(c, sourceType.Label())
+	if !ok {
+		return
+	}
+
+	var req dto.AllocateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+	requests, ok := bindAllocations(c, req.Allocations)
+	if !ok {
+		return
+	}
+
+	allocations, err := h.service.Allocate(c.Request.Context(), orgID, sourceType, sourceID, requests, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to allocate " + sourceType.Label(),
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toAllocationResponses(allocations))
+}
+
+// listSource lists the invoices the receipt or credit note in the path settles
+func (h *AllocationHandler) listSource(c *gin.Context, sourceType domain.DocumentType) {
+	orgID, sourceID, ok := parseOrgAndID(c, sourceType.Label())
+	if !ok {
+		return
+	}
+
+	allocations, err := h.service.ListSourceAllocations(c.Request.Context(), orgID, sourceType, sourceID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to list allocations",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toAllocationResponses(allocations))
+}
@@ -0,0 +1,182 @@
+// backend/internal/receivables/handler/credit_note_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CreditNoteHandler struct {
+	service service.CreditNoteServiceInterface
+}
+
+// NewCreditNoteHandler creates a new credit note handler
+func NewCreditNoteHandler(service service.CreditNoteServiceInterface) *CreditNoteHandler {
+	return &CreditNoteHandler{service: service}
+}
+
+// CreateCreditNote handles POST /ar-credit-notes?organization_id=
+// The credit note is posted straight away and settles the invoice it names;
+// if either step fails it is returned with a warning
+func (h *CreditNoteHandler) CreateCreditNote(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	customerID, err := uuid.Parse(req.CustomerID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid customer ID",
+			Message: err.Error(),
+		})
+		return
+	}
+	var invoiceID *uuid.UUID
+	if req.InvoiceID != "" {
+		id, err := uuid.Parse(req.InvoiceID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid invoice ID",
+				Message: err.Error(),
+			})
+			return
+		}
+		invoiceID = &id
+	}
+	date, ok := parseDate(c, req.CreditNoteDate, "credit note date")
+	if !ok {
+		return
+	}
+	lines, ok := bindLines(c, req.Lines)
+	if !ok {
+		return
+	}
+
+	cn := &domain.CreditNote{
+		Document: domain.Document{
+			OrganizationID: orgID,
+			CustomerID:     customerID,
+			Date:           date,
+			Currency:       req.Currency,
+			Reference:      req.Reference,
+			Description:    req.Description,
+			CreatedBy:      getUserIDFromContext(c),
+		},
+		InvoiceID: invoiceID,
+		Lines:     lines,
+	}
+
+	cn, err = h.service.CreateCreditNote(c.Request.Context(), cn)
+	respondDocument(c, http.StatusCreated, toCreditNoteResponse(cn), err, "Failed to create credit note")
+}
+
+// PostCreditNote handles POST /ar-credit-notes/:id/post?organization_id=
+// Retries posting a draft credit note. If it posts but can't settle its
+// invoice, it is returned with a warning.
+func (h *CreditNoteHandler) PostCreditNote(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "credit note")
+	if !ok {
+		return
+	}
+
+	cn, err := h.service.PostCreditNote(c.Request.Context(), orgID, id, getUserIDFromContext(c))
+	if err != nil && (cn == nil || !cn.IsPosted()) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to post credit note",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	respondDocument(c, http.StatusOK, toCreditNoteResponse(cn), err, "Failed to post credit note")
+}
+
+// VoidCreditNote handles POST /ar-credit-notes/:id/void?organization_id=
+func (h *CreditNoteHandler) VoidCreditNote(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "credit note")
+	if !ok {
+		return
+	}
+	reason, ok := bindVoid(c)
+	if !ok {
+		return
+	}
+
+	cn, err := h.service.VoidCreditNote(c.Request.Context(), orgID, id, getUserIDFromContext(c), reason)
+	respondDocument(c, http.StatusOK, toCreditNoteResponse(cn), err, "Failed to void credit note")
+}
+
+// GetCreditNote handles GET /ar-credit-notes/:id?organization_id=
+func (h *CreditNoteHandler) GetCreditNote(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "credit note")
+	if !ok {
+		return
+	}
+
+	cn, err := h.service.GetCreditNote(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Credit note not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCreditNoteResponse(cn))
+}
+
+// ListCreditNotes handles GET /ar-credit-notes?organization_id=&customer_id=&status=&open=&from=&to=&limit=&offset=
+func (h *CreditNoteHandler) ListCreditNotes(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+	filter, ok := parseDocumentFilter(c, orgID)
+	if !ok {
+		return
+	}
+
+	notes, err := h.service.ListCreditNotes(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list credit notes",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]*dto.DocumentResponse, len(notes))
+	for i, cn := range notes {
+		responses[i] = toCreditNoteResponse(cn)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// toCreditNoteResponse converts domain.CreditNote to DocumentResponse; nil
+// when there is no credit note
+func toCreditNoteResponse(cn *domain.CreditNote) *dto.DocumentResponse {
+	if cn == nil {
+		return nil
+	}
+
+	response := toDocumentResponse(&cn.Document)
+	response.InvoiceID = uuidString(cn.InvoiceID)
+	response.Lines = toLineResponses(cn.Lines)
+	return response
+}
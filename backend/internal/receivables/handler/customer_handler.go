@@ -0,0 +1,235 @@
+// backend/internal/receivables/handler/customer_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/chaitu35/costeasy/backend/pkg/contextx"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CustomerHandler struct {
+	service service.CustomerServiceInterface
+}
+
+// NewCustomerHandler creates a new customer handler
+func NewCustomerHandler(service service.CustomerServiceInterface) *CustomerHandler {
+	return &CustomerHandler{service: service}
+}
+
+// CreateCustomer handles POST /customers?organization_id=
+func (h *CustomerHandler) CreateCustomer(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	customer, _, ok := bindCustomer(c, orgID)
+	if !ok {
+		return
+	}
+	customer.CreatedBy = getUserIDFromContext(c)
+
+	customer, err := h.service.CreateCustomer(c.Request.Context(), customer)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to create customer",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCustomerResponse(customer))
+}
+
+// UpdateCustomer handles PUT /customers/:id?organization_id=
+func (h *CustomerHandler) UpdateCustomer(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "customer")
+	if !ok {
+		return
+	}
+
+	existing, err := h.service.GetCustomer(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Customer not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	customer, isActive, ok := bindCustomer(c, orgID)
+	if !ok {
+		return
+	}
+	customer.ID = id
+	customer.IsActive = existing.IsActive
+	if isActive != nil {
+		customer.IsActive = *isActive
+	}
+
+	customer, err = h.service.UpdateCustomer(c.Request.Context(), customer)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to update customer",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCustomerResponse(customer))
+}
+
+// GetCustomer handles GET /customers/:id?organization_id=
+func (h *CustomerHandler) GetCustomer(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "customer")
+	if !ok {
+		return
+	}
+
+	customer, err := h.service.GetCustomer(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Customer not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCustomerResponse(customer))
+}
+
+// ListCustomers handles GET /customers?organization_id=&customer_type=&search=&include_inactive=
+func (h *CustomerHandler) ListCustomers(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	customers, err := h.service.ListCustomers(c.Request.Context(), repository.CustomerFilter{
+		OrganizationID:  orgID,
+		CustomerType:    domain.CustomerType(c.Query("customer_type")),
+		Search:          c.Query("search"),
+		IncludeInactive: c.Query("include_inactive") == "true",
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list customers",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]dto.CustomerResponse, len(customers))
+	for i, customer := range customers {
+		responses[i] = toCustomerResponse(customer)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// bindCustomer reads a create or update request into a customer, writing the
+// error response and returning false when it is invalid
+func bindCustomer(c *gin.Context, orgID uuid.UUID) (*domain.Customer, *bool, bool) {
+	var req dto.CustomerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	accountID, err := uuid.Parse(req.ReceivableAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid receivable account ID",
+			Message: err.Error(),
+		})
+		return nil, nil, false
+	}
+
+	customer := &domain.Customer{
+		OrganizationID:      orgID,
+		Code:                req.Code,
+		Name:                req.Name,
+		CustomerType:        domain.CustomerType(req.CustomerType),
+		Email:               req.Email,
+		Phone:               req.Phone,
+		Address:             req.Address,
+		TaxNumber:           req.TaxNumber,
+		Currency:            req.Currency,
+		PaymentTermsDays:    req.PaymentTermsDays,
+		ReceivableAccountID: accountID,
+	}
+	return customer, req.IsActive, true
+}
+
+// toCustomerResponse converts domain.Customer to CustomerResponse
+func toCustomerResponse(cu *domain.Customer) dto.CustomerResponse {
+	return dto.CustomerResponse{
+		ID:                  cu.ID.String(),
+		OrganizationID:      cu.OrganizationID.String(),
+		Code:                cu.Code,
+		Name:                cu.Name,
+		CustomerType:        string(cu.CustomerType),
+		Email:               cu.Email,
+		Phone:               cu.Phone,
+		Address:             cu.Address,
+		TaxNumber:           cu.TaxNumber,
+		Currency:            cu.Currency,
+		PaymentTermsDays:    cu.PaymentTermsDays,
+		ReceivableAccountID: cu.ReceivableAccountID.String(),
+		IsActive:            cu.IsActive,
+		CreatedAt:           cu.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           cu.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// parseOrganizationID reads the organization_id query parameter, writing the
+// error response and returning false when it is invalid
+func parseOrganizationID(c *gin.Context) (uuid.UUID, bool) {
+	orgID, err := uuid.Parse(c.Query("organization_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid organization ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, false
+	}
+	return orgID, true
+}
+
+// parseOrgAndID reads the organization and the :id path parameter, writing
+// the error response and returning false when they are invalid
+func parseOrgAndID(c *gin.Context, what string) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid " + what + " ID",
+			Message: err.Error(),
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return orgID, id, true
+}
+
+// getUserIDFromContext returns the authenticated user's ID. Receivables
+// routes all require authentication.
+func getUserIDFromContext(c *gin.Context) uuid.UUID {
+	if uc, ok := contextx.Get(c.Request.Context()); ok {
+		return uc.UserID
+	}
+	return uuid.Nil
+}
@@ -0,0 +1,241 @@
+// backend/internal/receivables/handler/documents.go
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// respondDocument writes the result of creating, posting or voiding a
+// document. When the document was saved but a later step failed, such as
+// posting to a closed period, the document is still returned with the error
+// as a warning, since it now exists and can be posted or allocated later.
+func respondDocument(c *gin.Context, status int, response *dto.DocumentResponse, err error, failure string) {
+	if err != nil && response == nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   failure,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		response.Warning = err.Error()
+	}
+	c.JSON(status, response)
+}
+
+// parseDocumentFilter reads the list filters shared by invoices, credit notes
+// and receipts, writing the error response and returning false when invalid
+func parseDocumentFilter(c *gin.Context, orgID uuid.UUID) (repository.DocumentFilter, bool) {
+	filter := repository.DocumentFilter{
+		OrganizationID: orgID,
+		Status:         domain.DocumentStatus(c.Query("status")),
+		OpenOnly:       c.Query("open") == "true",
+	}
+	filter.Limit, _ = strconv.Atoi(c.DefaultQuery("limit", "50"))
+	filter.Offset, _ = strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	if value := c.Query("customer_id"); value != "" {
+		customerID, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid customer ID",
+				Message: err.Error(),
+			})
+			return filter, false
+		}
+		filter.CustomerID = &customerID
+	}
+
+	var ok bool
+	if filter.FromDate, ok = parseDateQuery(c, "from"); !ok {
+		return filter, false
+	}
+	if filter.ToDate, ok = parseDateQuery(c, "to"); !ok {
+		return filter, false
+	}
+	return filter, true
+}
+
+// bindLines reads the lines of an invoice or credit note request, writing the
+// error response and returning false when one is invalid
+func bindLines(c *gin.Context, reqs []dto.DocumentLineRequest) ([]domain.DocumentLine, bool) {
+	lines := make([]domain.DocumentLine, len(reqs))
+	for i, req := range reqs {
+		accountID, err := uuid.Parse(req.AccountID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid account ID on line " + strconv.Itoa(i+1),
+				Message: err.Error(),
+			})
+			return nil, false
+		}
+
+		quantity := money.New(1)
+		if req.Quantity != nil {
+			quantity = *req.Quantity
+		}
+
+		lines[i] = domain.DocumentLine{
+			AccountID:   accountID,
+			Description: req.Description,
+			Quantity:    quantity,
+			UnitPrice:   req.UnitPrice,
+		}
+	}
+	return lines, true
+}
+
+// bindAllocations reads the invoices a receipt or credit note should settle,
+// writing the error response and returning false when one is invalid
+func bindAllocations(c *gin.Context, reqs []dto.AllocationLineRequest) ([]service.AllocationRequest, bool) {
+	requests := make([]service.AllocationRequest, len(reqs))
+	for i, req := range reqs {
+		invoiceID, err := uuid.Parse(req.InvoiceID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Invalid invoice ID",
+				Message: err.Error(),
+			})
+			return nil, false
+		}
+		requests[i] = service.AllocationRequest{InvoiceID: invoiceID, Amount: req.Amount}
+	}
+	return requests, true
+}
+
+// bindVoid reads the reason for voiding a document, writing the error
+// response and returning false when it is missing
+func bindVoid(c *gin.Context) (string, bool) {
+	var req dto.VoidDocumentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return "", false
+	}
+	return req.Reason, true
+}
+
+// toDocumentResponse converts the common fields of domain.Document to DocumentResponse
+func toDocumentResponse(d *domain.Document) *dto.DocumentResponse {
+	return &dto.DocumentResponse{
+		ID:              d.ID.String(),
+		OrganizationID:  d.OrganizationID.String(),
+		CustomerID:      d.CustomerID.String(),
+		CustomerName:    d.CustomerName,
+		DocumentType:    string(d.Type),
+		Number:          d.Number,
+		Date:            d.Date.Format("2006-01-02"),
+		Currency:        d.Currency,
+		Reference:       d.Reference,
+		Description:     d.Description,
+		Status:          string(d.Status),
+		PaymentStatus:   string(d.PaymentStatus()),
+		TotalAmount:     d.TotalAmount,
+		AmountAllocated: d.AmountAllocated,
+		Balance:         d.Balance(),
+		JournalEntryID:  uuidString(d.JournalEntryID),
+		ReversalEntryID: uuidString(d.ReversalEntryID),
+		CreatedBy:       d.CreatedBy.String(),
+		CreatedAt:       d.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		PostedAt:        timestampString(d.PostedAt),
+		VoidedAt:        timestampString(d.VoidedAt),
+		VoidReason:      d.VoidReason,
+	}
+}
+
+// toLineResponses converts invoice or credit note lines to DocumentLineResponses
+func toLineResponses(lines []domain.DocumentLine) []dto.DocumentLineResponse {
+	var responses []dto.DocumentLineResponse
+	for _, l := range lines {
+		responses = append(responses, dto.DocumentLineResponse{
+			ID:          l.ID.String(),
+			LineNumber:  l.LineNumber,
+			AccountID:   l.AccountID.String(),
+			Description: l.Description,
+			Quantity:    l.Quantity,
+			UnitPrice:   l.UnitPrice,
+			Amount:      l.Amount,
+		})
+	}
+	return responses
+}
+
+// toAllocationResponses converts domain.Allocations to AllocationResponses
+func toAllocationResponses(allocations []*domain.Allocation) []dto.AllocationResponse {
+	responses := make([]dto.AllocationResponse, len(allocations))
+	for i, a := range allocations {
+		responses[i] = dto.AllocationResponse{
+			ID:             a.ID.String(),
+			CustomerID:     a.CustomerID.String(),
+			SourceType:     string(a.SourceType),
+			SourceID:       a.SourceID.String(),
+			SourceNumber:   a.SourceNumber,
+			InvoiceID:      a.InvoiceID.String(),
+			InvoiceNumber:  a.InvoiceNumber,
+			Amount:         a.Amount,
+			AllocationDate: a.AllocationDate.Format("2006-01-02"),
+			CreatedBy:      a.CreatedBy.String(),
+			CreatedAt:      a.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return responses
+}
+
+// parseDate reads a required YYYY-MM-DD date from a request body, writing the
+// error response and returning false when it is invalid
+func parseDate(c *gin.Context, value, what string) (time.Time, bool) {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid " + what,
+			Message: "Use format YYYY-MM-DD",
+		})
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// parseDateQuery reads an optional YYYY-MM-DD query parameter, writing the
+// error response and returning false when it is invalid
+func parseDateQuery(c *gin.Context, param string) (*time.Time, bool) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, true
+	}
+
+	date, ok := parseDate(c, value, param+" date")
+	if !ok {
+		return nil, false
+	}
+	return &date, true
+}
+
+// uuidString formats an optional ID
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+// timestampString formats an optional timestamp
+func timestampString(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02T15:04:05Z07:00")
+	return &s
+}
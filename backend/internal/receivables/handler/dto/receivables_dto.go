@@ -0,0 +1,210 @@
+// backend/internal/receivables/handler/dto/receivables_dto.go
+package dto
+
+import "github.com/chaitu35/costeasy/backend/pkg/money"
+
+// CustomerRequest represents the request body for creating or updating a customer
+type CustomerRequest struct {
+	Code                string `json:"code" binding:"required"`
+	Name                string `json:"name" binding:"required"`
+	CustomerType        string `json:"customer_type" binding:"required"` // PATIENT, INSURER or CORPORATE
+	Email               string `json:"email"`
+	Phone               string `json:"phone"`
+	Address             string `json:"address"`
+	TaxNumber           string `json:"tax_number"`
+	Currency            string `json:"currency"` // Defaults to the base currency; fixed once created
+	PaymentTermsDays    int    `json:"payment_terms_days"`
+	ReceivableAccountID string `json:"receivable_account_id" binding:"required"` // A postable ASSET account
+	IsActive            *bool  `json:"is_active"`                                // Updates only; defaults to unchanged
+}
+
+// CustomerResponse represents a customer
+type CustomerResponse struct {
+	ID                  string `json:"id"`
+	OrganizationID      string `json:"organization_id"`
+	Code                string `json:"code"`
+	Name                string `json:"name"`
+	CustomerType        string `json:"customer_type"`
+	Email               string `json:"email,omitempty"`
+	Phone               string `json:"phone,omitempty"`
+	Address             string `json:"address,omitempty"`
+	TaxNumber           string `json:"tax_number,omitempty"`
+	Currency            string `json:"currency"`
+	PaymentTermsDays    int    `json:"payment_terms_days"`
+	ReceivableAccountID string `json:"receivable_account_id"`
+	IsActive            bool   `json:"is_active"`
+	CreatedAt           string `json:"created_at"`
+	UpdatedAt           string `json:"updated_at"`
+}
+
+// DocumentLineRequest represents a line of an invoice or credit note
+type DocumentLineRequest struct {
+	AccountID   string        `json:"account_id" binding:"required"` // REVENUE, or a LIABILITY such as tax payable
+	Description string        `json:"description" binding:"required"`
+	Quantity    *money.Amount `json:"quantity"` // Defaults to 1
+	UnitPrice   money.Amount  `json:"unit_price"`
+}
+
+// InvoiceRequest represents the request body for creating an invoice
+type InvoiceRequest struct {
+	CustomerID  string                `json:"customer_id" binding:"required"`
+	InvoiceDate string                `json:"invoice_date" binding:"required"` // YYYY-MM-DD
+	DueDate     string                `json:"due_date"`                        // Defaults to the customer's payment terms
+	Currency    string                `json:"currency"`                        // Defaults to the customer's currency
+	Reference   string                `json:"reference"`                       // e.g. claim or encounter number
+	Description string                `json:"description"`
+	Lines       []DocumentLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// CreditNoteRequest represents the request body for creating a credit note
+type CreditNoteRequest struct {
+	CustomerID     string                `json:"customer_id" binding:"required"`
+	InvoiceID      string                `json:"invoice_id"`                          // Invoice credited; settled on posting
+	CreditNoteDate string                `json:"credit_note_date" binding:"required"` // YYYY-MM-DD
+	Currency       string                `json:"currency"`
+	Reference      string                `json:"reference"`
+	Description    string                `json:"description"`
+	Lines          []DocumentLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// AllocationLineRequest asks for part of a receipt or credit note to settle an invoice
+type AllocationLineRequest struct {
+	InvoiceID string       `json:"invoice_id" binding:"required"`
+	Amount    money.Amount `json:"amount"`
+}
+
+// ReceiptRequest represents the request body for recording a receipt
+type ReceiptRequest struct {
+	CustomerID       string                  `json:"customer_id" binding:"required"`
+	ReceiptDate      string                  `json:"receipt_date" binding:"required"` // YYYY-MM-DD
+	Amount           money.Amount            `json:"amount"`
+	DepositAccountID string                  `json:"deposit_account_id" binding:"required"` // Bank or cash ASSET account
+	PaymentMethod    string                  `json:"payment_method" binding:"required"`     // CASH, CARD, BANK_TRANSFER or CHEQUE
+	Currency         string                  `json:"currency"`
+	Reference        string                  `json:"reference"` // e.g. remittance advice or cheque number
+	Description      string                  `json:"description"`
+	Allocations      []AllocationLineRequest `json:"allocations" binding:"dive"` // Invoices settled; the rest stays on account
+}
+
+// AllocateRequest represents the request body for settling invoices with a receipt or credit note
+type AllocateRequest struct {
+	Allocations []AllocationLineRequest `json:"allocations" binding:"required,min=1,dive"`
+}
+
+// VoidDocumentRequest represents the request body for voiding a document
+type VoidDocumentRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// DocumentLineResponse represents a line of an invoice or credit note
+type DocumentLineResponse struct {
+	ID          string       `json:"id"`
+	LineNumber  int          `json:"line_number"`
+	AccountID   string       `json:"account_id"`
+	Description string       `json:"description"`
+	Quantity    money.Amount `json:"quantity"`
+	UnitPrice   money.Amount `json:"unit_price"`
+	Amount      money.Amount `json:"amount"`
+}
+
+// DocumentResponse represents an invoice, credit note or receipt
+type DocumentResponse struct {
+	ID               string                 `json:"id"`
+	OrganizationID   string                 `json:"organization_id"`
+	CustomerID       string                 `json:"customer_id"`
+	CustomerName     string                 `json:"customer_name,omitempty"`
+	DocumentType     string                 `json:"document_type"`
+	Number           string                 `json:"number"`
+	Date             string                 `json:"date"`
+	DueDate          *string                `json:"due_date,omitempty"`   // Invoices only
+	InvoiceID        *string                `json:"invoice_id,omitempty"` // Credit notes only
+	Currency         string                 `json:"currency"`
+	Reference        string                 `json:"reference,omitempty"`
+	Description      string                 `json:"description,omitempty"`
+	Status           string                 `json:"status"`
+	PaymentStatus    string                 `json:"payment_status,omitempty"` // Posted documents only
+	TotalAmount      money.Amount           `json:"total_amount"`
+	AmountAllocated  money.Amount           `json:"amount_allocated"`
+	Balance          money.Amount           `json:"balance"`                      // Outstanding on an invoice; unapplied on a receipt or credit note
+	DepositAccountID *string                `json:"deposit_account_id,omitempty"` // Receipts only
+	PaymentMethod    string                 `json:"payment_method,omitempty"`     // Receipts only
+	JournalEntryID   *string                `json:"journal_entry_id,omitempty"`
+	ReversalEntryID  *string                `json:"reversal_entry_id,omitempty"`
+	CreatedBy        string                 `json:"created_by"`
+	CreatedAt        string                 `json:"created_at"`
+	PostedAt         *string                `json:"posted_at,omitempty"`
+	VoidedAt         *string                `json:"voided_at,omitempty"`
+	VoidReason       string                 `json:"void_reason,omitempty"`
+	Lines            []DocumentLineResponse `json:"lines,omitempty"`
+	Warning          string                 `json:"warning,omitempty"` // Set when the document was saved but not posted, allocated or reversed
+}
+
+// AllocationResponse represents part of a receipt or credit note settling an invoice
+type AllocationResponse struct {
+	ID             string       `json:"id"`
+	CustomerID     string       `json:"customer_id"`
+	SourceType     string       `json:"source_type"`
+	SourceID       string       `json:"source_id"`
+	SourceNumber   string       `json:"source_number,omitempty"`
+	InvoiceID      string       `json:"invoice_id"`
+	InvoiceNumber  string       `json:"invoice_number,omitempty"`
+	Amount         money.Amount `json:"amount"`
+	AllocationDate string       `json:"allocation_date"`
+	CreatedBy      string       `json:"created_by"`
+	CreatedAt      string       `json:"created_at"`
+}
+
+// AgingBucketsResponse represents an outstanding balance split by age in days
+type AgingBucketsResponse struct {
+	Days0To30  money.Amount `json:"days_0_30"`
+	Days31To60 money.Amount `json:"days_31_60"`
+	Days61To90 money.Amount `json:"days_61_90"`
+	Over90     money.Amount `json:"over_90"`
+	Total      money.Amount `json:"total"`
+}
+
+// AgedInvoiceResponse represents an outstanding invoice on the aged receivables report
+type AgedInvoiceResponse struct {
+	InvoiceID       string       `json:"invoice_id"`
+	InvoiceNumber   string       `json:"invoice_number"`
+	InvoiceDate     string       `json:"invoice_date"`
+	DueDate         string       `json:"due_date"`
+	DaysOutstanding int          `json:"days_outstanding"`
+	Bucket          string       `json:"bucket"` // 0-30, 31-60, 61-90 or 90+
+	Outstanding     money.Amount `json:"outstanding"`
+}
+
+// AgedCustomerResponse represents one customer on the aged receivables report
+type AgedCustomerResponse struct {
+	CustomerID       string                `json:"customer_id"`
+	CustomerCode     string                `json:"customer_code"`
+	CustomerName     string                `json:"customer_name"`
+	CustomerType     string                `json:"customer_type"`
+	Currency         string                `json:"currency"`
+	Buckets          AgingBucketsResponse  `json:"buckets"`
+	UnappliedCredits money.Amount          `json:"unapplied_credits"` // Receipts and credit notes not yet allocated
+	NetBalance       money.Amount          `json:"net_balance"`
+	Invoices         []AgedInvoiceResponse `json:"invoices"`
+}
+
+// AgedTotalResponse represents the report totals for one currency
+type AgedTotalResponse struct {
+	Currency         string               `json:"currency"`
+	Buckets          AgingBucketsResponse `json:"buckets"`
+	UnappliedCredits money.Amount         `json:"unapplied_credits"`
+	NetBalance       money.Amount         `json:"net_balance"`
+}
+
+// AgedReceivablesResponse represents the aged receivables report
+type AgedReceivablesResponse struct {
+	AsOf      string                 `json:"as_of"`
+	Basis     string                 `json:"basis"` // INVOICE_DATE or DUE_DATE
+	Customers []AgedCustomerResponse `json:"customers"`
+	Totals    []AgedTotalResponse    `json:"totals"`
+}
+
+// ErrorResponse represents error response structure
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,177 @@
+// backend/internal/receivables/handler/invoice_handler.go
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InvoiceHandler struct {
+	service service.InvoiceServiceInterface
+}
+
+// NewInvoiceHandler creates a new invoice handler
+func NewInvoiceHandler(service service.InvoiceServiceInterface) *InvoiceHandler {
+	return &InvoiceHandler{service: service}
+}
+
+// CreateInvoice handles POST /ar-invoices?organization_id=
+// The invoice is posted straight away; if the ledger rejects it, it is kept
+// as a draft and returned with a warning
+func (h *InvoiceHandler) CreateInvoice(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.InvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	customerID, err := uuid.Parse(req.CustomerID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid customer ID",
+			Message: err.Error(),
+		})
+		return
+	}
+	invoiceDate, ok := parseDate(c, req.InvoiceDate, "invoice date")
+	if !ok {
+		return
+	}
+	var dueDate time.Time
+	if req.DueDate != "" {
+		if dueDate, ok = parseDate(c, req.DueDate, "due date"); !ok {
+			return
+		}
+	}
+	lines, ok := bindLines(c, req.Lines)
+	if !ok {
+		return
+	}
+
+	inv := &domain.Invoice{
+		Document: domain.Document{
+			OrganizationID: orgID,
+			CustomerID:     customerID,
+			Date:           invoiceDate,
+			Currency:       req.Currency,
+			Reference:      req.Reference,
+			Description:    req.Description,
+			CreatedBy:      getUserIDFromContext(c),
+		},
+		DueDate: dueDate,
+		Lines:   lines,
+	}
+
+	inv, err = h.service.CreateInvoice(c.Request.Context(), inv)
+	respondDocument(c, http.StatusCreated, toInvoiceResponse(inv), err, "Failed to create invoice")
+}
+
+// PostInvoice handles POST /ar-invoices/:id/post?organization_id=
+// Retries posting a draft invoice
+func (h *InvoiceHandler) PostInvoice(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "invoice")
+	if !ok {
+		return
+	}
+
+	inv, err := h.service.PostInvoice(c.Request.Context(), orgID, id, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to post invoice",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toInvoiceResponse(inv))
+}
+
+// VoidInvoice handles POST /ar-invoices/:id/void?organization_id=
+func (h *InvoiceHandler) VoidInvoice(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "invoice")
+	if !ok {
+		return
+	}
+	reason, ok := bindVoid(c)
+	if !ok {
+		return
+	}
+
+	inv, err := h.service.VoidInvoice(c.Request.Context(), orgID, id, getUserIDFromContext(c), reason)
+	respondDocument(c, http.StatusOK, toInvoiceResponse(inv), err, "Failed to void invoice")
+}
+
+// GetInvoice handles GET /ar-invoices/:id?organization_id=
+func (h *InvoiceHandler) GetInvoice(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "invoice")
+	if !ok {
+		return
+	}
+
+	inv, err := h.service.GetInvoice(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Invoice not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toInvoiceResponse(inv))
+}
+
+// ListInvoices handles GET /ar-invoices?organization_id=&customer_id=&status=&open=&from=&to=&limit=&offset=
+func (h *InvoiceHandler) ListInvoices(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+	filter, ok := parseDocumentFilter(c, orgID)
+	if !ok {
+		return
+	}
+
+	invoices, err := h.service.ListInvoices(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list invoices",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]*dto.DocumentResponse, len(invoices))
+	for i, inv := range invoices {
+		responses[i] = toInvoiceResponse(inv)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// toInvoiceResponse converts domain.Invoice to DocumentResponse; nil when
+// there is no invoice
+func toInvoiceResponse(inv *domain.Invoice) *dto.DocumentResponse {
+	if inv == nil {
+		return nil
+	}
+
+	response := toDocumentResponse(&inv.Document)
+	dueDate := inv.DueDate.Format("2006-01-02")
+	response.DueDate = &dueDate
+	response.Lines = toLineResponses(inv.Lines)
+	return response
+}
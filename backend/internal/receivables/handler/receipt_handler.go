@@ -0,0 +1,178 @@
+// backend/internal/receivables/handler/receipt_handler.go
+package handler
+
+import (
+	"net/http"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler/dto"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReceiptHandler struct {
+	service service.ReceiptServiceInterface
+}
+
+// NewReceiptHandler creates a new receipt handler
+func NewReceiptHandler(service service.ReceiptServiceInterface) *ReceiptHandler {
+	return &ReceiptHandler{service: service}
+}
+
+// CreateReceipt handles POST /ar-receipts?organization_id=
+// The receipt is posted straight away and settles the invoices listed; if
+// either step fails it is returned with a warning
+func (h *ReceiptHandler) CreateReceipt(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReceiptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	customerID, err := uuid.Parse(req.CustomerID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid customer ID",
+			Message: err.Error(),
+		})
+		return
+	}
+	depositAccountID, err := uuid.Parse(req.DepositAccountID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid deposit account ID",
+			Message: err.Error(),
+		})
+		return
+	}
+	date, ok := parseDate(c, req.ReceiptDate, "receipt date")
+	if !ok {
+		return
+	}
+	allocations, ok := bindAllocations(c, req.Allocations)
+	if !ok {
+		return
+	}
+
+	rc := &domain.Receipt{
+		Document: domain.Document{
+			OrganizationID: orgID,
+			CustomerID:     customerID,
+			Date:           date,
+			Currency:       req.Currency,
+			Reference:      req.Reference,
+			Description:    req.Description,
+			TotalAmount:    req.Amount,
+			CreatedBy:      getUserIDFromContext(c),
+		},
+		DepositAccountID: depositAccountID,
+		PaymentMethod:    domain.PaymentMethod(req.PaymentMethod),
+	}
+
+	rc, err = h.service.CreateReceipt(c.Request.Context(), rc, allocations)
+	respondDocument(c, http.StatusCreated, toReceiptResponse(rc), err, "Failed to create receipt")
+}
+
+// PostReceipt handles POST /ar-receipts/:id/post?organization_id=
+// Retries posting a draft receipt; allocate it once posted
+func (h *ReceiptHandler) PostReceipt(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "receipt")
+	if !ok {
+		return
+	}
+
+	rc, err := h.service.PostReceipt(c.Request.Context(), orgID, id, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Failed to post receipt",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReceiptResponse(rc))
+}
+
+// VoidReceipt handles POST /ar-receipts/:id/void?organization_id=
+func (h *ReceiptHandler) VoidReceipt(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "receipt")
+	if !ok {
+		return
+	}
+	reason, ok := bindVoid(c)
+	if !ok {
+		return
+	}
+
+	rc, err := h.service.VoidReceipt(c.Request.Context(), orgID, id, getUserIDFromContext(c), reason)
+	respondDocument(c, http.StatusOK, toReceiptResponse(rc), err, "Failed to void receipt")
+}
+
+// GetReceipt handles GET /ar-receipts/:id?organization_id=
+func (h *ReceiptHandler) GetReceipt(c *gin.Context) {
+	orgID, id, ok := parseOrgAndID(c, "receipt")
+	if !ok {
+		return
+	}
+
+	rc, err := h.service.GetReceipt(c.Request.Context(), orgID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Receipt not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReceiptResponse(rc))
+}
+
+// ListReceipts handles GET /ar-receipts?organization_id=&customer_id=&status=&open=&from=&to=&limit=&offset=
+func (h *ReceiptHandler) ListReceipts(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+	filter, ok := parseDocumentFilter(c, orgID)
+	if !ok {
+		return
+	}
+
+	receipts, err := h.service.ListReceipts(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list receipts",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	responses := make([]*dto.DocumentResponse, len(receipts))
+	for i, rc := range receipts {
+		responses[i] = toReceiptResponse(rc)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// toReceiptResponse converts domain.Receipt to DocumentResponse; nil when
+// there is no receipt
+func toReceiptResponse(rc *domain.Receipt) *dto.DocumentResponse {
+	if rc == nil {
+		return nil
+	}
+
+	response := toDocumentResponse(&rc.Document)
+	response.DepositAccountID = uuidString(&rc.DepositAccountID)
+	response.PaymentMethod = string(rc.PaymentMethod)
+	return response
+}
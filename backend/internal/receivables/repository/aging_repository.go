@@ -0,0 +1,117 @@
+// backend/internal/receivables/repository/aging_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AgingRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAgingRepository creates a new aged receivables repository
+func NewAgingRepository(pool *pgxpool.Pool) *AgingRepository {
+	return &AgingRepository{pool: pool}
+}
+
+// agedItemsQuery reconstructs each document's open balance as of $2 from the
+// allocations dated on or before it. Documents voided after $2 were still
+// open then, so they are included.
+const agedItemsQuery = `
+        WITH items AS (
+            SELECT i.customer_id, i.currency, i.id AS document_id, 'INVOICE' AS document_type,
+                   i.invoice_number AS number, i.invoice_date AS document_date, i.due_date,
+                   i.total_amount - COALESCE((
+                       SELECT SUM(a.amount) FROM ar_allocations a
+                       WHERE a.invoice_id = i.id AND a.allocation_date <= $2::date
+                   ), 0) AS outstanding
+            FROM ar_invoices i
+            WHERE i.organization_id = $1
+              AND i.invoice_date <= $2::date
+              AND i.journal_entry_id IS NOT NULL
+              AND (i.status = 'POSTED' OR i.voided_at::date > $2::date)
+
+            UNION ALL
+
+            SELECT cn.customer_id, cn.currency, cn.id, 'CREDIT_NOTE', cn.credit_note_number,
+                   cn.credit_note_date, cn.credit_note_date,
+                   cn.total_amount - COALESCE((
+                       SELECT SUM(a.amount) FROM ar_allocations a
+                       WHERE a.source_type = 'CREDIT_NOTE' AND a.source_id = cn.id
+                         AND a.allocation_date <= $2::date
+                   ), 0)
+            FROM ar_credit_notes cn
+            WHERE cn.organization_id = $1
+              AND cn.credit_note_date <= $2::date
+              AND cn.journal_entry_id IS NOT NULL
+              AND (cn.status = 'POSTED' OR cn.voided_at::date > $2::date)
+
+            UNION ALL
+
+            SELECT r.customer_id, r.currency, r.id, 'RECEIPT', r.receipt_number,
+                   r.receipt_date, r.receipt_date,
+                   r.total_amount - COALESCE((
+                       SELECT SUM(a.amount) FROM ar_allocations a
+                       WHERE a.source_type = 'RECEIPT' AND a.source_id = r.id
+                         AND a.allocation_date <= $2::date
+                   ), 0)
+            FROM ar_receipts r
+            WHERE r.organization_id = $1
+              AND r.receipt_date <= $2::date
+              AND r.journal_entry_id IS NOT NULL
+              AND (r.status = 'POSTED' OR r.voided_at::date > $2::date)
+        )
+        SELECT c.id, c.code, c.name, c.customer_type, items.currency, items.document_id,
+               items.document_type, items.number, items.document_date, items.due_date,
+               items.outstanding
+        FROM items
+        JOIN customers c ON c.id = items.customer_id
+        WHERE items.outstanding > 0
+          AND ($3 = '' OR c.customer_type = $3)
+          AND ($4::uuid IS NULL OR c.id = $4)
+        ORDER BY c.name, c.id, items.document_date, items.number
+`
+
+// ListAgedItems lists the invoices with a balance outstanding, and the
+// receipts and credit notes with an amount unallocated, as of a date, by
+// customer name then date
+func (r *AgingRepository) ListAgedItems(ctx context.Context, filter AgingFilter) ([]domain.AgedItem, error) {
+	rows, err := r.pool.Query(ctx, agedItemsQuery,
+		filter.OrganizationID,
+		filter.AsOf,
+		string(filter.CustomerType),
+		filter.CustomerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list aged receivables: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.AgedItem
+	for rows.Next() {
+		var item domain.AgedItem
+		err := rows.Scan(
+			&item.CustomerID,
+			&item.CustomerCode,
+			&item.CustomerName,
+			&item.CustomerType,
+			&item.Currency,
+			&item.DocumentID,
+			&item.DocumentType,
+			&item.Number,
+			&item.Date,
+			&item.DueDate,
+			&item.Outstanding,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan aged receivable: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
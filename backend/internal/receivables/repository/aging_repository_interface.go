@@ -0,0 +1,25 @@
+// backend/internal/receivables/repository/aging_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// AgingRepositoryInterface defines data access for the aged receivables report
+type AgingRepositoryInterface interface {
+	// ListAgedItems lists the open invoices, receipts and credit notes as of a date
+	ListAgedItems(ctx context.Context, filter AgingFilter) ([]domain.AgedItem, error)
+}
+
+// AgingFilter selects the documents on an aged receivables report; empty
+// fields match everything
+type AgingFilter struct {
+	OrganizationID uuid.UUID
+	AsOf           time.Time
+	CustomerType   domain.CustomerType
+	CustomerID     *uuid.UUID
+}
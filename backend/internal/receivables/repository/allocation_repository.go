@@ -0,0 +1,234 @@
+// backend/internal/receivables/repository/allocation_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AllocationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAllocationRepository creates a new allocation repository
+func NewAllocationRepository(pool *pgxpool.Pool) *AllocationRepository {
+	return &AllocationRepository{pool: pool}
+}
+
+const allocationColumns = `
+        a.id, a.organization_id, a.customer_id, a.source_type, a.source_id,
+        COALESCE(r.receipt_number, cn.credit_note_number, ''), a.invoice_id,
+        i.invoice_number, a.amount, a.allocation_date, a.created_by, a.created_at
+`
+
+const allocationFrom = `
+        FROM ar_allocations a
+        JOIN ar_invoices i ON i.id = a.invoice_id
+        LEFT JOIN ar_receipts r ON a.source_type = 'RECEIPT' AND r.id = a.source_id
+        LEFT JOIN ar_credit_notes cn ON a.source_type = 'CREDIT_NOTE' AND cn.id = a.source_id
+`
+
+// Create saves allocations and adds them to their documents' allocated
+// amounts in one transaction. The balances are checked again as they are
+// updated, so concurrent allocations can't settle more than a document holds.
+func (r *AllocationRepository) Create(ctx context.Context, allocations []*domain.Allocation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO ar_allocations (
+            id, organization_id, customer_id, source_type, source_id, invoice_id,
+            amount, allocation_date, created_by, created_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+
+	for _, a := range allocations {
+		if err := adjustAllocated(ctx, tx, domain.DocumentTypeInvoice, a.InvoiceID, a.Amount); err != nil {
+			return err
+		}
+		if err := adjustAllocated(ctx, tx, a.SourceType, a.SourceID, a.Amount); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, query,
+			a.ID,
+			a.OrganizationID,
+			a.CustomerID,
+			a.SourceType,
+			a.SourceID,
+			a.InvoiceID,
+			a.Amount,
+			a.AllocationDate,
+			a.CreatedBy,
+			a.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create allocation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit allocations: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes an allocation, returning its amount to both documents
+func (r *AllocationRepository) Delete(ctx context.Context, a *domain.Allocation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, "DELETE FROM ar_allocations WHERE id = $1", a.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete allocation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.NewReceivablesError("allocation not found", domain.ErrAllocationNotFound)
+	}
+
+	if err := adjustAllocated(ctx, tx, domain.DocumentTypeInvoice, a.InvoiceID, -a.Amount); err != nil {
+		return err
+	}
+	if err := adjustAllocated(ctx, tx, a.SourceType, a.SourceID, -a.Amount); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit allocation removal: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an allocation
+func (r *AllocationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Allocation, error) {
+	allocations, err := r.queryAllocations(ctx, "SELECT"+allocationColumns+allocationFrom+"WHERE a.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(allocations) == 0 {
+		return nil, domain.NewReceivablesError("allocation not found", domain.ErrAllocationNotFound)
+	}
+	return allocations[0], nil
+}
+
+// ListByInvoice lists the receipts and credit notes settling an invoice, in date order
+func (r *AllocationRepository) ListByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*domain.Allocation, error) {
+	query := "SELECT" + allocationColumns + allocationFrom + `
+        WHERE a.invoice_id = $1
+        ORDER BY a.allocation_date, a.created_at
+    `
+	return r.queryAllocations(ctx, query, invoiceID)
+}
+
+// ListBySource lists the invoices a receipt or credit note settles, in date order
+func (r *AllocationRepository) ListBySource(ctx context.Context, sourceType domain.DocumentType, sourceID uuid.UUID) ([]*domain.Allocation, error) {
+	query := "SELECT" + allocationColumns + allocationFrom + `
+        WHERE a.source_type = $1 AND a.source_id = $2
+        ORDER BY a.allocation_date, a.created_at
+    `
+	return r.queryAllocations(ctx, query, sourceType, sourceID)
+}
+
+// queryAllocations runs a query selecting allocationColumns
+func (r *AllocationRepository) queryAllocations(ctx context.Context, query string, args ...interface{}) ([]*domain.Allocation, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list allocations: %w", err)
+	}
+	defer rows.Close()
+
+	var allocations []*domain.Allocation
+	for rows.Next() {
+		var a domain.Allocation
+		err := rows.Scan(
+			&a.ID,
+			&a.OrganizationID,
+			&a.CustomerID,
+			&a.SourceType,
+			&a.SourceID,
+			&a.SourceNumber,
+			&a.InvoiceID,
+			&a.InvoiceNumber,
+			&a.Amount,
+			&a.AllocationDate,
+			&a.CreatedBy,
+			&a.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan allocation: %w", err)
+		}
+		allocations = append(allocations, &a)
+	}
+
+	return allocations, rows.Err()
+}
+
+// adjustAllocated adds delta to a posted document's allocated amount,
+// refusing to take it past the document's total or below zero, or to
+// allocate more of a document being voided
+func adjustAllocated(ctx context.Context, tx pgx.Tx, docType domain.DocumentType, id uuid.UUID, delta money.Amount) error {
+	query := fmt.Sprintf(`
+        UPDATE %s
+        SET amount_allocated = amount_allocated + $2
+        WHERE id = $1
+          AND status = 'POSTED'
+          AND ($2 < 0 OR reversal_entry_id IS NULL)
+          AND amount_allocated + $2 BETWEEN 0 AND total_amount
+    `, documentTables[docType])
+
+	result, err := tx.Exec(ctx, query, id, delta)
+	if err != nil {
+		return fmt.Errorf("failed to update allocated amount: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.NewReceivablesErrorf(domain.ErrAllocationExceeds,
+			"%s has changed and has too little left to allocate; reload it and try again", docType.Label())
+	}
+
+	return nil
+}
+
+// releaseAllocations removes every allocation of a receipt or credit note
+// being voided, returning the amounts to the invoices it settled
+func releaseAllocations(ctx context.Context, tx pgx.Tx, sourceType domain.DocumentType, sourceID uuid.UUID) error {
+	query := `
+        UPDATE ar_invoices i
+        SET amount_allocated = i.amount_allocated - a.amount
+        FROM (
+            SELECT invoice_id, SUM(amount) AS amount
+            FROM ar_allocations
+            WHERE source_type = $1 AND source_id = $2
+            GROUP BY invoice_id
+        ) a
+        WHERE i.id = a.invoice_id
+    `
+	if _, err := tx.Exec(ctx, query, sourceType, sourceID); err != nil {
+		return fmt.Errorf("failed to release allocations: %w", err)
+	}
+
+	_, err := tx.Exec(ctx, "DELETE FROM ar_allocations WHERE source_type = $1 AND source_id = $2", sourceType, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete allocations: %w", err)
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET amount_allocated = 0 WHERE id = $1", documentTables[sourceType])
+	if _, err := tx.Exec(ctx, query, sourceID); err != nil {
+		return fmt.Errorf("failed to release allocations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+// backend/internal/receivables/repository/allocation_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// AllocationRepositoryInterface defines data access for allocations of
+// receipts and credit notes to invoices
+type AllocationRepositoryInterface interface {
+	// Create saves allocations and adds them to their documents' allocated amounts
+	Create(ctx context.Context, allocations []*domain.Allocation) error
+
+	// Delete removes an allocation, returning its amount to both documents
+	Delete(ctx context.Context, allocation *domain.Allocation) error
+
+	// GetByID retrieves an allocation
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Allocation, error)
+
+	// ListByInvoice lists the receipts and credit notes settling an invoice
+	ListByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*domain.Allocation, error)
+
+	// ListBySource lists the invoices a receipt or credit note settles
+	ListBySource(ctx context.Context, sourceType domain.DocumentType, sourceID uuid.UUID) ([]*domain.Allocation, error)
+}
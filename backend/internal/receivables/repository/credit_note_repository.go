@@ -0,0 +1,162 @@
+// backend/internal/receivables/repository/credit_note_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CreditNoteRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCreditNoteRepository creates a new credit note repository
+func NewCreditNoteRepository(pool *pgxpool.Pool) *CreditNoteRepository {
+	return &CreditNoteRepository{pool: pool}
+}
+
+var creditNoteColumns = documentColumns("cn", "credit_note_number", "credit_note_date") + ", cn.invoice_id\n"
+
+// Create saves a new draft credit note with its lines, giving it the next credit note number
+func (r *CreditNoteRepository) Create(ctx context.Context, cn *domain.CreditNote) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cn.Number, err = nextDocumentNumber(ctx, tx, cn.OrganizationID, domain.DocumentTypeCreditNote)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO ar_credit_notes (
+            id, organization_id, customer_id, invoice_id, credit_note_number,
+            credit_note_date, currency, reference, description, status, total_amount,
+            amount_allocated, created_by, created_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10, $11, $12, $13, $14)
+    `
+
+	_, err = tx.Exec(ctx, query,
+		cn.ID,
+		cn.OrganizationID,
+		cn.CustomerID,
+		cn.InvoiceID,
+		cn.Number,
+		cn.Date,
+		cn.Currency,
+		cn.Reference,
+		cn.Description,
+		cn.Status,
+		cn.TotalAmount,
+		cn.AmountAllocated,
+		cn.CreatedBy,
+		cn.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create credit note: %w", err)
+	}
+
+	if err := insertDocumentLines(ctx, tx, "ar_credit_note_lines", "credit_note_id", cn.ID, cn.Lines); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit credit note: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus saves a credit note's posting or voiding. Voiding removes its
+// allocations in the same transaction.
+func (r *CreditNoteRepository) UpdateStatus(ctx context.Context, cn *domain.CreditNote) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if cn.Status == domain.DocumentStatusVoid {
+		if err := releaseAllocations(ctx, tx, domain.DocumentTypeCreditNote, cn.ID); err != nil {
+			return err
+		}
+	}
+
+	ok, err := updateDocumentStatus(ctx, tx, "ar_credit_notes", &cn.Document, "")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState,
+			"credit note %s has changed since it was read; reload it and try again", cn.Number)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit credit note: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a credit note with its lines
+func (r *CreditNoteRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.CreditNote, error) {
+	query := "SELECT" + creditNoteColumns + `
+        FROM ar_credit_notes cn
+        JOIN customers c ON c.id = cn.customer_id
+        WHERE cn.id = $1
+    `
+
+	cn, err := scanCreditNote(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewReceivablesError("credit note not found", domain.ErrCreditNoteNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credit note: %w", err)
+	}
+
+	cn.Lines, err = listDocumentLines(ctx, r.pool, "ar_credit_note_lines", "credit_note_id", cn.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cn, nil
+}
+
+// List lists an organization's credit notes, latest first, without their lines
+func (r *CreditNoteRepository) List(ctx context.Context, filter DocumentFilter) ([]*domain.CreditNote, error) {
+	query := documentListQuery(creditNoteColumns, "ar_credit_notes", "cn", "credit_note_number", "credit_note_date")
+
+	rows, err := r.pool.Query(ctx, query, documentListArgs(filter)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit notes: %w", err)
+	}
+	defer rows.Close()
+
+	var creditNotes []*domain.CreditNote
+	for rows.Next() {
+		cn, err := scanCreditNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan credit note: %w", err)
+		}
+		creditNotes = append(creditNotes, cn)
+	}
+
+	return creditNotes, rows.Err()
+}
+
+// scanCreditNote reads a row selected with creditNoteColumns
+func scanCreditNote(row pgx.Row) (*domain.CreditNote, error) {
+	var cn domain.CreditNote
+	if err := row.Scan(append(documentFields(&cn.Document), &cn.InvoiceID)...); err != nil {
+		return nil, err
+	}
+	cn.Type = domain.DocumentTypeCreditNote
+	return &cn, nil
+}
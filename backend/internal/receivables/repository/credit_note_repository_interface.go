@@ -0,0 +1,24 @@
+// backend/internal/receivables/repository/credit_note_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// CreditNoteRepositoryInterface defines data access for credit notes
+type CreditNoteRepositoryInterface interface {
+	// Create saves a new draft credit note with its lines, giving it the next credit note number
+	Create(ctx context.Context, creditNote *domain.CreditNote) error
+
+	// UpdateStatus saves a credit note's posting or voiding; voiding removes its allocations
+	UpdateStatus(ctx context.Context, creditNote *domain.CreditNote) error
+
+	// GetByID retrieves a credit note with its lines
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.CreditNote, error)
+
+	// List lists an organization's credit notes, latest first, without their lines
+	List(ctx context.Context, filter DocumentFilter) ([]*domain.CreditNote, error)
+}
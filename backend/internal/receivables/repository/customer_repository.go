@@ -0,0 +1,185 @@
+// backend/internal/receivables/repository/customer_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CustomerRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCustomerRepository creates a new customer repository
+func NewCustomerRepository(pool *pgxpool.Pool) *CustomerRepository {
+	return &CustomerRepository{pool: pool}
+}
+
+const customerColumns = `
+        id, organization_id, code, name, customer_type, COALESCE(email, ''),
+        COALESCE(phone, ''), COALESCE(address, ''), COALESCE(tax_number, ''),
+        currency, payment_terms_days, receivable_account_id, is_active,
+        created_by, created_at, updated_at
+`
+
+// Create saves a new customer
+func (r *CustomerRepository) Create(ctx context.Context, c *domain.Customer) error {
+	query := `
+        INSERT INTO customers (
+            id, organization_id, code, name, customer_type, email, phone, address,
+            tax_number, currency, payment_terms_days, receivable_account_id,
+            is_active, created_by, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''),
+            NULLIF($9, ''), $10, $11, $12, $13, $14, $15, $16)
+    `
+
+	_, err := r.pool.Exec(ctx, query,
+		c.ID,
+		c.OrganizationID,
+		c.Code,
+		c.Name,
+		c.CustomerType,
+		c.Email,
+		c.Phone,
+		c.Address,
+		c.TaxNumber,
+		c.Currency,
+		c.PaymentTermsDays,
+		c.ReceivableAccountID,
+		c.IsActive,
+		c.CreatedBy,
+		c.CreatedAt,
+		c.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create customer: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves changes to a customer
+func (r *CustomerRepository) Update(ctx context.Context, c *domain.Customer) error {
+	query := `
+        UPDATE customers
+        SET code = $2, name = $3, customer_type = $4, email = NULLIF($5, ''),
+            phone = NULLIF($6, ''), address = NULLIF($7, ''), tax_number = NULLIF($8, ''),
+            payment_terms_days = $9, receivable_account_id = $10, is_active = $11,
+            updated_at = $12
+        WHERE id = $1
+    `
+
+	result, err := r.pool.Exec(ctx, query,
+		c.ID,
+		c.Code,
+		c.Name,
+		c.CustomerType,
+		c.Email,
+		c.Phone,
+		c.Address,
+		c.TaxNumber,
+		c.PaymentTermsDays,
+		c.ReceivableAccountID,
+		c.IsActive,
+		c.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.NewReceivablesError("customer not found", domain.ErrCustomerNotFound)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a customer
+func (r *CustomerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Customer, error) {
+	query := "SELECT" + customerColumns + "FROM customers WHERE id = $1"
+
+	c, err := scanCustomer(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewReceivablesError("customer not found", domain.ErrCustomerNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return c, nil
+}
+
+// GetByCode retrieves an organization's customer by code, or nil if none
+func (r *CustomerRepository) GetByCode(ctx context.Context, orgID uuid.UUID, code string) (*domain.Customer, error) {
+	query := "SELECT" + customerColumns + "FROM customers WHERE organization_id = $1 AND code = $2"
+
+	c, err := scanCustomer(r.pool.QueryRow(ctx, query, orgID, code))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return c, nil
+}
+
+// List lists an organization's customers by name
+func (r *CustomerRepository) List(ctx context.Context, filter CustomerFilter) ([]*domain.Customer, error) {
+	query := "SELECT" + customerColumns + `
+        FROM customers
+        WHERE organization_id = $1
+          AND ($2 OR is_active)
+          AND ($3 = '' OR customer_type = $3)
+          AND ($4 = '' OR code ILIKE '%' || $4 || '%' OR name ILIKE '%' || $4 || '%')
+        ORDER BY name, code
+    `
+
+	rows, err := r.pool.Query(ctx, query, filter.OrganizationID, filter.IncludeInactive, string(filter.CustomerType), filter.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []*domain.Customer
+	for rows.Next() {
+		c, err := scanCustomer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan customer: %w", err)
+		}
+		customers = append(customers, c)
+	}
+
+	return customers, rows.Err()
+}
+
+// scanCustomer reads a row selected with customerColumns
+func scanCustomer(row pgx.Row) (*domain.Customer, error) {
+	var c domain.Customer
+	err := row.Scan(
+		&c.ID,
+		&c.OrganizationID,
+		&c.Code,
+		&c.Name,
+		&c.CustomerType,
+		&c.Email,
+		&c.Phone,
+		&c.Address,
+		&c.TaxNumber,
+		&c.Currency,
+		&c.PaymentTermsDays,
+		&c.ReceivableAccountID,
+		&c.IsActive,
+		&c.CreatedBy,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
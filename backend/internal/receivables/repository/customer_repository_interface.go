@@ -0,0 +1,35 @@
+// backend/internal/receivables/repository/customer_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// CustomerRepositoryInterface defines data access for customers
+type CustomerRepositoryInterface interface {
+	// Create saves a new customer
+	Create(ctx context.Context, customer *domain.Customer) error
+
+	// Update saves changes to a customer
+	Update(ctx context.Context, customer *domain.Customer) error
+
+	// GetByID retrieves a customer
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Customer, error)
+
+	// GetByCode retrieves an organization's customer by code (nil if none)
+	GetByCode(ctx context.Context, orgID uuid.UUID, code string) (*domain.Customer, error)
+
+	// List lists an organization's customers by name
+	List(ctx context.Context, filter CustomerFilter) ([]*domain.Customer, error)
+}
+
+// CustomerFilter selects customers; empty fields match everything
+type CustomerFilter struct {
+	OrganizationID  uuid.UUID
+	CustomerType    domain.CustomerType
+	Search          string // Part of the code or name
+	IncludeInactive bool
+}
@@ -0,0 +1,214 @@
+// backend/internal/receivables/repository/documents.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// documentTables are the tables holding each document type; allocations
+// update the settling document's amount_allocated in them
+var documentTables = map[domain.DocumentType]string{
+	domain.DocumentTypeInvoice:    "ar_invoices",
+	domain.DocumentTypeCreditNote: "ar_credit_notes",
+	domain.DocumentTypeReceipt:    "ar_receipts",
+}
+
+// documentColumns are the columns every document table shares, in the order
+// documentFields scans them. The number and date columns are named after the
+// document type, e.g. invoice_number and invoice_date.
+func documentColumns(alias, numberColumn, dateColumn string) string {
+	return fmt.Sprintf(`
+        %[1]s.id, %[1]s.organization_id, %[1]s.customer_id, c.name, %[1]s.%[2]s, %[1]s.%[3]s,
+        %[1]s.currency, COALESCE(%[1]s.reference, ''), %[1]s.description, %[1]s.status,
+        %[1]s.total_amount, %[1]s.amount_allocated, %[1]s.journal_entry_id,
+        %[1]s.reversal_entry_id, %[1]s.created_by, %[1]s.created_at, %[1]s.posted_at,
+        %[1]s.voided_by, %[1]s.voided_at, COALESCE(%[1]s.void_reason, '')`,
+		alias, numberColumn, dateColumn)
+}
+
+// documentFields are the scan destinations for documentColumns
+func documentFields(d *domain.Document) []interface{} {
+	return []interface{}{
+		&d.ID,
+		&d.OrganizationID,
+		&d.CustomerID,
+		&d.CustomerName,
+		&d.Number,
+		&d.Date,
+		&d.Currency,
+		&d.Reference,
+		&d.Description,
+		&d.Status,
+		&d.TotalAmount,
+		&d.AmountAllocated,
+		&d.JournalEntryID,
+		&d.ReversalEntryID,
+		&d.CreatedBy,
+		&d.CreatedAt,
+		&d.PostedAt,
+		&d.VoidedBy,
+		&d.VoidedAt,
+		&d.VoidReason,
+	}
+}
+
+// documentListQuery selects a page of documents matching a DocumentFilter,
+// latest first. Its arguments come from documentListArgs.
+func documentListQuery(columns, table, alias, numberColumn, dateColumn string) string {
+	return fmt.Sprintf(`SELECT %[1]s
+        FROM %[2]s %[3]s
+        JOIN customers c ON c.id = %[3]s.customer_id
+        WHERE %[3]s.organization_id = $1
+          AND ($2::uuid IS NULL OR %[3]s.customer_id = $2)
+          AND ($3 = '' OR %[3]s.status = $3)
+          AND (NOT $4 OR (%[3]s.status = 'POSTED' AND %[3]s.amount_allocated < %[3]s.total_amount))
+          AND ($5::date IS NULL OR %[3]s.%[5]s >= $5)
+          AND ($6::date IS NULL OR %[3]s.%[5]s <= $6)
+        ORDER BY %[3]s.%[5]s DESC, %[3]s.%[4]s DESC
+        LIMIT $7 OFFSET $8
+    `, columns, table, alias, numberColumn, dateColumn)
+}
+
+// documentListArgs are the arguments of a documentListQuery
+func documentListArgs(filter DocumentFilter) []interface{} {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	return []interface{}{
+		filter.OrganizationID,
+		filter.CustomerID,
+		string(filter.Status),
+		filter.OpenOnly,
+		filter.FromDate,
+		filter.ToDate,
+		limit,
+		filter.Offset,
+	}
+}
+
+// nextDocumentNumber issues the organization's next number for a document
+// type, e.g. INV-000042. Numbers are issued inside the transaction saving the
+// document, so a failed save leaves no gap.
+func nextDocumentNumber(ctx context.Context, tx pgx.Tx, orgID uuid.UUID, docType domain.DocumentType) (string, error) {
+	query := `
+        INSERT INTO ar_document_sequences (organization_id, document_type, last_number)
+        VALUES ($1, $2, 1)
+        ON CONFLICT (organization_id, document_type)
+        DO UPDATE SET last_number = ar_document_sequences.last_number + 1
+        RETURNING last_number
+    `
+
+	var n int64
+	if err := tx.QueryRow(ctx, query, orgID, docType).Scan(&n); err != nil {
+		return "", fmt.Errorf("failed to allocate %s number: %w", docType, err)
+	}
+
+	return fmt.Sprintf("%s-%06d", docType.NumberPrefix(), n), nil
+}
+
+// insertDocumentLines saves an invoice's or credit note's lines
+func insertDocumentLines(ctx context.Context, tx pgx.Tx, table, parentColumn string, parentID uuid.UUID, lines []domain.DocumentLine) error {
+	query := fmt.Sprintf(`
+        INSERT INTO %s (
+            id, %s, line_number, account_id, description, quantity, unit_price, amount
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `, table, parentColumn)
+
+	for _, line := range lines {
+		_, err := tx.Exec(ctx, query,
+			line.ID,
+			parentID,
+			line.LineNumber,
+			line.AccountID,
+			line.Description,
+			line.Quantity,
+			line.UnitPrice,
+			line.Amount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create line %d: %w", line.LineNumber, err)
+		}
+	}
+
+	return nil
+}
+
+// listDocumentLines retrieves an invoice's or credit note's lines in order
+func listDocumentLines(ctx context.Context, pool *pgxpool.Pool, table, parentColumn string, parentID uuid.UUID) ([]domain.DocumentLine, error) {
+	query := fmt.Sprintf(`
+        SELECT id, line_number, account_id, description, quantity, unit_price, amount
+        FROM %s
+        WHERE %s = $1
+        ORDER BY line_number
+    `, table, parentColumn)
+
+	rows, err := pool.Query(ctx, query, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []domain.DocumentLine
+	for rows.Next() {
+		var line domain.DocumentLine
+		err := rows.Scan(
+			&line.ID,
+			&line.LineNumber,
+			&line.AccountID,
+			&line.Description,
+			&line.Quantity,
+			&line.UnitPrice,
+			&line.Amount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
+
+// execer runs a statement on a pool or in a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// updateDocumentStatus saves a document's posting or voiding. A void
+// document is never changed, and guard can add a further condition; false is
+// returned when no row qualified.
+func updateDocumentStatus(ctx context.Context, db execer, table string, d *domain.Document, guard string) (bool, error) {
+	query := fmt.Sprintf(`
+        UPDATE %s
+        SET status = $2, journal_entry_id = $3, reversal_entry_id = $4, posted_at = $5,
+            voided_by = $6, voided_at = $7, void_reason = NULLIF($8, '')
+        WHERE id = $1 AND status <> 'VOID'
+    `, table)
+	if guard != "" {
+		query += " AND " + guard
+	}
+
+	result, err := db.Exec(ctx, query,
+		d.ID,
+		d.Status,
+		d.JournalEntryID,
+		d.ReversalEntryID,
+		d.PostedAt,
+		d.VoidedBy,
+		d.VoidedAt,
+		d.VoidReason,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", d.Number, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
@@ -0,0 +1,151 @@
+// backend/internal/receivables/repository/invoice_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type InvoiceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewInvoiceRepository creates a new invoice repository
+func NewInvoiceRepository(pool *pgxpool.Pool) *InvoiceRepository {
+	return &InvoiceRepository{pool: pool}
+}
+
+var invoiceColumns = documentColumns("i", "invoice_number", "invoice_date") + ", i.due_date\n"
+
+// Create saves a new draft invoice with its lines, giving it the next invoice number
+func (r *InvoiceRepository) Create(ctx context.Context, inv *domain.Invoice) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	inv.Number, err = nextDocumentNumber(ctx, tx, inv.OrganizationID, domain.DocumentTypeInvoice)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO ar_invoices (
+            id, organization_id, customer_id, invoice_number, invoice_date, due_date,
+            currency, reference, description, status, total_amount, amount_allocated,
+            created_by, created_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10, $11, $12, $13, $14)
+    `
+
+	_, err = tx.Exec(ctx, query,
+		inv.ID,
+		inv.OrganizationID,
+		inv.CustomerID,
+		inv.Number,
+		inv.Date,
+		inv.DueDate,
+		inv.Currency,
+		inv.Reference,
+		inv.Description,
+		inv.Status,
+		inv.TotalAmount,
+		inv.AmountAllocated,
+		inv.CreatedBy,
+		inv.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create invoice: %w", err)
+	}
+
+	if err := insertDocumentLines(ctx, tx, "ar_invoice_lines", "invoice_id", inv.ID, inv.Lines); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit invoice: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus saves an invoice's posting or voiding. An invoice is only
+// voided while nothing is allocated to it.
+func (r *InvoiceRepository) UpdateStatus(ctx context.Context, inv *domain.Invoice) error {
+	guard := ""
+	if inv.Status == domain.DocumentStatusVoid {
+		guard = "amount_allocated = 0"
+	}
+
+	ok, err := updateDocumentStatus(ctx, r.pool, "ar_invoices", &inv.Document, guard)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState,
+			"invoice %s has changed since it was read; reload it and try again", inv.Number)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an invoice with its lines
+func (r *InvoiceRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Invoice, error) {
+	query := "SELECT" + invoiceColumns + `
+        FROM ar_invoices i
+        JOIN customers c ON c.id = i.customer_id
+        WHERE i.id = $1
+    `
+
+	inv, err := scanInvoice(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewReceivablesError("invoice not found", domain.ErrInvoiceNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	inv.Lines, err = listDocumentLines(ctx, r.pool, "ar_invoice_lines", "invoice_id", inv.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// List lists an organization's invoices, latest first, without their lines
+func (r *InvoiceRepository) List(ctx context.Context, filter DocumentFilter) ([]*domain.Invoice, error) {
+	query := documentListQuery(invoiceColumns, "ar_invoices", "i", "invoice_number", "invoice_date")
+
+	rows, err := r.pool.Query(ctx, query, documentListArgs(filter)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+	defer rows.Close()
+
+	var invoices []*domain.Invoice
+	for rows.Next() {
+		inv, err := scanInvoice(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invoice: %w", err)
+		}
+		invoices = append(invoices, inv)
+	}
+
+	return invoices, rows.Err()
+}
+
+// scanInvoice reads a row selected with invoiceColumns
+func scanInvoice(row pgx.Row) (*domain.Invoice, error) {
+	var inv domain.Invoice
+	if err := row.Scan(append(documentFields(&inv.Document), &inv.DueDate)...); err != nil {
+		return nil, err
+	}
+	inv.Type = domain.DocumentTypeInvoice
+	return &inv, nil
+}
@@ -0,0 +1,38 @@
+// backend/internal/receivables/repository/invoice_repository_interface.go
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// InvoiceRepositoryInterface defines data access for sales invoices
+type InvoiceRepositoryInterface interface {
+	// Create saves a new draft invoice with its lines, giving it the next invoice number
+	Create(ctx context.Context, invoice *domain.Invoice) error
+
+	// UpdateStatus saves an invoice's posting or voiding
+	UpdateStatus(ctx context.Context, invoice *domain.Invoice) error
+
+	// GetByID retrieves an invoice with its lines
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Invoice, error)
+
+	// List lists an organization's invoices, latest first, without their lines
+	List(ctx context.Context, filter DocumentFilter) ([]*domain.Invoice, error)
+}
+
+// DocumentFilter selects invoices, credit notes or receipts; empty fields
+// match everything
+type DocumentFilter struct {
+	OrganizationID uuid.UUID
+	CustomerID     *uuid.UUID
+	Status         domain.DocumentStatus
+	OpenOnly       bool // Posted with a balance left to settle
+	FromDate       *time.Time
+	ToDate         *time.Time
+	Limit          int // Defaults to 50
+	Offset         int
+}
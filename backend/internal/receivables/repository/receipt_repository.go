@@ -0,0 +1,154 @@
+// backend/internal/receivables/repository/receipt_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReceiptRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReceiptRepository creates a new receipt repository
+func NewReceiptRepository(pool *pgxpool.Pool) *ReceiptRepository {
+	return &ReceiptRepository{pool: pool}
+}
+
+var receiptColumns = documentColumns("r", "receipt_number", "receipt_date") + ", r.deposit_account_id, r.payment_method\n"
+
+// Create saves a new draft receipt, giving it the next receipt number
+func (r *ReceiptRepository) Create(ctx context.Context, rc *domain.Receipt) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rc.Number, err = nextDocumentNumber(ctx, tx, rc.OrganizationID, domain.DocumentTypeReceipt)
+	if err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO ar_receipts (
+            id, organization_id, customer_id, receipt_number, receipt_date, currency,
+            total_amount, amount_allocated, deposit_account_id, payment_method,
+            reference, description, status, created_by, created_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), $12, $13, $14, $15)
+    `
+
+	_, err = tx.Exec(ctx, query,
+		rc.ID,
+		rc.OrganizationID,
+		rc.CustomerID,
+		rc.Number,
+		rc.Date,
+		rc.Currency,
+		rc.TotalAmount,
+		rc.AmountAllocated,
+		rc.DepositAccountID,
+		rc.PaymentMethod,
+		rc.Reference,
+		rc.Description,
+		rc.Status,
+		rc.CreatedBy,
+		rc.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create receipt: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit receipt: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus saves a receipt's posting or voiding. Voiding removes its
+// allocations in the same transaction.
+func (r *ReceiptRepository) UpdateStatus(ctx context.Context, rc *domain.Receipt) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if rc.Status == domain.DocumentStatusVoid {
+		if err := releaseAllocations(ctx, tx, domain.DocumentTypeReceipt, rc.ID); err != nil {
+			return err
+		}
+	}
+
+	ok, err := updateDocumentStatus(ctx, tx, "ar_receipts", &rc.Document, "")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState,
+			"receipt %s has changed since it was read; reload it and try again", rc.Number)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit receipt: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a receipt
+func (r *ReceiptRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Receipt, error) {
+	query := "SELECT" + receiptColumns + `
+        FROM ar_receipts r
+        JOIN customers c ON c.id = r.customer_id
+        WHERE r.id = $1
+    `
+
+	rc, err := scanReceipt(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, domain.NewReceivablesError("receipt not found", domain.ErrReceiptNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	return rc, nil
+}
+
+// List lists an organization's receipts, latest first
+func (r *ReceiptRepository) List(ctx context.Context, filter DocumentFilter) ([]*domain.Receipt, error) {
+	query := documentListQuery(receiptColumns, "ar_receipts", "r", "receipt_number", "receipt_date")
+
+	rows, err := r.pool.Query(ctx, query, documentListArgs(filter)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list receipts: %w", err)
+	}
+	defer rows.Close()
+
+	var receipts []*domain.Receipt
+	for rows.Next() {
+		rc, err := scanReceipt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan receipt: %w", err)
+		}
+		receipts = append(receipts, rc)
+	}
+
+	return receipts, rows.Err()
+}
+
+// scanReceipt reads a row selected with receiptColumns
+func scanReceipt(row pgx.Row) (*domain.Receipt, error) {
+	var rc domain.Receipt
+	if err := row.Scan(append(documentFields(&rc.Document), &rc.DepositAccountID, &rc.PaymentMethod)...); err != nil {
+		return nil, err
+	}
+	rc.Type = domain.DocumentTypeReceipt
+	return &rc, nil
+}
@@ -0,0 +1,24 @@
+// backend/internal/receivables/repository/receipt_repository_interface.go
+package repository
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// ReceiptRepositoryInterface defines data access for customer receipts
+type ReceiptRepositoryInterface interface {
+	// Create saves a new draft receipt, giving it the next receipt number
+	Create(ctx context.Context, receipt *domain.Receipt) error
+
+	// UpdateStatus saves a receipt's posting or voiding; voiding removes its allocations
+	UpdateStatus(ctx context.Context, receipt *domain.Receipt) error
+
+	// GetByID retrieves a receipt
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Receipt, error)
+
+	// List lists an organization's receipts, latest first
+	List(ctx context.Context, filter DocumentFilter) ([]*domain.Receipt, error)
+}
@@ -0,0 +1,78 @@
+// backend/internal/receivables/routes/receivables_routes.go
+package routes
+
+import (
+	"github.com/chaitu35/costeasy/backend/app/middleware"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterReceivablesRoutes registers customer, sales document, allocation
+// and aged receivables routes. Every document posts to the ledger as it is
+// created; voiding reverses the entry and has its own permission.
+func RegisterReceivablesRoutes(
+	r *gin.RouterGroup,
+	customerHandler *handler.CustomerHandler,
+	invoiceHandler *handler.InvoiceHandler,
+	creditNoteHandler *handler.CreditNoteHandler,
+	receiptHandler *handler.ReceiptHandler,
+	allocationHandler *handler.AllocationHandler,
+	agingHandler *handler.AgingHandler,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	customers := r.Group("/customers")
+	customers.Use(authMiddleware.Authenticate())
+	{
+		customers.POST("", authMiddleware.RequirePermission("customers", "manage"), customerHandler.CreateCustomer)    // Patient, insurer or corporate payer
+		customers.GET("", authMiddleware.RequirePermission("customers", "view"), customerHandler.ListCustomers)        // Filter by type or search code and name
+		customers.GET("/:id", authMiddleware.RequirePermission("customers", "view"), customerHandler.GetCustomer)      // Customer details
+		customers.PUT("/:id", authMiddleware.RequirePermission("customers", "manage"), customerHandler.UpdateCustomer) // Update or deactivate
+	}
+
+	invoices := r.Group("/ar-invoices")
+	invoices.Use(authMiddleware.Authenticate())
+	{
+		invoices.POST("", authMiddleware.RequirePermission("ar_invoices", "create"), invoiceHandler.CreateInvoice)                          // Create and post to the ledger
+		invoices.GET("", authMiddleware.RequirePermission("ar_invoices", "view"), invoiceHandler.ListInvoices)                              // Filter by customer, status, open and date
+		invoices.GET("/:id", authMiddleware.RequirePermission("ar_invoices", "view"), invoiceHandler.GetInvoice)                            // Invoice with its lines
+		invoices.POST("/:id/post", authMiddleware.RequirePermission("ar_invoices", "create"), invoiceHandler.PostInvoice)                   // Retry posting a draft
+		invoices.POST("/:id/void", authMiddleware.RequirePermission("ar_invoices", "void"), invoiceHandler.VoidInvoice)                     // Void and reverse its entry
+		invoices.GET("/:id/allocations", authMiddleware.RequirePermission("ar_invoices", "view"), allocationHandler.ListInvoiceAllocations) // Receipts and credit notes settling it
+	}
+
+	creditNotes := r.Group("/ar-credit-notes")
+	creditNotes.Use(authMiddleware.Authenticate())
+	{
+		creditNotes.POST("", authMiddleware.RequirePermission("ar_credit_notes", "create"), creditNoteHandler.CreateCreditNote)                       // Create, post and settle the invoice named
+		creditNotes.GET("", authMiddleware.RequirePermission("ar_credit_notes", "view"), creditNoteHandler.ListCreditNotes)                           // Filter by customer, status, open and date
+		creditNotes.GET("/:id", authMiddleware.RequirePermission("ar_credit_notes", "view"), creditNoteHandler.GetCreditNote)                         // Credit note with its lines
+		creditNotes.POST("/:id/post", authMiddleware.RequirePermission("ar_credit_notes", "create"), creditNoteHandler.PostCreditNote)                // Retry posting a draft
+		creditNotes.POST("/:id/void", authMiddleware.RequirePermission("ar_credit_notes", "void"), creditNoteHandler.VoidCreditNote)                  // Void, reverse and release allocations
+		creditNotes.GET("/:id/allocations", authMiddleware.RequirePermission("ar_credit_notes", "view"), allocationHandler.ListCreditNoteAllocations) // Invoices it settles
+		creditNotes.POST("/:id/allocations", authMiddleware.RequirePermission("ar_allocations", "manage"), allocationHandler.AllocateCreditNote)      // Settle invoices with it
+	}
+
+	receipts := r.Group("/ar-receipts")
+	receipts.Use(authMiddleware.Authenticate())
+	{
+		receipts.POST("", authMiddleware.RequirePermission("ar_receipts", "create"), receiptHandler.CreateReceipt)                          // Create, post and settle the invoices listed
+		receipts.GET("", authMiddleware.RequirePermission("ar_receipts", "view"), receiptHandler.ListReceipts)                              // Filter by customer, status, open and date
+		receipts.GET("/:id", authMiddleware.RequirePermission("ar_receipts", "view"), receiptHandler.GetReceipt)                            // Receipt details
+		receipts.POST("/:id/post", authMiddleware.RequirePermission("ar_receipts", "create"), receiptHandler.PostReceipt)                   // Retry posting a draft
+		receipts.POST("/:id/void", authMiddleware.RequirePermission("ar_receipts", "void"), receiptHandler.VoidReceipt)                     // Void, e.g. a bounced cheque
+		receipts.GET("/:id/allocations", authMiddleware.RequirePermission("ar_receipts", "view"), allocationHandler.ListReceiptAllocations) // Invoices it settles
+		receipts.POST("/:id/allocations", authMiddleware.RequirePermission("ar_allocations", "manage"), allocationHandler.AllocateReceipt)  // Settle invoices with it
+	}
+
+	allocations := r.Group("/ar-allocations")
+	allocations.Use(authMiddleware.Authenticate())
+	{
+		allocations.DELETE("/:id", authMiddleware.RequirePermission("ar_allocations", "manage"), allocationHandler.Unallocate) // Reopen the invoice it settled
+	}
+
+	reports := r.Group("/ar-reports")
+	reports.Use(authMiddleware.Authenticate())
+	{
+		reports.GET("/aged-receivables", authMiddleware.RequirePermission("ar_reports", "view"), agingHandler.AgedReceivables) // 0-30, 31-60, 61-90 and 90+ days by customer
+	}
+}
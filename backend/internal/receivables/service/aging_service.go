@@ -0,0 +1,29 @@
+// backend/internal/receivables/service/aging_service.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+)
+
+type AgingService struct {
+	repo repository.AgingRepositoryInterface
+}
+
+// NewAgingService creates a new aging service
+func NewAgingService(repo repository.AgingRepositoryInterface) *AgingService {
+	return &AgingService{repo: repo}
+}
+
+// AgedReceivables reports what each customer owed as of a date, split into
+// 0-30, 31-60, 61-90 and 90+ day buckets by invoice or due date. Unapplied
+// receipts and credit notes are shown against each customer's balance.
+func (s *AgingService) AgedReceivables(ctx context.Context, filter repository.AgingFilter, basis domain.AgingBasis) (*domain.AgedReceivables, error) {
+	items, err := s.repo.ListAgedItems(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildAgedReceivables(filter.AsOf, basis, items), nil
+}
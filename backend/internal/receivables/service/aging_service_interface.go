@@ -0,0 +1,15 @@
+// backend/internal/receivables/service/aging_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+)
+
+// AgingServiceInterface defines business logic for receivables reports
+type AgingServiceInterface interface {
+	// AgedReceivables reports what each customer owed as of a date, by age
+	AgedReceivables(ctx context.Context, filter repository.AgingFilter, basis domain.AgingBasis) (*domain.AgedReceivables, error)
+}
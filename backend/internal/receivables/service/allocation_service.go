@@ -0,0 +1,168 @@
+// backend/internal/receivables/service/allocation_service.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/chaitu35/costeasy/backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// AllocationRequest asks for part of a receipt or credit note to settle an invoice
+type AllocationRequest struct {
+	InvoiceID uuid.UUID
+	Amount    money.Amount
+}
+
+// documentEntities are the audit entity types of each document type
+var documentEntities = map[domain.DocumentType]string{
+	domain.DocumentTypeInvoice:    audit.EntityInvoice,
+	domain.DocumentTypeCreditNote: audit.EntityCreditNote,
+	domain.DocumentTypeReceipt:    audit.EntityReceipt,
+}
+
+type AllocationService struct {
+	repo           repository.AllocationRepositoryInterface
+	invoiceRepo    repository.InvoiceRepositoryInterface
+	creditNoteRepo repository.CreditNoteRepositoryInterface
+	receiptRepo    repository.ReceiptRepositoryInterface
+	recorder       audit.Recorder
+}
+
+// NewAllocationService creates a new allocation service
+func NewAllocationService(
+	repo repository.AllocationRepositoryInterface,
+	invoiceRepo repository.InvoiceRepositoryInterface,
+	creditNoteRepo repository.CreditNoteRepositoryInterface,
+	receiptRepo repository.ReceiptRepositoryInterface,
+	recorder audit.Recorder,
+) *AllocationService {
+	return &AllocationService{
+		repo:           repo,
+		invoiceRepo:    invoiceRepo,
+		creditNoteRepo: creditNoteRepo,
+		receiptRepo:    receiptRepo,
+		recorder:       recorder,
+	}
+}
+
+// Allocate settles invoices with a posted receipt or credit note. Each
+// invoice must belong to the same customer, and neither document can be
+// settled past its total.
+func (s *AllocationService) Allocate(ctx context.Context, orgID uuid.UUID, sourceType domain.DocumentType, sourceID uuid.UUID, requests []AllocationRequest, allocatedBy uuid.UUID) ([]*domain.Allocation, error) {
+	source, err := s.findSource(ctx, orgID, sourceType, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations, err := s.plan(ctx, source, requests, allocatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, allocations); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, documentEntities[sourceType], sourceID, "ALLOCATE", nil, allocations)
+
+	return allocations, nil
+}
+
+// CheckAllocations checks a document not yet posted could settle the
+// requested invoices once it is
+func (s *AllocationService) CheckAllocations(ctx context.Context, source *domain.Document, requests []AllocationRequest) error {
+	preview := *source
+	preview.Status = domain.DocumentStatusPosted
+	_, err := s.plan(ctx, &preview, requests, uuid.Nil)
+	return err
+}
+
+// Unallocate removes an allocation, reopening the invoice it settled
+func (s *AllocationService) Unallocate(ctx context.Context, orgID, allocationID uuid.UUID) error {
+	allocation, err := s.repo.GetByID(ctx, allocationID)
+	if err != nil {
+		return err
+	}
+	if !allocation.BelongsTo(orgID) {
+		return domain.NewReceivablesError("allocation not found", domain.ErrAllocationNotFound)
+	}
+
+	if err := s.repo.Delete(ctx, allocation); err != nil {
+		return err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, documentEntities[allocation.SourceType], allocation.SourceID, "UNALLOCATE", allocation, nil)
+	return nil
+}
+
+// ListInvoiceAllocations lists the receipts and credit notes settling one of
+// an organization's invoices
+func (s *AllocationService) ListInvoiceAllocations(ctx context.Context, orgID, invoiceID uuid.UUID) ([]*domain.Allocation, error) {
+	if _, err := findInvoice(ctx, s.invoiceRepo, orgID, invoiceID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByInvoice(ctx, invoiceID)
+}
+
+// ListSourceAllocations lists the invoices one of an organization's receipts
+// or credit notes settles
+func (s *AllocationService) ListSourceAllocations(ctx context.Context, orgID uuid.UUID, sourceType domain.DocumentType, sourceID uuid.UUID) ([]*domain.Allocation, error) {
+	if _, err := s.findSource(ctx, orgID, sourceType, sourceID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListBySource(ctx, sourceType, sourceID)
+}
+
+// plan builds the allocations for a request, checking them against the
+// documents' balances as it goes so an invoice listed twice is not over-settled
+func (s *AllocationService) plan(ctx context.Context, source *domain.Document, requests []AllocationRequest, allocatedBy uuid.UUID) ([]*domain.Allocation, error) {
+	if len(requests) == 0 {
+		return nil, domain.NewReceivablesError("at least one invoice is required", domain.ErrAllocationInvalid)
+	}
+
+	invoices := make(map[uuid.UUID]*domain.Invoice)
+	allocations := make([]*domain.Allocation, 0, len(requests))
+	for _, req := range requests {
+		inv, ok := invoices[req.InvoiceID]
+		if !ok {
+			var err error
+			inv, err = findInvoice(ctx, s.invoiceRepo, source.OrganizationID, req.InvoiceID)
+			if err != nil {
+				return nil, err
+			}
+			invoices[req.InvoiceID] = inv
+		}
+
+		allocation, err := domain.Allocate(source, inv, req.Amount, allocatedBy)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	return allocations, nil
+}
+
+// findSource retrieves one of an organization's receipts or credit notes
+func (s *AllocationService) findSource(ctx context.Context, orgID uuid.UUID, sourceType domain.DocumentType, id uuid.UUID) (*domain.Document, error) {
+	switch sourceType {
+	case domain.DocumentTypeReceipt:
+		rc, err := findReceipt(ctx, s.receiptRepo, orgID, id)
+		if err != nil {
+			return nil, err
+		}
+		return &rc.Document, nil
+	case domain.DocumentTypeCreditNote:
+		cn, err := findCreditNote(ctx, s.creditNoteRepo, orgID, id)
+		if err != nil {
+			return nil, err
+		}
+		return &cn.Document, nil
+	default:
+		return nil, domain.NewReceivablesErrorf(domain.ErrAllocationInvalid, "%s documents cannot settle invoices", sourceType)
+	}
+}
@@ -0,0 +1,28 @@
+// backend/internal/receivables/service/allocation_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// AllocationServiceInterface defines business logic for settling invoices
+// with receipts and credit notes
+type AllocationServiceInterface interface {
+	// Allocate settles invoices with a posted receipt or credit note
+	Allocate(ctx context.Context, orgID uuid.UUID, sourceType domain.DocumentType, sourceID uuid.UUID, requests []AllocationRequest, allocatedBy uuid.UUID) ([]*domain.Allocation, error)
+
+	// CheckAllocations checks a document not yet posted could settle the requested invoices once it is
+	CheckAllocations(ctx context.Context, source *domain.Document, requests []AllocationRequest) error
+
+	// Unallocate removes an allocation, reopening the invoice it settled
+	Unallocate(ctx context.Context, orgID, allocationID uuid.UUID) error
+
+	// ListInvoiceAllocations lists the receipts and credit notes settling an invoice
+	ListInvoiceAllocations(ctx context.Context, orgID, invoiceID uuid.UUID) ([]*domain.Allocation, error)
+
+	// ListSourceAllocations lists the invoices a receipt or credit note settles
+	ListSourceAllocations(ctx context.Context, orgID uuid.UUID, sourceType domain.DocumentType, sourceID uuid.UUID) ([]*domain.Allocation, error)
+}
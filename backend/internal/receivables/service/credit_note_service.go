@@ -0,0 +1,243 @@
+// backend/internal/receivables/service/credit_note_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type CreditNoteService struct {
+	repo         repository.CreditNoteRepositoryInterface
+	invoiceRepo  repository.InvoiceRepositoryInterface
+	customerRepo repository.CustomerRepositoryInterface
+	accountRepo  glrepository.GLAccountRepositoryInterface
+	entryService glservice.JournalEntryServiceInterface
+	allocations  AllocationServiceInterface
+	recorder     audit.Recorder
+}
+
+// NewCreditNoteService creates a new credit note service
+func NewCreditNoteService(
+	repo repository.CreditNoteRepositoryInterface,
+	invoiceRepo repository.InvoiceRepositoryInterface,
+	customerRepo repository.CustomerRepositoryInterface,
+	accountRepo glrepository.GLAccountRepositoryInterface,
+	entryService glservice.JournalEntryServiceInterface,
+	allocations AllocationServiceInterface,
+	recorder audit.Recorder,
+) *CreditNoteService {
+	return &CreditNoteService{
+		repo:         repo,
+		invoiceRepo:  invoiceRepo,
+		customerRepo: customerRepo,
+		accountRepo:  accountRepo,
+		entryService: entryService,
+		allocations:  allocations,
+		recorder:     recorder,
+	}
+}
+
+// CreateCreditNote credits a customer and posts the credit note to the
+// ledger. A credit note raised against an invoice settles as much of it as
+// it can once posted. Like invoices, it is saved as a draft first and
+// returned with the error if the ledger rejects it, or left pending if its
+// entry needs approval.
+func (s *CreditNoteService) CreateCreditNote(ctx context.Context, cn *domain.CreditNote) (*domain.CreditNote, error) {
+	customer, err := findCustomer(ctx, s.customerRepo, cn.OrganizationID, cn.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := cn.Prepare(customer, cn.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := s.checkInvoice(ctx, cn); err != nil {
+		return nil, err
+	}
+	if err := checkLineAccounts(ctx, s.accountRepo, cn.OrganizationID, customer, cn.Lines); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, cn); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, cn.OrganizationID, audit.EntityCreditNote, cn.ID, audit.ActionCreate, nil, cn)
+
+	return s.post(ctx, cn, customer, cn.CreatedBy)
+}
+
+// PostCreditNote posts a draft credit note whose posting failed when it was
+// created, or one whose entry has since been approved
+func (s *CreditNoteService) PostCreditNote(ctx context.Context, orgID, id, postedBy uuid.UUID) (*domain.CreditNote, error) {
+	cn, err := findCreditNote(ctx, s.repo, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !cn.CanPost() {
+		return nil, domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState, "credit note %s cannot be posted (status: %s)", cn.Number, cn.Status)
+	}
+	if err := s.checkInvoice(ctx, cn); err != nil {
+		return nil, err
+	}
+
+	customer, err := findCustomer(ctx, s.customerRepo, orgID, cn.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, cn, customer, postedBy)
+}
+
+// VoidCreditNote cancels a credit note, reversing its entry if it was
+// posted and releasing the invoices it settled once the reversal posts
+func (s *CreditNoteService) VoidCreditNote(ctx context.Context, orgID, id, voidedBy uuid.UUID, reason string) (*domain.CreditNote, error) {
+	cn, err := findCreditNote(ctx, s.repo, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *cn
+	if err := cn.Void(voidedBy, reason); err != nil {
+		return nil, err
+	}
+
+	if before.IsPosted() {
+		reversal, err := voidEntry(ctx, s.entryService, &before.Document, voidedBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reverse credit note %s: %w", cn.Number, err)
+		}
+		if reversal.Status != gldomain.EntryStatusPosted {
+			return s.awaitReversal(ctx, &before, reversal)
+		}
+		cn.ReversalEntryID = &reversal.ID
+	}
+
+	if err := s.repo.UpdateStatus(ctx, cn); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityCreditNote, cn.ID, "VOID", &before, cn)
+
+	return cn, nil
+}
+
+// GetCreditNote retrieves one of an organization's credit notes with its lines
+func (s *CreditNoteService) GetCreditNote(ctx context.Context, orgID, id uuid.UUID) (*domain.CreditNote, error) {
+	return findCreditNote(ctx, s.repo, orgID, id)
+}
+
+// ListCreditNotes lists an organization's credit notes, latest first
+func (s *CreditNoteService) ListCreditNotes(ctx context.Context, filter repository.DocumentFilter) ([]*domain.CreditNote, error) {
+	return s.repo.List(ctx, filter)
+}
+
+// checkInvoice checks the invoice a credit note is raised against, if any,
+// is a posted invoice of the same customer
+func (s *CreditNoteService) checkInvoice(ctx context.Context, cn *domain.CreditNote) error {
+	if cn.InvoiceID == nil {
+		return nil
+	}
+
+	inv, err := findInvoice(ctx, s.invoiceRepo, cn.OrganizationID, *cn.InvoiceID)
+	if err != nil {
+		return err
+	}
+	if err := cn.CheckInvoice(inv); err != nil {
+		return err
+	}
+	if !inv.IsPosted() {
+		return domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState, "invoice %s is not posted (status: %s)", inv.Number, inv.Status)
+	}
+	return nil
+}
+
+// post posts a credit note's entry, records it against the credit note and
+// settles the invoice it was raised against. An entry needing approval leaves
+// the credit note pending until it is approved and the credit note is posted
+// again.
+func (s *CreditNoteService) post(ctx context.Context, cn *domain.CreditNote, customer *domain.Customer, postedBy uuid.UUID) (*domain.CreditNote, error) {
+	entry, err := documentEntry(ctx, s.entryService, &cn.Document, func() *gldomain.JournalEntry { return cn.BuildEntry(customer) }, postedBy)
+	if err != nil {
+		return cn, notPosted(&cn.Document, err)
+	}
+
+	before := *cn
+	if entry.Status != gldomain.EntryStatusPosted {
+		if err := cn.MarkPendingApproval(entry.ID); err != nil {
+			return nil, err
+		}
+		if err := s.repo.UpdateStatus(ctx, cn); err != nil {
+			return nil, err
+		}
+		audit.LogChange(ctx, s.recorder, cn.OrganizationID, audit.EntityCreditNote, cn.ID, "SUBMIT", &before, cn)
+		return cn, nil
+	}
+
+	if err := cn.MarkPosted(entry.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateStatus(ctx, cn); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, cn.OrganizationID, audit.EntityCreditNote, cn.ID, "POST", &before, cn)
+
+	if cn.InvoiceID == nil {
+		return cn, nil
+	}
+
+	// Anything the invoice no longer needs stays on the customer's account
+	inv, err := findInvoice(ctx, s.invoiceRepo, cn.OrganizationID, *cn.InvoiceID)
+	if err != nil {
+		return cn, fmt.Errorf("credit note %s was posted but not allocated: %w", cn.Number, err)
+	}
+	amount := cn.Balance()
+	if inv.Balance() < amount {
+		amount = inv.Balance()
+	}
+	if !amount.IsPositive() || !inv.IsPosted() {
+		return cn, nil
+	}
+
+	request := []AllocationRequest{{InvoiceID: inv.ID, Amount: amount}}
+	if _, err := s.allocations.Allocate(ctx, cn.OrganizationID, domain.DocumentTypeCreditNote, cn.ID, request, postedBy); err != nil {
+		return cn, fmt.Errorf("credit note %s was posted but not allocated: %w", cn.Number, err)
+	}
+	cn.AmountAllocated += amount
+
+	return cn, nil
+}
+
+// awaitReversal records the reversal raised to void a credit note while it awaits
+// approval. The credit note stays posted until the reversal posts.
+func (s *CreditNoteService) awaitReversal(ctx context.Context, cn *domain.CreditNote, reversal *gldomain.JournalEntry) (*domain.CreditNote, error) {
+	if cn.ReversalEntryID == nil {
+		before := *cn
+		cn.ReversalEntryID = &reversal.ID
+		if err := s.repo.UpdateStatus(ctx, cn); err != nil {
+			return nil, err
+		}
+		audit.LogChange(ctx, s.recorder, cn.OrganizationID, audit.EntityCreditNote, cn.ID, "SUBMIT", &before, cn)
+	}
+	return cn, awaitingReversal(&cn.Document, reversal)
+}
+
+// findCreditNote retrieves one of an organization's credit notes
+func findCreditNote(ctx context.Context, repo repository.CreditNoteRepositoryInterface, orgID, id uuid.UUID) (*domain.CreditNote, error) {
+	cn, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !cn.BelongsTo(orgID) {
+		return nil, domain.NewReceivablesError("credit note not found", domain.ErrCreditNoteNotFound)
+	}
+	return cn, nil
+}
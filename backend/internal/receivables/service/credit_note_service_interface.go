@@ -0,0 +1,28 @@
+// backend/internal/receivables/service/credit_note_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/google/uuid"
+)
+
+// CreditNoteServiceInterface defines business logic for credit notes
+type CreditNoteServiceInterface interface {
+	// CreateCreditNote credits a customer and posts the credit note to the ledger
+	CreateCreditNote(ctx context.Context, cn *domain.CreditNote) (*domain.CreditNote, error)
+
+	// PostCreditNote posts a draft credit note whose posting failed when it was created
+	PostCreditNote(ctx context.Context, orgID, id, postedBy uuid.UUID) (*domain.CreditNote, error)
+
+	// VoidCreditNote cancels a credit note, reversing its entry and releasing its allocations
+	VoidCreditNote(ctx context.Context, orgID, id, voidedBy uuid.UUID, reason string) (*domain.CreditNote, error)
+
+	// GetCreditNote retrieves one of an organization's credit notes with its lines
+	GetCreditNote(ctx context.Context, orgID, id uuid.UUID) (*domain.CreditNote, error)
+
+	// ListCreditNotes lists an organization's credit notes, latest first
+	ListCreditNotes(ctx context.Context, filter repository.DocumentFilter) ([]*domain.CreditNote, error)
+}
@@ -0,0 +1,157 @@
+// backend/internal/receivables/service/customer_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type CustomerService struct {
+	repo        repository.CustomerRepositoryInterface
+	accountRepo glrepository.GLAccountRepositoryInterface
+	rateRepo    glrepository.ExchangeRateRepositoryInterface
+	recorder    audit.Recorder
+}
+
+// NewCustomerService creates a new customer service
+func NewCustomerService(
+	repo repository.CustomerRepositoryInterface,
+	accountRepo glrepository.GLAccountRepositoryInterface,
+	rateRepo glrepository.ExchangeRateRepositoryInterface,
+	recorder audit.Recorder,
+) *CustomerService {
+	return &CustomerService{
+		repo:        repo,
+		accountRepo: accountRepo,
+		rateRepo:    rateRepo,
+		recorder:    recorder,
+	}
+}
+
+// CreateCustomer sets up a customer. The currency defaults to the
+// organization's base currency, and the receivable account must be a
+// postable ASSET account.
+func (s *CustomerService) CreateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
+	customer.Normalize()
+	if customer.Currency == "" && customer.OrganizationID != uuid.Nil {
+		base, err := s.rateRepo.GetBaseCurrency(ctx, customer.OrganizationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get base currency: %w", err)
+		}
+		customer.Currency = base
+	}
+	if err := customer.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkCode(ctx, customer); err != nil {
+		return nil, err
+	}
+	if err := s.checkReceivableAccount(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	customer.ID = uuid.New()
+	customer.IsActive = true
+	customer.CreatedAt = now
+	customer.UpdatedAt = now
+
+	if err := s.repo.Create(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, customer.OrganizationID, audit.EntityCustomer, customer.ID, audit.ActionCreate, nil, customer)
+
+	return customer, nil
+}
+
+// UpdateCustomer saves changes to one of an organization's customers. The
+// currency can't change, since the customer's documents are in it.
+func (s *CustomerService) UpdateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
+	existing, err := findCustomer(ctx, s.repo, customer.OrganizationID, customer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	customer.Normalize()
+	if customer.Currency == "" {
+		customer.Currency = existing.Currency
+	}
+	if customer.Currency != existing.Currency {
+		return nil, domain.NewReceivablesError("a customer's currency cannot be changed", domain.ErrCustomerInvalid)
+	}
+	if err := customer.Validate(); err != nil {
+		return nil, err
+	}
+	if customer.Code != existing.Code {
+		if err := s.checkCode(ctx, customer); err != nil {
+			return nil, err
+		}
+	}
+	if customer.ReceivableAccountID != existing.ReceivableAccountID {
+		if err := s.checkReceivableAccount(ctx, customer); err != nil {
+			return nil, err
+		}
+	}
+
+	customer.CreatedBy = existing.CreatedBy
+	customer.CreatedAt = existing.CreatedAt
+	customer.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, customer.OrganizationID, audit.EntityCustomer, customer.ID, audit.ActionUpdate, existing, customer)
+
+	return customer, nil
+}
+
+// GetCustomer retrieves one of an organization's customers
+func (s *CustomerService) GetCustomer(ctx context.Context, orgID, id uuid.UUID) (*domain.Customer, error) {
+	return findCustomer(ctx, s.repo, orgID, id)
+}
+
+// ListCustomers lists an organization's customers by name
+func (s *CustomerService) ListCustomers(ctx context.Context, filter repository.CustomerFilter) ([]*domain.Customer, error) {
+	return s.repo.List(ctx, filter)
+}
+
+// checkCode checks no other customer of the organization has the customer's code
+func (s *CustomerService) checkCode(ctx context.Context, customer *domain.Customer) error {
+	other, err := s.repo.GetByCode(ctx, customer.OrganizationID, customer.Code)
+	if err != nil {
+		return fmt.Errorf("failed to check customer code: %w", err)
+	}
+	if other != nil && other.ID != customer.ID {
+		return domain.NewReceivablesErrorf(domain.ErrCustomerCodeExists, "customer code %s is already used by %s", customer.Code, other.Name)
+	}
+	return nil
+}
+
+// checkReceivableAccount checks a customer's receivable account is one of the
+// organization's active, postable ASSET accounts
+func (s *CustomerService) checkReceivableAccount(ctx context.Context, customer *domain.Customer) error {
+	return checkAccount(ctx, s.accountRepo, customer.OrganizationID, customer.ReceivableAccountID,
+		domain.ErrCustomerAccountInvalid, "receivable account", gldomain.AccountTypeAsset)
+}
+
+// findCustomer retrieves one of an organization's customers
+func findCustomer(ctx context.Context, repo repository.CustomerRepositoryInterface, orgID, id uuid.UUID) (*domain.Customer, error) {
+	customer, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !customer.BelongsTo(orgID) {
+		return nil, domain.NewReceivablesError("customer not found", domain.ErrCustomerNotFound)
+	}
+	return customer, nil
+}
@@ -0,0 +1,25 @@
+// backend/internal/receivables/service/customer_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/google/uuid"
+)
+
+// CustomerServiceInterface defines business logic for customers
+type CustomerServiceInterface interface {
+	// CreateCustomer sets up a customer posting to an ASSET receivable account
+	CreateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error)
+
+	// UpdateCustomer saves changes to one of an organization's customers
+	UpdateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error)
+
+	// GetCustomer retrieves one of an organization's customers
+	GetCustomer(ctx context.Context, orgID, id uuid.UUID) (*domain.Customer, error)
+
+	// ListCustomers lists an organization's customers by name
+	ListCustomers(ctx context.Context, filter repository.CustomerFilter) ([]*domain.Customer, error)
+}
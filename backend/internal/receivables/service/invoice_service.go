@@ -0,0 +1,188 @@
+// backend/internal/receivables/service/invoice_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type InvoiceService struct {
+	repo         repository.InvoiceRepositoryInterface
+	customerRepo repository.CustomerRepositoryInterface
+	accountRepo  glrepository.GLAccountRepositoryInterface
+	entryService glservice.JournalEntryServiceInterface
+	recorder     audit.Recorder
+}
+
+// NewInvoiceService creates a new invoice service
+func NewInvoiceService(
+	repo repository.InvoiceRepositoryInterface,
+	customerRepo repository.CustomerRepositoryInterface,
+	accountRepo glrepository.GLAccountRepositoryInterface,
+	entryService glservice.JournalEntryServiceInterface,
+	recorder audit.Recorder,
+) *InvoiceService {
+	return &InvoiceService{
+		repo:         repo,
+		customerRepo: customerRepo,
+		accountRepo:  accountRepo,
+		entryService: entryService,
+		recorder:     recorder,
+	}
+}
+
+// CreateInvoice bills a customer and posts the invoice to the ledger. The
+// invoice is numbered and saved as a draft first; if the ledger rejects it,
+// such as for a closed period, the draft is returned with the error so it
+// can be posted later. If its entry needs approval the invoice is left
+// pending until the entry is approved.
+func (s *InvoiceService) CreateInvoice(ctx context.Context, inv *domain.Invoice) (*domain.Invoice, error) {
+	customer, err := findCustomer(ctx, s.customerRepo, inv.OrganizationID, inv.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := inv.Prepare(customer, inv.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := checkLineAccounts(ctx, s.accountRepo, inv.OrganizationID, customer, inv.Lines); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, inv); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, inv.OrganizationID, audit.EntityInvoice, inv.ID, audit.ActionCreate, nil, inv)
+
+	return s.post(ctx, inv, customer, inv.CreatedBy)
+}
+
+// PostInvoice posts a draft invoice whose posting failed when it was
+// created, or one whose entry has since been approved
+func (s *InvoiceService) PostInvoice(ctx context.Context, orgID, id, postedBy uuid.UUID) (*domain.Invoice, error) {
+	inv, err := findInvoice(ctx, s.repo, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !inv.CanPost() {
+		return nil, domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState, "invoice %s cannot be posted (status: %s)", inv.Number, inv.Status)
+	}
+
+	customer, err := findCustomer(ctx, s.customerRepo, orgID, inv.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, inv, customer, postedBy)
+}
+
+// VoidInvoice cancels an invoice. A posted invoice's entry is reversed, so
+// it must have no receipts or credit notes allocated to it; it is voided
+// once the reversal posts, which may wait on approval.
+func (s *InvoiceService) VoidInvoice(ctx context.Context, orgID, id, voidedBy uuid.UUID, reason string) (*domain.Invoice, error) {
+	inv, err := findInvoice(ctx, s.repo, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *inv
+	if err := inv.Void(voidedBy, reason); err != nil {
+		return nil, err
+	}
+
+	if before.IsPosted() {
+		reversal, err := voidEntry(ctx, s.entryService, &before.Document, voidedBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reverse invoice %s: %w", inv.Number, err)
+		}
+		if reversal.Status != gldomain.EntryStatusPosted {
+			return s.awaitReversal(ctx, &before, reversal)
+		}
+		inv.ReversalEntryID = &reversal.ID
+	}
+
+	if err := s.repo.UpdateStatus(ctx, inv); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityInvoice, inv.ID, "VOID", &before, inv)
+
+	return inv, nil
+}
+
+// GetInvoice retrieves one of an organization's invoices with its lines
+func (s *InvoiceService) GetInvoice(ctx context.Context, orgID, id uuid.UUID) (*domain.Invoice, error) {
+	return findInvoice(ctx, s.repo, orgID, id)
+}
+
+// ListInvoices lists an organization's invoices, latest first
+func (s *InvoiceService) ListInvoices(ctx context.Context, filter repository.DocumentFilter) ([]*domain.Invoice, error) {
+	return s.repo.List(ctx, filter)
+}
+
+// post posts an invoice's entry and records it against the invoice. An
+// entry needing approval leaves the invoice pending until it is approved and
+// the invoice is posted again.
+func (s *InvoiceService) post(ctx context.Context, inv *domain.Invoice, customer *domain.Customer, postedBy uuid.UUID) (*domain.Invoice, error) {
+	entry, err := documentEntry(ctx, s.entryService, &inv.Document, func() *gldomain.JournalEntry { return inv.BuildEntry(customer) }, postedBy)
+	if err != nil {
+		return inv, notPosted(&inv.Document, err)
+	}
+
+	before := *inv
+	if entry.Status != gldomain.EntryStatusPosted {
+		if err := inv.MarkPendingApproval(entry.ID); err != nil {
+			return nil, err
+		}
+		if err := s.repo.UpdateStatus(ctx, inv); err != nil {
+			return nil, err
+		}
+		audit.LogChange(ctx, s.recorder, inv.OrganizationID, audit.EntityInvoice, inv.ID, "SUBMIT", &before, inv)
+		return inv, nil
+	}
+
+	if err := inv.MarkPosted(entry.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateStatus(ctx, inv); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, inv.OrganizationID, audit.EntityInvoice, inv.ID, "POST", &before, inv)
+
+	return inv, nil
+}
+
+// awaitReversal records the reversal raised to void an invoice while it awaits
+// approval. The invoice stays posted until the reversal posts.
+func (s *InvoiceService) awaitReversal(ctx context.Context, inv *domain.Invoice, reversal *gldomain.JournalEntry) (*domain.Invoice, error) {
+	if inv.ReversalEntryID == nil {
+		before := *inv
+		inv.ReversalEntryID = &reversal.ID
+		if err := s.repo.UpdateStatus(ctx, inv); err != nil {
+			return nil, err
+		}
+		audit.LogChange(ctx, s.recorder, inv.OrganizationID, audit.EntityInvoice, inv.ID, "SUBMIT", &before, inv)
+	}
+	return inv, awaitingReversal(&inv.Document, reversal)
+}
+
+// findInvoice retrieves one of an organization's invoices
+func findInvoice(ctx context.Context, repo repository.InvoiceRepositoryInterface, orgID, id uuid.UUID) (*domain.Invoice, error) {
+	inv, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !inv.BelongsTo(orgID) {
+		return nil, domain.NewReceivablesError("invoice not found", domain.ErrInvoiceNotFound)
+	}
+	return inv, nil
+}
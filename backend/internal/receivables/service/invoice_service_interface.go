@@ -0,0 +1,28 @@
+// backend/internal/receivables/service/invoice_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/google/uuid"
+)
+
+// InvoiceServiceInterface defines business logic for sales invoices
+type InvoiceServiceInterface interface {
+	// CreateInvoice bills a customer and posts the invoice to the ledger
+	CreateInvoice(ctx context.Context, inv *domain.Invoice) (*domain.Invoice, error)
+
+	// PostInvoice posts a draft invoice whose posting failed when it was created
+	PostInvoice(ctx context.Context, orgID, id, postedBy uuid.UUID) (*domain.Invoice, error)
+
+	// VoidInvoice cancels an invoice, reversing its entry if it was posted
+	VoidInvoice(ctx context.Context, orgID, id, voidedBy uuid.UUID, reason string) (*domain.Invoice, error)
+
+	// GetInvoice retrieves one of an organization's invoices with its lines
+	GetInvoice(ctx context.Context, orgID, id uuid.UUID) (*domain.Invoice, error)
+
+	// ListInvoices lists an organization's invoices, latest first
+	ListInvoices(ctx context.Context, filter repository.DocumentFilter) ([]*domain.Invoice, error)
+}
@@ -0,0 +1,181 @@
+// backend/internal/receivables/service/posting.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/google/uuid"
+)
+
+// documentEntry posts a document's journal entry. A draft's entry is built
+// and posted; a document awaiting approval picks up its submitted entry.
+// The entry returned is posted or pending approval.
+func documentEntry(ctx context.Context, entries glservice.JournalEntryServiceInterface, d *domain.Document, build func() *gldomain.JournalEntry, postedBy uuid.UUID) (*gldomain.JournalEntry, error) {
+	comment := fmt.Sprintf("Raised by %s %s", d.Type.Label(), d.Number)
+	if d.Status == domain.DocumentStatusPendingApproval {
+		return pendingEntry(ctx, entries, *d.JournalEntryID, postedBy, comment)
+	}
+	return postEntry(ctx, entries, build(), postedBy, comment)
+}
+
+// voidEntry reverses a posted document's journal entry, or picks up the
+// reversal already raised for it. The reversal returned is posted or
+// pending approval; the document is only voided once it has posted.
+func voidEntry(ctx context.Context, entries glservice.JournalEntryServiceInterface, d *domain.Document, voidedBy uuid.UUID) (*gldomain.JournalEntry, error) {
+	comment := fmt.Sprintf("Voids %s %s", d.Type.Label(), d.Number)
+	if d.ReversalEntryID != nil {
+		return pendingEntry(ctx, entries, *d.ReversalEntryID, voidedBy, comment)
+	}
+	return reverseEntry(ctx, entries, *d.JournalEntryID, voidedBy, comment)
+}
+
+// notPosted reports why a document's entry wasn't posted
+func notPosted(d *domain.Document, err error) error {
+	if d.Status == domain.DocumentStatusDraft {
+		return fmt.Errorf("%s %s was saved as a draft: %w", d.Type.Label(), d.Number, err)
+	}
+	return fmt.Errorf("%s %s was not posted: %w", d.Type.Label(), d.Number, err)
+}
+
+// awaitingReversal warns that a document stays posted until its reversal is
+// approved
+func awaitingReversal(d *domain.Document, reversal *gldomain.JournalEntry) error {
+	return fmt.Errorf("%s %s will be voided once reversal entry %s is approved; void it again then",
+		d.Type.Label(), d.Number, reversal.EntryNumber)
+}
+
+// postEntry creates and posts a document's journal entry through the journal
+// entry service, so it gets the same period, account and approval checks as
+// any other entry. An entry matching an approval rule is submitted for
+// approval instead and returned pending. If it can't be posted or submitted
+// the draft entry is removed, leaving the document a draft that can be
+// posted again.
+func postEntry(ctx context.Context, entries glservice.JournalEntryServiceInterface, entry *gldomain.JournalEntry, postedBy uuid.UUID, comment string) (*gldomain.JournalEntry, error) {
+	created, err := entries.CreateEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	posted, err := postOrSubmit(ctx, entries, created.ID, postedBy, comment)
+	if err != nil {
+		return nil, removeDraft(ctx, entries, created.ID, err)
+	}
+	return posted, nil
+}
+
+// reverseEntry reverses a document's posted journal entry and posts the
+// reversal, or submits it for approval as postEntry does. The original entry
+// is only marked reversed once the reversal posts. If the reversal can't be
+// posted or submitted it is removed, leaving the original posted.
+func reverseEntry(ctx context.Context, entries glservice.JournalEntryServiceInterface, entryID, reversedBy uuid.UUID, comment string) (*gldomain.JournalEntry, error) {
+	reversal, err := entries.ReverseEntry(ctx, entryID, reversedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	posted, err := postOrSubmit(ctx, entries, reversal.ID, reversedBy, comment)
+	if err != nil {
+		return nil, removeDraft(ctx, entries, reversal.ID, err)
+	}
+	return posted, nil
+}
+
+// pendingEntry picks up what became of a document's entry that was
+// submitted for approval. A posted entry is returned as is; one that was
+// rejected back to draft is posted or submitted again. An entry still
+// awaiting approval is an error.
+func pendingEntry(ctx context.Context, entries glservice.JournalEntryServiceInterface, entryID, postedBy uuid.UUID, comment string) (*gldomain.JournalEntry, error) {
+	entry, err := entries.GetEntry(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entry.Status {
+	case gldomain.EntryStatusPosted:
+		return entry, nil
+	case gldomain.EntryStatusDraft:
+		return postOrSubmit(ctx, entries, entry.ID, postedBy, comment)
+	case gldomain.EntryStatusPendingApproval:
+		return nil, domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState, "journal entry %s is awaiting approval", entry.EntryNumber)
+	default:
+		return nil, domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState, "journal entry %s cannot be posted (status: %s)", entry.EntryNumber, entry.Status)
+	}
+}
+
+// postOrSubmit posts a draft entry. One that matches an approval rule is
+// submitted for approval instead, the way recurring journals are, so the
+// entry returned is either posted or pending approval.
+func postOrSubmit(ctx context.Context, entries glservice.JournalEntryServiceInterface, entryID, userID uuid.UUID, comment string) (*gldomain.JournalEntry, error) {
+	err := entries.PostEntry(ctx, entryID, userID)
+	if err == nil {
+		return entries.GetEntry(ctx, entryID)
+	}
+
+	var glErr *gldomain.GLError
+	if !errors.As(err, &glErr) || glErr.Code != gldomain.ErrJournalApprovalRequired {
+		return nil, err
+	}
+	return entries.SubmitEntry(ctx, entryID, userID, comment)
+}
+
+// removeDraft removes a draft entry that couldn't be posted, returning the
+// error that stopped it
+func removeDraft(ctx context.Context, entries glservice.JournalEntryServiceInterface, entryID uuid.UUID, err error) error {
+	if delErr := entries.DeleteEntry(ctx, entryID); delErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to remove draft entry: %w", delErr))
+	}
+	return err
+}
+
+// checkAccount checks an account a customer or document posts to is one of
+// the organization's active, postable accounts of one of the given types,
+// failing with the given error code
+func checkAccount(ctx context.Context, accountRepo glrepository.GLAccountRepositoryInterface, orgID, accountID uuid.UUID, code, what string, types ...gldomain.AccountType) error {
+	account, err := accountRepo.GetGLAccountByID(ctx, accountID, true)
+	if err != nil {
+		return domain.NewReceivablesErrorf(code, "%s %s not found", what, accountID)
+	}
+	if !account.BelongsTo(orgID) {
+		return domain.NewReceivablesErrorf(code, "%s %s belongs to another organization", what, account.Code)
+	}
+	if !account.IsActive {
+		return domain.NewReceivablesErrorf(code, "%s %s is inactive", what, account.Code)
+	}
+	if !account.IsPostable {
+		return domain.NewReceivablesErrorf(code, "%s %s is a summary account and cannot be posted to", what, account.Code)
+	}
+
+	for _, t := range types {
+		if account.Type == t {
+			return nil
+		}
+	}
+	return domain.NewReceivablesErrorf(code, "%s %s cannot be a %s account", what, account.Code, account.Type)
+}
+
+// checkLineAccounts checks each line of an invoice or credit note posts to a
+// revenue account, or a liability such as tax payable
+func checkLineAccounts(ctx context.Context, accountRepo glrepository.GLAccountRepositoryInterface, orgID uuid.UUID, customer *domain.Customer, lines []domain.DocumentLine) error {
+	checked := make(map[uuid.UUID]bool)
+	for _, line := range lines {
+		if checked[line.AccountID] {
+			continue
+		}
+		if line.AccountID == customer.ReceivableAccountID {
+			return domain.NewReceivablesErrorf(domain.ErrDocumentAccount,
+				"line %d cannot post to the customer's receivable account", line.LineNumber)
+		}
+		what := fmt.Sprintf("line %d account", line.LineNumber)
+		if err := checkAccount(ctx, accountRepo, orgID, line.AccountID, domain.ErrDocumentAccount, what, gldomain.AccountTypeRevenue, gldomain.AccountTypeLiability); err != nil {
+			return err
+		}
+		checked[line.AccountID] = true
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// backend/internal/receivables/service/receipt_service.go
+package service
+
+import (
+	"context"
+	"fmt"
+
+	gldomain "github.com/chaitu35/costeasy/backend/internal/gl-core/domain"
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type ReceiptService struct {
+	repo         repository.ReceiptRepositoryInterface
+	customerRepo repository.CustomerRepositoryInterface
+	accountRepo  glrepository.GLAccountRepositoryInterface
+	entryService glservice.JournalEntryServiceInterface
+	allocations  AllocationServiceInterface
+	recorder     audit.Recorder
+}
+
+// NewReceiptService creates a new receipt service
+func NewReceiptService(
+	repo repository.ReceiptRepositoryInterface,
+	customerRepo repository.CustomerRepositoryInterface,
+	accountRepo glrepository.GLAccountRepositoryInterface,
+	entryService glservice.JournalEntryServiceInterface,
+	allocations AllocationServiceInterface,
+	recorder audit.Recorder,
+) *ReceiptService {
+	return &ReceiptService{
+		repo:         repo,
+		customerRepo: customerRepo,
+		accountRepo:  accountRepo,
+		entryService: entryService,
+		allocations:  allocations,
+		recorder:     recorder,
+	}
+}
+
+// CreateReceipt records money received from a customer, posts it to the
+// ledger and settles the given invoices with it. The allocations are checked
+// before anything is saved. Like invoices, the receipt is saved as a draft
+// first and returned with the error if the ledger rejects it, or left
+// pending if its entry needs approval; its allocations are then made once it
+// has been posted.
+func (s *ReceiptService) CreateReceipt(ctx context.Context, rc *domain.Receipt, allocations []AllocationRequest) (*domain.Receipt, error) {
+	customer, err := findCustomer(ctx, s.customerRepo, rc.OrganizationID, rc.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := rc.Prepare(customer, rc.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := checkAccount(ctx, s.accountRepo, rc.OrganizationID, rc.DepositAccountID,
+		domain.ErrDocumentAccount, "deposit account", gldomain.AccountTypeAsset); err != nil {
+		return nil, err
+	}
+	if len(allocations) > 0 {
+		if err := s.allocations.CheckAllocations(ctx, &rc.Document, allocations); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Create(ctx, rc); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, rc.OrganizationID, audit.EntityReceipt, rc.ID, audit.ActionCreate, nil, rc)
+
+	rc, err = s.post(ctx, rc, customer, rc.CreatedBy)
+	if err != nil || len(allocations) == 0 {
+		return rc, err
+	}
+	if !rc.IsPosted() {
+		return rc, fmt.Errorf("receipt %s awaits approval of its entry and was not allocated", rc.Number)
+	}
+
+	allocated, err := s.allocations.Allocate(ctx, rc.OrganizationID, domain.DocumentTypeReceipt, rc.ID, allocations, rc.CreatedBy)
+	if err != nil {
+		return rc, fmt.Errorf("receipt %s was posted but not allocated: %w", rc.Number, err)
+	}
+	for _, a := range allocated {
+		rc.AmountAllocated += a.Amount
+	}
+
+	return rc, nil
+}
+
+// PostReceipt posts a draft receipt whose posting failed when it was
+// created, or one whose entry has since been approved
+func (s *ReceiptService) PostReceipt(ctx context.Context, orgID, id, postedBy uuid.UUID) (*domain.Receipt, error) {
+	rc, err := findReceipt(ctx, s.repo, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !rc.CanPost() {
+		return nil, domain.NewReceivablesErrorf(domain.ErrDocumentInvalidState, "receipt %s cannot be posted (status: %s)", rc.Number, rc.Status)
+	}
+
+	customer, err := findCustomer(ctx, s.customerRepo, orgID, rc.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, rc, customer, postedBy)
+}
+
+// VoidReceipt cancels a receipt, such as a bounced cheque, reversing its
+// entry if it was posted and reopening the invoices it settled once the
+// reversal posts
+func (s *ReceiptService) VoidReceipt(ctx context.Context, orgID, id, voidedBy uuid.UUID, reason string) (*domain.Receipt, error) {
+	rc, err := findReceipt(ctx, s.repo, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *rc
+	if err := rc.Void(voidedBy, reason); err != nil {
+		return nil, err
+	}
+
+	if before.IsPosted() {
+		reversal, err := voidEntry(ctx, s.entryService, &before.Document, voidedBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reverse receipt %s: %w", rc.Number, err)
+		}
+		if reversal.Status != gldomain.EntryStatusPosted {
+			return s.awaitReversal(ctx, &before, reversal)
+		}
+		rc.ReversalEntryID = &reversal.ID
+	}
+
+	if err := s.repo.UpdateStatus(ctx, rc); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, orgID, audit.EntityReceipt, rc.ID, "VOID", &before, rc)
+
+	return rc, nil
+}
+
+// GetReceipt retrieves one of an organization's receipts
+func (s *ReceiptService) GetReceipt(ctx context.Context, orgID, id uuid.UUID) (*domain.Receipt, error) {
+	return findReceipt(ctx, s.repo, orgID, id)
+}
+
+// ListReceipts lists an organization's receipts, latest first
+func (s *ReceiptService) ListReceipts(ctx context.Context, filter repository.DocumentFilter) ([]*domain.Receipt, error) {
+	return s.repo.List(ctx, filter)
+}
+
+// post posts a receipt's entry and records it against the receipt. An
+// entry needing approval leaves the receipt pending until it is approved and
+// the receipt is posted again.
+func (s *ReceiptService) post(ctx context.Context, rc *domain.Receipt, customer *domain.Customer, postedBy uuid.UUID) (*domain.Receipt, error) {
+	entry, err := documentEntry(ctx, s.entryService, &rc.Document, func() *gldomain.JournalEntry { return rc.BuildEntry(customer) }, postedBy)
+	if err != nil {
+		return rc, notPosted(&rc.Document, err)
+	}
+
+	before := *rc
+	if entry.Status != gldomain.EntryStatusPosted {
+		if err := rc.MarkPendingApproval(entry.ID); err != nil {
+			return nil, err
+		}
+		if err := s.repo.UpdateStatus(ctx, rc); err != nil {
+			return nil, err
+		}
+		audit.LogChange(ctx, s.recorder, rc.OrganizationID, audit.EntityReceipt, rc.ID, "SUBMIT", &before, rc)
+		return rc, nil
+	}
+
+	if err := rc.MarkPosted(entry.ID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateStatus(ctx, rc); err != nil {
+		return nil, err
+	}
+
+	audit.LogChange(ctx, s.recorder, rc.OrganizationID, audit.EntityReceipt, rc.ID, "POST", &before, rc)
+
+	return rc, nil
+}
+
+// awaitReversal records the reversal raised to void a receipt while it awaits
+// approval. The receipt stays posted until the reversal posts.
+func (s *ReceiptService) awaitReversal(ctx context.Context, rc *domain.Receipt, reversal *gldomain.JournalEntry) (*domain.Receipt, error) {
+	if rc.ReversalEntryID == nil {
+		before := *rc
+		rc.ReversalEntryID = &reversal.ID
+		if err := s.repo.UpdateStatus(ctx, rc); err != nil {
+			return nil, err
+		}
+		audit.LogChange(ctx, s.recorder, rc.OrganizationID, audit.EntityReceipt, rc.ID, "SUBMIT", &before, rc)
+	}
+	return rc, awaitingReversal(&rc.Document, reversal)
+}
+
+// findReceipt retrieves one of an organization's receipts
+func findReceipt(ctx context.Context, repo repository.ReceiptRepositoryInterface, orgID, id uuid.UUID) (*domain.Receipt, error) {
+	rc, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !rc.BelongsTo(orgID) {
+		return nil, domain.NewReceivablesError("receipt not found", domain.ErrReceiptNotFound)
+	}
+	return rc, nil
+}
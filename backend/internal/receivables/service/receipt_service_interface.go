@@ -0,0 +1,28 @@
+// backend/internal/receivables/service/receipt_service_interface.go
+package service
+
+import (
+	"context"
+
+	"github.com/chaitu35/costeasy/backend/internal/receivables/domain"
+	"github.com/chaitu35/costeasy/backend/internal/receivables/repository"
+	"github.com/google/uuid"
+)
+
+// ReceiptServiceInterface defines business logic for customer receipts
+type ReceiptServiceInterface interface {
+	// CreateReceipt records money received, posts it and settles the given invoices with it
+	CreateReceipt(ctx context.Context, rc *domain.Receipt, allocations []AllocationRequest) (*domain.Receipt, error)
+
+	// PostReceipt posts a draft receipt whose posting failed when it was created
+	PostReceipt(ctx context.Context, orgID, id, postedBy uuid.UUID) (*domain.Receipt, error)
+
+	// VoidReceipt cancels a receipt, reversing its entry and releasing its allocations
+	VoidReceipt(ctx context.Context, orgID, id, voidedBy uuid.UUID, reason string) (*domain.Receipt, error)
+
+	// GetReceipt retrieves one of an organization's receipts
+	GetReceipt(ctx context.Context, orgID, id uuid.UUID) (*domain.Receipt, error)
+
+	// ListReceipts lists an organization's receipts, latest first
+	ListReceipts(ctx context.Context, filter repository.DocumentFilter) ([]*domain.Receipt, error)
+}
@@ -94,6 +94,8 @@ const (
 	ErrOrgEstablishmentIDExists = "ORG_ESTABLISHMENT_ID_EXISTS"
 	ErrOrgAlreadyActive         = "ORG_ALREADY_ACTIVE"
 	ErrOrgAlreadyInactive       = "ORG_ALREADY_INACTIVE"
+	ErrOrgCOATemplateInvalid    = "ORG_COA_TEMPLATE_INVALID"
+	ErrOrgCOAProvisioningFailed = "ORG_COA_PROVISIONING_FAILED"
 	
 )
 
@@ -29,6 +29,11 @@ type CreateOrganizationRequest struct {
 	LicenseExpiry   string `json:"license_expiry"`
 	EstablishmentID string `json:"establishment_id"`
 	Description     string `json:"description"`
+
+	// Chart of accounts provisioning. Set ProvisionAccounts to create a starter
+	// chart from COATemplate, or from the default template for Type when empty.
+	ProvisionAccounts bool   `json:"provision_accounts"`
+	COATemplate       string `json:"coa_template"`
 }
 
 // UpdateOrganizationRequest represents the request to update an organization
@@ -79,6 +84,8 @@ type OrganizationResponse struct {
 	EstablishmentID string     `json:"establishment_id"`
 	Description     string     `json:"description"`
 	IsActive        bool       `json:"is_active"`
+	COATemplate     string     `json:"coa_template,omitempty"` // Template provisioned on creation
+	COAError        string     `json:"coa_error,omitempty"`    // Why provisioning failed; the organization was still created
 	CreatedAt       time.Time  `json:"created_at"`
 	UpdatedAt       time.Time  `json:"updated_at"`
 }
@@ -30,7 +30,10 @@ func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
 	}
 
 	org, err := h.service.CreateOrganization(c.Request.Context(), &req)
-	if err != nil {
+	if err != nil && org != nil {
+		// The organization was created but its chart of accounts was not
+		org.COAError = err.Error()
+	} else if err != nil {
 		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		return
 	}
@@ -11,6 +11,8 @@ import (
 	"github.com/xuri/excelize/v2"
 	"golang.org/x/crypto/bcrypt"
 
+	glrepository "github.com/chaitu35/costeasy/backend/internal/gl-core/repository"
+	glservice "github.com/chaitu35/costeasy/backend/internal/gl-core/service"
 	"github.com/chaitu35/costeasy/backend/internal/settings/domain"
 )
 
@@ -232,6 +234,32 @@ func (s *Seeder) SeedAccountsFromExcel(ctx context.Context, filePath, orgID stri
 	return nil
 }
 
+// SeedAccountsFromTemplate provisions an organization's GL chart of accounts from
+// a built-in industry template (HEALTHCARE_CLINIC, TRADING, SERVICES). Safe to
+// re-run: only accounts and payroll mappings the organization lacks are added.
+func (s *Seeder) SeedAccountsFromTemplate(ctx context.Context, templateCode, orgID string) error {
+	log.Printf("Provisioning accounts from template %s for organization: %s", templateCode, orgID)
+
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return fmt.Errorf("invalid organization ID: %w", err)
+	}
+
+	templates := glservice.NewCOATemplateService(
+		glrepository.NewCOATemplateRepository(s.pool),
+		glrepository.NewGLAccountRepository(s.pool),
+	)
+
+	app, err := templates.ApplyTemplate(ctx, id, templateCode, uuid.Nil)
+	if err != nil {
+		return fmt.Errorf("failed to apply template: %w", err)
+	}
+
+	log.Printf("✓ Applied %s v%d: %d accounts, %d payroll mappings added",
+		app.TemplateCode, app.Version, app.AccountsCreated, app.MappingsCreated)
+	return nil
+}
+
 // ==================== Shafafiya ====================
 
 func (s *Seeder) SeedShafafiyaFromExcel(ctx context.Context, filePath string) error {
@@ -7,20 +7,29 @@ import (
 	"github.com/chaitu35/costeasy/backend/internal/settings/domain"
 	"github.com/chaitu35/costeasy/backend/internal/settings/handler/dto"
 	"github.com/chaitu35/costeasy/backend/internal/settings/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
 	"github.com/google/uuid"
 )
 
 type OrganizationService struct {
-	repo repository.OrganizationRepositoryInterface
+	repo        repository.OrganizationRepositoryInterface
+	provisioner ChartOfAccountsProvisioner
+	recorder    audit.Recorder
 }
 
-func NewOrganizationService(repo repository.OrganizationRepositoryInterface) OrganizationServiceInterface {
+// NewOrganizationService creates a new organization service. provisioner may be
+// nil, in which case chart of accounts provisioning requests are rejected.
+func NewOrganizationService(repo repository.OrganizationRepositoryInterface, provisioner ChartOfAccountsProvisioner, recorder audit.Recorder) OrganizationServiceInterface {
 	return &OrganizationService{
-		repo: repo,
+		repo:        repo,
+		provisioner: provisioner,
+		recorder:    recorder,
 	}
 }
 
-// CreateOrganization creates a new organization
+// CreateOrganization creates a new organization, provisioning its chart of
+// accounts when requested. Provisioning runs once the organization is saved;
+// if it fails the organization is still returned, with the provisioning error.
 func (s *OrganizationService) CreateOrganization(ctx context.Context, req *dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error) {
 	// Validate organization name uniqueness
 	if req.Code != "" {
@@ -38,6 +47,19 @@ func (s *OrganizationService) CreateOrganization(ctx context.Context, req *dto.C
 		}
 	}
 
+	// Resolve the chart of accounts template before anything is created
+	var coaTemplate string
+	if req.ProvisionAccounts || req.COATemplate != "" {
+		if s.provisioner == nil {
+			return nil, domain.NewDomainError("chart of accounts provisioning is not available", domain.ErrOrgCOATemplateInvalid)
+		}
+		code, err := s.provisioner.ResolveCOATemplate(req.Type, req.COATemplate)
+		if err != nil {
+			return nil, domain.NewDomainErrorWithCause("invalid chart of accounts template", domain.ErrOrgCOATemplateInvalid, err)
+		}
+		coaTemplate = code
+	}
+
 	// Create organization domain object
 	org := &domain.Organization{
 		ID:              uuid.New(),
@@ -78,7 +100,21 @@ func (s *OrganizationService) CreateOrganization(ctx context.Context, req *dto.C
 		return nil, err
 	}
 
-	return dto.ToOrganizationResponse(org), nil
+	s.recordChange(ctx, audit.ActionCreate, nil, org)
+
+	response := dto.ToOrganizationResponse(org)
+
+	// Provision the starter chart of accounts. The organization is kept if this
+	// fails, so it is returned with the error; the template can be applied again
+	// from the chart of accounts module.
+	if coaTemplate != "" {
+		if err := s.provisioner.ProvisionChartOfAccounts(ctx, org.ID, coaTemplate); err != nil {
+			return response, domain.NewDomainErrorWithCause("organization created but chart of accounts provisioning failed", domain.ErrOrgCOAProvisioningFailed, err)
+		}
+		response.COATemplate = coaTemplate
+	}
+
+	return response, nil
 }
 
 // GetOrganizationByID retrieves organization by ID
@@ -128,6 +164,7 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, id uuid.UU
 	}
 
 	// Update fields using helper functions
+	before := *existingOrg
 	existingOrg.Name = req.Name
 	existingOrg.Code = domain.StringPtr(req.Code)
 	existingOrg.DisplayName = domain.StringPtr(req.DisplayName)
@@ -159,6 +196,8 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, id uuid.UU
 		return nil, err
 	}
 
+	s.recordChange(ctx, audit.ActionUpdate, &before, existingOrg)
+
 	return dto.ToOrganizationResponse(existingOrg), nil
 }
 
@@ -173,7 +212,14 @@ func (s *OrganizationService) ActivateOrganization(ctx context.Context, id uuid.
 		return domain.NewDomainError("organization is already active", domain.ErrOrgAlreadyActive)
 	}
 
-	return s.repo.ActivateOrganization(ctx, id)
+	if err := s.repo.ActivateOrganization(ctx, id); err != nil {
+		return err
+	}
+
+	after := *org
+	after.IsActive = true
+	s.recordChange(ctx, "ACTIVATE", org, &after)
+	return nil
 }
 
 // DeactivateOrganization deactivates an organization
@@ -187,7 +233,31 @@ func (s *OrganizationService) DeactivateOrganization(ctx context.Context, id uui
 		return domain.NewDomainError("organization is already inactive", domain.ErrOrgAlreadyInactive)
 	}
 
-	return s.repo.DeactivateOrganization(ctx, id)
+	if err := s.repo.DeactivateOrganization(ctx, id); err != nil {
+		return err
+	}
+
+	after := *org
+	after.IsActive = false
+	s.recordChange(ctx, "DEACTIVATE", org, &after)
+	return nil
+}
+
+// recordChange writes an organization change to the audit trail
+func (s *OrganizationService) recordChange(ctx context.Context, action string, before, after *domain.Organization) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	change := audit.Change{
+		OrganizationID: &subject.ID,
+		EntityType:     audit.EntityOrganization,
+		EntityID:       subject.ID,
+		Action:         action,
+		Before:         before,
+		After:          after,
+	}
+	audit.Log(ctx, s.recorder, change)
 }
 
 // GetOrganizationStats returns statistics about organizations
@@ -20,3 +20,10 @@ type OrganizationServiceInterface interface {
     GetOrganizationsByType(ctx context.Context, orgType domain.OrganizationType) ([]*dto.OrganizationResponse, error)
     GetOrganizationsByEmirate(ctx context.Context, emirate domain.UAEmirate) ([]*dto.OrganizationResponse, error)
 }
+
+// ChartOfAccountsProvisioner provisions a starter chart of accounts for new
+// organizations. Implemented by the GL module's template service.
+type ChartOfAccountsProvisioner interface {
+    ResolveCOATemplate(orgType, code string) (string, error)
+    ProvisionChartOfAccounts(ctx context.Context, orgID uuid.UUID, code string) error
+}
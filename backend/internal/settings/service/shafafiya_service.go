@@ -4,9 +4,11 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/chaitu35/costeasy/backend/internal/settings/domain"
 	"github.com/chaitu35/costeasy/backend/internal/settings/repository"
+	"github.com/chaitu35/costeasy/backend/pkg/audit"
 	"github.com/chaitu35/costeasy/backend/pkg/crypto"
 	"github.com/google/uuid"
 )
@@ -22,6 +24,7 @@ type ShafafiyaService struct {
 	orgRepo       repository.OrganizationRepositoryInterface
 	cryptoService *crypto.CryptoService
 	config        *Config
+	recorder      audit.Recorder
 }
 
 // NewShafafiyaService creates a new Shafafiya service
@@ -30,12 +33,14 @@ func NewShafafiyaService(
 	orgRepo repository.OrganizationRepositoryInterface,
 	cryptoService *crypto.CryptoService,
 	config *Config,
+	recorder audit.Recorder,
 ) *ShafafiyaService {
 	return &ShafafiyaService{
 		repo:          repo,
 		orgRepo:       orgRepo,
 		cryptoService: cryptoService,
 		config:        config,
+		recorder:      recorder,
 	}
 }
 
@@ -107,6 +112,8 @@ func (s *ShafafiyaService) CreateShafafiyaSettings(ctx context.Context, settings
 		return domain.ShafafiyaOrgSettings{}, fmt.Errorf("failed to create Shafafiya settings: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionCreate, nil, &created)
+
 	return created, nil
 }
 
@@ -145,7 +152,7 @@ func (s *ShafafiyaService) UpdateShafafiyaCredentials(ctx context.Context, orgID
 	}
 
 	// Verify settings exist
-	_, err := s.repo.GetShafafiyaSettings(ctx, orgID)
+	before, err := s.repo.GetShafafiyaSettings(ctx, orgID)
 	if err != nil {
 		return fmt.Errorf("shafafiya settings not found: %w", err)
 	}
@@ -161,6 +168,7 @@ func (s *ShafafiyaService) UpdateShafafiyaCredentials(ctx context.Context, orgID
 		return fmt.Errorf("failed to update credentials: %w", err)
 	}
 
+	s.recordUpdate(ctx, "UPDATE_CREDENTIALS", before)
 	return nil
 }
 
@@ -196,11 +204,17 @@ func (s *ShafafiyaService) UpdateShafafiyaCosting(ctx context.Context, orgID uui
 		)
 	}
 
+	before, err := s.repo.GetShafafiyaSettings(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("shafafiya settings not found: %w", err)
+	}
+
 	// Update costing configuration
 	if err := s.repo.UpdateShafafiyaCosting(ctx, orgID, costingMethod, allocationMethod); err != nil {
 		return fmt.Errorf("failed to update costing configuration: %w", err)
 	}
 
+	s.recordUpdate(ctx, "UPDATE_COSTING", before)
 	return nil
 }
 
@@ -230,11 +244,17 @@ func (s *ShafafiyaService) UpdateShafafiyaSubmission(ctx context.Context, orgID
 		)
 	}
 
+	before, err := s.repo.GetShafafiyaSettings(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("shafafiya settings not found: %w", err)
+	}
+
 	// Update submission configuration
 	if err := s.repo.UpdateShafafiyaSubmission(ctx, orgID, language, currency, includeSensitive); err != nil {
 		return fmt.Errorf("failed to update submission configuration: %w", err)
 	}
 
+	s.recordUpdate(ctx, "UPDATE_SUBMISSION", before)
 	return nil
 }
 
@@ -272,7 +292,7 @@ func (s *ShafafiyaService) DeleteShafafiyaSettings(ctx context.Context, orgID uu
 	}
 
 	// Verify settings exist
-	_, err := s.repo.GetShafafiyaSettings(ctx, orgID)
+	before, err := s.repo.GetShafafiyaSettings(ctx, orgID)
 	if err != nil {
 		return fmt.Errorf("shafafiya settings not found: %w", err)
 	}
@@ -282,6 +302,7 @@ func (s *ShafafiyaService) DeleteShafafiyaSettings(ctx context.Context, orgID uu
 		return fmt.Errorf("failed to delete Shafafiya settings: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionDelete, &before, nil)
 	return nil
 }
 
@@ -561,6 +582,7 @@ func (s *ShafafiyaService) UpdateShafafiyaSettings(ctx context.Context, orgID uu
 	}
 
 	// Update fields
+	before := existing
 	existing.Username = settings.Username
 	existing.Password = settings.Password
 	existing.ProviderCode = settings.ProviderCode
@@ -576,6 +598,8 @@ func (s *ShafafiyaService) UpdateShafafiyaSettings(ctx context.Context, orgID uu
 		return nil, fmt.Errorf("failed to update settings: %w", err)
 	}
 
+	s.recordChange(ctx, audit.ActionUpdate, &before, &updated)
+
 	return &updated, nil
 }
 
@@ -588,3 +612,43 @@ func (s *ShafafiyaService) ListAllShafafiyaSettings(ctx context.Context) ([]doma
 
 	return settings, nil
 }
+
+// recordUpdate records a partial update, reading the settings back for the after snapshot
+func (s *ShafafiyaService) recordUpdate(ctx context.Context, action string, before domain.ShafafiyaOrgSettings) {
+	after, err := s.repo.GetShafafiyaSettings(ctx, before.OrganizationID)
+	if err != nil {
+		log.Printf("⚠️  Audit log: failed to reload Shafafiya settings for %s: %v", action, err)
+		return
+	}
+	s.recordChange(ctx, action, &before, &after)
+}
+
+// recordChange writes a Shafafiya settings change to the audit trail. The
+// encrypted password is left out of the snapshots; a credentials change is
+// visible from the action.
+func (s *ShafafiyaService) recordChange(ctx context.Context, action string, before, after *domain.ShafafiyaOrgSettings) {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+	change := audit.Change{
+		OrganizationID: &subject.OrganizationID,
+		EntityType:     audit.EntityShafafiyaSettings,
+		EntityID:       subject.ID,
+		Action:         action,
+	}
+	if before != nil {
+		change.Before = redactShafafiyaSettings(*before)
+	}
+	if after != nil {
+		change.After = redactShafafiyaSettings(*after)
+	}
+
+	audit.Log(ctx, s.recorder, change)
+}
+
+// redactShafafiyaSettings blanks the stored password so it never reaches the audit trail
+func redactShafafiyaSettings(settings domain.ShafafiyaOrgSettings) domain.ShafafiyaOrgSettings {
+	settings.Password = ""
+	return settings
+}
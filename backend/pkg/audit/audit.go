@@ -0,0 +1,79 @@
+package audit
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// Audited entity types
+const (
+	EntityGLAccount          = "GL_ACCOUNT"
+	EntityJournalEntry       = "JOURNAL_ENTRY"
+	EntityOrganization       = "ORGANIZATION"
+	EntityShafafiyaSettings  = "SHAFAFIYA_SETTINGS"
+	EntityUser               = "USER"
+	EntityRole               = "ROLE"
+	EntityEmployee           = "EMPLOYEE"
+	EntityBankAccount        = "BANK_ACCOUNT"
+	EntityBankStatement      = "BANK_STATEMENT"
+	EntityBankReconciliation = "BANK_RECONCILIATION"
+	EntityCustomer           = "CUSTOMER"
+	EntityInvoice            = "AR_INVOICE"
+	EntityCreditNote         = "AR_CREDIT_NOTE"
+	EntityReceipt            = "AR_RECEIPT"
+)
+
+// Common actions. Modules may record their own verbs (POST, VOID, TERMINATE).
+const (
+	ActionCreate = "CREATE"
+	ActionUpdate = "UPDATE"
+	ActionDelete = "DELETE"
+)
+
+// Change describes one change to an audited entity. Before and After are
+// snapshots marshalled to JSON; nil for creates and deletes respectively.
+// Snapshots must not carry secrets.
+type Change struct {
+	OrganizationID *uuid.UUID
+	EntityType     string
+	EntityID       uuid.UUID
+	Action         string
+	Before         interface{}
+	After          interface{}
+}
+
+// Recorder defines the standard interface for writing to the audit trail.
+// The acting user and client IP are taken from the request context.
+type Recorder interface {
+	Record(ctx context.Context, change Change) error
+}
+
+// Log writes a change to the audit trail. Changes are recorded after they are
+// saved, so failing the request would report a saved change as not made; a
+// failure is logged for follow-up instead.
+func Log(ctx context.Context, recorder Recorder, change Change) {
+	if err := recorder.Record(ctx, change); err != nil {
+		log.Printf("⚠️  Audit log: failed to record %s %s %s: %v", change.Action, change.EntityType, change.EntityID, err)
+	}
+}
+
+// LogChange writes a change to an organization's entity to the audit trail, as Log
+func LogChange(ctx context.Context, recorder Recorder, orgID uuid.UUID, entityType string, entityID uuid.UUID, action string, before, after interface{}) {
+	Log(ctx, recorder, Change{
+		OrganizationID: &orgID,
+		EntityType:     entityType,
+		EntityID:       entityID,
+		Action:         action,
+		Before:         before,
+		After:          after,
+	})
+}
+
+// NopRecorder discards changes, for tools that run without an audit trail
+type NopRecorder struct{}
+
+func (NopRecorder) Record(ctx context.Context, change Change) error {
+	return nil
+}
@@ -12,9 +12,11 @@ const UserContextKey contextKey = "user_context"
 
 // UserContext holds lightweight user info from JWT
 type UserContext struct {
-	UserID uuid.UUID
-	Email  string
-	Roles  []string
+	UserID    uuid.UUID
+	Email     string
+	Roles     []string
+	IPAddress string // Client IP of the request, for the audit trail
+	UserAgent string
 }
 
 // Helper: extract UserContext from request context
@@ -0,0 +1,65 @@
+// backend/pkg/money/currency.go
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency with its number of minor units
+type Currency struct {
+	Code       string `json:"code"`
+	MinorUnits int    `json:"minor_units"` // Decimal places: 2 for AED, 0 for JPY, 3 for KWD
+}
+
+// Common currencies
+var (
+	AED = Currency{Code: "AED", MinorUnits: 2}
+	SAR = Currency{Code: "SAR", MinorUnits: 2}
+	QAR = Currency{Code: "QAR", MinorUnits: 2}
+	USD = Currency{Code: "USD", MinorUnits: 2}
+	EUR = Currency{Code: "EUR", MinorUnits: 2}
+	GBP = Currency{Code: "GBP", MinorUnits: 2}
+	INR = Currency{Code: "INR", MinorUnits: 2}
+	PKR = Currency{Code: "PKR", MinorUnits: 2}
+	PHP = Currency{Code: "PHP", MinorUnits: 2}
+	EGP = Currency{Code: "EGP", MinorUnits: 2}
+	JPY = Currency{Code: "JPY", MinorUnits: 0}
+	KWD = Currency{Code: "KWD", MinorUnits: 3}
+	BHD = Currency{Code: "BHD", MinorUnits: 3}
+	OMR = Currency{Code: "OMR", MinorUnits: 3}
+	JOD = Currency{Code: "JOD", MinorUnits: 3}
+)
+
+// DefaultCurrency is used when an organization or record has no currency set
+var DefaultCurrency = AED
+
+var currencies = map[string]Currency{
+	AED.Code: AED, SAR.Code: SAR, QAR.Code: QAR, USD.Code: USD,
+	EUR.Code: EUR, GBP.Code: GBP, INR.Code: INR, PKR.Code: PKR,
+	PHP.Code: PHP, EGP.Code: EGP, JPY.Code: JPY, KWD.Code: KWD,
+	BHD.Code: BHD, OMR.Code: OMR, JOD.Code: JOD,
+}
+
+// LookupCurrency returns a known currency by ISO code (case-insensitive)
+func LookupCurrency(code string) (Currency, error) {
+	c, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Currency{}, fmt.Errorf("unknown currency: %s", code)
+	}
+	return c, nil
+}
+
+// CurrencyOrDefault returns a known currency by code, or DefaultCurrency when
+// the code is empty or unknown
+func CurrencyOrDefault(code string) Currency {
+	if c, err := LookupCurrency(code); err == nil {
+		return c
+	}
+	return DefaultCurrency
+}
+
+// String returns the ISO code
+func (c Currency) String() string {
+	return c.Code
+}
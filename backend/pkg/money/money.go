@@ -0,0 +1,327 @@
+// backend/pkg/money/money.go
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places an Amount holds. Four places cover
+// every ISO 4217 minor unit (0-3) with a spare digit for intermediate results.
+const Scale = 4
+
+// scaleFactor is 10^Scale
+const scaleFactor = 10000
+
+// Amount is an exact decimal money amount stored as an integer number of
+// 1/10^Scale units. Amounts add, subtract and compare exactly with the
+// built-in operators; the zero value is 0.
+type Amount int64
+
+// Zero is the zero amount
+const Zero Amount = 0
+
+// ErrInvalidAmount is returned when a value cannot be parsed as an amount
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+// New creates an amount from whole units (e.g. New(12) is 12.00)
+func New(units int64) Amount {
+	return Amount(units * scaleFactor)
+}
+
+// FromMinor creates an amount from a count of the currency's minor units
+// (e.g. FromMinor(1250, AED) is 12.50, FromMinor(1250, KWD) is 1.250)
+func FromMinor(minor int64, currency Currency) Amount {
+	return Amount(minor * pow10(Scale-currency.MinorUnits))
+}
+
+// FromFloat converts a float to the nearest amount. Only use it at boundaries
+// where values arrive as floats (spreadsheets, legacy callers).
+func FromFloat(f float64) Amount {
+	return Amount(math.Round(f * scaleFactor))
+}
+
+// Parse parses a decimal string such as "1234.50", "-0.5" or "1,234.50".
+// More than Scale decimal places is an error rather than a silent rounding.
+func Parse(input string) (Amount, error) {
+	units, err := parseDecimal(input, Scale)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidAmount, err)
+	}
+	return Amount(units), nil
+}
+
+// MustParse parses a decimal string and panics on error. For constants only.
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String formats the amount with at least two decimal places and no trailing
+// zeros beyond that (1234.5 -> "1234.50", 0.125 -> "0.125")
+func (a Amount) String() string {
+	s := a.StringFixed(Scale)
+	for i := 0; i < Scale-2 && strings.HasSuffix(s, "0"); i++ {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// StringFixed formats the amount with exactly the given number of decimal
+// places (0 to Scale), rounding half away from zero
+func (a Amount) StringFixed(places int) string {
+	if places > Scale {
+		places = Scale
+	}
+	if places < 0 {
+		places = 0
+	}
+	return formatDecimal(int64(a.RoundTo(places))/pow10(Scale-places), places)
+}
+
+// Float64 returns the amount as a float for display and spreadsheet output.
+// Never use the result for further money arithmetic.
+func (a Amount) Float64() float64 {
+	return float64(a) / scaleFactor
+}
+
+// RoundTo rounds the amount to the given number of decimal places, half away from zero
+func (a Amount) RoundTo(places int) Amount {
+	if places >= Scale {
+		return a
+	}
+	if places < 0 {
+		places = 0
+	}
+	step := pow10(Scale - places)
+	return Amount(divRound(int64(a), step) * step)
+}
+
+// Round rounds the amount to the currency's minor units, half away from zero
+func (a Amount) Round(currency Currency) Amount {
+	return a.RoundTo(currency.MinorUnits)
+}
+
+// IsRounded checks if the amount has no precision beyond the currency's minor units
+func (a Amount) IsRounded(currency Currency) bool {
+	return a.Round(currency) == a
+}
+
+// Minor returns the amount as a count of the currency's minor units, rounded
+func (a Amount) Minor(currency Currency) int64 {
+	return divRound(int64(a), pow10(Scale-currency.MinorUnits))
+}
+
+// Abs returns the absolute value
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// IsZero checks if the amount is zero
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// IsNegative checks if the amount is below zero
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+// IsPositive checks if the amount is above zero
+func (a Amount) IsPositive() bool {
+	return a > 0
+}
+
+// MulRatio multiplies the amount by num/den, rounding half away from zero at
+// full Scale (e.g. prorating a salary by days worked)
+func (a Amount) MulRatio(num, den int64) Amount {
+	if den == 0 {
+		return 0
+	}
+	// Split to avoid overflow on large amounts
+	q, r := int64(a)/den, int64(a)%den
+	return Amount(q*num + divRound(r*num, den))
+}
+
+// Sum adds amounts
+func Sum(amounts ...Amount) Amount {
+	var total Amount
+	for _, a := range amounts {
+		total += a
+	}
+	return total
+}
+
+// MarshalJSON writes the amount as a JSON number (e.g. 1234.50)
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number or string exactly, without going through float64
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*a = 0
+		return nil
+	}
+	s = strings.Trim(s, `"`)
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidAmount, s)
+		}
+		*a = FromFloat(f)
+		return nil
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner for DECIMAL/NUMERIC columns
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return fmt.Errorf("%w: cannot scan NULL (use *money.Amount or COALESCE)", ErrInvalidAmount)
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*a = parsed
+	case int64:
+		*a = New(v)
+	case float64:
+		*a = FromFloat(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidAmount, src)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer, sending the amount as an exact decimal string
+func (a Amount) Value() (driver.Value, error) {
+	return a.StringFixed(Scale), nil
+}
+
+// parseDecimal parses a decimal string into an integer count of 1/10^scale units
+func parseDecimal(input string, scale int) (int64, error) {
+	s := strings.TrimSpace(strings.ReplaceAll(input, ",", ""))
+	if s == "" {
+		return 0, errors.New("empty string")
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("%q is not a decimal number", input)
+	}
+	if len(frac) > scale {
+		// Allow trailing zeros beyond the scale (e.g. "1.230000" from numeric columns)
+		if strings.Trim(frac[scale:], "0") != "" {
+			return 0, fmt.Errorf("%q has more than %d decimal places", input, scale)
+		}
+		frac = frac[:scale]
+	}
+
+	factor := pow10(scale)
+	var units int64
+	if whole != "" {
+		w, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || w > math.MaxInt64/factor {
+			return 0, fmt.Errorf("%q is out of range", input)
+		}
+		units = w * factor
+	}
+	if frac != "" {
+		f, err := strconv.ParseUint(frac, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a decimal number", input)
+		}
+		units += int64(f) * pow10(scale-len(frac))
+	}
+
+	if negative {
+		units = -units
+	}
+	return units, nil
+}
+
+// formatDecimal formats an integer count of 1/10^scale units with all scale places
+func formatDecimal(units int64, scale int) string {
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+	factor := pow10(scale)
+	if scale == 0 {
+		return fmt.Sprintf("%s%d", sign, units)
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, units/factor, scale, units%factor)
+}
+
+// divRound divides n by d (d > 0), rounding half away from zero
+func divRound(n, d int64) int64 {
+	if d < 0 {
+		n, d = -n, -d
+	}
+	q, r := n/d, n%d
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= d {
+		if n < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
+
+// isDigits checks if s contains only ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// pow10 returns 10^n for small non-negative n
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
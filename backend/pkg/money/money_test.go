@@ -0,0 +1,286 @@
+// backend/pkg/money/money_test.go
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Amount
+		wantErr bool
+	}{
+		{input: "1234.50", want: 12345000},
+		{input: "-0.5", want: -5000},
+		{input: "+7", want: 70000},
+		{input: "1,234.50", want: 12345000},
+		{input: " 12 ", want: 120000},
+		{input: ".5", want: 5000},
+		{input: "5.", want: 50000},
+		{input: "0.0001", want: 1},
+		{input: "1.230000", want: 12300}, // Trailing zeros beyond Scale from numeric columns
+		{input: "", wantErr: true},
+		{input: "-", wantErr: true},
+		{input: ".", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "1.2.3", wantErr: true},
+		{input: "1e3", wantErr: true},
+		{input: "1.23456", wantErr: true}, // More places than Scale is not rounded
+		{input: "1000000000000000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAmount) {
+					t.Fatalf("Parse(%q) error = %v, want ErrInvalidAmount", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		want   string
+	}{
+		{Zero, "0.00"},
+		{New(12), "12.00"},
+		{12345000, "1234.50"},
+		{1250, "0.125"},
+		{1, "0.0001"},
+		{-5000, "-0.50"},
+		{-12345678, "-1234.5678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.amount.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			parsed, err := Parse(tt.want)
+			if err != nil || parsed != tt.amount {
+				t.Errorf("Parse(%q) = %d, %v; want %d", tt.want, parsed, err, tt.amount)
+			}
+		})
+	}
+}
+
+func TestAmountStringFixed(t *testing.T) {
+	tests := []struct {
+		amount string
+		places int
+		want   string
+	}{
+		{"2.345", 2, "2.35"},
+		{"-2.345", 2, "-2.35"},
+		{"2.3449", 2, "2.34"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"1.5", 4, "1.5000"},
+		{"1.5", 6, "1.5000"},
+		{"1.5", -1, "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			if got := MustParse(tt.amount).StringFixed(tt.places); got != tt.want {
+				t.Errorf("StringFixed(%d) = %q, want %q", tt.places, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountRounding(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency Currency
+		want     string
+	}{
+		{"half up", "2.345", AED, "2.35"},
+		{"half away from zero", "-2.345", AED, "-2.35"},
+		{"below half", "2.3449", AED, "2.34"},
+		{"already rounded", "2.34", AED, "2.34"},
+		{"no minor units", "12.5", JPY, "13.00"},
+		{"three minor units", "1.2345", KWD, "1.235"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount := MustParse(tt.amount)
+			got := amount.Round(tt.currency)
+			if got != MustParse(tt.want) {
+				t.Errorf("Round(%s) = %s, want %s", tt.currency, got, tt.want)
+			}
+			if got.RoundTo(tt.currency.MinorUnits) != got {
+				t.Errorf("RoundTo(%d) changed an already rounded amount %s", tt.currency.MinorUnits, got)
+			}
+			if wantRounded := amount == got; amount.IsRounded(tt.currency) != wantRounded {
+				t.Errorf("IsRounded(%s) = %v, want %v", tt.currency, !wantRounded, wantRounded)
+			}
+		})
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	tests := []struct {
+		minor    int64
+		currency Currency
+		want     string
+	}{
+		{1250, AED, "12.50"},
+		{1250, KWD, "1.25"},
+		{1250, JPY, "1250.00"},
+		{-1, AED, "-0.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency.Code+" "+tt.want, func(t *testing.T) {
+			got := FromMinor(tt.minor, tt.currency)
+			if got != MustParse(tt.want) {
+				t.Errorf("FromMinor(%d, %s) = %s, want %s", tt.minor, tt.currency, got, tt.want)
+			}
+			if back := got.Minor(tt.currency); back != tt.minor {
+				t.Errorf("Minor(%s) = %d, want %d", tt.currency, back, tt.minor)
+			}
+		})
+	}
+}
+
+func TestMulRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		num, den int64
+		want     string
+	}{
+		{"prorated salary", "3000.00", 15, 31, "1451.6129"},
+		{"third", "100.00", 1, 3, "33.3333"},
+		{"two thirds", "100.00", 2, 3, "66.6667"},
+		{"half unit rounds away from zero", "0.0002", 1, 4, "0.0001"},
+		{"negative half unit rounds away from zero", "-0.0002", 1, 4, "-0.0001"},
+		{"whole", "250.00", 4, 4, "250.00"},
+		{"zero denominator", "250.00", 1, 0, "0.00"},
+		{"large amount does not overflow", "900000000000000.00", 3, 4, "675000000000000.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MustParse(tt.amount).MulRatio(tt.num, tt.den)
+			if got != MustParse(tt.want) {
+				t.Errorf("MulRatio(%d, %d) = %s, want %s", tt.num, tt.den, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Amount
+		wantErr bool
+	}{
+		{name: "numeric string", src: "1234.5000", want: 12345000},
+		{name: "bytes", src: []byte("-0.1000"), want: -1000},
+		{name: "integer", src: int64(12), want: 120000},
+		{name: "float", src: 0.1, want: 1000},
+		{name: "null", src: nil, wantErr: true},
+		{name: "bad string", src: "abc", wantErr: true},
+		{name: "unsupported type", src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Amount
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAmount) {
+					t.Fatalf("Scan(%v) error = %v, want ErrInvalidAmount", tt.src, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) error = %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%v) = %d, want %d", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountValue(t *testing.T) {
+	tests := []struct {
+		amount string
+		want   string
+	}{
+		{"1234.5", "1234.5000"},
+		{"-0.01", "-0.0100"},
+		{"0", "0.0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			value, err := MustParse(tt.amount).Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if value != tt.want {
+				t.Errorf("Value() = %v, want %q", value, tt.want)
+			}
+
+			var scanned Amount
+			if err := scanned.Scan(value); err != nil || scanned != MustParse(tt.amount) {
+				t.Errorf("Scan(Value()) = %s, %v; want %s", scanned, err, tt.amount)
+			}
+		})
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Amount Amount `json:"amount"`
+	}{MustParse("1234.5")})
+	if err != nil {
+		t.Fatalf("Marshal error = %v", err)
+	}
+	if string(data) != `{"amount":1234.50}` {
+		t.Errorf("Marshal = %s", data)
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`12.34`, "12.34"},
+		{`"12.34"`, "12.34"},
+		{`1e2`, "100"},
+		{`null`, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got Amount
+			if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", tt.input, err)
+			}
+			if got != MustParse(tt.want) {
+				t.Errorf("Unmarshal(%s) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// backend/pkg/money/rate.go
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RateScale is the number of decimal places an exchange rate holds
+const RateScale = 8
+
+// rateScaleFactor is 10^RateScale
+const rateScaleFactor = 100000000
+
+// Rate is an exact exchange rate stored as an integer number of 1/10^RateScale
+// units: the number of quote currency units per unit of the base currency
+// (e.g. 3.6725 AED per USD)
+type Rate int64
+
+// One is the identity rate used for amounts already in the target currency
+const One Rate = rateScaleFactor
+
+// ErrInvalidRate is returned when a value cannot be parsed as a rate
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// ParseRate parses a decimal string such as "3.6725" or "0.04502"
+func ParseRate(input string) (Rate, error) {
+	units, err := parseDecimal(input, RateScale)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidRate, err)
+	}
+	return Rate(units), nil
+}
+
+// MustParseRate parses a rate and panics on error. For constants only.
+func MustParseRate(s string) Rate {
+	r, err := ParseRate(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// String formats the rate without trailing zeros beyond the first decimal place
+// (3.67250000 -> "3.6725", 1 -> "1.0")
+func (r Rate) String() string {
+	s := formatDecimal(int64(r), RateScale)
+	for i := 0; i < RateScale-1 && strings.HasSuffix(s, "0"); i++ {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// IsPositive checks if the rate is above zero
+func (r Rate) IsPositive() bool {
+	return r > 0
+}
+
+// Inverse returns 1/r rounded to RateScale places (e.g. the USD per AED rate
+// from an AED per USD rate). The inverse of a zero rate is zero.
+func (r Rate) Inverse() Rate {
+	if r == 0 {
+		return 0
+	}
+	return Rate(divRound(rateScaleFactor*rateScaleFactor, int64(r)))
+}
+
+// Convert multiplies the amount by the rate, rounding half away from zero at
+// full Scale. Round the result to the target currency afterwards.
+func (a Amount) Convert(rate Rate) Amount {
+	n := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(rate)))
+	d := big.NewInt(rateScaleFactor)
+	q, rem := new(big.Int).QuoRem(n, d, new(big.Int))
+	if rem.Abs(rem).Lsh(rem, 1).Cmp(d) >= 0 {
+		if n.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return Amount(q.Int64())
+}
+
+// MarshalJSON writes the rate as a JSON number (e.g. 3.6725)
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number or string exactly, without going through float64
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*r = 0
+		return nil
+	}
+	parsed, err := ParseRate(strings.Trim(s, `"`))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner for NUMERIC columns
+func (r *Rate) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return fmt.Errorf("%w: cannot scan NULL (use *money.Rate or COALESCE)", ErrInvalidRate)
+	case string:
+		parsed, err := ParseRate(v)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+	case []byte:
+		parsed, err := ParseRate(string(v))
+		if err != nil {
+			return err
+		}
+		*r = parsed
+	case int64:
+		*r = Rate(v * rateScaleFactor)
+	case float64:
+		parsed, err := ParseRate(strconv.FormatFloat(v, 'f', RateScale, 64))
+		if err != nil {
+			return err
+		}
+		*r = parsed
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidRate, src)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer, sending the rate as an exact decimal string
+func (r Rate) Value() (driver.Value, error) {
+	return formatDecimal(int64(r), RateScale), nil
+}
@@ -0,0 +1,152 @@
+// backend/pkg/money/rate_test.go
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Rate
+		wantErr bool
+	}{
+		{input: "3.6725", want: 367250000},
+		{input: "0.04502", want: 4502000},
+		{input: "1", want: One},
+		{input: "0.00000001", want: 1},
+		{input: "3.672500000000", want: 367250000},
+		{input: "", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "0.000000001", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseRate(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRate) {
+					t.Fatalf("ParseRate(%q) error = %v, want ErrInvalidRate", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRate(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRate(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateString(t *testing.T) {
+	tests := []struct {
+		rate Rate
+		want string
+	}{
+		{367250000, "3.6725"},
+		{One, "1.0"},
+		{1, "0.00000001"},
+		{4502000, "0.04502"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.rate.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateInverse(t *testing.T) {
+	tests := []struct {
+		rate string
+		want string
+	}{
+		{"3.6725", "0.27229408"},
+		{"1", "1"},
+		{"4", "0.25"},
+		{"0", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rate, func(t *testing.T) {
+			if got := MustParseRate(tt.rate).Inverse(); got != MustParseRate(tt.want) {
+				t.Errorf("Inverse() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		rate   string
+		want   string
+	}{
+		{"exact", "100.00", "3.6725", "367.25"},
+		{"rounds at full scale", "33.33", "3.6725", "122.4044"},
+		{"negative", "-33.33", "3.6725", "-122.4044"},
+		{"half unit rounds away from zero", "0.0001", "0.5", "0.0001"},
+		{"negative half unit rounds away from zero", "-0.0001", "0.5", "-0.0001"},
+		{"identity", "1234.5678", "1", "1234.5678"},
+		{"large amount does not overflow", "900000000000.00", "3.6725", "3305250000000.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MustParse(tt.amount).Convert(MustParseRate(tt.rate))
+			if got != MustParse(tt.want) {
+				t.Errorf("Convert(%s) = %s, want %s", tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateScanValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Rate
+		wantErr bool
+	}{
+		{name: "numeric string", src: "3.67250000", want: 367250000},
+		{name: "bytes", src: []byte("0.04502000"), want: 4502000},
+		{name: "integer", src: int64(2), want: 2 * One},
+		{name: "float", src: 3.6725, want: 367250000},
+		{name: "null", src: nil, wantErr: true},
+		{name: "unsupported type", src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Rate
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRate) {
+					t.Fatalf("Scan(%v) error = %v, want ErrInvalidRate", tt.src, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) error = %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%v) = %d, want %d", tt.src, got, tt.want)
+			}
+
+			value, err := got.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			var back Rate
+			if err := back.Scan(value); err != nil || back != got {
+				t.Errorf("Scan(Value()) = %s, %v; want %s", back, err, got)
+			}
+		})
+	}
+}
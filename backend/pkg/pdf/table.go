@@ -0,0 +1,210 @@
+// backend/pkg/pdf/table.go
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Page geometry in points: landscape A4 with half-inch margins
+const (
+	pageWidth  = 842.0
+	pageHeight = 595.0
+	margin     = 36.0
+
+	fontSize   = 8.0
+	titleSize  = 12.0
+	leading    = 10.0
+	charWidth  = fontSize * 0.6 // Courier glyphs are 600/1000 em wide
+	columnGap  = 2              // Characters between columns
+	maxColumn  = 40             // Widest a column grows before cells are cut
+	truncation = "~"
+)
+
+var numericCell = regexp.MustCompile(`^\(?-?[0-9,]+(\.[0-9]+)?\)?$`)
+
+// RenderTable lays out a titled table on as many landscape A4 pages as it
+// needs, repeating the header on each page. Text is set in Courier so columns
+// line up; numbers are right aligned. Characters outside Latin-1 print as '?'.
+func RenderTable(title string, subtitle string, header []string, rows [][]string) ([]byte, error) {
+	if len(header) == 0 {
+		return nil, fmt.Errorf("table has no columns")
+	}
+
+	widths := columnWidths(header, rows)
+	headerLine := formatRow(header, widths, false)
+	rule := strings.Repeat("-", len(headerLine))
+
+	// Rows per page after the title block and the header
+	top := pageHeight - margin - titleSize - leading
+	if subtitle != "" {
+		top -= leading
+	}
+	perPage := int((top-margin-leading)/leading) - 2
+	if perPage < 1 {
+		perPage = 1
+	}
+
+	pageCount := (len(rows) + perPage - 1) / perPage
+	if pageCount == 0 {
+		pageCount = 1
+	}
+
+	var pages []string
+	for p := 0; p < pageCount; p++ {
+		var content strings.Builder
+		y := pageHeight - margin - titleSize
+		writeText(&content, "F2", titleSize, margin, y, title)
+		if subtitle != "" {
+			y -= leading + 2
+			writeText(&content, "F1", fontSize, margin, y, subtitle)
+		}
+
+		y -= leading * 2
+		writeText(&content, "F2", fontSize, margin, y, headerLine)
+		y -= leading
+		writeText(&content, "F1", fontSize, margin, y, rule)
+
+		end := (p + 1) * perPage
+		if end > len(rows) {
+			end = len(rows)
+		}
+		for _, row := range rows[p*perPage : end] {
+			y -= leading
+			writeText(&content, "F1", fontSize, margin, y, formatRow(row, widths, true))
+		}
+
+		footer := fmt.Sprintf("Page %d of %d", p+1, pageCount)
+		writeText(&content, "F1", fontSize, pageWidth-margin-float64(len(footer))*charWidth, margin/2, footer)
+		pages = append(pages, content.String())
+	}
+
+	return assemble(pages), nil
+}
+
+// columnWidths sizes each column to its widest cell, then narrows the widest
+// columns until the table fits the page width
+func columnWidths(header []string, rows [][]string) []int {
+	widths := make([]int, len(header))
+	for i, h := range header {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+	for _, row := range rows {
+		for i := 0; i < len(row) && i < len(widths); i++ {
+			if n := utf8.RuneCountInString(row[i]); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	for i := range widths {
+		if widths[i] > maxColumn {
+			widths[i] = maxColumn
+		}
+	}
+
+	usable := pageWidth - 2*margin
+	available := int(usable / charWidth)
+	for {
+		total := columnGap * (len(widths) - 1)
+		widest := 0
+		for i, w := range widths {
+			total += w
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if total <= available || widths[widest] <= 4 {
+			return widths
+		}
+		widths[widest]--
+	}
+}
+
+// formatRow pads or cuts each cell to its column width
+func formatRow(cells []string, widths []int, alignNumbers bool) string {
+	parts := make([]string, len(widths))
+	for i, w := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		runes := []rune(cell)
+		if len(runes) > w {
+			cell = string(runes[:w-len(truncation)]) + truncation
+			runes = []rune(cell)
+		}
+		pad := strings.Repeat(" ", w-len(runes))
+		if alignNumbers && numericCell.MatchString(cell) {
+			parts[i] = pad + cell
+		} else {
+			parts[i] = cell + pad
+		}
+	}
+	return strings.TrimRight(strings.Join(parts, strings.Repeat(" ", columnGap)), " ")
+}
+
+// writeText appends a text-showing operator for one line
+func writeText(b *strings.Builder, font string, size, x, y float64, text string) {
+	fmt.Fprintf(b, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escape(text))
+}
+
+// escape encodes text as a PDF literal string in WinAnsiEncoding
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 32 && r < 127:
+			b.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// assemble writes the document objects, cross-reference table and trailer
+func assemble(pages []string) []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1-4: catalog, page tree, fonts. Each page then takes two objects.
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, content := range pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}